@@ -3,22 +3,37 @@ package app
 import (
 	"errors"
 
+	corestore "cosmossdk.io/core/store"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 
+	pokerante "onchainpoker/apps/cosmos/x/poker/ante"
+
 	ibcante "github.com/cosmos/ibc-go/v10/modules/core/ante"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 )
 
-// AnteHandlerOptions extends the SDK's default ante handler options with IBC.
+// AnteHandlerOptions extends the SDK's default ante handler options with IBC,
+// the gameplay fee policy and the poker/dealer circuit breaker.
 type AnteHandlerOptions struct {
 	ante.HandlerOptions
 	IBCKeeper *ibckeeper.Keeper
+
+	DealerKeeper         pokerante.DealerKeeper
+	PokerKeeper          pokerante.PokerKeeper
+	AuthzKeeper          pokerante.AuthzKeeper
+	GameplayStoreService corestore.TransientStoreService
+
+	PauseKeeper pokerante.PauseKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that includes the standard SDK
 // decorators plus the IBC RedundantRelayDecorator (rejects already-processed
-// IBC relay messages to prevent gas griefing).
+// IBC relay messages to prevent gas griefing). Fees are deducted for every
+// transaction except gameplay ones, which are rate limited instead (see
+// x/poker/ante). Messages paused by the x/poker circuit breaker are rejected
+// before any fee is charged.
 func NewAnteHandler(options AnteHandlerOptions) (sdk.AnteHandler, error) {
 	if options.AccountKeeper == nil {
 		return nil, errors.New("account keeper is required for ante builder")
@@ -29,14 +44,37 @@ func NewAnteHandler(options AnteHandlerOptions) (sdk.AnteHandler, error) {
 	if options.SignModeHandler == nil {
 		return nil, errors.New("sign mode handler is required for ante builder")
 	}
+	if options.DealerKeeper == nil {
+		return nil, errors.New("dealer keeper is required for ante builder")
+	}
+	if options.PokerKeeper == nil {
+		return nil, errors.New("poker keeper is required for ante builder")
+	}
+	if options.AuthzKeeper == nil {
+		return nil, errors.New("authz keeper is required for ante builder")
+	}
+	if options.GameplayStoreService == nil {
+		return nil, errors.New("gameplay store service is required for ante builder")
+	}
+	if options.PauseKeeper == nil {
+		return nil, errors.New("pause keeper is required for ante builder")
+	}
 
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
+		pokerante.NewCircuitBreakerDecorator(options.PauseKeeper),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		pokerante.NewGameplayFeeDecorator(
+			ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+			options.DealerKeeper,
+			options.PokerKeeper,
+			options.AuthzKeeper,
+			options.GameplayStoreService,
+		),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
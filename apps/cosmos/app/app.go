@@ -10,8 +10,11 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/rootmulti"
 	storetypes "cosmossdk.io/store/types"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	appparams "onchainpoker/apps/cosmos/app/params"
+	dealerkeeper "onchainpoker/apps/cosmos/x/dealer/keeper"
+	pokerante "onchainpoker/apps/cosmos/x/poker/ante"
 	pokerkeeper "onchainpoker/apps/cosmos/x/poker/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -23,13 +26,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
@@ -68,15 +78,23 @@ type OcpApp struct {
 	AccountKeeper         authkeeper.AccountKeeper
 	BankKeeper            bankkeeper.BaseKeeper
 	PokerKeeper           pokerkeeper.Keeper
+	DealerKeeper          dealerkeeper.Keeper
 	StakingKeeper         *stakingkeeper.Keeper
 	SlashingKeeper        slashingkeeper.Keeper
 	DistrKeeper           distrkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
+	AuthzKeeper           authzkeeper.Keeper
+	FeeGrantKeeper        feegrantkeeper.Keeper
+	GovKeeper             *govkeeper.Keeper
+	UpgradeKeeper         *upgradekeeper.Keeper
 
 	// IBC keepers (manually wired — ibc-go v10 does not support depinject).
 	IBCKeeper      *ibckeeper.Keeper
 	TransferKeeper *ibctransferkeeper.Keeper
+
+	// simulation manager
+	sm *module.SimulationManager
 }
 
 func init() {
@@ -124,11 +142,16 @@ func NewOcpApp(
 		&app.AccountKeeper,
 		&app.BankKeeper,
 		&app.PokerKeeper,
+		&app.DealerKeeper,
 		&app.StakingKeeper,
 		&app.SlashingKeeper,
 		&app.DistrKeeper,
 		&app.EvidenceKeeper,
 		&app.ConsensusParamsKeeper,
+		&app.AuthzKeeper,
+		&app.FeeGrantKeeper,
+		&app.GovKeeper,
+		&app.UpgradeKeeper,
 	); err != nil {
 		panic(err)
 	}
@@ -143,14 +166,14 @@ func NewOcpApp(
 		panic(err)
 	}
 
-	// IBC authority — set to the IBC module address so nobody can submit
-	// governance-style IBC messages (chain has no x/gov).
-	ibcAuthority := authtypes.NewModuleAddress(ibcexported.ModuleName).String()
+	// IBC authority — x/gov, so client recovery and other governance-style
+	// IBC messages go through proposals.
+	ibcAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		app.appCodec,
 		runtime.NewKVStoreService(ibcStoreKey),
-		noopUpgradeKeeper{sentinel: 1},
+		app.UpgradeKeeper,
 		ibcAuthority,
 	)
 
@@ -200,15 +223,38 @@ func NewOcpApp(
 
 	// ── end IBC ──
 
-	// Custom ante handler (includes IBC RedundantRelayDecorator).
+	// Per-block counters for the gameplay rate limit (see x/poker/ante).
+	gameplayStoreKey := storetypes.NewTransientStoreKey(pokerante.TransientStoreKey)
+	if err := app.RegisterStores(gameplayStoreKey); err != nil {
+		panic(err)
+	}
+
+	// Simulation manager: every module implementing AppModuleSimulation takes
+	// part; x/auth is overridden to get random genesis accounts.
+	app.sm = module.NewSimulationManagerFromAppModules(
+		app.ModuleManager.Modules,
+		map[string]module.AppModuleSimulation{
+			authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, nil),
+		},
+	)
+	app.sm.RegisterStoreDecoders()
+
+	// Custom ante handler (includes IBC RedundantRelayDecorator and the
+	// gameplay fee policy).
 	anteHandler, err := NewAnteHandler(AnteHandlerOptions{
 		HandlerOptions: ante.HandlerOptions{
 			AccountKeeper:   app.AccountKeeper,
 			BankKeeper:      app.BankKeeper,
 			SignModeHandler: app.txConfig.SignModeHandler(),
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			FeegrantKeeper:  app.FeeGrantKeeper,
 		},
-		IBCKeeper: app.IBCKeeper,
+		IBCKeeper:            app.IBCKeeper,
+		DealerKeeper:         app.DealerKeeper,
+		PokerKeeper:          app.PokerKeeper,
+		AuthzKeeper:          app.AuthzKeeper,
+		GameplayStoreService: runtime.NewTransientStoreService(gameplayStoreKey),
+		PauseKeeper:          app.PokerKeeper,
 	})
 	if err != nil {
 		panic(err)
@@ -237,9 +283,6 @@ func NewOcpApp(
 	return app
 }
 
-// RegisterUpgradeHandlers wires on-chain upgrade handlers (placeholder for now).
-func (app *OcpApp) RegisterUpgradeHandlers() {}
-
 func (app *OcpApp) LegacyAmino() *codec.LegacyAmino { return app.legacyAmino }
 func (app *OcpApp) AppCodec() codec.Codec           { return app.appCodec }
 func (app *OcpApp) InterfaceRegistry() codectypes.InterfaceRegistry {
@@ -247,6 +290,16 @@ func (app *OcpApp) InterfaceRegistry() codectypes.InterfaceRegistry {
 }
 func (app *OcpApp) TxConfig() client.TxConfig { return app.txConfig }
 
+// BlockedAddresses returns the module account addresses that cannot receive
+// funds via bank sends.
+func BlockedAddresses() map[string]bool {
+	blocked := make(map[string]bool, len(blockedModuleAccounts))
+	for _, name := range blockedModuleAccounts {
+		blocked[authtypes.NewModuleAddress(name).String()] = true
+	}
+	return blocked
+}
+
 func (app *OcpApp) kvStoreKeys() map[string]*storetypes.KVStoreKey {
 	keys := make(map[string]*storetypes.KVStoreKey)
 	for _, k := range app.GetStoreKeys() {
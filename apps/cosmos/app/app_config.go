@@ -4,17 +4,23 @@ import (
 	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
 	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
 	authmodulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
+	authzmodulev1 "cosmossdk.io/api/cosmos/authz/module/v1"
 	bankmodulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
 	consensusmodulev1 "cosmossdk.io/api/cosmos/consensus/module/v1"
 	distrmodulev1 "cosmossdk.io/api/cosmos/distribution/module/v1"
 	evidencemodulev1 "cosmossdk.io/api/cosmos/evidence/module/v1"
+	feegrantmodulev1 "cosmossdk.io/api/cosmos/feegrant/module/v1"
 	genutilmodulev1 "cosmossdk.io/api/cosmos/genutil/module/v1"
+	govmodulev1 "cosmossdk.io/api/cosmos/gov/module/v1"
 	slashingmodulev1 "cosmossdk.io/api/cosmos/slashing/module/v1"
 	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
 	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
+	upgrademodulev1 "cosmossdk.io/api/cosmos/upgrade/module/v1"
 	vestingmodulev1 "cosmossdk.io/api/cosmos/vesting/module/v1"
 	"cosmossdk.io/core/appconfig"
 	"cosmossdk.io/depinject"
+	_ "cosmossdk.io/x/upgrade" // import for side-effects
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	"onchainpoker/apps/cosmos/app/params"
 	dealermodulev1 "onchainpoker/apps/cosmos/x/dealer/module/v1"
@@ -32,6 +38,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/vesting" // import for side-effects
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	_ "github.com/cosmos/cosmos-sdk/x/authz/module" // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/bank" // import for side-effects
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/cosmos-sdk/x/consensus" // import for side-effects
@@ -40,8 +48,12 @@ import (
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	_ "github.com/cosmos/cosmos-sdk/x/evidence" // import for side-effects
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	_ "github.com/cosmos/cosmos-sdk/x/feegrant/module" // import for side-effects
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	_ "github.com/cosmos/cosmos-sdk/x/gov" // import for side-effects
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	_ "github.com/cosmos/cosmos-sdk/x/slashing" // import for side-effects
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	_ "github.com/cosmos/cosmos-sdk/x/staking" // import for side-effects
@@ -60,8 +72,13 @@ var (
 		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		// Game escrow module account (holds table buy-ins/bonds).
 		{Account: pokertypes.ModuleName},
+		// Collects slashed dealer stake to pay reporters and affected players,
+		// burning the remainder.
+		{Account: dealertypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		// ICS-20 transfer module needs Minter + Burner for cross-chain tokens.
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
+		// Holds proposal deposits; burns them on veto.
+		{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 	}
 
 	// blockedModuleAccounts is the list of module account names that cannot
@@ -72,6 +89,7 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		pokertypes.ModuleName,
+		dealertypes.ModuleName,
 	}
 
 	ModuleConfig = []*appv1alpha1.ModuleConfig{
@@ -79,7 +97,10 @@ var (
 			Name: runtime.ModuleName,
 			Config: appconfig.WrapAny(&runtimev1alpha1.Module{
 				AppName: params.AppName,
+				// upgrade runs first so a scheduled upgrade halts the old
+				// binary before any other state transition in that block.
 				PreBlockers: []string{
+					upgradetypes.ModuleName,
 					authtypes.ModuleName,
 				},
 				// During begin block slashing happens after distr.BeginBlocker so that
@@ -93,12 +114,16 @@ var (
 					ibcexported.ModuleName,
 					ibctransfertypes.ModuleName,
 					dealertypes.ModuleName,
+					authz.ModuleName,
 				},
 				EndBlockers: []string{
 					banktypes.ModuleName,
+					govtypes.ModuleName,
 					stakingtypes.ModuleName,
+					feegrant.ModuleName,
 					ibcexported.ModuleName,
 					ibctransfertypes.ModuleName,
+					pokertypes.ModuleName,
 				},
 				SkipStoreKeys: []string{
 					"tx",
@@ -118,8 +143,12 @@ var (
 					ibcexported.ModuleName,
 					ibctransfertypes.ModuleName,
 					dealertypes.ModuleName,
+					govtypes.ModuleName,
 					genutiltypes.ModuleName,
 					evidencetypes.ModuleName,
+					authz.ModuleName,
+					feegrant.ModuleName,
+					upgradetypes.ModuleName,
 					vestingtypes.ModuleName,
 				},
 				ExportGenesis: []string{
@@ -133,8 +162,12 @@ var (
 					ibcexported.ModuleName,
 					ibctransfertypes.ModuleName,
 					dealertypes.ModuleName,
+					govtypes.ModuleName,
 					genutiltypes.ModuleName,
 					evidencetypes.ModuleName,
+					authz.ModuleName,
+					feegrant.ModuleName,
+					upgradetypes.ModuleName,
 					vestingtypes.ModuleName,
 				},
 			}),
@@ -200,6 +233,28 @@ var (
 			Name:   consensustypes.ModuleName,
 			Config: appconfig.WrapAny(&consensusmodulev1.Module{}),
 		},
+		{
+			// Lets players delegate in-game messages to session keys
+			// (see pokertypes.SessionAuthorization).
+			Name:   authz.ModuleName,
+			Config: appconfig.WrapAny(&authzmodulev1.Module{}),
+		},
+		{
+			Name:   feegrant.ModuleName,
+			Config: appconfig.WrapAny(&feegrantmodulev1.Module{}),
+		},
+		{
+			// Authority for module params (e.g. dealer MsgUpdateParams) and IBC
+			// client/channel governance.
+			Name:   govtypes.ModuleName,
+			Config: appconfig.WrapAny(&govmodulev1.Module{}),
+		},
+		{
+			// Scheduled software upgrades (MsgSoftwareUpgrade via x/gov) and
+			// IBC client upgrades. Handlers live in app/upgrades.go.
+			Name:   upgradetypes.ModuleName,
+			Config: appconfig.WrapAny(&upgrademodulev1.Module{}),
+		},
 	}
 
 	// AppConfig is application configuration (used by depinject).
@@ -207,6 +262,13 @@ var (
 		appconfig.Compose(&appv1alpha1.Config{
 			Modules: ModuleConfig,
 		}),
+		// x/staking burns through the dealer wrapper so stake slashed by
+		// x/dealer reaches the dealer module account instead of being burned.
+		depinject.BindInterfaceInModule(
+			stakingtypes.ModuleName,
+			"github.com/cosmos/cosmos-sdk/x/staking/types/types.BankKeeper",
+			"onchainpoker/apps/cosmos/x/dealer/keeper/keeper.StakingBankKeeper",
+		),
 		depinject.Supply(
 			// Custom module basics
 			map[string]module.AppModuleBasic{
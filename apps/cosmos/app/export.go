@@ -46,5 +46,5 @@ func (app *OcpApp) ExportAppStateAndValidators(
 	}, err
 }
 
-// SimulationManager implements runtime.AppI. We don't currently run the SDK simulator.
-func (app *OcpApp) SimulationManager() *module.SimulationManager { return nil }
+// SimulationManager implements runtime.AppI; see sim_test.go.
+func (app *OcpApp) SimulationManager() *module.SimulationManager { return app.sm }
@@ -0,0 +1,164 @@
+//go:build sims
+
+package app
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/testutil/simsx"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	pokerkeeper "onchainpoker/apps/cosmos/x/poker/keeper"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// Run with:
+//
+//	go test ./app -tags sims -run TestFullAppSimulation -Enabled=true -NumBlocks=200 -BlockSize=50 -Commit=true -v
+//	go test ./app -tags sims -run TestAppImportExport -Enabled=true -NumBlocks=100 -Commit=true -v
+//	go test ./app -tags sims -run TestAppStateDeterminism -Enabled=true -NumBlocks=50 -Commit=true -v
+
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+var (
+	exportWithValidatorSet []string
+	exportAllModules       []string
+)
+
+func setupStateFactory(app *OcpApp) simsx.SimStateFactory {
+	return simsx.SimStateFactory{
+		Codec:         app.AppCodec(),
+		AppStateFn:    simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		BlockedAddr:   BlockedAddresses(),
+		AccountSource: app.AccountKeeper,
+		BalanceSource: app.BankKeeper,
+	}
+}
+
+func TestFullAppSimulation(t *testing.T) {
+	simsx.Run(t, NewOcpApp, setupStateFactory)
+}
+
+func TestAppImportExport(t *testing.T) {
+	simsx.Run(t, NewOcpApp, setupStateFactory, func(tb testing.TB, ti simsx.TestInstance[*OcpApp], _ []simtypes.Account) {
+		tb.Helper()
+		app := ti.App
+
+		tb.Log("exporting genesis...")
+		exported, err := app.ExportAppStateAndValidators(false, exportWithValidatorSet, exportAllModules)
+		require.NoError(tb, err)
+
+		tb.Log("importing genesis...")
+		newApp := simsx.NewSimulationAppInstance(tb, ti.Cfg, NewOcpApp).App
+
+		var genesisState map[string]json.RawMessage
+		require.NoError(tb, json.Unmarshal(exported.AppState, &genesisState))
+		ctxB := newApp.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+		if _, err := newApp.ModuleManager.InitGenesis(ctxB, genesisState); err != nil {
+			if strings.Contains(err.Error(), "validator set is empty after InitGenesis") {
+				tb.Skip("skipping: all validators have been unbonded")
+			}
+			require.NoError(tb, err)
+		}
+		require.NoError(tb, newApp.StoreConsensusParams(ctxB, exported.ConsensusParams))
+
+		tb.Log("comparing stores...")
+		skipPrefixes := map[string][][]byte{
+			stakingtypes.StoreKey: {
+				stakingtypes.UnbondingQueueKey, stakingtypes.RedelegationQueueKey, stakingtypes.ValidatorQueueKey,
+				stakingtypes.HistoricalInfoKey, stakingtypes.UnbondingIDKey, stakingtypes.UnbondingIndexKey,
+				stakingtypes.UnbondingTypeKey, stakingtypes.ValidatorUpdatesKey,
+			},
+			slashingtypes.StoreKey: {slashingtypes.ValidatorMissedBlockBitmapKeyPrefix},
+			// Table cooldown bookkeeping is not part of x/poker genesis.
+			pokertypes.StoreKey: {pokerkeeper.LastHandEndedHeightKeyPrefix},
+		}
+		assertEqualStores(tb, app, newApp, skipPrefixes)
+	})
+}
+
+func assertEqualStores(tb testing.TB, app, newApp *OcpApp, skipPrefixes map[string][][]byte) {
+	tb.Helper()
+	ctxA := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	ctxB := newApp.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	storeKeys := app.GetStoreKeys()
+	require.NotEmpty(tb, storeKeys)
+	for _, keyA := range storeKeys {
+		// Only compare KV stores.
+		if _, ok := keyA.(*storetypes.KVStoreKey); !ok {
+			continue
+		}
+		name := keyA.Name()
+		keyB := newApp.UnsafeFindStoreKey(name)
+		require.NotNil(tb, keyB, "store %s missing from imported app", name)
+
+		failedKVAs, failedKVBs := simtestutil.DiffKVStores(ctxA.KVStore(keyA), ctxB.KVStore(keyB), skipPrefixes[name])
+		require.Equal(tb, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare in %s", name)
+
+		tb.Logf("compared %d different key/value pairs in %s", len(failedKVAs), name)
+		if !assert.Equal(tb, 0, len(failedKVAs), simtestutil.GetSimulationLog(name, app.SimulationManager().StoreDecoders, failedKVAs, failedKVBs)) {
+			for _, v := range failedKVAs {
+				tb.Logf("store mismatch: %q", v)
+			}
+			tb.FailNow()
+		}
+	}
+}
+
+// TestAppStateDeterminism runs every seed several times and requires
+// identical app hashes across runs.
+func TestAppStateDeterminism(t *testing.T) {
+	const numTimesToRunPerSeed = 3
+
+	var seeds []int64
+	if s := simcli.NewConfigFromFlags().Seed; s != simcli.DefaultSeedValue {
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			seeds = append(seeds, s)
+		}
+	} else {
+		for i := 0; i < 3; i++ {
+			seed := rand.Int63()
+			for j := 0; j < numTimesToRunPerSeed; j++ {
+				seeds = append(seeds, seed)
+			}
+		}
+	}
+
+	var mx sync.Mutex
+	appHashResults := make(map[int64][][]byte)
+	captureAndCheckHash := func(tb testing.TB, ti simsx.TestInstance[*OcpApp], _ []simtypes.Account) {
+		tb.Helper()
+		seed, appHash := ti.Cfg.Seed, ti.App.LastCommitID().Hash
+
+		mx.Lock()
+		otherHashes := appHashResults[seed]
+		if len(otherHashes) < numTimesToRunPerSeed-1 {
+			appHashResults[seed] = append(otherHashes, appHash)
+		} else {
+			delete(appHashResults, seed)
+		}
+		mx.Unlock()
+
+		for _, h := range otherHashes {
+			require.Equal(tb, h, appHash, "non-determinism in seed %d", seed)
+		}
+	}
+	simsx.RunWithSeeds(t, NewOcpApp, setupStateFactory, seeds, []byte{}, captureAndCheckHash)
+}
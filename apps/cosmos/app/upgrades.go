@@ -0,0 +1,67 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Upgrade is a named software upgrade. Name must match the name of the
+// MsgSoftwareUpgrade plan that schedules it.
+type Upgrade struct {
+	Name string
+
+	// CreateUpgradeHandler builds the handler run at the plan height.
+	// Use defaultUpgradeHandler unless the upgrade needs extra state changes.
+	CreateUpgradeHandler func(*module.Manager, module.Configurator) upgradetypes.UpgradeHandler
+
+	// StoreUpgrades lists store keys added, renamed or deleted by the new
+	// binary. They are applied when the node restarts at the plan height.
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// Upgrades is the registry of named upgrades this binary can apply. Append
+// new entries; do not remove old ones while nodes may still sync through them.
+var Upgrades = []Upgrade{}
+
+// defaultUpgradeHandler runs every module's registered consensus migrations
+// (e.g. x/poker Migrate1to2).
+func defaultUpgradeHandler(mm *module.Manager, cfg module.Configurator) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, cfg, fromVM)
+	}
+}
+
+// RegisterUpgradeHandlers registers the handlers in Upgrades with x/upgrade
+// and, if this binary is starting at a scheduled upgrade height, installs
+// the store loader for that upgrade's added/removed stores. It must run
+// before app.Load.
+func (app *OcpApp) RegisterUpgradeHandlers() {
+	seen := make(map[string]bool, len(Upgrades))
+	for _, u := range Upgrades {
+		if seen[u.Name] {
+			panic(fmt.Sprintf("duplicate upgrade name %q", u.Name))
+		}
+		seen[u.Name] = true
+		app.UpgradeKeeper.SetUpgradeHandler(u.Name, u.CreateUpgradeHandler(app.ModuleManager, app.Configurator()))
+	}
+
+	info, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Errorf("failed to read upgrade info from disk: %w", err))
+	}
+	if info.Name == "" || app.UpgradeKeeper.IsSkipHeight(info.Height) {
+		return
+	}
+	for _, u := range Upgrades {
+		if u.Name == info.Name {
+			storeUpgrades := u.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(info.Height, &storeUpgrades))
+			return
+		}
+	}
+}
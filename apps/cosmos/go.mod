@@ -29,7 +29,10 @@ require (
 	google.golang.org/grpc v1.79.1
 )
 
-require github.com/cometbft/cometbft v0.39.0-beta.2
+require (
+	github.com/cometbft/cometbft v0.39.0-beta.2
+	google.golang.org/protobuf v1.36.11
+)
 
 require (
 	cosmossdk.io/collections v1.4.0 // indirect
@@ -210,7 +213,6 @@ require (
 	golang.org/x/text v0.34.0 // indirect
 	google.golang.org/genproto v0.0.0-20260114163908-3f89685c29c3 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20260128011058-8636f8732409 // indirect
-	google.golang.org/protobuf v1.36.11 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	gotest.tools/v3 v3.5.2 // indirect
 	nhooyr.io/websocket v1.8.17 // indirect
@@ -0,0 +1,153 @@
+package ocpcrypto
+
+import "fmt"
+
+// Batch verification folds every verification equation of n proofs into a
+// single multi-scalar multiplication: each equation lhs == rhs becomes
+// lhs - rhs == 0 and is scaled by its own power of a challenge z, so the sum
+// vanishes for all z only if every equation holds. z is derived from a
+// transcript over all statements and proofs, so batches stay deterministic
+// (as on-chain verification must be) while a forged proof still passes with
+// probability about (number of equations)/q.
+//
+// A failed batch does not say which proof is bad; callers that need to
+// attribute a fault fall back to the single-proof verifiers.
+
+const batchVerifyDomain = "ocp/v1/batch-verify"
+
+// ChaumPedersenStatement is one ChaumPedersenVerify input.
+type ChaumPedersenStatement struct {
+	Y     Point
+	C1    Point
+	D     Point
+	Proof ChaumPedersenProof
+}
+
+// EncShareStatement is one EncShareVerify input.
+type EncShareStatement struct {
+	Y     Point
+	C1    Point
+	PKP   Point
+	U     Point
+	V     Point
+	Proof EncShareProof
+}
+
+// batchWeights returns m successive powers z, z^2, ..., z^m of a challenge
+// bound to kind and every element of items.
+func batchWeights(kind string, items [][]byte, m int) ([]Scalar, error) {
+	tr := NewTranscript(batchVerifyDomain)
+	_ = tr.AppendMessage("kind", []byte(kind))
+	for _, item := range items {
+		_ = tr.AppendMessage("item", item)
+	}
+	z, err := tr.ChallengeScalar("z")
+	if err != nil {
+		return nil, err
+	}
+	if z.IsZero() {
+		return nil, fmt.Errorf("batch verify: zero weight")
+	}
+	out := make([]Scalar, m)
+	w := z
+	for i := range out {
+		out[i] = w
+		w = ScalarMul(w, z)
+	}
+	return out, nil
+}
+
+// ChaumPedersenBatchVerify reports whether every statement's proof would be
+// accepted by ChaumPedersenVerify.
+func ChaumPedersenBatchVerify(stmts []ChaumPedersenStatement) (bool, error) {
+	if len(stmts) == 0 {
+		return false, fmt.Errorf("chaum-pedersen: empty batch")
+	}
+	items := make([][]byte, len(stmts))
+	for i, st := range stmts {
+		items[i] = concatBytes(st.Y.Bytes(), st.C1.Bytes(), st.D.Bytes(), EncodeChaumPedersenProof(st.Proof))
+	}
+	w, err := batchWeights(chaumPedersenDomain, items, 2*len(stmts))
+	if err != nil {
+		return false, err
+	}
+
+	// For each i with weights a, b:
+	//   a*(s*G - A - e*Y) + b*(s*C1 - B - e*D) == 0
+	gCoeff := ScalarZero()
+	scalars := make([]Scalar, 0, 1+5*len(stmts))
+	points := make([]Point, 0, 1+5*len(stmts))
+	for i, st := range stmts {
+		e, err := chaumPedersenChallenge(st.Y, st.C1, st.D, st.Proof)
+		if err != nil {
+			return false, err
+		}
+		a, b := w[2*i], w[2*i+1]
+		gCoeff = ScalarAdd(gCoeff, ScalarMul(a, st.Proof.S))
+		scalars = append(scalars,
+			ScalarNeg(a),
+			ScalarNeg(ScalarMul(a, e)),
+			ScalarMul(b, st.Proof.S),
+			ScalarNeg(b),
+			ScalarNeg(ScalarMul(b, e)),
+		)
+		points = append(points, st.Proof.A, st.Y, st.C1, st.Proof.B, st.D)
+	}
+	scalars = append(scalars, gCoeff)
+	points = append(points, PointBase())
+
+	sum, err := MultiScalarMulVarTime(scalars, points)
+	if err != nil {
+		return false, err
+	}
+	return PointEq(sum, PointZero()), nil
+}
+
+// EncShareBatchVerify reports whether every statement's proof would be
+// accepted by EncShareVerify.
+func EncShareBatchVerify(stmts []EncShareStatement) (bool, error) {
+	if len(stmts) == 0 {
+		return false, fmt.Errorf("encshare: empty batch")
+	}
+	items := make([][]byte, len(stmts))
+	for i, st := range stmts {
+		items[i] = concatBytes(st.Y.Bytes(), st.C1.Bytes(), st.PKP.Bytes(), st.U.Bytes(), st.V.Bytes(), EncodeEncShareProof(st.Proof))
+	}
+	w, err := batchWeights(encShareDomain, items, 3*len(stmts))
+	if err != nil {
+		return false, err
+	}
+
+	// For each i with weights a, b, c:
+	//   a*(sx*G - A1 - e*Y) + b*(sr*G - A2 - e*U) + c*(sx*C1 + sr*PKP - A3 - e*V) == 0
+	gCoeff := ScalarZero()
+	scalars := make([]Scalar, 0, 1+8*len(stmts))
+	points := make([]Point, 0, 1+8*len(stmts))
+	for i, st := range stmts {
+		e, err := encShareChallenge(st.Y, st.C1, st.PKP, st.U, st.V, st.Proof)
+		if err != nil {
+			return false, err
+		}
+		a, b, c := w[3*i], w[3*i+1], w[3*i+2]
+		gCoeff = ScalarAdd(gCoeff, ScalarAdd(ScalarMul(a, st.Proof.SX), ScalarMul(b, st.Proof.SR)))
+		scalars = append(scalars,
+			ScalarNeg(a),
+			ScalarNeg(ScalarMul(a, e)),
+			ScalarNeg(b),
+			ScalarNeg(ScalarMul(b, e)),
+			ScalarMul(c, st.Proof.SX),
+			ScalarMul(c, st.Proof.SR),
+			ScalarNeg(c),
+			ScalarNeg(ScalarMul(c, e)),
+		)
+		points = append(points, st.Proof.A1, st.Y, st.Proof.A2, st.U, st.C1, st.PKP, st.Proof.A3, st.V)
+	}
+	scalars = append(scalars, gCoeff)
+	points = append(points, PointBase())
+
+	sum, err := MultiScalarMulVarTime(scalars, points)
+	if err != nil {
+		return false, err
+	}
+	return PointEq(sum, PointZero()), nil
+}
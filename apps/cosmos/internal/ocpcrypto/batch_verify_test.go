@@ -0,0 +1,120 @@
+package ocpcrypto
+
+import "testing"
+
+func batchChaumPedersenStatements(t testing.TB, n int) []ChaumPedersenStatement {
+	t.Helper()
+	out := make([]ChaumPedersenStatement, n)
+	for i := range out {
+		x := ScalarFromUint64(uint64(100 + i))
+		y := MulBase(x)
+		c1 := MulBase(ScalarFromUint64(uint64(7 + 3*i)))
+		d := MulPoint(c1, x)
+		p, err := ChaumPedersenProve(y, c1, d, x, ScalarFromUint64(uint64(55+i)))
+		if err != nil {
+			t.Fatalf("prove %d: %v", i, err)
+		}
+		out[i] = ChaumPedersenStatement{Y: y, C1: c1, D: d, Proof: p}
+	}
+	return out
+}
+
+func batchEncShareStatements(t testing.TB, n int) []EncShareStatement {
+	t.Helper()
+	pkp := MulBase(ScalarFromUint64(9))
+	x := ScalarFromUint64(5)
+	y := MulBase(x)
+	out := make([]EncShareStatement, n)
+	for i := range out {
+		r := ScalarFromUint64(uint64(20 + i))
+		c1 := MulBase(ScalarFromUint64(uint64(123 + i)))
+		u := MulBase(r)
+		v := PointAdd(MulPoint(c1, x), MulPoint(pkp, r))
+		p, err := EncShareProve(y, c1, pkp, u, v, x, r, ScalarFromUint64(uint64(11+i)), ScalarFromUint64(uint64(31+i)))
+		if err != nil {
+			t.Fatalf("prove %d: %v", i, err)
+		}
+		out[i] = EncShareStatement{Y: y, C1: c1, PKP: pkp, U: u, V: v, Proof: p}
+	}
+	return out
+}
+
+func TestChaumPedersenBatchVerify(t *testing.T) {
+	stmts := batchChaumPedersenStatements(t, 5)
+	if ok, err := ChaumPedersenBatchVerify(stmts); err != nil || !ok {
+		t.Fatalf("valid batch: ok=%v err=%v", ok, err)
+	}
+	if ok, err := ChaumPedersenBatchVerify(stmts[:1]); err != nil || !ok {
+		t.Fatalf("single-item batch: ok=%v err=%v", ok, err)
+	}
+
+	// Any one bad statement fails the whole batch.
+	bad := append([]ChaumPedersenStatement(nil), stmts...)
+	bad[3].D = PointAdd(bad[3].D, PointBase())
+	if ok, err := ChaumPedersenBatchVerify(bad); err != nil || ok {
+		t.Fatalf("bad statement: ok=%v err=%v", ok, err)
+	}
+	bad = append([]ChaumPedersenStatement(nil), stmts...)
+	bad[0].Proof.S = ScalarAdd(bad[0].Proof.S, ScalarFromUint64(1))
+	if ok, err := ChaumPedersenBatchVerify(bad); err != nil || ok {
+		t.Fatalf("bad response: ok=%v err=%v", ok, err)
+	}
+	bad = append([]ChaumPedersenStatement(nil), stmts...)
+	bad[1].Proof, bad[2].Proof = bad[2].Proof, bad[1].Proof
+	if ok, err := ChaumPedersenBatchVerify(bad); err != nil || ok {
+		t.Fatalf("swapped proofs: ok=%v err=%v", ok, err)
+	}
+
+	if _, err := ChaumPedersenBatchVerify(nil); err == nil {
+		t.Fatalf("expected error for empty batch")
+	}
+}
+
+func TestEncShareBatchVerify(t *testing.T) {
+	stmts := batchEncShareStatements(t, 4)
+	if ok, err := EncShareBatchVerify(stmts); err != nil || !ok {
+		t.Fatalf("valid batch: ok=%v err=%v", ok, err)
+	}
+
+	bad := append([]EncShareStatement(nil), stmts...)
+	bad[2].V = PointAdd(bad[2].V, PointBase())
+	if ok, err := EncShareBatchVerify(bad); err != nil || ok {
+		t.Fatalf("bad ciphertext: ok=%v err=%v", ok, err)
+	}
+	bad = append([]EncShareStatement(nil), stmts...)
+	bad[1].Proof.SR = ScalarAdd(bad[1].Proof.SR, ScalarFromUint64(1))
+	if ok, err := EncShareBatchVerify(bad); err != nil || ok {
+		t.Fatalf("bad response: ok=%v err=%v", ok, err)
+	}
+	bad = append([]EncShareStatement(nil), stmts...)
+	bad[0].PKP = MulBase(ScalarFromUint64(10))
+	if ok, err := EncShareBatchVerify(bad); err != nil || ok {
+		t.Fatalf("wrong recipient: ok=%v err=%v", ok, err)
+	}
+
+	if _, err := EncShareBatchVerify(nil); err == nil {
+		t.Fatalf("expected error for empty batch")
+	}
+}
+
+func BenchmarkEncShareVerify_18(b *testing.B) {
+	stmts := batchEncShareStatements(b, 18)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, st := range stmts {
+			if ok, err := EncShareVerify(st.Y, st.C1, st.PKP, st.U, st.V, st.Proof); err != nil || !ok {
+				b.Fatal("verify failed")
+			}
+		}
+	}
+}
+
+func BenchmarkEncShareBatchVerify_18(b *testing.B) {
+	stmts := batchEncShareStatements(b, 18)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if ok, err := EncShareBatchVerify(stmts); err != nil || !ok {
+			b.Fatal("batch verify failed")
+		}
+	}
+}
@@ -39,14 +39,18 @@ func ChaumPedersenProve(y Point, c1 Point, d Point, x Scalar, w Scalar) (ChaumPe
 	return ChaumPedersenProof{A: a, B: b, S: s}, nil
 }
 
-func ChaumPedersenVerify(y Point, c1 Point, d Point, proof ChaumPedersenProof) (bool, error) {
+func chaumPedersenChallenge(y Point, c1 Point, d Point, proof ChaumPedersenProof) (Scalar, error) {
 	tr := NewTranscript(chaumPedersenDomain)
 	_ = tr.AppendMessage("y", y.Bytes())
 	_ = tr.AppendMessage("c1", c1.Bytes())
 	_ = tr.AppendMessage("d", d.Bytes())
 	_ = tr.AppendMessage("a", proof.A.Bytes())
 	_ = tr.AppendMessage("b", proof.B.Bytes())
-	e, err := tr.ChallengeScalar("e")
+	return tr.ChallengeScalar("e")
+}
+
+func ChaumPedersenVerify(y Point, c1 Point, d Point, proof ChaumPedersenProof) (bool, error) {
+	e, err := chaumPedersenChallenge(y, c1, d, proof)
 	if err != nil {
 		return false, err
 	}
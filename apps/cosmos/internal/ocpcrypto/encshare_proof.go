@@ -47,7 +47,7 @@ func EncShareProve(Y Point, C1 Point, PKP Point, U Point, V Point, x Scalar, r S
 	return EncShareProof{A1: a1, A2: a2, A3: a3, SX: sx, SR: sr}, nil
 }
 
-func EncShareVerify(Y Point, C1 Point, PKP Point, U Point, V Point, proof EncShareProof) (bool, error) {
+func encShareChallenge(Y Point, C1 Point, PKP Point, U Point, V Point, proof EncShareProof) (Scalar, error) {
 	tr := NewTranscript(encShareDomain)
 	_ = tr.AppendMessage("Y", Y.Bytes())
 	_ = tr.AppendMessage("C1", C1.Bytes())
@@ -57,7 +57,11 @@ func EncShareVerify(Y Point, C1 Point, PKP Point, U Point, V Point, proof EncSha
 	_ = tr.AppendMessage("A1", proof.A1.Bytes())
 	_ = tr.AppendMessage("A2", proof.A2.Bytes())
 	_ = tr.AppendMessage("A3", proof.A3.Bytes())
-	e, err := tr.ChallengeScalar("e")
+	return tr.ChallengeScalar("e")
+}
+
+func EncShareVerify(Y Point, C1 Point, PKP Point, U Point, V Point, proof EncShareProof) (bool, error) {
+	e, err := encShareChallenge(Y, C1, PKP, U, V, proof)
 	if err != nil {
 		return false, err
 	}
@@ -8,6 +8,7 @@ import (
 
 var (
 	hashToScalarPrefix = []byte("OCPv1|hash_to_scalar|")
+	hashToPointPrefix  = []byte("OCPv1|hash_to_point|")
 )
 
 func updateLenBytes(h hash.Hash, b []byte) {
@@ -30,3 +31,22 @@ func HashToScalar(domainSep string, msgs ...[]byte) (Scalar, error) {
 	return ScalarFromUniformBytes(digest)
 }
 
+// HashToPoint maps a domain-separated message to a ristretto255 element with
+// no known discrete log relative to the base point, using the same framing
+// as HashToScalar and the ristretto255 one-way map over 64 uniform bytes.
+func HashToPoint(domainSep string, msgs ...[]byte) (Point, error) {
+	h := sha512.New()
+	h.Write(hashToPointPrefix)
+	updateLenBytes(h, []byte(domainSep))
+	for _, m := range msgs {
+		if m == nil {
+			return Point{}, fmt.Errorf("hashToPoint: nil msg")
+		}
+		updateLenBytes(h, m)
+	}
+	var p Point
+	if _, err := p.v.SetUniformBytes(h.Sum(nil)); err != nil {
+		return Point{}, fmt.Errorf("hashToPoint: %w", err)
+	}
+	return p, nil
+}
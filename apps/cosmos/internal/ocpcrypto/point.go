@@ -68,3 +68,19 @@ func MulPoint(p Point, k Scalar) Point {
 	return out
 }
 
+// MultiScalarMulVarTime returns sum(scalars[i] * points[i]). It runs in
+// variable time and must only be used on public inputs (e.g. in verifiers).
+func MultiScalarMulVarTime(scalars []Scalar, points []Point) (Point, error) {
+	if len(scalars) != len(points) {
+		return Point{}, fmt.Errorf("multiScalarMul: %d scalars for %d points", len(scalars), len(points))
+	}
+	ss := make([]*ristretto255.Scalar, len(scalars))
+	ps := make([]*ristretto255.Element, len(points))
+	for i := range scalars {
+		ss[i] = &scalars[i].v
+		ps[i] = &points[i].v
+	}
+	var out Point
+	out.v.VarTimeMultiScalarMult(ss, ps)
+	return out, nil
+}
@@ -0,0 +1,580 @@
+package ocpshuffle
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+)
+
+func v3TestContext(t *testing.T) []byte {
+	t.Helper()
+	ctx, err := BuildShuffleContext(7, 9, 1, "cosmosvaloper1shuffler")
+	if err != nil {
+		t.Fatalf("BuildShuffleContext: %v", err)
+	}
+	return ctx
+}
+
+func proveV3(t *testing.T, pk ocpcrypto.Point, deckIn []ocpcrypto.ElGamalCiphertext, seedByte byte, ctx []byte) ShuffleProveResult {
+	t.Helper()
+	res, err := ShuffleProveV3(pk, deckIn, ShuffleProveOpts{Seed: bytes.Repeat([]byte{seedByte}, 32), Context: ctx})
+	if err != nil {
+		t.Fatalf("prove: %v", err)
+	}
+	return res
+}
+
+func TestShuffleV3_ValidProofVerifiesAcrossDeckSizes(t *testing.T) {
+	sk := ocpcrypto.ScalarFromUint64(4242)
+	pk := ocpcrypto.MulBase(sk)
+	ctx := v3TestContext(t)
+
+	for _, n := range []int{2, 3, 10, 52} {
+		deckIn := makeDeck(pk, n, 500+uint64(n))
+		res := proveV3(t, pk, deckIn, byte(n), ctx)
+
+		if want := 1 + 2 + 2 + len(ctx) + n*64 + 2*n*32 + 5*32 + 2*n*32; len(res.ProofBytes) != want {
+			t.Fatalf("n=%d: proof length %d, want %d", n, len(res.ProofBytes), want)
+		}
+		vr := ShuffleVerify(pk, deckIn, res.ProofBytes, ctx)
+		if !vr.OK {
+			t.Fatalf("n=%d: verify failed: %s", n, vr.Error)
+		}
+		for i := range vr.DeckOut {
+			if !bytes.Equal(vr.DeckOut[i].C1.Bytes(), res.DeckOut[i].C1.Bytes()) ||
+				!bytes.Equal(vr.DeckOut[i].C2.Bytes(), res.DeckOut[i].C2.Bytes()) {
+				t.Fatalf("n=%d: deckOut[%d] differs from prover output", n, i)
+			}
+		}
+
+		// Independent cross-check: the output decrypts to a permutation of
+		// the input plaintexts.
+		seen := map[string]int{}
+		for _, ct := range deckIn {
+			seen[string(ocpcrypto.ElGamalDecrypt(sk, ct).Bytes())]++
+		}
+		for i, ct := range vr.DeckOut {
+			m := string(ocpcrypto.ElGamalDecrypt(sk, ct).Bytes())
+			if seen[m] == 0 {
+				t.Fatalf("n=%d: deckOut[%d] decrypts to a card not in the input", n, i)
+			}
+			seen[m]--
+		}
+	}
+}
+
+func TestShuffleV3_RejectsTamperedProofs(t *testing.T) {
+	pk := ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(99))
+	ctx := v3TestContext(t)
+	n := 6
+	deckIn := makeDeck(pk, n, 77)
+	res := proveV3(t, pk, deckIn, 3, ctx)
+	deckOff := 1 + 2 + 2 + len(ctx)
+
+	mutate := func(f func(b []byte)) []byte {
+		b := append([]byte(nil), res.ProofBytes...)
+		f(b)
+		return b
+	}
+	otherDeck := makeDeck(pk, n, 78)
+
+	cases := []struct {
+		name  string
+		pk    ocpcrypto.Point
+		deck  []ocpcrypto.ElGamalCiphertext
+		proof []byte
+		ctx   []byte
+	}{
+		{"swapped output cards", pk, deckIn, mutate(func(b []byte) {
+			a := append([]byte(nil), b[deckOff:deckOff+64]...)
+			copy(b[deckOff:deckOff+64], b[deckOff+64:deckOff+128])
+			copy(b[deckOff+64:deckOff+128], a)
+		}), ctx},
+		{"replaced output card", pk, deckIn, mutate(func(b []byte) {
+			copy(b[deckOff:deckOff+64], encodeCiphertext(otherDeck[0]))
+		}), ctx},
+		{"flipped response", pk, deckIn, mutate(func(b []byte) {
+			b[len(b)-32] ^= 1
+		}), ctx},
+		{"different input deck", pk, otherDeck, res.ProofBytes, ctx},
+		{"different pk", ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(100)), deckIn, res.ProofBytes, ctx},
+		{"different context", pk, deckIn, res.ProofBytes, append(append([]byte(nil), ctx...), 'x')},
+		{"missing context", pk, deckIn, res.ProofBytes, nil},
+		{"truncated", pk, deckIn, res.ProofBytes[:len(res.ProofBytes)-1], ctx},
+		{"trailing bytes", pk, deckIn, append(append([]byte(nil), res.ProofBytes...), 0), ctx},
+	}
+	for _, tc := range cases {
+		if vr := ShuffleVerify(tc.pk, tc.deck, tc.proof, tc.ctx); vr.OK {
+			t.Fatalf("%s: expected verification failure", tc.name)
+		}
+	}
+}
+
+func TestShuffleV3_RequiresContext(t *testing.T) {
+	pk := ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(5))
+	if _, err := ShuffleProveV3(pk, makeDeck(pk, 4, 1), ShuffleProveOpts{Seed: []byte{1}}); err == nil {
+		t.Fatalf("expected error without context")
+	}
+}
+
+func TestShuffleVerify_DispatchesLegacyVersions(t *testing.T) {
+	pk := ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(11))
+	deckIn := makeDeck(pk, 4, 40)
+	ctx := v3TestContext(t)
+
+	v1, err := ShuffleProveV1(pk, deckIn, ShuffleProveOpts{Seed: []byte{1}, Rounds: 4})
+	if err != nil {
+		t.Fatalf("prove v1: %v", err)
+	}
+	if vr := ShuffleVerify(pk, deckIn, v1.ProofBytes, nil); !vr.OK {
+		t.Fatalf("v1 verify failed: %s", vr.Error)
+	}
+	v2, err := ShuffleProveV1(pk, deckIn, ShuffleProveOpts{Seed: []byte{2}, Rounds: 4, Context: ctx})
+	if err != nil {
+		t.Fatalf("prove v2: %v", err)
+	}
+	if vr := ShuffleVerify(pk, deckIn, v2.ProofBytes, ctx); !vr.OK {
+		t.Fatalf("v2 verify failed: %s", vr.Error)
+	}
+	if vr := ShuffleVerify(pk, deckIn, []byte{9}, ctx); vr.OK || vr.Error != "unsupported version 9" {
+		t.Fatalf("unexpected result for unknown version: %+v", vr)
+	}
+}
+
+type shuffleV3Vector struct {
+	Sk      uint64 `json:"sk"`
+	N       int    `json:"n"`
+	DeckTag uint64 `json:"deckTag"`
+	Seed    string `json:"seed"`
+	Context string `json:"context"`
+	Proof   string `json:"proof"`
+}
+
+type shuffleV3Fixture struct {
+	sk     ocpcrypto.Scalar
+	pk     ocpcrypto.Point
+	deckIn []ocpcrypto.ElGamalCiphertext
+	seed   []byte
+	ctx    []byte
+	proof  []byte
+}
+
+func loadShuffleV3Vector(t *testing.T) shuffleV3Fixture {
+	t.Helper()
+	raw, err := os.ReadFile(filepath.Join("testdata", "shuffle_v3_n8.json"))
+	if err != nil {
+		t.Fatalf("read vector: %v", err)
+	}
+	var v shuffleV3Vector
+	if err := json.Unmarshal(raw, &v); err != nil {
+		t.Fatalf("parse vector: %v", err)
+	}
+	var f shuffleV3Fixture
+	if f.seed, err = hex.DecodeString(v.Seed); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	if f.ctx, err = hex.DecodeString(v.Context); err != nil {
+		t.Fatalf("context: %v", err)
+	}
+	if f.proof, err = hex.DecodeString(v.Proof); err != nil {
+		t.Fatalf("proof: %v", err)
+	}
+	f.sk = ocpcrypto.ScalarFromUint64(v.Sk)
+	f.pk = ocpcrypto.MulBase(f.sk)
+	f.deckIn = makeDeck(f.pk, v.N, v.DeckTag)
+	return f
+}
+
+// The vector pins the v3 wire format: the stored proof must verify as-is,
+// and the deterministic prover must reproduce it byte for byte. On its own
+// that only guards against format drift; TestShuffleV3_VectorFromWitness
+// checks the same bytes against the Terelius-Wikstrom equations.
+func TestShuffleV3_Vector(t *testing.T) {
+	f := loadShuffleV3Vector(t)
+	if vr := ShuffleVerifyV3(f.pk, f.deckIn, f.proof, f.ctx); !vr.OK {
+		t.Fatalf("stored proof does not verify: %s", vr.Error)
+	}
+	res, err := ShuffleProveV3(f.pk, f.deckIn, ShuffleProveOpts{Seed: f.seed, Context: f.ctx})
+	if err != nil {
+		t.Fatalf("prove: %v", err)
+	}
+	if !bytes.Equal(res.ProofBytes, f.proof) {
+		t.Fatalf("prover output differs from stored vector")
+	}
+}
+
+// shuffleV3Witness is the prover's secret state for the stored vector,
+// replayed from its seed in the order ShuffleProveV3 draws it.
+type shuffleV3Witness struct {
+	perm            []int
+	rOut, r, rHat   []ocpcrypto.Scalar
+	w, wHat, wPrime []ocpcrypto.Scalar
+}
+
+func replayShuffleV3Witness(t *testing.T, seed []byte, n int) shuffleV3Witness {
+	t.Helper()
+	rng, err := NewDeterministicRng(seed)
+	if err != nil {
+		t.Fatalf("rng: %v", err)
+	}
+	draw := func(k int) []ocpcrypto.Scalar {
+		out := make([]ocpcrypto.Scalar, k)
+		for i := range out {
+			if out[i], err = sampleNonzeroScalar(rng); err != nil {
+				t.Fatalf("rng: %v", err)
+			}
+		}
+		return out
+	}
+	var w shuffleV3Witness
+	if w.perm, err = randomPermutation(rng, n); err != nil {
+		t.Fatalf("rng: %v", err)
+	}
+	w.rOut, w.r, w.rHat = draw(n), draw(n), draw(n)
+	w.w, w.wHat, w.wPrime = draw(4), draw(n), draw(n)
+	return w
+}
+
+// shuffleV3Parts splits a v3 proof into its wire fields.
+type shuffleV3Parts struct {
+	deckOut        []ocpcrypto.ElGamalCiphertext
+	c, cHat        []ocpcrypto.Point
+	ch             ocpcrypto.Scalar
+	s              []ocpcrypto.Scalar // s1..s4
+	sHat, sPrime   []ocpcrypto.Scalar
+	deckOff, chOff int
+}
+
+func splitShuffleV3Proof(t *testing.T, proof []byte, ctxLen, n int) shuffleV3Parts {
+	t.Helper()
+	var p shuffleV3Parts
+	p.deckOff = 1 + 2 + 2 + ctxLen
+	p.chOff = p.deckOff + n*64 + 2*n*32
+	point := func(off int) ocpcrypto.Point {
+		pt, err := ocpcrypto.PointFromBytesCanonical(proof[off : off+32])
+		if err != nil {
+			t.Fatalf("point at %d: %v", off, err)
+		}
+		return pt
+	}
+	scalar := func(off int) ocpcrypto.Scalar {
+		sc, err := ocpcrypto.ScalarFromBytesCanonical(proof[off : off+32])
+		if err != nil {
+			t.Fatalf("scalar at %d: %v", off, err)
+		}
+		return sc
+	}
+	for i := 0; i < n; i++ {
+		off := p.deckOff + i*64
+		p.deckOut = append(p.deckOut, ocpcrypto.ElGamalCiphertext{C1: point(off), C2: point(off + 32)})
+		p.c = append(p.c, point(p.deckOff+n*64+i*32))
+		p.cHat = append(p.cHat, point(p.deckOff+n*64+n*32+i*32))
+		p.sHat = append(p.sHat, scalar(p.chOff+5*32+i*32))
+		p.sPrime = append(p.sPrime, scalar(p.chOff+5*32+n*32+i*32))
+	}
+	p.ch = scalar(p.chOff)
+	for i := 1; i <= 4; i++ {
+		p.s = append(p.s, scalar(p.chOff+i*32))
+	}
+	if want := p.chOff + 5*32 + 2*n*32; len(proof) != want {
+		t.Fatalf("proof length %d, want %d", len(proof), want)
+	}
+	return p
+}
+
+// v3Transcript restates the v3 Fiat-Shamir schedule from the format comment
+// in shuffle_v3.go: u_j from the statement and c, then the final challenge
+// over ĉ and the t commitments.
+func v3Transcript(t *testing.T, ctx []byte, pk ocpcrypto.Point, deckIn, deckOut []ocpcrypto.ElGamalCiphertext, c []ocpcrypto.Point) (*ocpcrypto.Transcript, []ocpcrypto.Scalar) {
+	t.Helper()
+	deckBytes := func(deck []ocpcrypto.ElGamalCiphertext) []byte {
+		var b []byte
+		for _, ct := range deck {
+			b = append(append(b, ct.C1.Bytes()...), ct.C2.Bytes()...)
+		}
+		return b
+	}
+	tr := ocpcrypto.NewTranscript("ocp/v3/shuffle/tw")
+	_ = tr.AppendMessage("ctx", ctx)
+	_ = tr.AppendMessage("pk", pk.Bytes())
+	_ = tr.AppendMessage("deck_in", deckBytes(deckIn))
+	_ = tr.AppendMessage("deck_out", deckBytes(deckOut))
+	_ = tr.AppendMessage("c", v3PointBytes(c))
+	seed, err := tr.ChallengeScalar("u")
+	if err != nil {
+		t.Fatalf("challenge u: %v", err)
+	}
+	u := make([]ocpcrypto.Scalar, len(c))
+	for j := range u {
+		if u[j], err = ocpcrypto.HashToScalar("ocp/v3/shuffle/tw/u", seed.Bytes(), []byte{byte(j), byte(j >> 8), byte(j >> 16), byte(j >> 24)}); err != nil {
+			t.Fatalf("u[%d]: %v", j, err)
+		}
+	}
+	return tr, u
+}
+
+func v3FinalChallenge(t *testing.T, tr *ocpcrypto.Transcript, cHat []ocpcrypto.Point, t1, t2, t3, t41, t42 ocpcrypto.Point, tHat []ocpcrypto.Point) ocpcrypto.Scalar {
+	t.Helper()
+	_ = tr.AppendMessage("c_hat", v3PointBytes(cHat))
+	_ = tr.AppendMessage("t1", t1.Bytes())
+	_ = tr.AppendMessage("t2", t2.Bytes())
+	_ = tr.AppendMessage("t3", t3.Bytes())
+	_ = tr.AppendMessage("t41", t41.Bytes())
+	_ = tr.AppendMessage("t42", t42.Bytes())
+	_ = tr.AppendMessage("t_hat", v3PointBytes(tHat))
+	ch, err := tr.ChallengeScalar("c")
+	if err != nil {
+		t.Fatalf("challenge c: %v", err)
+	}
+	return ch
+}
+
+func v3PointBytes(ps []ocpcrypto.Point) []byte {
+	var b []byte
+	for _, p := range ps {
+		b = append(b, p.Bytes()...)
+	}
+	return b
+}
+
+// v3Commitments recomputes the prover's t values from the witness nonces
+// with plain scalar multiplications.
+func v3Commitments(pk ocpcrypto.Point, hs []ocpcrypto.Point, h ocpcrypto.Point, deckOut []ocpcrypto.ElGamalCiphertext, cHat []ocpcrypto.Point, w shuffleV3Witness) (t1, t2, t3, t41, t42 ocpcrypto.Point, tHat []ocpcrypto.Point) {
+	t1 = ocpcrypto.MulBase(w.w[0])
+	t2 = ocpcrypto.MulBase(w.w[1])
+	t3 = ocpcrypto.MulBase(w.w[2])
+	t41 = ocpcrypto.PointSub(ocpcrypto.PointZero(), ocpcrypto.MulBase(w.w[3]))
+	t42 = ocpcrypto.PointSub(ocpcrypto.PointZero(), ocpcrypto.MulPoint(pk, w.w[3]))
+	prev := h
+	for i := range deckOut {
+		t3 = ocpcrypto.PointAdd(t3, ocpcrypto.MulPoint(hs[i], w.wPrime[i]))
+		t41 = ocpcrypto.PointAdd(t41, ocpcrypto.MulPoint(deckOut[i].C1, w.wPrime[i]))
+		t42 = ocpcrypto.PointAdd(t42, ocpcrypto.MulPoint(deckOut[i].C2, w.wPrime[i]))
+		tHat = append(tHat, ocpcrypto.PointAdd(ocpcrypto.MulBase(w.wHat[i]), ocpcrypto.MulPoint(prev, w.wPrime[i])))
+		prev = cHat[i]
+	}
+	return t1, t2, t3, t41, t42, tHat
+}
+
+// Derives the stored vector from first principles instead of trusting the
+// prover: the witness is replayed from the seed, every relation of the
+// Terelius-Wikstrom statement is checked with plain group arithmetic, and
+// each wire field is recomputed from the witness and compared to the file.
+func TestShuffleV3_VectorFromWitness(t *testing.T) {
+	f := loadShuffleV3Vector(t)
+	n := len(f.deckIn)
+	p := splitShuffleV3Proof(t, f.proof, len(f.ctx), n)
+	w := replayShuffleV3Witness(t, f.seed, n)
+	eq := func(what string, got, want ocpcrypto.Point) {
+		t.Helper()
+		if !ocpcrypto.PointEq(got, want) {
+			t.Fatalf("%s mismatch", what)
+		}
+	}
+	eqScalar := func(what string, got, want ocpcrypto.Scalar) {
+		t.Helper()
+		if !bytes.Equal(got.Bytes(), want.Bytes()) {
+			t.Fatalf("%s mismatch", what)
+		}
+	}
+
+	// The output deck is the input deck permuted and re-encrypted, and it
+	// decrypts to the permuted plaintexts.
+	for i, j := range w.perm {
+		eq("deck_out C1", p.deckOut[i].C1, ocpcrypto.PointAdd(f.deckIn[j].C1, ocpcrypto.MulBase(w.rOut[i])))
+		eq("deck_out C2", p.deckOut[i].C2, ocpcrypto.PointAdd(f.deckIn[j].C2, ocpcrypto.MulPoint(f.pk, w.rOut[i])))
+		eq("plaintext", ocpcrypto.ElGamalDecrypt(f.sk, p.deckOut[i]), ocpcrypto.ElGamalDecrypt(f.sk, f.deckIn[j]))
+	}
+
+	// c_j = r_j*G + H_i for the output position i holding input j.
+	h, err := ocpcrypto.HashToPoint("ocp/v3/shuffle/gens", []byte("h"))
+	if err != nil {
+		t.Fatalf("h: %v", err)
+	}
+	hs := make([]ocpcrypto.Point, n)
+	for i := range hs {
+		if hs[i], err = ocpcrypto.HashToPoint("ocp/v3/shuffle/gens", []byte("h_i"), []byte{byte(i), byte(i >> 8), byte(i >> 16), byte(i >> 24)}); err != nil {
+			t.Fatalf("h_%d: %v", i, err)
+		}
+	}
+	for i, j := range w.perm {
+		eq("c", p.c[j], ocpcrypto.PointAdd(ocpcrypto.MulBase(w.r[j]), hs[i]))
+	}
+
+	// ĉ_i = r̂_i*G + u'_i*ĉ_{i-1} with u'_i = u_{perm[i]}.
+	tr, u := v3Transcript(t, f.ctx, f.pk, f.deckIn, p.deckOut, p.c)
+	uPerm := make([]ocpcrypto.Scalar, n)
+	for i, j := range w.perm {
+		uPerm[i] = u[j]
+	}
+	prev := h
+	for i := range p.cHat {
+		eq("c_hat", p.cHat[i], ocpcrypto.PointAdd(ocpcrypto.MulBase(w.rHat[i]), ocpcrypto.MulPoint(prev, uPerm[i])))
+		prev = p.cHat[i]
+	}
+
+	// Aggregate witnesses, with r̂ = Σ r̂_i * Π_{k>i} u'_k spelled out.
+	rBar, rTilde, rStar, rHatAgg := ocpcrypto.ScalarZero(), ocpcrypto.ScalarZero(), ocpcrypto.ScalarZero(), ocpcrypto.ScalarZero()
+	prodU := ocpcrypto.ScalarFromUint64(1)
+	for j := 0; j < n; j++ {
+		rBar = ocpcrypto.ScalarAdd(rBar, w.r[j])
+		rTilde = ocpcrypto.ScalarAdd(rTilde, ocpcrypto.ScalarMul(u[j], w.r[j]))
+		rStar = ocpcrypto.ScalarAdd(rStar, ocpcrypto.ScalarMul(uPerm[j], w.rOut[j]))
+		prodU = ocpcrypto.ScalarMul(prodU, u[j])
+		tail := ocpcrypto.ScalarFromUint64(1)
+		for k := j + 1; k < n; k++ {
+			tail = ocpcrypto.ScalarMul(tail, uPerm[k])
+		}
+		rHatAgg = ocpcrypto.ScalarAdd(rHatAgg, ocpcrypto.ScalarMul(w.rHat[j], tail))
+	}
+
+	// The statement the proof is about holds for this witness.
+	sumC, sumH, sumUC, sumUH := ocpcrypto.PointZero(), ocpcrypto.PointZero(), ocpcrypto.PointZero(), ocpcrypto.PointZero()
+	sumOut, sumIn := ocpcrypto.ElGamalCiphertext{C1: ocpcrypto.PointZero(), C2: ocpcrypto.PointZero()}, ocpcrypto.ElGamalCiphertext{C1: ocpcrypto.PointZero(), C2: ocpcrypto.PointZero()}
+	for i := 0; i < n; i++ {
+		sumC = ocpcrypto.PointAdd(sumC, p.c[i])
+		sumH = ocpcrypto.PointAdd(sumH, hs[i])
+		sumUC = ocpcrypto.PointAdd(sumUC, ocpcrypto.MulPoint(p.c[i], u[i]))
+		sumUH = ocpcrypto.PointAdd(sumUH, ocpcrypto.MulPoint(hs[i], uPerm[i]))
+		sumOut.C1 = ocpcrypto.PointAdd(sumOut.C1, ocpcrypto.MulPoint(p.deckOut[i].C1, uPerm[i]))
+		sumOut.C2 = ocpcrypto.PointAdd(sumOut.C2, ocpcrypto.MulPoint(p.deckOut[i].C2, uPerm[i]))
+		sumIn.C1 = ocpcrypto.PointAdd(sumIn.C1, ocpcrypto.MulPoint(f.deckIn[i].C1, u[i]))
+		sumIn.C2 = ocpcrypto.PointAdd(sumIn.C2, ocpcrypto.MulPoint(f.deckIn[i].C2, u[i]))
+	}
+	eq("Σc - ΣH = r̄*G", ocpcrypto.PointSub(sumC, sumH), ocpcrypto.MulBase(rBar))
+	eq("ĉ_{n-1} - (Πu)*H = r̂*G", ocpcrypto.PointSub(p.cHat[n-1], ocpcrypto.MulPoint(h, prodU)), ocpcrypto.MulBase(rHatAgg))
+	eq("Σu*c = r̃*G + Σu'*H", sumUC, ocpcrypto.PointAdd(ocpcrypto.MulBase(rTilde), sumUH))
+	eq("Σu'*out - Σu*in = r*·G", ocpcrypto.PointSub(sumOut.C1, sumIn.C1), ocpcrypto.MulBase(rStar))
+	eq("Σu'*out - Σu*in = r*·pk", ocpcrypto.PointSub(sumOut.C2, sumIn.C2), ocpcrypto.MulPoint(f.pk, rStar))
+
+	// The challenge and responses are the ones the sigma protocol dictates.
+	t1, t2, t3, t41, t42, tHat := v3Commitments(f.pk, hs, h, p.deckOut, p.cHat, w)
+	ch := v3FinalChallenge(t, tr, p.cHat, t1, t2, t3, t41, t42, tHat)
+	eqScalar("challenge", p.ch, ch)
+	respond := func(w, x ocpcrypto.Scalar) ocpcrypto.Scalar {
+		return ocpcrypto.ScalarAdd(w, ocpcrypto.ScalarMul(ch, x))
+	}
+	for i, x := range []ocpcrypto.Scalar{rBar, rHatAgg, rTilde, rStar} {
+		eqScalar("s", p.s[i], respond(w.w[i], x))
+	}
+	for i := 0; i < n; i++ {
+		eqScalar("s_hat", p.sHat[i], respond(w.wHat[i], w.rHat[i]))
+		eqScalar("s_prime", p.sPrime[i], respond(w.wPrime[i], uPerm[i]))
+	}
+}
+
+// Negative vectors derived from the stored proof. Each one still parses, so
+// the verifier must reject it at the challenge check.
+func TestShuffleV3_VectorRejectsForgeries(t *testing.T) {
+	f := loadShuffleV3Vector(t)
+	n := len(f.deckIn)
+	p := splitShuffleV3Proof(t, f.proof, len(f.ctx), n)
+	w := replayShuffleV3Witness(t, f.seed, n)
+
+	h, hs, err := shuffleV3Generators(n)
+	if err != nil {
+		t.Fatalf("generators: %v", err)
+	}
+	tr, _ := v3Transcript(t, f.ctx, f.pk, f.deckIn, p.deckOut, p.c)
+
+	// A prover that commits to a t̂ other than the one its responses open to
+	// and re-derives the challenge over it: the responses are honest for the
+	// new challenge, but the verifier rebuilds the real t̂.
+	t1, t2, t3, t41, t42, tHat := v3Commitments(f.pk, hs, h, p.deckOut, p.cHat, w)
+	tHat[0] = ocpcrypto.PointAdd(tHat[0], ocpcrypto.PointBase())
+	forgedCh := v3FinalChallenge(t, tr, p.cHat, t1, t2, t3, t41, t42, tHat)
+	// s = w + ch*x, so each response is rebased onto the forged challenge.
+	rebase := func(s, w ocpcrypto.Scalar) ocpcrypto.Scalar {
+		x := v3Opening(t, s, w, p.ch)
+		return ocpcrypto.ScalarAdd(w, ocpcrypto.ScalarMul(forgedCh, x))
+	}
+	tamperedTHat := append([]byte(nil), f.proof[:p.chOff]...)
+	tamperedTHat = append(tamperedTHat, encodeScalar(forgedCh)...)
+	for i := range p.s {
+		tamperedTHat = append(tamperedTHat, encodeScalar(rebase(p.s[i], w.w[i]))...)
+	}
+	for i := range p.sHat {
+		tamperedTHat = append(tamperedTHat, encodeScalar(rebase(p.sHat[i], w.wHat[i]))...)
+	}
+	for i := range p.sPrime {
+		tamperedTHat = append(tamperedTHat, encodeScalar(rebase(p.sPrime[i], w.wPrime[i]))...)
+	}
+
+	swap := func(off, size int) []byte {
+		b := append([]byte(nil), f.proof...)
+		a := append([]byte(nil), b[off:off+size]...)
+		copy(b[off:off+size], b[off+size:off+2*size])
+		copy(b[off+size:off+2*size], a)
+		return b
+	}
+	wrongCh := append([]byte(nil), f.proof...)
+	copy(wrongCh[p.chOff:], encodeScalar(ocpcrypto.ScalarAdd(p.ch, ocpcrypto.ScalarFromUint64(1))))
+
+	cases := []struct {
+		name  string
+		proof []byte
+	}{
+		{"tampered t_hat", tamperedTHat},
+		{"permuted output cards", swap(p.deckOff, 64)},
+		{"permuted commitments", swap(p.deckOff+n*64, 32)},
+		{"permuted c_hat", swap(p.deckOff+n*64+n*32, 32)},
+		{"permuted s_prime", swap(p.chOff+5*32+n*32, 32)},
+		{"wrong challenge", wrongCh},
+	}
+	for _, tc := range cases {
+		if bytes.Equal(tc.proof, f.proof) {
+			t.Fatalf("%s: vector unchanged", tc.name)
+		}
+		vr := ShuffleVerifyV3(f.pk, f.deckIn, tc.proof, f.ctx)
+		if vr.OK || vr.Error != "invalid shuffle proof" {
+			t.Fatalf("%s: got %+v, want rejection at the challenge check", tc.name, vr)
+		}
+	}
+}
+
+// v3Opening recovers x from a response s = w + ch*x.
+func v3Opening(t *testing.T, s, w, ch ocpcrypto.Scalar) ocpcrypto.Scalar {
+	t.Helper()
+	inv, err := ocpcrypto.ScalarInv(ch)
+	if err != nil {
+		t.Fatalf("invert challenge: %v", err)
+	}
+	return ocpcrypto.ScalarMul(ocpcrypto.ScalarSub(s, w), inv)
+}
+
+func BenchmarkShuffleVerifyV2_N52(b *testing.B) {
+	pk := ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(1))
+	deckIn := makeDeck(pk, 52, 1)
+	ctx := []byte("bench")
+	res, err := ShuffleProveV1(pk, deckIn, ShuffleProveOpts{Seed: []byte{1}, Context: ctx})
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if vr := ShuffleVerify(pk, deckIn, res.ProofBytes, ctx); !vr.OK {
+			b.Fatal(vr.Error)
+		}
+	}
+}
+
+func BenchmarkShuffleVerifyV3_N52(b *testing.B) {
+	pk := ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(1))
+	deckIn := makeDeck(pk, 52, 1)
+	ctx := []byte("bench")
+	res, err := ShuffleProveV3(pk, deckIn, ShuffleProveOpts{Seed: []byte{1}, Context: ctx})
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if vr := ShuffleVerify(pk, deckIn, res.ProofBytes, ctx); !vr.OK {
+			b.Fatal(vr.Error)
+		}
+	}
+}
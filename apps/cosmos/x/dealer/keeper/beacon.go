@@ -164,9 +164,10 @@ func (k Keeper) openBeacon(ctx context.Context, epochID uint64, commitBlocks, re
 //   - no beacon state is currently stored, and
 //   - no DKG is in flight (so we don't race with an active dealing cycle).
 //
-// Auto-open picks the pending NextEpochID; windows use the chain-id-driven
-// defaults. MsgOpenBeaconWindow remains available as a manual override for
-// bootstrapping, recovery, or tuning a specific window's durations.
+// Auto-open picks the pending epoch id (see pendingEpochID); windows use
+// the chain-id-driven defaults. MsgOpenBeaconWindow remains available as a
+// manual override for bootstrapping, recovery, or tuning a specific window's
+// durations.
 func (k Keeper) MaybeAutoOpenBeacon(ctx context.Context) error {
 	bs, err := k.GetBeaconState(ctx)
 	if err != nil {
@@ -174,6 +175,10 @@ func (k Keeper) MaybeAutoOpenBeacon(ctx context.Context) error {
 	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	curHeight := sdkCtx.BlockHeight()
+	nextEpoch, err := k.pendingEpochID(ctx)
+	if err != nil {
+		return err
+	}
 	if bs != nil && len(bs.Final) == 0 {
 		// Skip reopen if (pre-upgrade) ANY unconsumed beacon exists, or
 		// (post-upgrade) the window is still open, or the beacon already
@@ -194,10 +199,6 @@ func (k Keeper) MaybeAutoOpenBeacon(ctx context.Context) error {
 	if dkg != nil {
 		return nil // DKG in progress; beacon waits
 	}
-	nextEpoch, err := k.GetNextEpochID(ctx)
-	if err != nil {
-		return err
-	}
 	if nextEpoch == 0 {
 		return nil // defensive guard; GetNextEpochID returns 1 by default
 	}
@@ -248,6 +249,9 @@ func (m msgServer) OpenBeaconWindow(ctx context.Context, req *dealertypes.MsgOpe
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 	if req.Caller == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing caller")
 	}
@@ -279,6 +283,9 @@ func (m msgServer) BeaconCommit(ctx context.Context, req *dealertypes.MsgBeaconC
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 	if req.Validator == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing validator")
 	}
@@ -344,6 +351,9 @@ func (m msgServer) BeaconReveal(ctx context.Context, req *dealertypes.MsgBeaconR
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 	if req.Validator == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing validator")
 	}
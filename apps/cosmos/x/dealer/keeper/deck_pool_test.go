@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	"onchainpoker/apps/cosmos/internal/ocpshuffle"
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+type deckPoolFixture struct {
+	ctx         context.Context
+	k           Keeper
+	ms          dealertypes.MsgServer
+	pokerKeeper *fakeDealerPokerKeeper
+	vals        []string
+	caller      string
+}
+
+func deckPoolDealerMeta() *pokertypes.DealerMeta {
+	holePos := make([]uint32, 18)
+	for i := range holePos {
+		holePos[i] = 255
+	}
+	return &pokertypes.DealerMeta{HolePos: holePos, RevealPos: 255}
+}
+
+// newDeckPoolFixture sets up a 3-member epoch and a table whose hand 1 is
+// being bet, so hand 2 is the one a deck may be prepared for.
+func newDeckPoolFixture(t *testing.T) deckPoolFixture {
+	t.Helper()
+	vals := sortedValopers(3, 0x81)
+	bonded := make([]stakingtypes.Validator, len(vals))
+	for i, v := range vals {
+		bonded[i] = makeBondedValidatorForDealerTest(t, v, 1, byte(0xe0+i))
+	}
+	ctx, k, ms, pokerKeeper := newDealerMsgServerForOverflowTests(t, time.Unix(1_000, 0).UTC(), 10, bonded)
+
+	epoch := hookTestEpoch(1, 1, vals)
+	epoch.PkEpoch = ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(9)).Bytes()
+	require.NoError(t, k.SetEpoch(ctx, epoch))
+
+	caller := sdk.AccAddress(bytes.Repeat([]byte{0x42}, 20)).String()
+	require.NoError(t, pokerKeeper.SetTable(ctx, &pokertypes.Table{
+		Id:         1,
+		Creator:    caller,
+		Params:     pokertypes.TableParams{MaxPlayers: 9, DealerTimeoutSecs: 60},
+		Seats:      make([]*pokertypes.Seat, 9),
+		NextHandId: 2,
+		Hand: &pokertypes.Hand{
+			HandId: 1,
+			Phase:  pokertypes.HandPhase_HAND_PHASE_BETTING,
+			Dealer: deckPoolDealerMeta(),
+		},
+	}))
+	return deckPoolFixture{ctx: ctx, k: k, ms: ms, pokerKeeper: pokerKeeper, vals: vals, caller: caller}
+}
+
+func (f deckPoolFixture) initHand(handID, epochID uint64, deckSize uint32) error {
+	_, err := f.ms.InitHand(f.ctx, &dealertypes.MsgInitHand{
+		Caller:   f.caller,
+		TableId:  1,
+		HandId:   handID,
+		EpochId:  epochID,
+		DeckSize: deckSize,
+	})
+	return err
+}
+
+func (f deckPoolFixture) shuffle(t *testing.T, handID uint64, shuffler string) error {
+	t.Helper()
+	dh, err := f.k.GetHand(f.ctx, 1, handID)
+	require.NoError(t, err)
+	pkHand, err := ocpcrypto.PointFromBytesCanonical(dh.PkHand)
+	require.NoError(t, err)
+	deckIn := make([]ocpcrypto.ElGamalCiphertext, len(dh.Deck))
+	for i, ct := range dh.Deck {
+		c1, err := ocpcrypto.PointFromBytesCanonical(ct.C1)
+		require.NoError(t, err)
+		c2, err := ocpcrypto.PointFromBytesCanonical(ct.C2)
+		require.NoError(t, err)
+		deckIn[i] = ocpcrypto.ElGamalCiphertext{C1: c1, C2: c2}
+	}
+	round := dh.ShuffleStep + 1
+	shuffleCtx, err := ocpshuffle.BuildShuffleContext(1, handID, uint16(round), shuffler)
+	require.NoError(t, err)
+	res, err := ocpshuffle.ShuffleProveV3(pkHand, deckIn, ocpshuffle.ShuffleProveOpts{Seed: []byte(shuffler), Context: shuffleCtx})
+	require.NoError(t, err)
+	_, err = f.ms.SubmitShuffle(f.ctx, &dealertypes.MsgSubmitShuffle{
+		Shuffler:     shuffler,
+		TableId:      1,
+		HandId:       handID,
+		Round:        round,
+		ProofShuffle: res.ProofBytes,
+	})
+	return err
+}
+
+// startHand mimics poker StartHand handing out the prepared hand id.
+func (f deckPoolFixture) startHand(t *testing.T, handID uint64) {
+	t.Helper()
+	tbl, err := f.pokerKeeper.GetTable(f.ctx, 1)
+	require.NoError(t, err)
+	inHand := make([]bool, 9)
+	inHand[0], inHand[4] = true, true
+	tbl.Hand = &pokertypes.Hand{
+		HandId: handID,
+		Phase:  pokertypes.HandPhase_HAND_PHASE_SHUFFLE,
+		InHand: inHand,
+		Dealer: deckPoolDealerMeta(),
+	}
+	tbl.NextHandId = handID + 1
+	require.NoError(t, f.pokerKeeper.SetTable(f.ctx, tbl))
+}
+
+func TestDeckPool_PreparedDeckIsDealtWhenHandStarts(t *testing.T) {
+	f := newDeckPoolFixture(t)
+
+	// Only the table's next hand can be prepared, and only once.
+	require.NoError(t, f.initHand(2, 1, 4))
+	require.Equal(t, 1, countEvents(sdk.UnwrapSDKContext(f.ctx), dealertypes.EventTypeDealerDeckPrepared))
+	require.ErrorContains(t, f.initHand(2, 1, 4), "already initialized")
+	require.ErrorContains(t, f.initHand(3, 1, 4), "hand_id mismatch")
+
+	// Shuffles land while hand 1 plays, with no clock running on them.
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(50_000, 0).UTC())
+	for _, v := range f.vals {
+		require.NoError(t, f.shuffle(t, 2, v))
+	}
+	prepared, err := f.k.GetHand(f.ctx, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), prepared.ShuffleStep)
+	require.Zero(t, prepared.ShuffleDeadline)
+	require.False(t, prepared.Finalized)
+	_, err = f.ms.Timeout(f.ctx, &dealertypes.MsgTimeout{Caller: f.caller, TableId: 1, HandId: 2})
+	require.Error(t, err)
+
+	// The next StartHand picks up the shuffled deck and deals it at once.
+	f.startHand(t, 2)
+	require.NoError(t, f.initHand(2, 1, 4))
+	dh, err := f.k.GetHand(f.ctx, 1, 2)
+	require.NoError(t, err)
+	require.True(t, dh.Finalized)
+	require.Equal(t, prepared.Deck, dh.Deck)
+	require.Equal(t, prepared.PkHand, dh.PkHand)
+
+	tbl, err := f.pokerKeeper.GetTable(f.ctx, 1)
+	require.NoError(t, err)
+	require.True(t, tbl.Hand.Dealer.DeckFinalized)
+	require.Equal(t, uint32(4), tbl.Hand.Dealer.DeckSize)
+	require.Equal(t, uint32(0), tbl.Hand.Dealer.HolePos[0])
+	require.Equal(t, uint32(1), tbl.Hand.Dealer.HolePos[8])
+	require.Equal(t, uint32(4), tbl.Hand.Dealer.Cursor)
+}
+
+func TestDeckPool_AdoptionRules(t *testing.T) {
+	f := newDeckPoolFixture(t)
+	require.NoError(t, f.initHand(2, 1, 4))
+	require.NoError(t, f.shuffle(t, 2, f.vals[0]))
+
+	// A partly shuffled deck is adopted as is; the caller cannot swap it for a
+	// different one by asking for another deck size.
+	f.startHand(t, 2)
+	require.ErrorContains(t, f.initHand(2, 1, 5), "deck_size mismatch")
+
+	// The committee rotates before the hand starts: the prepared deck keeps
+	// epoch 1 retained until the hand replaces it with a fresh epoch-2 deck.
+	next := hookTestEpoch(2, 20, f.vals)
+	next.PkEpoch = ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(11)).Bytes()
+	require.NoError(t, f.k.activateEpoch(f.ctx, next))
+	retiring, err := f.k.GetRetiringEpoch(f.ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, retiring)
+
+	require.NoError(t, f.initHand(2, 2, 4))
+	dh, err := f.k.GetHand(f.ctx, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), dh.EpochId)
+	require.Zero(t, dh.ShuffleStep)
+	require.Equal(t, int64(1_000+60), dh.ShuffleDeadline)
+	require.False(t, dh.Finalized)
+	retiring, err = f.k.GetRetiringEpoch(f.ctx, 1)
+	require.NoError(t, err)
+	require.Nil(t, retiring)
+	require.Equal(t, 1, countEvents(sdk.UnwrapSDKContext(f.ctx), dealertypes.EventTypeDealerDeckDiscarded))
+}
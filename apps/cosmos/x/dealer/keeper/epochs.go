@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/x/dealer/types"
+)
+
+// Epoch rotation must be invisible to players: a hand is bound to the epoch
+// that was active at InitHand and keeps verifying shares against that epoch's
+// members and pub shares until it finishes. When a new epoch finalizes while
+// hands are still bound to the previous one, the previous epoch moves to the
+// retiring store and is deleted once its last hand is released. Hands are
+// counted per epoch as they are stored, and poker's hooks release a hand as
+// soon as it leaves its table.
+
+func (k Keeper) GetRetiringEpoch(ctx context.Context, epochID uint64) (*types.DealerEpoch, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.RetiringEpochKey(epochID))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil
+	}
+	var e types.DealerEpoch
+	if err := k.cdc.Unmarshal(bz, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (k Keeper) SetRetiringEpoch(ctx context.Context, epochID uint64, e *types.DealerEpoch) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if e == nil {
+		return store.Delete(types.RetiringEpochKey(epochID))
+	}
+	if e.EpochId != epochID {
+		return fmt.Errorf("retiring epoch id mismatch: key %d value %d", epochID, e.EpochId)
+	}
+	bz, err := k.cdc.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.RetiringEpochKey(epochID), bz)
+}
+
+func (k Keeper) IterateRetiringEpochs(ctx context.Context, cb func(e types.DealerEpoch) (stop bool)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	it, err := store.Iterator(types.RetiringEpochKeyPrefix, storetypes.PrefixEndBytes(types.RetiringEpochKeyPrefix))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != 1+8 || key[0] != types.RetiringEpochKeyPrefix[0] {
+			continue
+		}
+		var e types.DealerEpoch
+		if err := k.cdc.Unmarshal(it.Value(), &e); err != nil {
+			return err
+		}
+		if e.EpochId != binary.BigEndian.Uint64(key[1:]) {
+			return fmt.Errorf("retiring epoch key/value mismatch at %d", e.EpochId)
+		}
+		if cb(e) {
+			break
+		}
+	}
+	return nil
+}
+
+// EpochByID returns the active epoch if it matches epochID, otherwise the
+// retiring epoch with that id, or nil if neither exists.
+func (k Keeper) EpochByID(ctx context.Context, epochID uint64) (*types.DealerEpoch, error) {
+	epoch, err := k.GetEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if epoch != nil && epoch.EpochId == epochID {
+		return epoch, nil
+	}
+	return k.GetRetiringEpoch(ctx, epochID)
+}
+
+// setEpochByID writes back an epoch loaded via EpochByID (e.g. after
+// recording slashed members), keeping it in whichever store it came from.
+func (k Keeper) setEpochByID(ctx context.Context, e *types.DealerEpoch) error {
+	active, err := k.GetEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if active != nil && active.EpochId == e.EpochId {
+		return k.SetEpoch(ctx, e)
+	}
+	return k.SetRetiringEpoch(ctx, e.EpochId, e)
+}
+
+// epochHasHands reports whether any in-flight dealer hand is bound to epochID.
+func (k Keeper) epochHasHands(ctx context.Context, epochID uint64) (bool, error) {
+	n, err := k.GetEpochHandCount(ctx, epochID)
+	return n != 0, err
+}
+
+// activateEpoch installs next as the active epoch. The epoch it replaces is
+// retained in the retiring store if hands are still bound to it.
+func (k Keeper) activateEpoch(ctx context.Context, next *types.DealerEpoch) error {
+	prev, err := k.GetEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if prev != nil && prev.EpochId != next.EpochId {
+		inUse, err := k.epochHasHands(ctx, prev.EpochId)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			if err := k.SetRetiringEpoch(ctx, prev.EpochId, prev); err != nil {
+				return err
+			}
+		}
+	}
+	return k.SetEpoch(ctx, next)
+}
+
+// releaseHand drops a finished hand's dealer state and retires its epoch if
+// it was superseded and this was the last hand bound to it.
+func (k Keeper) releaseHand(ctx context.Context, tableID, handID uint64) ([]sdk.Event, error) {
+	dh, err := k.GetHand(ctx, tableID, handID)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.SetHand(ctx, tableID, handID, nil); err != nil {
+		return nil, err
+	}
+	if dh == nil {
+		return nil, nil
+	}
+	retiring, err := k.GetRetiringEpoch(ctx, dh.EpochId)
+	if err != nil {
+		return nil, err
+	}
+	if retiring == nil {
+		return nil, nil
+	}
+	inUse, err := k.epochHasHands(ctx, dh.EpochId)
+	if err != nil || inUse {
+		return nil, err
+	}
+	if err := k.SetRetiringEpoch(ctx, dh.EpochId, nil); err != nil {
+		return nil, err
+	}
+	return []sdk.Event{sdk.NewEvent(
+		types.EventTypeDealerEpochRetired,
+		sdk.NewAttribute("epochId", fmt.Sprintf("%d", dh.EpochId)),
+	)}, nil
+}
+
+// releaseTableHands releases every dealer hand of a table, including a deck
+// prepared for a hand the table will never start.
+func (k Keeper) releaseTableHands(ctx context.Context, tableID uint64) ([]sdk.Event, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := types.TableHandsPrefix(tableID)
+	it, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	var handIDs []uint64
+	for ; it.Valid(); it.Next() {
+		if key := it.Key(); len(key) == 1+8+8 {
+			handIDs = append(handIDs, binary.BigEndian.Uint64(key[1+8:]))
+		}
+	}
+	if err := it.Close(); err != nil {
+		return nil, err
+	}
+
+	var events []sdk.Event
+	for _, handID := range handIDs {
+		releaseEvents, err := k.releaseHand(ctx, tableID, handID)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, releaseEvents...)
+	}
+	return events, nil
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+func TestActivateEpoch_RetainsPreviousUntilHandsRelease(t *testing.T) {
+	ctx, k, _, _ := newDealerMsgServerForOverflowTests(t, time.Unix(100, 0).UTC(), 10, nil)
+
+	require.NoError(t, k.SetEpoch(ctx, &dealertypes.DealerEpoch{EpochId: 1, Threshold: 2}))
+	require.NoError(t, k.SetHand(ctx, 1, 1, &dealertypes.DealerHand{EpochId: 1}))
+	require.NoError(t, k.SetHand(ctx, 2, 1, &dealertypes.DealerHand{EpochId: 1}))
+
+	require.NoError(t, k.activateEpoch(ctx, &dealertypes.DealerEpoch{EpochId: 2, Threshold: 2}))
+
+	active, err := k.GetEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), active.EpochId)
+	old, err := k.EpochByID(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, old)
+	require.Equal(t, uint64(1), old.EpochId)
+
+	// Slashing bookkeeping on the old epoch stays in the retiring store.
+	old.Slashed = []string{"v"}
+	require.NoError(t, k.setEpochByID(ctx, old))
+	old, err = k.GetRetiringEpoch(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"v"}, old.Slashed)
+	active, err = k.GetEpoch(ctx)
+	require.NoError(t, err)
+	require.Empty(t, active.Slashed)
+
+	events, err := k.releaseHand(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Empty(t, events)
+	old, err = k.GetRetiringEpoch(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, old)
+
+	events, err = k.releaseHand(ctx, 2, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, dealertypes.EventTypeDealerEpochRetired, events[0].Type)
+	old, err = k.GetRetiringEpoch(ctx, 1)
+	require.NoError(t, err)
+	require.Nil(t, old)
+
+	// An epoch with no bound hands is dropped immediately on rotation.
+	require.NoError(t, k.activateEpoch(ctx, &dealertypes.DealerEpoch{EpochId: 3, Threshold: 2}))
+	old, err = k.EpochByID(ctx, 2)
+	require.NoError(t, err)
+	require.Nil(t, old)
+}
+
+func TestSubmitPubShare_UsesHandEpochAfterRotation(t *testing.T) {
+	valoper := sdk.ValAddress(bytes.Repeat([]byte{0x33}, 20)).String()
+	ctx, k, ms, pokerKeeper := newDealerMsgServerForOverflowTests(t, time.Unix(200, 0).UTC(), 1, nil)
+
+	require.NoError(t, k.SetEpoch(ctx, &dealertypes.DealerEpoch{
+		EpochId:   9,
+		Threshold: 2,
+		Members:   []dealertypes.DealerMember{{Validator: valoper, Index: 1}},
+	}))
+	require.NoError(t, pokerKeeper.SetTable(ctx, &pokertypes.Table{
+		Id: 1,
+		Hand: &pokertypes.Hand{
+			HandId: 1,
+			Dealer: &pokertypes.DealerMeta{RevealPos: 0, RevealDeadline: 300},
+		},
+	}))
+	require.NoError(t, k.SetHand(ctx, 1, 1, &dealertypes.DealerHand{
+		EpochId:  9,
+		DeckSize: 1,
+		Deck:     []dealertypes.DealerCiphertext{{C1: []byte{0x00}, C2: []byte{0x00}}},
+		PubShares: []dealertypes.DealerPubShare{
+			{Pos: 0, Validator: valoper, Index: 1, Share: []byte{0x01}, Proof: []byte{0x02}},
+		},
+	}))
+
+	// Rotate to a committee the validator is not part of.
+	require.NoError(t, k.activateEpoch(ctx, &dealertypes.DealerEpoch{EpochId: 10, Threshold: 2}))
+
+	// The hand still resolves its members against epoch 9, so the request
+	// reaches the per-member duplicate check instead of failing on the epoch.
+	_, err := ms.SubmitPubShare(ctx, &dealertypes.MsgSubmitPubShare{
+		Validator:  valoper,
+		TableId:    1,
+		HandId:     1,
+		Pos:        0,
+		PubShare:   []byte{0x03},
+		ProofShare: []byte{0x04},
+	})
+	require.ErrorContains(t, err, "duplicate pub share")
+}
+
+func TestPokerHooks_ReleaseHandsPokerEnds(t *testing.T) {
+	ctx, k, _, _ := newDealerMsgServerForOverflowTests(t, time.Unix(100, 0).UTC(), 10, nil)
+	hooks := k.PokerHooks()
+
+	require.NoError(t, k.SetEpoch(ctx, &dealertypes.DealerEpoch{EpochId: 1, Threshold: 2}))
+	require.NoError(t, k.SetHand(ctx, 1, 1, &dealertypes.DealerHand{EpochId: 1}))
+	require.NoError(t, k.SetHand(ctx, 2, 4, &dealertypes.DealerHand{EpochId: 1}))
+	require.NoError(t, k.activateEpoch(ctx, &dealertypes.DealerEpoch{EpochId: 2, Threshold: 2}))
+	// Table 2 has hand 5's deck prepared under the new epoch.
+	require.NoError(t, k.SetHand(ctx, 2, 5, &dealertypes.DealerHand{EpochId: 2}))
+	retiring, err := k.GetRetiringEpoch(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, retiring)
+
+	// Everyone folds to one player on table 1: poker ends the hand without
+	// the dealer revealing anything.
+	require.NoError(t, hooks.AfterHandEnded(ctx, 1, 1))
+	dh, err := k.GetHand(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Nil(t, dh)
+	require.Zero(t, countEvents(sdk.UnwrapSDKContext(ctx), dealertypes.EventTypeDealerEpochRetired))
+
+	// A hand the dealer already released is skipped.
+	require.NoError(t, hooks.AfterHandEnded(ctx, 1, 1))
+
+	// Table 2 is closed after hand 4 folds out: both its hands go, and with
+	// hand 4 the last hand bound to epoch 1.
+	require.NoError(t, hooks.AfterTableClosed(ctx, 2))
+	for _, handID := range []uint64{4, 5} {
+		dh, err = k.GetHand(ctx, 2, handID)
+		require.NoError(t, err)
+		require.Nil(t, dh)
+	}
+	require.Equal(t, 1, countEvents(sdk.UnwrapSDKContext(ctx), dealertypes.EventTypeDealerEpochRetired))
+	retiring, err = k.GetRetiringEpoch(ctx, 1)
+	require.NoError(t, err)
+	require.Nil(t, retiring)
+	for _, epochID := range []uint64{1, 2} {
+		n, err := k.GetEpochHandCount(ctx, epochID)
+		require.NoError(t, err)
+		require.Zero(t, n)
+	}
+}
+
+func TestSetHand_CountsHandsPerEpoch(t *testing.T) {
+	ctx, k, _, _ := newDealerMsgServerForOverflowTests(t, time.Unix(100, 0).UTC(), 10, nil)
+	count := func(epochID uint64) uint64 {
+		n, err := k.GetEpochHandCount(ctx, epochID)
+		require.NoError(t, err)
+		return n
+	}
+
+	require.NoError(t, k.SetHand(ctx, 1, 1, &dealertypes.DealerHand{EpochId: 1}))
+	require.NoError(t, k.SetHand(ctx, 1, 1, &dealertypes.DealerHand{EpochId: 1, ShuffleStep: 1}))
+	require.NoError(t, k.SetHand(ctx, 1, 2, &dealertypes.DealerHand{EpochId: 1}))
+	require.NoError(t, k.SetHand(ctx, 2, 1, &dealertypes.DealerHand{EpochId: 2}))
+	require.Equal(t, uint64(2), count(1))
+	require.Equal(t, uint64(1), count(2))
+
+	require.NoError(t, k.SetHand(ctx, 1, 1, nil))
+	require.NoError(t, k.SetHand(ctx, 1, 1, nil))
+	require.Equal(t, uint64(1), count(1))
+
+	// The v2 migration recounts hands stored before counting began.
+	require.NoError(t, k.setEpochHandCount(ctx, 1, 0))
+	require.NoError(t, k.setEpochHandCount(ctx, 2, 0))
+	require.NoError(t, NewMigrator(k).Migrate1to2(sdk.UnwrapSDKContext(ctx)))
+	require.Equal(t, uint64(1), count(1))
+	require.Equal(t, uint64(1), count(2))
+}
@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks lets x/staking tell the dealer when a committee member's validator
+// leaves the bonded set. Staking calls AfterValidatorBeginUnbonding from its
+// EndBlocker both for validators that unbond or fall out of the active set
+// and for validators jailed earlier in the block (e.g. for downtime), so a
+// jailed member stops being expected by the end of the block it was jailed in.
+type Hooks struct {
+	k Keeper
+}
+
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+func (h Hooks) AfterValidatorBeginUnbonding(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	// Staking hook errors halt the chain; apply the update atomically or not
+	// at all, and leave a missed update to the dealer timeouts.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+	if err := h.k.deactivateMember(cacheCtx, valAddr.String()); err != nil {
+		h.k.Logger(ctx).Error("dealer: deactivate unbonding committee member", "validator", valAddr.String(), "err", err)
+		return nil
+	}
+	write()
+	return nil
+}
+
+func (Hooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error   { return nil }
+func (Hooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error { return nil }
+func (Hooks) AfterValidatorRemoved(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+func (Hooks) AfterValidatorBonded(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+func (Hooks) BeforeDelegationCreated(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+func (Hooks) BeforeDelegationSharesModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+func (Hooks) BeforeDelegationRemoved(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+func (Hooks) AfterDelegationModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+func (Hooks) BeforeValidatorSlashed(context.Context, sdk.ValAddress, sdkmath.LegacyDec) error {
+	return nil
+}
+func (Hooks) AfterUnbondingInitiated(context.Context, uint64) error { return nil }
+
+// deactivateMember marks valoper inactive in the active epoch and in every
+// retiring epoch it belongs to. In fixed shuffle order, hands waiting on it
+// to shuffle get a fresh shuffle deadline for the next member in QUAL order.
+// Hands whose remaining members have all shuffled get an expired deadline so
+// the deck can be finalized right away. If the active epoch drops below threshold, the next
+// MaybeAutoBeginEpoch begins a replacement DKG without waiting for the
+// epoch to age out.
+func (k Keeper) deactivateMember(ctx context.Context, valoper string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	epochs := []*types.DealerEpoch{}
+	active, err := k.GetEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if active != nil {
+		epochs = append(epochs, active)
+	}
+	if err := k.IterateRetiringEpochs(ctx, func(e types.DealerEpoch) bool {
+		epochs = append(epochs, &e)
+		return false
+	}); err != nil {
+		return err
+	}
+
+	for _, epoch := range epochs {
+		if findEpochMember(epoch, valoper) == nil || epochIsSlashed(epoch, valoper) || epochIsInactive(epoch, valoper) {
+			continue
+		}
+
+		// Note which hands were waiting on this member before QUAL changes.
+		// In open shuffle order no single member is waited on.
+		type shufflingHand struct {
+			tableID, handID uint64
+			wasNext         bool
+		}
+		var shuffling []shufflingHand
+		if err := k.IterateHands(ctx, func(tableID, handID uint64, dh types.DealerHand) bool {
+			if dh.EpochId == epoch.EpochId && !dh.Finalized {
+				next, ok := nextShuffler(epoch, &dh)
+				shuffling = append(shuffling, shufflingHand{tableID, handID, params.MinShufflers == 0 && ok && next == valoper})
+			}
+			return false
+		}); err != nil {
+			return err
+		}
+
+		epochMarkInactive(epoch, valoper)
+		if err := k.setEpochByID(ctx, epoch); err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeDealerMemberInactive,
+			sdk.NewAttribute("epochId", fmt.Sprintf("%d", epoch.EpochId)),
+			sdk.NewAttribute("validator", valoper),
+		))
+
+		for _, sh := range shuffling {
+			if err := k.rescheduleShuffle(ctx, epoch, sh.tableID, sh.handID, sh.wasNext); err != nil {
+				return err
+			}
+		}
+
+		if epoch == active {
+			qual := len(epochQualMembers(epoch))
+			if qual < int(epoch.Threshold) {
+				sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+					types.EventTypeDealerEpochRotationDue,
+					sdk.NewAttribute("epochId", fmt.Sprintf("%d", epoch.EpochId)),
+					sdk.NewAttribute("qualified", fmt.Sprintf("%d", qual)),
+					sdk.NewAttribute("threshold", fmt.Sprintf("%d", epoch.Threshold)),
+				))
+			}
+		}
+	}
+	return nil
+}
+
+// rescheduleShuffle updates a hand's shuffle deadline after a member left
+// QUAL, so the next shuffler is never penalized for time spent waiting on the
+// one that left.
+func (k Keeper) rescheduleShuffle(ctx context.Context, epoch *types.DealerEpoch, tableID, handID uint64, wasNext bool) error {
+	dh, err := k.GetHand(ctx, tableID, handID)
+	if err != nil || dh == nil {
+		return err
+	}
+	if dh.ShuffleDeadline == 0 {
+		// Prepared for a hand that has not started; no clock is running.
+		return nil
+	}
+	nowUnix := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if _, ok := nextShuffler(epoch, dh); !ok {
+		// Everyone still qualified has shuffled; DealerTimeout finalizes now.
+		dh.ShuffleDeadline = nowUnix
+	} else if wasNext {
+		t, err := k.pokerKeeper.GetTable(ctx, tableID)
+		if err != nil {
+			return err
+		}
+		deadline, err := addInt64AndU64Checked(nowUnix, tableDealerTimeoutSecs(t), "dealer shuffle deadline")
+		if err != nil {
+			return err
+		}
+		dh.ShuffleDeadline = deadline
+	} else {
+		return nil
+	}
+	return k.SetHand(ctx, tableID, handID, dh)
+}
+
+var _ pokertypes.PokerHooks = PokerHooks{}
+
+// PokerHooks lets x/poker tell the dealer when it is done with a hand. A hand
+// poker ends on its own, for example when all but one player fold, or whose
+// table is closed, is released here so it cannot keep a retiring epoch alive.
+// Hands the dealer ends itself are already released and are skipped.
+type PokerHooks struct {
+	k Keeper
+}
+
+func (k Keeper) PokerHooks() PokerHooks { return PokerHooks{k} }
+
+func (h PokerHooks) AfterHandEnded(ctx context.Context, tableID, handID uint64) error {
+	events, err := h.k.releaseHand(ctx, tableID, handID)
+	if err != nil {
+		return err
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(events)
+	return nil
+}
+
+func (h PokerHooks) AfterTableClosed(ctx context.Context, tableID uint64) error {
+	events, err := h.k.releaseTableHands(ctx, tableID)
+	if err != nil {
+		return err
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(events)
+	return nil
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+func sortedValopers(n int, base byte) []string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = sdk.ValAddress(bytes.Repeat([]byte{base + byte(i)}, 20)).String()
+	}
+	sort.Strings(out)
+	return out
+}
+
+func hookTestEpoch(id uint64, startHeight int64, validators []string) *dealertypes.DealerEpoch {
+	e := &dealertypes.DealerEpoch{EpochId: id, Threshold: 2, StartHeight: startHeight}
+	for i, v := range validators {
+		e.Members = append(e.Members, dealertypes.DealerMember{Validator: v, Index: uint32(i + 1)})
+	}
+	return e
+}
+
+func countEvents(ctx sdk.Context, typ string) int {
+	n := 0
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == typ {
+			n++
+		}
+	}
+	return n
+}
+
+func TestHooks_UnbondingMemberLeavesQualAndReschedulesShuffle(t *testing.T) {
+	vals := sortedValopers(3, 0x71)
+	ctx, k, _, pokerKeeper := newDealerMsgServerForOverflowTests(t, time.Unix(100, 0).UTC(), 10, nil)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Fixed shuffle order, where the next shuffler is known.
+	params := dealertypes.DefaultParams()
+	require.Zero(t, params.MinShufflers)
+	require.NoError(t, k.SetEpoch(ctx, hookTestEpoch(1, 1, vals)))
+	require.NoError(t, pokerKeeper.SetTable(ctx, &pokertypes.Table{Id: 1, Params: pokertypes.TableParams{DealerTimeoutSecs: 60}}))
+	require.NoError(t, k.SetHand(ctx, 1, 1, &dealertypes.DealerHand{
+		EpochId:         1,
+		ShuffleStep:     1,
+		Shufflers:       []string{vals[0]},
+		ShuffleDeadline: 130,
+	}))
+
+	// vals[1] was due to shuffle next; vals[2] takes over with a full window.
+	valAddr, err := sdk.ValAddressFromBech32(vals[1])
+	require.NoError(t, err)
+	require.NoError(t, k.Hooks().AfterValidatorBeginUnbonding(ctx, nil, valAddr))
+
+	epoch, err := k.GetEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{vals[1]}, epoch.Inactive)
+	require.Empty(t, epoch.Slashed)
+	dh, err := k.GetHand(ctx, 1, 1)
+	require.NoError(t, err)
+	next, ok := nextShuffler(epoch, dh)
+	require.True(t, ok)
+	require.Equal(t, vals[2], next)
+	require.Equal(t, int64(160), dh.ShuffleDeadline)
+	require.Equal(t, 1, countEvents(sdkCtx, dealertypes.EventTypeDealerMemberInactive))
+	require.Zero(t, countEvents(sdkCtx, dealertypes.EventTypeDealerEpochRotationDue))
+
+	// Repeated hooks for the same validator are no-ops.
+	require.NoError(t, k.Hooks().AfterValidatorBeginUnbonding(ctx, nil, valAddr))
+	require.Equal(t, 1, countEvents(sdkCtx, dealertypes.EventTypeDealerMemberInactive))
+
+	// Losing vals[2] leaves only vals[0], who already shuffled: the deck can
+	// be finalized immediately and the epoch is below threshold.
+	valAddr, err = sdk.ValAddressFromBech32(vals[2])
+	require.NoError(t, err)
+	require.NoError(t, k.Hooks().AfterValidatorBeginUnbonding(ctx, nil, valAddr))
+	dh, err = k.GetHand(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), dh.ShuffleDeadline)
+	require.Equal(t, 1, countEvents(sdkCtx, dealertypes.EventTypeDealerEpochRotationDue))
+}
+
+func TestHooks_DeactivatesRetiringEpochMembers(t *testing.T) {
+	vals := sortedValopers(3, 0x71)
+	ctx, k, _, _ := newDealerMsgServerForOverflowTests(t, time.Unix(100, 0).UTC(), 10, nil)
+
+	require.NoError(t, k.SetEpoch(ctx, hookTestEpoch(1, 1, vals)))
+	require.NoError(t, k.SetHand(ctx, 1, 1, &dealertypes.DealerHand{EpochId: 1, Finalized: true}))
+	require.NoError(t, k.activateEpoch(ctx, hookTestEpoch(2, 5, vals[1:])))
+
+	valAddr, err := sdk.ValAddressFromBech32(vals[0])
+	require.NoError(t, err)
+	require.NoError(t, k.Hooks().AfterValidatorBeginUnbonding(ctx, nil, valAddr))
+
+	old, err := k.GetRetiringEpoch(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{vals[0]}, old.Inactive)
+	active, err := k.GetEpoch(ctx)
+	require.NoError(t, err)
+	require.Empty(t, active.Inactive)
+}
+
+func TestMaybeAutoBeginEpoch_EarlyWhenQualBelowThreshold(t *testing.T) {
+	const h int64 = 1_000
+	ctx, k := rotationFixture(t, h, 3)
+
+	params := dealertypes.DefaultParams()
+	params.EpochLengthBlocks = 500
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetNextEpochID(ctx, 2))
+	require.NoError(t, k.SetBeaconState(ctx, closedBeacon(2, h)))
+
+	vals := sortedValopers(3, 0x40)
+	require.NoError(t, k.SetEpoch(ctx, hookTestEpoch(1, h-10, vals)))
+
+	// A young epoch with a working quorum is left alone.
+	valAddr, err := sdk.ValAddressFromBech32(vals[0])
+	require.NoError(t, err)
+	require.NoError(t, k.Hooks().AfterValidatorBeginUnbonding(ctx, nil, valAddr))
+	require.NoError(t, k.MaybeAutoBeginEpoch(ctx))
+	dkg, err := k.GetDKG(ctx)
+	require.NoError(t, err)
+	require.Nil(t, dkg)
+
+	valAddr, err = sdk.ValAddressFromBech32(vals[1])
+	require.NoError(t, err)
+	require.NoError(t, k.Hooks().AfterValidatorBeginUnbonding(ctx, nil, valAddr))
+	require.NoError(t, k.MaybeAutoBeginEpoch(ctx))
+	dkg, err = k.GetDKG(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, dkg)
+	require.Equal(t, uint64(2), dkg.EpochId)
+}
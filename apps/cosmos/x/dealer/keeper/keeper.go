@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,8 +23,12 @@ type Keeper struct {
 	stakingKeeper          types.StakingKeeper
 	committeeStakingKeeper committee.StakingKeeper
 	slashingKeeper         types.SlashingKeeper
+	bankKeeper             types.BankKeeper
 
 	pokerKeeper types.PokerKeeper
+
+	// authority may update params via MsgUpdateParams (x/gov by default).
+	authority string
 }
 
 func NewKeeper(
@@ -32,7 +37,9 @@ func NewKeeper(
 	stakingKeeper types.StakingKeeper,
 	committeeStakingKeeper committee.StakingKeeper,
 	slashingKeeper types.SlashingKeeper,
+	bankKeeper types.BankKeeper,
 	pokerKeeper types.PokerKeeper,
+	authority string,
 ) Keeper {
 	if cdc == nil {
 		panic("dealer keeper: cdc is nil")
@@ -49,19 +56,32 @@ func NewKeeper(
 	if slashingKeeper == nil {
 		panic("dealer keeper: slashing keeper is nil")
 	}
+	if bankKeeper == nil {
+		panic("dealer keeper: bank keeper is nil")
+	}
 	if pokerKeeper == nil {
 		panic("dealer keeper: poker keeper is nil")
 	}
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("dealer keeper: invalid authority %q: %s", authority, err))
+	}
 	return Keeper{
 		storeService:           storeService,
 		cdc:                    cdc,
 		stakingKeeper:          stakingKeeper,
 		committeeStakingKeeper: committeeStakingKeeper,
 		slashingKeeper:         slashingKeeper,
+		bankKeeper:             bankKeeper,
 		pokerKeeper:            pokerKeeper,
+		authority:              authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update module params.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx context.Context) log.Logger {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
@@ -149,6 +169,34 @@ func (k Keeper) SetDKG(ctx context.Context, d *types.DealerDKG) error {
 
 // ---- Hand state ----
 
+func (k Keeper) GetReshare(ctx context.Context) (*types.DealerReshare, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ReshareKey)
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil
+	}
+	var r types.DealerReshare
+	if err := k.cdc.Unmarshal(bz, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (k Keeper) SetReshare(ctx context.Context, r *types.DealerReshare) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if r == nil {
+		return store.Delete(types.ReshareKey)
+	}
+	bz, err := k.cdc.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.ReshareKey, bz)
+}
+
 func (k Keeper) GetHand(ctx context.Context, tableID, handID uint64) (*types.DealerHand, error) {
 	store := k.storeService.OpenKVStore(ctx)
 	bz, err := store.Get(types.HandKey(tableID, handID))
@@ -165,14 +213,101 @@ func (k Keeper) GetHand(ctx context.Context, tableID, handID uint64) (*types.Dea
 	return &h, nil
 }
 
+// SetHand stores or, for a nil h, deletes a dealer hand and keeps its epoch's
+// hand count in step. A hand stays bound to the epoch it was created under.
 func (k Keeper) SetHand(ctx context.Context, tableID, handID uint64, h *types.DealerHand) error {
 	store := k.storeService.OpenKVStore(ctx)
+	key := types.HandKey(tableID, handID)
 	if h == nil {
-		return store.Delete(types.HandKey(tableID, handID))
+		prev, err := k.GetHand(ctx, tableID, handID)
+		if err != nil || prev == nil {
+			return err
+		}
+		if err := k.addEpochHands(ctx, prev.EpochId, -1); err != nil {
+			return err
+		}
+		return store.Delete(key)
+	}
+	exists, err := store.Has(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if err := k.addEpochHands(ctx, h.EpochId, 1); err != nil {
+			return err
+		}
 	}
 	bz, err := k.cdc.Marshal(h)
 	if err != nil {
 		return err
 	}
-	return store.Set(types.HandKey(tableID, handID), bz)
+	return store.Set(key, bz)
+}
+
+// GetEpochHandCount returns the number of dealer hands bound to epochID.
+func (k Keeper) GetEpochHandCount(ctx context.Context, epochID uint64) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.EpochHandCountKey(epochID))
+	if err != nil {
+		return 0, err
+	}
+	if bz == nil {
+		return 0, nil
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid epoch hand count encoding")
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func (k Keeper) setEpochHandCount(ctx context.Context, epochID, n uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if n == 0 {
+		return store.Delete(types.EpochHandCountKey(epochID))
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, n)
+	return store.Set(types.EpochHandCountKey(epochID), bz)
+}
+
+func (k Keeper) addEpochHands(ctx context.Context, epochID uint64, delta int) error {
+	n, err := k.GetEpochHandCount(ctx, epochID)
+	if err != nil {
+		return err
+	}
+	if delta < 0 && n == 0 {
+		return fmt.Errorf("epoch %d hand count underflow", epochID)
+	}
+	if delta < 0 {
+		n--
+	} else {
+		n++
+	}
+	return k.setEpochHandCount(ctx, epochID, n)
+}
+
+func (k Keeper) IterateHands(ctx context.Context, cb func(tableID, handID uint64, h types.DealerHand) (stop bool)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	it, err := store.Iterator(types.HandKeyPrefix, storetypes.PrefixEndBytes(types.HandKeyPrefix))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != 1+8+8 || key[0] != types.HandKeyPrefix[0] {
+			continue
+		}
+		var h types.DealerHand
+		if err := k.cdc.Unmarshal(it.Value(), &h); err != nil {
+			return err
+		}
+		tableID := binary.BigEndian.Uint64(key[1:])
+		handID := binary.BigEndian.Uint64(key[1+8:])
+		if cb(tableID, handID, h) {
+			break
+		}
+	}
+	return nil
 }
@@ -79,6 +79,12 @@ func deriveHandScalar(epochID, tableID, handID uint64, initHeight int64, initSal
 	)
 }
 
+// DeriveHandScalar exposes deriveHandScalar to off-chain tooling (and the
+// module simulation), which must reproduce k_hand to build per-hand shares.
+func DeriveHandScalar(epochID, tableID, handID uint64, initHeight int64, initSalt []byte) (ocpcrypto.Scalar, error) {
+	return deriveHandScalar(epochID, tableID, handID, initHeight, initSalt)
+}
+
 func hashToNonzeroScalar(domain string, msgs ...[]byte) (ocpcrypto.Scalar, error) {
 	for counter := uint32(0); counter < 256; counter++ {
 		var extra []byte
@@ -326,13 +332,35 @@ func epochSlash(epoch *types.DealerEpoch, valoper string) bool {
 	if epoch == nil || valoper == "" {
 		return false
 	}
-	if epochIsSlashed(epoch, valoper) {
+	return insertSorted(&epoch.Slashed, valoper)
+}
+
+func epochIsInactive(epoch *types.DealerEpoch, valoper string) bool {
+	if epoch == nil || valoper == "" || len(epoch.Inactive) == 0 {
 		return false
 	}
-	i := sort.SearchStrings(epoch.Slashed, valoper)
-	epoch.Slashed = append(epoch.Slashed, "")
-	copy(epoch.Slashed[i+1:], epoch.Slashed[i:])
-	epoch.Slashed[i] = valoper
+	i := sort.SearchStrings(epoch.Inactive, valoper)
+	return i < len(epoch.Inactive) && epoch.Inactive[i] == valoper
+}
+
+// epochMarkInactive records that a member's validator left the bonded set.
+// It reports false if the member was already slashed or inactive.
+func epochMarkInactive(epoch *types.DealerEpoch, valoper string) bool {
+	if epoch == nil || valoper == "" || epochIsSlashed(epoch, valoper) {
+		return false
+	}
+	return insertSorted(&epoch.Inactive, valoper)
+}
+
+// insertSorted adds v to a sorted set, reporting false if already present.
+func insertSorted(set *[]string, v string) bool {
+	i := sort.SearchStrings(*set, v)
+	if i < len(*set) && (*set)[i] == v {
+		return false
+	}
+	*set = append(*set, "")
+	copy((*set)[i+1:], (*set)[i:])
+	(*set)[i] = v
 	return true
 }
 
@@ -342,7 +370,7 @@ func epochQualMembers(epoch *types.DealerEpoch) []types.DealerMember {
 	}
 	out := make([]types.DealerMember, 0, len(epoch.Members))
 	for _, m := range epoch.Members {
-		if epochIsSlashed(epoch, m.Validator) {
+		if epochIsSlashed(epoch, m.Validator) || epochIsInactive(epoch, m.Validator) {
 			continue
 		}
 		out = append(out, m)
@@ -350,6 +378,72 @@ func epochQualMembers(epoch *types.DealerEpoch) []types.DealerMember {
 	return out
 }
 
+// handShufflers returns the validators that have shuffled dh's deck. Hands
+// created before shufflers were recorded fall back to the QUAL prefix of
+// length shuffle_step, which was the fixed order at the time.
+func handShufflers(epoch *types.DealerEpoch, dh *types.DealerHand) []string {
+	if len(dh.Shufflers) == int(dh.ShuffleStep) {
+		return dh.Shufflers
+	}
+	qual := epochQualMembers(epoch)
+	out := make([]string, 0, dh.ShuffleStep)
+	for i := 0; i < int(dh.ShuffleStep) && i < len(qual); i++ {
+		out = append(out, qual[i].Validator)
+	}
+	return out
+}
+
+// pendingShufflers returns the qualified members that have not yet shuffled
+// dh's deck, in QUAL order.
+func pendingShufflers(epoch *types.DealerEpoch, dh *types.DealerHand) []string {
+	done := map[string]bool{}
+	for _, v := range handShufflers(epoch, dh) {
+		done[v] = true
+	}
+	var out []string
+	for _, m := range epochQualMembers(epoch) {
+		if !done[m.Validator] {
+			out = append(out, m.Validator)
+		}
+	}
+	return out
+}
+
+// nextShuffler returns the first qualified member that has not yet shuffled
+// dh's deck, or false once every qualified member has. It is the expected
+// shuffler in fixed shuffle order.
+func nextShuffler(epoch *types.DealerEpoch, dh *types.DealerHand) (string, bool) {
+	pending := pendingShufflers(epoch, dh)
+	if len(pending) == 0 {
+		return "", false
+	}
+	return pending[0], true
+}
+
+// shuffleQuorum returns how many distinct members must have shuffled a deck
+// for an expired open-order shuffle slot to finalize it rather than abort the
+// hand: max(min_shufflers, threshold), capped at the current QUAL size.
+func shuffleQuorum(params types.Params, epoch *types.DealerEpoch) int {
+	k := int(max(params.MinShufflers, epoch.Threshold))
+	return min(k, len(epochQualMembers(epoch)))
+}
+
+// shuffleSlotSecs is the time a hand waits for its next shuffle round.
+func shuffleSlotSecs(params types.Params, t *pokertypes.Table) uint64 {
+	if params.MinShufflers != 0 && params.ShuffleSlotSecs != 0 {
+		return params.ShuffleSlotSecs
+	}
+	return tableDealerTimeoutSecs(t)
+}
+
+// isPreparedHand reports whether handID is the table's next hand, whose deck
+// may be initialized and shuffled while the current hand plays. StartHand
+// hands out ids strictly in order and the per-hand key and shuffle context
+// both commit to handID, so a prepared deck can only ever deal that hand.
+func isPreparedHand(t *pokertypes.Table, handID uint64) bool {
+	return handID == t.NextHandId && (t.Hand == nil || t.Hand.HandId != handID)
+}
+
 // ---- Transcript root ----
 
 // dkgTranscriptRoot computes the canonical DKG epoch transcript root as
@@ -678,8 +772,10 @@ func isHolePos(meta *pokertypes.DealerMeta, h *pokertypes.Hand, pos uint32) (sea
 
 // ---- Shuffle verification ----
 
-// verifyShuffle verifies a shuffle proof. `context` must be nil for legacy v1
-// proofs and non-nil for v2 proofs. Callers should pass the canonical context
+// verifyShuffle verifies a shuffle proof, dispatching on its version byte:
+// the compact v3 argument, or the v1/v2 switching network still accepted so
+// hands shuffled by older provers can finish. `context` must be nil for
+// legacy v1 proofs and non-nil for v2 and v3. Callers should pass the canonical context
 // bytes built via ocpshuffle.BuildShuffleContext(tableID, handID, round,
 // shuffler) so that the Fiat-Shamir transcripts bind full request context and
 // reject any cross-hand / cross-round replay.
@@ -702,7 +798,7 @@ func verifyShuffle(pkHandBytes []byte, deckIn []types.DealerCiphertext, proofByt
 		in = append(in, ocpcrypto.ElGamalCiphertext{C1: c1, C2: c2})
 	}
 
-	vr := ocpshuffle.ShuffleVerifyV1(pkHand, in, proofBytes, context)
+	vr := ocpshuffle.ShuffleVerify(pkHand, in, proofBytes, context)
 	if !vr.OK {
 		return nil, "", fmt.Errorf("shuffle verify failed: %s", vr.Error)
 	}
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	"onchainpoker/apps/cosmos/internal/ocpshuffle"
+	"onchainpoker/apps/cosmos/x/dealer/types"
 )
 
 // pointToCardIDLinear is the pre-optimization linear-scan implementation,
@@ -127,3 +129,35 @@ func BenchmarkPointToCardID_Average(b *testing.B) {
 		}
 	}
 }
+
+func TestVerifyShuffle_AcceptsV2AndV3Proofs(t *testing.T) {
+	pk := ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(31))
+	deckIn := make([]ocpcrypto.ElGamalCiphertext, 4)
+	stored := make([]types.DealerCiphertext, 4)
+	for i := range deckIn {
+		ct, err := ocpcrypto.ElGamalEncrypt(pk, cardPoint(i), ocpcrypto.ScalarFromUint64(uint64(i+1)))
+		require.NoError(t, err)
+		deckIn[i] = ct
+		stored[i] = types.DealerCiphertext{C1: ct.C1.Bytes(), C2: ct.C2.Bytes()}
+	}
+	shuffleCtx, err := ocpshuffle.BuildShuffleContext(1, 1, 1, "cosmosvaloper1x")
+	require.NoError(t, err)
+	opts := ocpshuffle.ShuffleProveOpts{Seed: []byte{1}, Context: shuffleCtx}
+
+	v2, err := ocpshuffle.ShuffleProveV1(pk, deckIn, opts)
+	require.NoError(t, err)
+	v3, err := ocpshuffle.ShuffleProveV3(pk, deckIn, opts)
+	require.NoError(t, err)
+	require.Less(t, len(v3.ProofBytes), len(v2.ProofBytes))
+
+	for _, proof := range [][]byte{v2.ProofBytes, v3.ProofBytes} {
+		out, hash, err := verifyShuffle(pk.Bytes(), stored, proof, shuffleCtx)
+		require.NoError(t, err)
+		require.Len(t, out, 4)
+		require.Len(t, hash, 64)
+	}
+
+	bad := append([]byte{4}, v3.ProofBytes[1:]...)
+	_, _, err = verifyShuffle(pk.Bytes(), stored, bad, shuffleCtx)
+	require.ErrorContains(t, err, "unsupported version 4")
+}
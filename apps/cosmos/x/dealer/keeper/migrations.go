@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/x/dealer/types"
+)
+
+// Migrator provides the upgrade handlers for the x/dealer module. New
+// migrations should be added as Migrate{N}to{N+1} methods and registered in
+// module.go's RegisterServices.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator constructs a Migrator over the given keeper.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 lifts x/dealer from ConsensusVersion 1 to 2. v2 keeps a count
+// of the dealer hands bound to each epoch, which SetHand maintains; hands
+// stored before v2 are not counted, so this handler counts them once.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	gctx := sdk.WrapSDKContext(ctx)
+	counts := map[uint64]uint64{}
+	var epochIDs []uint64
+	if err := m.keeper.IterateHands(gctx, func(_, _ uint64, h types.DealerHand) bool {
+		if counts[h.EpochId] == 0 {
+			epochIDs = append(epochIDs, h.EpochId)
+		}
+		counts[h.EpochId]++
+		return false
+	}); err != nil {
+		return fmt.Errorf("dealer migrate v1->v2: iterate hands: %w", err)
+	}
+	for _, id := range epochIDs {
+		if err := m.keeper.setEpochHandCount(gctx, id, counts[id]); err != nil {
+			return fmt.Errorf("dealer migrate v1->v2: epoch %d: %w", id, err)
+		}
+	}
+	ctx.Logger().Info("x/dealer migrated to v2 (per-epoch hand counts)", "epochs", len(epochIDs))
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -57,6 +58,9 @@ func (m msgServer) BeginEpoch(ctx context.Context, req *dealertypes.MsgBeginEpoc
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 	if req.Caller == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing caller")
 	}
@@ -73,17 +77,58 @@ func (m msgServer) BeginEpoch(ctx context.Context, req *dealertypes.MsgBeginEpoc
 		return nil, err
 	}
 
+	if _, err := m.beginEpoch(ctx, beginEpochArgs{
+		epochID:         req.EpochId,
+		threshold:       req.Threshold,
+		committeeSize:   req.CommitteeSize,
+		commitBlocks:    req.CommitBlocks,
+		complaintBlocks: req.ComplaintBlocks,
+		revealBlocks:    req.RevealBlocks,
+		finalizeBlocks:  req.FinalizeBlocks,
+		randEpoch:       req.RandEpoch,
+		origin:          "manual",
+	}); err != nil {
+		return nil, err
+	}
+
+	return &dealertypes.MsgBeginEpochResponse{}, nil
+}
+
+// beginEpochArgs carries the MsgBeginEpoch parameters into beginEpoch so the
+// message handler and scheduled rotation share one code path. Zero windows
+// take the DKG defaults.
+type beginEpochArgs struct {
+	epochID         uint64
+	threshold       uint32
+	committeeSize   uint32
+	commitBlocks    uint64
+	complaintBlocks uint64
+	revealBlocks    uint64
+	finalizeBlocks  uint64
+	randEpoch       []byte
+	origin          string // "manual" or "auto", reported on the event
+}
+
+// beginEpoch samples the committee for the next epoch and starts its DKG.
+// It does not authenticate anyone; callers check threshold and committee
+// size bounds.
+func (m msgServer) beginEpoch(ctx context.Context, args beginEpochArgs) (*dealertypes.DealerDKG, error) {
 	if cur, err := m.GetDKG(ctx); err != nil {
 		return nil, err
 	} else if cur != nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("dkg already in progress")
 	}
+	if rs, err := m.GetReshare(ctx); err != nil {
+		return nil, err
+	} else if rs != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("reshare in progress")
+	}
 
 	next, err := m.GetNextEpochID(ctx)
 	if err != nil {
 		return nil, err
 	}
-	epochID := req.EpochId
+	epochID := args.epochID
 	if epochID == 0 {
 		epochID = next
 	}
@@ -102,37 +147,37 @@ func (m msgServer) BeginEpoch(ctx context.Context, req *dealertypes.MsgBeginEpoc
 	// this requires a closed randomness-beacon window; devnet/local fall back
 	// to the caller-supplied rand_epoch or DevnetRandEpoch. See
 	// x/dealer/keeper/beacon_select_beacon.go.
-	randBytes, err := m.selectRandEpochForSampling(ctx, epochID, req.RandEpoch)
+	randBytes, err := m.selectRandEpochForSampling(ctx, epochID, args.randEpoch)
 	if err != nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap(err.Error())
 	}
-	members, randEpoch, err := sampleMembers(ctx, m.committeeStakingKeeper, epochID, randBytes, int(req.CommitteeSize))
+	members, randEpoch, err := sampleMembers(ctx, m.committeeStakingKeeper, epochID, randBytes, int(args.committeeSize))
 	if err != nil {
 		return nil, err
 	}
 
-	commitBlocks := req.CommitBlocks
+	commitBlocks := args.commitBlocks
 	if commitBlocks == 0 {
 		commitBlocks = dkgCommitBlocksDefault
 	}
 	if err := validateDKGWindow("commitBlocks", commitBlocks); err != nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap(err.Error())
 	}
-	complaintBlocks := req.ComplaintBlocks
+	complaintBlocks := args.complaintBlocks
 	if complaintBlocks == 0 {
 		complaintBlocks = dkgComplaintBlocksDefault
 	}
 	if err := validateDKGWindow("complaintBlocks", complaintBlocks); err != nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap(err.Error())
 	}
-	revealBlocks := req.RevealBlocks
+	revealBlocks := args.revealBlocks
 	if revealBlocks == 0 {
 		revealBlocks = dkgRevealBlocksDefault
 	}
 	if err := validateDKGWindow("revealBlocks", revealBlocks); err != nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap(err.Error())
 	}
-	finalizeBlocks := req.FinalizeBlocks
+	finalizeBlocks := args.finalizeBlocks
 	if finalizeBlocks == 0 {
 		finalizeBlocks = dkgFinalizeBlocksDefault
 	}
@@ -161,7 +206,7 @@ func (m msgServer) BeginEpoch(ctx context.Context, req *dealertypes.MsgBeginEpoc
 
 	dkg := &dealertypes.DealerDKG{
 		EpochId:           epochID,
-		Threshold:         req.Threshold,
+		Threshold:         args.threshold,
 		Members:           members,
 		StartHeight:       startH,
 		CommitDeadline:    commitDL,
@@ -185,22 +230,26 @@ func (m msgServer) BeginEpoch(ctx context.Context, req *dealertypes.MsgBeginEpoc
 	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
 		dealertypes.EventTypeDealerEpochBegun,
 		sdk.NewAttribute("epochId", fmt.Sprintf("%d", epochID)),
-		sdk.NewAttribute("threshold", fmt.Sprintf("%d", req.Threshold)),
+		sdk.NewAttribute("threshold", fmt.Sprintf("%d", args.threshold)),
 		sdk.NewAttribute("committeeSize", fmt.Sprintf("%d", len(members))),
 		sdk.NewAttribute("startHeight", fmt.Sprintf("%d", startH)),
 		sdk.NewAttribute("commitDeadline", fmt.Sprintf("%d", commitDL)),
 		sdk.NewAttribute("complaintDeadline", fmt.Sprintf("%d", complaintDL)),
 		sdk.NewAttribute("revealDeadline", fmt.Sprintf("%d", revealDL)),
 		sdk.NewAttribute("finalizeDeadline", fmt.Sprintf("%d", finalizeDL)),
+		sdk.NewAttribute("origin", args.origin),
 	))
 
-	return &dealertypes.MsgBeginEpochResponse{}, nil
+	return dkg, nil
 }
 
 func (m msgServer) DkgCommit(ctx context.Context, req *dealertypes.MsgDkgCommit) (*dealertypes.MsgDkgCommitResponse, error) {
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 	if req.Dealer == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing dealer")
 	}
@@ -310,6 +359,9 @@ func (m msgServer) DkgEncryptedShare(ctx context.Context, req *dealertypes.MsgDk
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 	if req.Dealer == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing dealer")
 	}
@@ -366,49 +418,9 @@ func (m msgServer) DkgEncryptedShare(ctx context.Context, req *dealertypes.MsgDk
 		return nil, dealertypes.ErrInvalidRequest.Wrap("dealer has not committed")
 	}
 
-	// Validate sizes + canonical encoding of u, v, proof, scalar_ct.
-	if len(req.U) != ocpcrypto.PointBytes {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("u must be 32 bytes")
-	}
-	if len(req.V) != ocpcrypto.PointBytes {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("v must be 32 bytes")
-	}
-	if len(req.Proof) != 160 {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("proof must be 160 bytes")
-	}
-	if len(req.ScalarCt) != ocpcrypto.DkgScalarAeadCtBytes {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("scalar_ct must be %d bytes", ocpcrypto.DkgScalarAeadCtBytes)
-	}
-	U, err := ocpcrypto.PointFromBytesCanonical(req.U)
-	if err != nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("u invalid: %v", err)
-	}
-	V, err := ocpcrypto.PointFromBytesCanonical(req.V)
-	if err != nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("v invalid: %v", err)
-	}
-	proof, err := ocpcrypto.DecodeDkgEncShareProof(req.Proof)
-	if err != nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("proof invalid: %v", err)
-	}
-
-	// Decode the dealer's Feldman commitments to group elements.
-	commitments := make([]ocpcrypto.Point, 0, len(commit.Commitments))
-	for i, cb := range commit.Commitments {
-		cp, err := ocpcrypto.PointFromBytesCanonical(cb)
-		if err != nil {
-			return nil, dealertypes.ErrInvalidRequest.Wrapf("stored commitment[%d] invalid: %v", i, err)
-		}
-		commitments = append(commitments, cp)
-	}
-
-	ok, err := ocpcrypto.DkgEncShareVerify(commitments, req.RecipientIndex, pkR, U, V, proof)
-	if err != nil {
+	if err := verifyEncryptedShare(commit.Commitments, req.RecipientIndex, pkR, req.U, req.V, req.Proof, req.ScalarCt); err != nil {
 		return nil, err
 	}
-	if !ok {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("invalid encrypted-share proof")
-	}
 
 	// Duplicate detection and canonical storage.
 	for _, es := range dkg.EncryptedShares {
@@ -439,10 +451,64 @@ func (m msgServer) DkgEncryptedShare(ctx context.Context, req *dealertypes.MsgDk
 	return &dealertypes.MsgDkgEncryptedShareResponse{}, nil
 }
 
+// verifyEncryptedShare checks the wire encoding of a DKG v2 encrypted share
+// and its DkgEncShareVerify NIZK against the dealer's Feldman commitments at
+// recipientIndex under the recipient's ephemeral key pkR. It is shared by the
+// DKG and reshare flows.
+func verifyEncryptedShare(commitBytes [][]byte, recipientIndex uint32, pkR ocpcrypto.Point, u, v, proofBytes, scalarCt []byte) error {
+	// Validate sizes + canonical encoding of u, v, proof, scalar_ct.
+	if len(u) != ocpcrypto.PointBytes {
+		return dealertypes.ErrInvalidRequest.Wrap("u must be 32 bytes")
+	}
+	if len(v) != ocpcrypto.PointBytes {
+		return dealertypes.ErrInvalidRequest.Wrap("v must be 32 bytes")
+	}
+	if len(proofBytes) != 160 {
+		return dealertypes.ErrInvalidRequest.Wrap("proof must be 160 bytes")
+	}
+	if len(scalarCt) != ocpcrypto.DkgScalarAeadCtBytes {
+		return dealertypes.ErrInvalidRequest.Wrapf("scalar_ct must be %d bytes", ocpcrypto.DkgScalarAeadCtBytes)
+	}
+	U, err := ocpcrypto.PointFromBytesCanonical(u)
+	if err != nil {
+		return dealertypes.ErrInvalidRequest.Wrapf("u invalid: %v", err)
+	}
+	V, err := ocpcrypto.PointFromBytesCanonical(v)
+	if err != nil {
+		return dealertypes.ErrInvalidRequest.Wrapf("v invalid: %v", err)
+	}
+	proof, err := ocpcrypto.DecodeDkgEncShareProof(proofBytes)
+	if err != nil {
+		return dealertypes.ErrInvalidRequest.Wrapf("proof invalid: %v", err)
+	}
+
+	// Decode the dealer's Feldman commitments to group elements.
+	commitments := make([]ocpcrypto.Point, 0, len(commitBytes))
+	for i, cb := range commitBytes {
+		cp, err := ocpcrypto.PointFromBytesCanonical(cb)
+		if err != nil {
+			return dealertypes.ErrInvalidRequest.Wrapf("stored commitment[%d] invalid: %v", i, err)
+		}
+		commitments = append(commitments, cp)
+	}
+
+	ok, err := ocpcrypto.DkgEncShareVerify(commitments, recipientIndex, pkR, U, V, proof)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return dealertypes.ErrInvalidRequest.Wrap("invalid encrypted-share proof")
+	}
+	return nil
+}
+
 func (m msgServer) DkgComplaintMissing(ctx context.Context, req *dealertypes.MsgDkgComplaintMissing) (*dealertypes.MsgDkgComplaintMissingResponse, error) {
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 	if req.Complainer == "" || req.Dealer == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing complainer/dealer")
 	}
@@ -513,6 +579,9 @@ func (m msgServer) DkgComplaintInvalid(ctx context.Context, req *dealertypes.Msg
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 	if req.Complainer == "" || req.Dealer == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing complainer/dealer")
 	}
@@ -610,10 +679,25 @@ func (m msgServer) DkgComplaintInvalid(ctx context.Context, req *dealertypes.Msg
 		slashFraction := bpsToDec(params.SlashBpsDkg)
 		jailDuration := time.Duration(params.JailSecondsDkg) * time.Second
 
-		// Apply penalty using the DKG start height + power snapshot.
+		// Apply penalty using the DKG start height + power snapshot, and
+		// reward the complainer from it.
+		complainerAcc, err := sdk.ValAddressFromBech32(req.Complainer)
+		if err != nil {
+			return nil, err
+		}
+		ctx, payout, err := m.beginSlashPayout(ctx, sdk.AccAddress(complainerAcc).String())
+		if err != nil {
+			return nil, err
+		}
 		if err := m.applyPenalty(ctx, req.Dealer, dkg.StartHeight, dealerMem.Power, slashFraction, jailDuration); err != nil {
 			return nil, err
 		}
+		payout.culprits = []string{req.Dealer}
+		payoutEvents, err := m.settleSlashPayout(ctx, payout)
+		if err != nil {
+			return nil, err
+		}
+		sdkCtx.EventManager().EmitEvents(payoutEvents)
 		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
 			dealertypes.EventTypeValidatorSlashed,
 			sdk.NewAttribute("epochId", fmt.Sprintf("%d", dkg.EpochId)),
@@ -660,6 +744,9 @@ func (m msgServer) DkgComplaintAEADBad(ctx context.Context, req *dealertypes.Msg
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 	if req.Complainer == "" || req.Dealer == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing complainer/dealer")
 	}
@@ -822,9 +909,28 @@ func (m msgServer) DkgComplaintAEADBad(ctx context.Context, req *dealertypes.Msg
 	// already a set. The complaint record still lands so subsequent
 	// findDKGComplaint duplicate-checks fire as expected.
 	if slashedNow {
+		// Only a complaint that proves the dealer at fault earns a reward.
+		reporter := ""
+		if dealerAtFault {
+			complainerAcc, err := sdk.ValAddressFromBech32(req.Complainer)
+			if err != nil {
+				return nil, err
+			}
+			reporter = sdk.AccAddress(complainerAcc).String()
+		}
+		ctx, payout, err := m.beginSlashPayout(ctx, reporter)
+		if err != nil {
+			return nil, err
+		}
 		if err := m.applyPenalty(ctx, guiltyAddr, dkg.StartHeight, guiltyPower, slashFraction, jailDuration); err != nil {
 			return nil, err
 		}
+		payout.culprits = []string{guiltyAddr}
+		payoutEvents, err := m.settleSlashPayout(ctx, payout)
+		if err != nil {
+			return nil, err
+		}
+		sdkCtx.EventManager().EmitEvents(payoutEvents)
 		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
 			dealertypes.EventTypeValidatorSlashed,
 			sdk.NewAttribute("epochId", fmt.Sprintf("%d", dkg.EpochId)),
@@ -863,6 +969,9 @@ func (m msgServer) DkgShareReveal(ctx context.Context, req *dealertypes.MsgDkgSh
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 
 	// DKG version gate: on v2 chains the plaintext reveal path is closed.
 	// Dealers must use MsgDkgEncryptedShare instead. See docs/DKG-V2.md.
@@ -954,6 +1063,9 @@ func (m msgServer) FinalizeEpoch(ctx context.Context, req *dealertypes.MsgFinali
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 	if req.Caller == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing caller")
 	}
@@ -991,6 +1103,9 @@ func (m msgServer) DkgTimeout(ctx context.Context, req *dealertypes.MsgDkgTimeou
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 	if req.Caller == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing caller")
 	}
@@ -1029,6 +1144,11 @@ func (m msgServer) DkgTimeout(ctx context.Context, req *dealertypes.MsgDkgTimeou
 	slashFraction := bpsToDec(params.SlashBpsDkg)
 	jailDuration := time.Duration(params.JailSecondsDkg) * time.Second
 
+	ctx, payout, err := m.beginSlashPayout(ctx, req.Caller)
+	if err != nil {
+		return nil, err
+	}
+
 	// Slash missing commits once the commit deadline passes.
 	for _, mem := range dkg.Members {
 		if findDKGCommit(dkg, mem.Validator) != nil {
@@ -1073,6 +1193,12 @@ func (m msgServer) DkgTimeout(ctx context.Context, req *dealertypes.MsgDkgTimeou
 			sdk.NewAttribute("qual", fmt.Sprintf("%d", qual)),
 			sdk.NewAttribute("reason", "dkg-below-threshold"),
 		))
+		payout.culprits = slashedValidators(events)
+		payoutEvents, err := m.settleSlashPayout(ctx, payout)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, payoutEvents...)
 		for _, ev := range events {
 			sdkCtx.EventManager().EmitEvent(ev)
 		}
@@ -1093,16 +1219,128 @@ func (m msgServer) DkgTimeout(ctx context.Context, req *dealertypes.MsgDkgTimeou
 		events = append(events, finalEvents...)
 	}
 
+	payout.culprits = slashedValidators(events)
+	payoutEvents, err := m.settleSlashPayout(ctx, payout)
+	if err != nil {
+		return nil, err
+	}
+	events = append(events, payoutEvents...)
 	for _, ev := range events {
 		sdkCtx.EventManager().EmitEvent(ev)
 	}
 	return &dealertypes.MsgDkgTimeoutResponse{}, nil
 }
 
+// ReportDKGWithholding slashes one member that missed the DKG commit deadline.
+// The DKG is aborted only if the remaining members fall below threshold.
+func (m msgServer) ReportDKGWithholding(ctx context.Context, req *dealertypes.MsgReportDKGWithholding) (*dealertypes.MsgReportDKGWithholdingResponse, error) {
+	if req == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
+	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
+	if req.Reporter == "" {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("missing reporter")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Reporter); err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("invalid reporter address")
+	}
+	if _, err := sdk.ValAddressFromBech32(req.Validator); err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("invalid validator address")
+	}
+
+	dkg, err := m.GetDKG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dkg == nil {
+		return nil, dealertypes.ErrNoDkgInFlight.Wrap("no dkg in progress")
+	}
+	if req.EpochId != dkg.EpochId {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("epoch_id mismatch")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() <= dkg.CommitDeadline {
+		return nil, dealertypes.ErrInvalidRequest.Wrapf("too early to report dkg withholding: height=%d commitDeadline=%d", sdkCtx.BlockHeight(), dkg.CommitDeadline)
+	}
+	mem := findDKGMember(dkg, req.Validator)
+	if mem == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("validator not in dkg committee")
+	}
+	if dkgIsSlashed(dkg, req.Validator) {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("validator already slashed")
+	}
+	if findDKGCommit(dkg, req.Validator) != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("dkg commit already submitted")
+	}
+
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slashFraction := bpsToDec(params.SlashBpsDkg)
+	jailDuration := time.Duration(params.JailSecondsDkg) * time.Second
+
+	ctx, payout, err := m.beginSlashPayout(ctx, req.Reporter)
+	if err != nil {
+		return nil, err
+	}
+
+	dkgSlash(dkg, req.Validator)
+	if err := m.applyPenalty(ctx, req.Validator, dkg.StartHeight, mem.Power, slashFraction, jailDuration); err != nil {
+		return nil, err
+	}
+	events := []sdk.Event{sdk.NewEvent(
+		dealertypes.EventTypeValidatorSlashed,
+		sdk.NewAttribute("epochId", fmt.Sprintf("%d", dkg.EpochId)),
+		sdk.NewAttribute("validator", req.Validator),
+		sdk.NewAttribute("reason", "dkg-commit-withheld"),
+		sdk.NewAttribute("slashFraction", slashFraction.String()),
+		sdk.NewAttribute("distributionHeight", fmt.Sprintf("%d", dkg.StartHeight)),
+		sdk.NewAttribute("power", fmt.Sprintf("%d", mem.Power)),
+	)}
+
+	qual := 0
+	for _, dm := range dkg.Members {
+		if !dkgIsSlashed(dkg, dm.Validator) {
+			qual++
+		}
+	}
+	if qual < int(dkg.Threshold) {
+		if err := m.SetDKG(ctx, nil); err != nil {
+			return nil, err
+		}
+		events = append(events, sdk.NewEvent(
+			dealertypes.EventTypeDealerEpochAborted,
+			sdk.NewAttribute("epochId", fmt.Sprintf("%d", dkg.EpochId)),
+			sdk.NewAttribute("threshold", fmt.Sprintf("%d", dkg.Threshold)),
+			sdk.NewAttribute("qual", fmt.Sprintf("%d", qual)),
+			sdk.NewAttribute("reason", "dkg-below-threshold"),
+		))
+	} else if err := m.SetDKG(ctx, dkg); err != nil {
+		return nil, err
+	}
+
+	payout.culprits = []string{req.Validator}
+	payoutEvents, err := m.settleSlashPayout(ctx, payout)
+	if err != nil {
+		return nil, err
+	}
+	for _, ev := range append(events, payoutEvents...) {
+		sdkCtx.EventManager().EmitEvent(ev)
+	}
+	return &dealertypes.MsgReportDKGWithholdingResponse{}, nil
+}
+
 func (m msgServer) InitHand(ctx context.Context, req *dealertypes.MsgInitHand) (*dealertypes.MsgInitHandResponse, error) {
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), req.TableId); err != nil {
+		return nil, err
+	}
 	if req.Caller == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing caller")
 	}
@@ -1117,7 +1355,10 @@ func (m msgServer) InitHand(ctx context.Context, req *dealertypes.MsgInitHand) (
 	if err != nil {
 		return nil, err
 	}
-	if t == nil || t.Hand == nil {
+	// A deck for the table's next hand may be prepared while the current hand
+	// plays; see isPreparedHand.
+	prepare := t != nil && isPreparedHand(t, req.HandId)
+	if t == nil || (t.Hand == nil && !prepare) {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("no active hand")
 	}
 	// Gamemaster (table creator) bypass; otherwise require an active bonded validator.
@@ -1127,19 +1368,25 @@ func (m msgServer) InitHand(ctx context.Context, req *dealertypes.MsgInitHand) (
 		}
 	}
 	h := t.Hand
-	if h.HandId != req.HandId {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("hand_id mismatch")
-	}
-	if h.Phase != pokertypes.HandPhase_HAND_PHASE_SHUFFLE {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("hand not in shuffle phase")
-	}
-	if h.Dealer == nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("hand missing dealer meta")
+	if !prepare {
+		if h.HandId != req.HandId {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("hand_id mismatch")
+		}
+		if h.Phase != pokertypes.HandPhase_HAND_PHASE_SHUFFLE {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("hand not in shuffle phase")
+		}
+		if h.Dealer == nil {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("hand missing dealer meta")
+		}
 	}
 
-	if existing, err := m.GetHand(ctx, req.TableId, req.HandId); err != nil {
+	// A stored deck for a live hand that has no deck yet was prepared ahead of
+	// time and is adopted below; anything else is a duplicate init.
+	existing, err := m.GetHand(ctx, req.TableId, req.HandId)
+	if err != nil {
 		return nil, err
-	} else if existing != nil {
+	}
+	if existing != nil && (prepare || h.Dealer.DeckSize != 0 || existing.Finalized) {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("dealer hand already initialized")
 	}
 
@@ -1162,13 +1409,117 @@ func (m msgServer) InitHand(ctx context.Context, req *dealertypes.MsgInitHand) (
 		return nil, dealertypes.ErrInvalidRequest.Wrapf("invalid deck_size %d", deckSize)
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var events []sdk.Event
+	if existing != nil {
+		if existing.DeckSize != deckSize {
+			return nil, dealertypes.ErrInvalidRequest.Wrapf("deck_size mismatch: prepared deck has %d got %d", existing.DeckSize, deckSize)
+		}
+		if existing.EpochId != epoch.EpochId {
+			// The committee rotated after the deck was prepared. Hands always run
+			// under the epoch that is active when they start, so the deck is
+			// dropped and a fresh one is built below.
+			releaseEvents, err := m.releaseHand(ctx, req.TableId, req.HandId)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, sdk.NewEvent(
+				dealertypes.EventTypeDealerDeckDiscarded,
+				sdk.NewAttribute("tableId", fmt.Sprintf("%d", req.TableId)),
+				sdk.NewAttribute("handId", fmt.Sprintf("%d", req.HandId)),
+				sdk.NewAttribute("epochId", fmt.Sprintf("%d", existing.EpochId)),
+			))
+			events = append(events, releaseEvents...)
+			existing = nil
+		}
+	}
+
+	dh := existing
+	if dh == nil {
+		dh, err = newDealerHand(sdkCtx, epoch, req.TableId, req.HandId, deckSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if prepare {
+		// Prepared decks have no shuffle clock: nobody is waiting on them, so a
+		// slow shuffler is only penalized once a hand adopts the deck.
+		if err := m.SetHand(ctx, req.TableId, req.HandId, dh); err != nil {
+			return nil, err
+		}
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			dealertypes.EventTypeDealerDeckPrepared,
+			sdk.NewAttribute("tableId", fmt.Sprintf("%d", req.TableId)),
+			sdk.NewAttribute("handId", fmt.Sprintf("%d", req.HandId)),
+			sdk.NewAttribute("epochId", fmt.Sprintf("%d", epoch.EpochId)),
+			sdk.NewAttribute("deckSize", fmt.Sprintf("%d", deckSize)),
+		))
+		return &dealertypes.MsgInitHandResponse{}, nil
+	}
+
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nowUnix := sdkCtx.BlockTime().Unix()
+	shuffleDeadline, err := addInt64AndU64Checked(nowUnix, shuffleSlotSecs(params, t), "dealer shuffle deadline")
+	if err != nil {
+		return nil, err
+	}
+	dh.ShuffleDeadline = shuffleDeadline
+
+	// Update poker meta.
+	meta := h.Dealer
+	meta.EpochId = epoch.EpochId
+	meta.DeckSize = deckSize
+	meta.DeckFinalized = false
+	meta.Cursor = 0
+	meta.RevealPos = 255
+	meta.RevealDeadline = 0
+	meta.HolePos = make([]uint32, 18)
+	for i := range meta.HolePos {
+		meta.HolePos[i] = 255
+	}
+
+	if err := m.pokerKeeper.SetTable(ctx, t); err != nil {
+		return nil, err
+	}
+	if err := m.SetHand(ctx, req.TableId, req.HandId, dh); err != nil {
+		return nil, err
+	}
+
+	events = append(events, sdk.NewEvent(
+		dealertypes.EventTypeDealerHandInitialized,
+		sdk.NewAttribute("tableId", fmt.Sprintf("%d", req.TableId)),
+		sdk.NewAttribute("handId", fmt.Sprintf("%d", req.HandId)),
+		sdk.NewAttribute("epochId", fmt.Sprintf("%d", epoch.EpochId)),
+		sdk.NewAttribute("deckSize", fmt.Sprintf("%d", deckSize)),
+		sdk.NewAttribute("shuffleStep", fmt.Sprintf("%d", dh.ShuffleStep)),
+	))
+
+	// A deck every qualified member already shuffled is dealt right away.
+	if _, ok := nextShuffler(epoch, dh); ok || dh.ShuffleStep == 0 || len(epochQualMembers(epoch)) < int(epoch.Threshold) {
+		sdkCtx.EventManager().EmitEvents(events)
+		return &dealertypes.MsgInitHandResponse{}, nil
+	}
+	deckEvents, err := m.finalizeDeck(ctx, req.TableId, req.HandId)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx.EventManager().EmitEvents(append(events, deckEvents...))
+	return &dealertypes.MsgInitHandResponse{}, nil
+}
+
+// newDealerHand builds the canonical encrypted deck D0 for a hand under the
+// per-hand key derived from epoch, table, hand and the current block.
+func newDealerHand(sdkCtx sdk.Context, epoch *dealertypes.DealerEpoch, tableID, handID uint64, deckSize uint32) (*dealertypes.DealerHand, error) {
 	// Capture per-hand init-time block entropy to mix into k_hand (v2 hand-derive).
 	// Without per-hand entropy, leaking the epoch secret would retroactively decrypt
 	// every hand in the epoch. LastBlockId.Hash is already fixed at init time, so a
 	// future attacker cannot grind it after the fact.
-	sdkCtxInit := sdk.UnwrapSDKContext(ctx)
-	initHeight := sdkCtxInit.BlockHeight()
-	initSalt := append([]byte(nil), sdkCtxInit.BlockHeader().LastBlockId.Hash...)
+	initHeight := sdkCtx.BlockHeight()
+	initSalt := append([]byte(nil), sdkCtx.BlockHeader().LastBlockId.Hash...)
 	if len(initSalt) != 32 {
 		// Genesis / empty prior block hash: pad to 32 zero bytes deterministically.
 		padded := make([]byte, 32)
@@ -1176,7 +1527,7 @@ func (m msgServer) InitHand(ctx context.Context, req *dealertypes.MsgInitHand) (
 		initSalt = padded
 	}
 
-	k, err := deriveHandScalar(epoch.EpochId, t.Id, h.HandId, initHeight, initSalt)
+	k, err := deriveHandScalar(epoch.EpochId, tableID, handID, initHeight, initSalt)
 	if err != nil {
 		return nil, err
 	}
@@ -1205,21 +1556,14 @@ func (m msgServer) InitHand(ctx context.Context, req *dealertypes.MsgInitHand) (
 		})
 	}
 
-	nowUnix := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
-	to := tableDealerTimeoutSecs(t)
-	shuffleDeadline, err := addInt64AndU64Checked(nowUnix, to, "dealer shuffle deadline")
-	if err != nil {
-		return nil, err
-	}
-
-	dh := &dealertypes.DealerHand{
+	return &dealertypes.DealerHand{
 		EpochId:            epoch.EpochId,
 		PkHand:             append([]byte(nil), pkHand.Bytes()...),
 		DeckSize:           deckSize,
 		Deck:               deck,
 		ShuffleStep:        0,
 		Finalized:          false,
-		ShuffleDeadline:    shuffleDeadline,
+		ShuffleDeadline:    0,
 		HoleSharesDeadline: 0,
 		PubShares:          []dealertypes.DealerPubShare{},
 		EncShares:          []dealertypes.DealerEncShare{},
@@ -1227,43 +1571,16 @@ func (m msgServer) InitHand(ctx context.Context, req *dealertypes.MsgInitHand) (
 		// Per-hand block entropy (proto fields 20/21). Requires proto regen.
 		InitHeight:   initHeight,
 		InitHashSalt: initSalt,
-	}
-
-	// Update poker meta.
-	meta := h.Dealer
-	meta.EpochId = epoch.EpochId
-	meta.DeckSize = deckSize
-	meta.DeckFinalized = false
-	meta.Cursor = 0
-	meta.RevealPos = 255
-	meta.RevealDeadline = 0
-	meta.HolePos = make([]uint32, 18)
-	for i := range meta.HolePos {
-		meta.HolePos[i] = 255
-	}
-
-	if err := m.pokerKeeper.SetTable(ctx, t); err != nil {
-		return nil, err
-	}
-	if err := m.SetHand(ctx, req.TableId, req.HandId, dh); err != nil {
-		return nil, err
-	}
-
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
-		dealertypes.EventTypeDealerHandInitialized,
-		sdk.NewAttribute("tableId", fmt.Sprintf("%d", req.TableId)),
-		sdk.NewAttribute("handId", fmt.Sprintf("%d", req.HandId)),
-		sdk.NewAttribute("epochId", fmt.Sprintf("%d", epoch.EpochId)),
-		sdk.NewAttribute("deckSize", fmt.Sprintf("%d", deckSize)),
-	))
-	return &dealertypes.MsgInitHandResponse{}, nil
+	}, nil
 }
 
 func (m msgServer) SubmitShuffle(ctx context.Context, req *dealertypes.MsgSubmitShuffle) (*dealertypes.MsgSubmitShuffleResponse, error) {
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), req.TableId); err != nil {
+		return nil, err
+	}
 	if req.Shuffler == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing shuffler")
 	}
@@ -1281,15 +1598,21 @@ func (m msgServer) SubmitShuffle(ctx context.Context, req *dealertypes.MsgSubmit
 	if err != nil {
 		return nil, err
 	}
-	if t == nil || t.Hand == nil || t.Hand.Dealer == nil {
+	if t == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("dealer hand not initialized")
 	}
-	h := t.Hand
-	if h.HandId != req.HandId {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("hand_id mismatch")
-	}
-	if h.Phase != pokertypes.HandPhase_HAND_PHASE_SHUFFLE {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("hand not in shuffle phase")
+	prepared := isPreparedHand(t, req.HandId)
+	if !prepared {
+		if t.Hand == nil || t.Hand.Dealer == nil {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("dealer hand not initialized")
+		}
+		h := t.Hand
+		if h.HandId != req.HandId {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("hand_id mismatch")
+		}
+		if h.Phase != pokertypes.HandPhase_HAND_PHASE_SHUFFLE {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("hand not in shuffle phase")
+		}
 	}
 
 	dh, err := m.GetHand(ctx, req.TableId, req.HandId)
@@ -1311,11 +1634,11 @@ func (m msgServer) SubmitShuffle(ctx context.Context, req *dealertypes.MsgSubmit
 		return nil, dealertypes.ErrInvalidRequest.Wrapf("round mismatch: expected %d got %d", dh.ShuffleStep+1, req.Round)
 	}
 
-	epoch, err := m.GetEpoch(ctx)
+	epoch, err := m.EpochByID(ctx, dh.EpochId)
 	if err != nil {
 		return nil, err
 	}
-	if epoch == nil || epoch.EpochId != dh.EpochId {
+	if epoch == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("epoch not available")
 	}
 	if findEpochMember(epoch, req.Shuffler) == nil {
@@ -1325,13 +1648,28 @@ func (m msgServer) SubmitShuffle(ctx context.Context, req *dealertypes.MsgSubmit
 		return nil, dealertypes.ErrInvalidRequest.Wrap("shuffler is slashed")
 	}
 
-	qual := epochQualMembers(epoch)
-	if int(dh.ShuffleStep) >= len(qual) {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("no qualified shuffler available")
+	if epochIsInactive(epoch, req.Shuffler) {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("shuffler is inactive")
 	}
-	expectID := qual[dh.ShuffleStep].Validator
-	if req.Shuffler != expectID {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("unexpected shuffler: expected %s got %s", expectID, req.Shuffler)
+
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.MinShufflers == 0 {
+		expectID, ok := nextShuffler(epoch, dh)
+		if !ok {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("no qualified shuffler available")
+		}
+		if req.Shuffler != expectID {
+			return nil, dealertypes.ErrInvalidRequest.Wrapf("unexpected shuffler: expected %s got %s", expectID, req.Shuffler)
+		}
+	} else if slices.Contains(handShufflers(epoch, dh), req.Shuffler) {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("shuffler already shuffled this deck")
+	}
+
+	if uint32(req.ProofShuffle[0]) < params.MinShuffleProofVersion {
+		return nil, dealertypes.ErrInvalidRequest.Wrapf("shuffle proof v%d below min_shuffle_proof_version %d", req.ProofShuffle[0], params.MinShuffleProofVersion)
 	}
 
 	// Build canonical context bytes (must match what the prover bound into
@@ -1347,12 +1685,15 @@ func (m msgServer) SubmitShuffle(ctx context.Context, req *dealertypes.MsgSubmit
 	}
 
 	dh.Deck = deckOut
+	dh.Shufflers = append(handShufflers(epoch, dh), req.Shuffler)
 	dh.ShuffleStep = req.Round
-	shuffleDeadline, err := addInt64AndU64Checked(nowUnix, tableDealerTimeoutSecs(t), "dealer shuffle deadline")
-	if err != nil {
-		return nil, err
+	if !prepared {
+		shuffleDeadline, err := addInt64AndU64Checked(nowUnix, shuffleSlotSecs(params, t), "dealer shuffle deadline")
+		if err != nil {
+			return nil, err
+		}
+		dh.ShuffleDeadline = shuffleDeadline
 	}
-	dh.ShuffleDeadline = shuffleDeadline
 
 	if err := m.SetHand(ctx, req.TableId, req.HandId, dh); err != nil {
 		return nil, err
@@ -1374,6 +1715,9 @@ func (m msgServer) FinalizeDeck(ctx context.Context, req *dealertypes.MsgFinaliz
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), req.TableId); err != nil {
+		return nil, err
+	}
 	if req.Caller == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing caller")
 	}
@@ -1399,164 +1743,221 @@ func (m msgServer) SubmitEncShare(ctx context.Context, req *dealertypes.MsgSubmi
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
-	if req.Validator == "" {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("missing validator")
+	entry := dealertypes.EncShareEntry{
+		Pos:           req.Pos,
+		PkPlayer:      req.PkPlayer,
+		EncShare:      req.EncShare,
+		ProofEncShare: req.ProofEncShare,
 	}
-	if _, err := sdk.ValAddressFromBech32(req.Validator); err != nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("invalid validator address")
+	if err := m.submitEncShares(ctx, sdk.MsgTypeURL(req), req.Validator, req.TableId, req.HandId, []dealertypes.EncShareEntry{entry}); err != nil {
+		return nil, err
 	}
-	if req.TableId == 0 || req.HandId == 0 {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("table_id and hand_id must be > 0")
+	return &dealertypes.MsgSubmitEncShareResponse{}, nil
+}
+
+func (m msgServer) SubmitEncShares(ctx context.Context, req *dealertypes.MsgSubmitEncShares) (*dealertypes.MsgSubmitEncSharesResponse, error) {
+	if req == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
+	}
+	if err := m.submitEncShares(ctx, sdk.MsgTypeURL(req), req.Validator, req.TableId, req.HandId, req.Shares); err != nil {
+		return nil, err
+	}
+	return &dealertypes.MsgSubmitEncSharesResponse{}, nil
+}
+
+// submitEncShares validates and stores one validator's encrypted shares for
+// the given hole positions. Every entry is checked before any is stored, and
+// the proofs of a multi-entry batch are verified together, so a batch is
+// accepted or rejected as a whole.
+func (m msgServer) submitEncShares(ctx context.Context, msgTypeURL, validator string, tableID, handID uint64, entries []dealertypes.EncShareEntry) error {
+	if err := m.pokerKeeper.CheckNotPaused(ctx, msgTypeURL, tableID); err != nil {
+		return err
+	}
+	if validator == "" {
+		return dealertypes.ErrInvalidRequest.Wrap("missing validator")
 	}
-	if len(req.PkPlayer) != ocpcrypto.PointBytes {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("pk_player must be 32 bytes")
+	if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+		return dealertypes.ErrInvalidRequest.Wrap("invalid validator address")
 	}
-	if len(req.EncShare) != 64 {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("enc_share must be 64 bytes")
+	if tableID == 0 || handID == 0 {
+		return dealertypes.ErrInvalidRequest.Wrap("table_id and hand_id must be > 0")
 	}
-	if len(req.ProofEncShare) != 160 {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("proof_enc_share must be 160 bytes")
+	// At most 9 seats with 2 hole cards each.
+	if len(entries) == 0 || len(entries) > 18 {
+		return dealertypes.ErrInvalidRequest.Wrap("shares must have between 1 and 18 entries")
+	}
+	for _, e := range entries {
+		if len(e.PkPlayer) != ocpcrypto.PointBytes {
+			return dealertypes.ErrInvalidRequest.Wrap("pk_player must be 32 bytes")
+		}
+		if len(e.EncShare) != 64 {
+			return dealertypes.ErrInvalidRequest.Wrap("enc_share must be 64 bytes")
+		}
+		if len(e.ProofEncShare) != 160 {
+			return dealertypes.ErrInvalidRequest.Wrap("proof_enc_share must be 160 bytes")
+		}
 	}
 
-	t, err := m.pokerKeeper.GetTable(ctx, req.TableId)
+	t, err := m.pokerKeeper.GetTable(ctx, tableID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if t == nil || t.Hand == nil || t.Hand.Dealer == nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("dealer hand not initialized")
+		return dealertypes.ErrInvalidRequest.Wrap("dealer hand not initialized")
 	}
 	h := t.Hand
-	if h.HandId != req.HandId {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("hand_id mismatch")
+	if h.HandId != handID {
+		return dealertypes.ErrInvalidRequest.Wrap("hand_id mismatch")
 	}
 	if h.Phase != pokertypes.HandPhase_HAND_PHASE_SHUFFLE {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("hand not in shuffle phase")
+		return dealertypes.ErrInvalidRequest.Wrap("hand not in shuffle phase")
 	}
 	meta := h.Dealer
 	if !meta.DeckFinalized || len(meta.HolePos) != 18 {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("deck not finalized")
+		return dealertypes.ErrInvalidRequest.Wrap("deck not finalized")
 	}
 
-	dh, err := m.GetHand(ctx, req.TableId, req.HandId)
+	dh, err := m.GetHand(ctx, tableID, handID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if dh == nil {
-		return nil, dealertypes.ErrHandNotFound.Wrap("dealer hand not initialized")
+		return dealertypes.ErrHandNotFound.Wrap("dealer hand not initialized")
 	}
 	if !dh.Finalized {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("deck not finalized")
+		return dealertypes.ErrInvalidRequest.Wrap("deck not finalized")
 	}
-	if int(req.Pos) >= len(dh.Deck) {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("pos out of bounds")
+	for _, e := range entries {
+		if int(e.Pos) >= len(dh.Deck) {
+			return dealertypes.ErrInvalidRequest.Wrap("pos out of bounds")
+		}
 	}
 
 	nowUnix := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
 	if dh.HoleSharesDeadline == 0 {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("hole shares deadline not initialized")
+		return dealertypes.ErrInvalidRequest.Wrap("hole shares deadline not initialized")
 	}
 	if nowUnix >= dh.HoleSharesDeadline {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("hole shares deadline passed; call dealer/timeout")
+		return dealertypes.ErrInvalidRequest.Wrap("hole shares deadline passed; call dealer/timeout")
 	}
 
-	epoch, err := m.GetEpoch(ctx)
+	epoch, err := m.EpochByID(ctx, dh.EpochId)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if epoch == nil || epoch.EpochId != dh.EpochId {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("epoch not available")
+	if epoch == nil {
+		return dealertypes.ErrInvalidRequest.Wrap("epoch not available")
 	}
-	mem := findEpochMember(epoch, req.Validator)
+	mem := findEpochMember(epoch, validator)
 	if mem == nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("validator not in committee")
+		return dealertypes.ErrInvalidRequest.Wrap("validator not in committee")
 	}
-	if epochIsSlashed(epoch, req.Validator) {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("validator is slashed")
+	if epochIsSlashed(epoch, validator) {
+		return dealertypes.ErrInvalidRequest.Wrap("validator is slashed")
 	}
 
-	// Gate: only allow encrypted shares for in-hand hole positions, and require pk match.
-	holeSeat, ok := isHolePos(meta, h, req.Pos)
-	if !ok {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("pos is not a hole card position")
-	}
-	if holeSeat < 0 || holeSeat >= 9 || t.Seats[holeSeat] == nil || len(t.Seats[holeSeat].Pk) != ocpcrypto.PointBytes {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("seat missing pk")
-	}
-	if !bytes.Equal(t.Seats[holeSeat].Pk, req.PkPlayer) {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("pk_player mismatch for seat %d", holeSeat)
-	}
+	seen := make(map[uint32]bool, len(entries))
+	for _, e := range entries {
+		// Gate: only allow encrypted shares for in-hand hole positions, and require pk match.
+		holeSeat, ok := isHolePos(meta, h, e.Pos)
+		if !ok {
+			return dealertypes.ErrInvalidRequest.Wrap("pos is not a hole card position")
+		}
+		if holeSeat < 0 || holeSeat >= 9 || t.Seats[holeSeat] == nil || len(t.Seats[holeSeat].Pk) != ocpcrypto.PointBytes {
+			return dealertypes.ErrInvalidRequest.Wrap("seat missing pk")
+		}
+		if !bytes.Equal(t.Seats[holeSeat].Pk, e.PkPlayer) {
+			return dealertypes.ErrInvalidRequest.Wrapf("pk_player mismatch for seat %d", holeSeat)
+		}
 
-	// Prevent duplicates.
-	for _, es := range dh.EncShares {
-		if es.Pos == req.Pos && es.Validator == req.Validator {
-			return nil, dealertypes.ErrInvalidRequest.Wrap("duplicate enc share")
+		// Prevent duplicates.
+		if seen[e.Pos] {
+			return dealertypes.ErrInvalidRequest.Wrapf("pos %d repeated in batch", e.Pos)
+		}
+		seen[e.Pos] = true
+		for _, es := range dh.EncShares {
+			if es.Pos == e.Pos && es.Validator == validator {
+				return dealertypes.ErrInvalidRequest.Wrap("duplicate enc share")
+			}
 		}
 	}
 
 	k, err := deriveHandScalar(dh.EpochId, t.Id, h.HandId, dh.InitHeight, dh.InitHashSalt)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	Yepoch, err := ocpcrypto.PointFromBytesCanonical(mem.PubShare)
 	if err != nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("pub_share invalid: %v", err)
+		return dealertypes.ErrInvalidRequest.Wrapf("pub_share invalid: %v", err)
 	}
 	Yhand := ocpcrypto.MulPoint(Yepoch, k)
 
-	c1Cipher, err := ocpcrypto.PointFromBytesCanonical(dh.Deck[req.Pos].C1)
-	if err != nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("ciphertext c1 invalid: %v", err)
-	}
-	pkPlayer, err := ocpcrypto.PointFromBytesCanonical(req.PkPlayer)
-	if err != nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("pk_player invalid: %v", err)
-	}
-	U, err := ocpcrypto.PointFromBytesCanonical(req.EncShare[:32])
-	if err != nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("enc_share.u invalid: %v", err)
-	}
-	V, err := ocpcrypto.PointFromBytesCanonical(req.EncShare[32:])
-	if err != nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("enc_share.v invalid: %v", err)
+	stmts := make([]ocpcrypto.EncShareStatement, 0, len(entries))
+	for _, e := range entries {
+		c1Cipher, err := ocpcrypto.PointFromBytesCanonical(dh.Deck[e.Pos].C1)
+		if err != nil {
+			return dealertypes.ErrInvalidRequest.Wrapf("ciphertext c1 invalid: %v", err)
+		}
+		pkPlayer, err := ocpcrypto.PointFromBytesCanonical(e.PkPlayer)
+		if err != nil {
+			return dealertypes.ErrInvalidRequest.Wrapf("pk_player invalid: %v", err)
+		}
+		U, err := ocpcrypto.PointFromBytesCanonical(e.EncShare[:32])
+		if err != nil {
+			return dealertypes.ErrInvalidRequest.Wrapf("enc_share.u invalid: %v", err)
+		}
+		V, err := ocpcrypto.PointFromBytesCanonical(e.EncShare[32:])
+		if err != nil {
+			return dealertypes.ErrInvalidRequest.Wrapf("enc_share.v invalid: %v", err)
+		}
+		proof, err := ocpcrypto.DecodeEncShareProof(e.ProofEncShare)
+		if err != nil {
+			return dealertypes.ErrInvalidRequest.Wrapf("proof_enc_share invalid: %v", err)
+		}
+		stmts = append(stmts, ocpcrypto.EncShareStatement{Y: Yhand, C1: c1Cipher, PKP: pkPlayer, U: U, V: V, Proof: proof})
 	}
-	proof, err := ocpcrypto.DecodeEncShareProof(req.ProofEncShare)
-	if err != nil {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("proof_enc_share invalid: %v", err)
+	var okProof bool
+	if len(stmts) == 1 {
+		st := stmts[0]
+		okProof, err = ocpcrypto.EncShareVerify(st.Y, st.C1, st.PKP, st.U, st.V, st.Proof)
+	} else {
+		okProof, err = ocpcrypto.EncShareBatchVerify(stmts)
 	}
-	okProof, err := ocpcrypto.EncShareVerify(Yhand, c1Cipher, pkPlayer, U, V, proof)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if !okProof {
-		return nil, dealertypes.ErrInvalidRequest.Wrap("invalid enc share proof")
+		return dealertypes.ErrInvalidRequest.Wrap("invalid enc share proof")
 	}
 
-	dh.EncShares = append(dh.EncShares, dealertypes.DealerEncShare{
-		Pos:       req.Pos,
-		Validator: req.Validator,
-		Index:     mem.Index,
-		PkPlayer:  append([]byte(nil), req.PkPlayer...),
-		EncShare:  append([]byte(nil), req.EncShare...),
-		Proof:     append([]byte(nil), req.ProofEncShare...),
-	})
+	for _, e := range entries {
+		dh.EncShares = append(dh.EncShares, dealertypes.DealerEncShare{
+			Pos:       e.Pos,
+			Validator: validator,
+			Index:     mem.Index,
+			PkPlayer:  append([]byte(nil), e.PkPlayer...),
+			EncShare:  append([]byte(nil), e.EncShare...),
+			Proof:     append([]byte(nil), e.ProofEncShare...),
+		})
+	}
 	sortEncShares(dh)
 
 	// If we have enough encrypted shares for all in-hand hole cards, open betting.
 	if ready, err := dealerHoleEncSharesReady(epoch, t, dh); err != nil {
-		return nil, err
+		return err
 	} else if ready && h.Phase == pokertypes.HandPhase_HAND_PHASE_SHUFFLE {
 		dh.HoleSharesDeadline = 0
-		if err := m.SetHand(ctx, req.TableId, req.HandId, dh); err != nil {
-			return nil, err
+		if err := m.SetHand(ctx, tableID, handID, dh); err != nil {
+			return err
 		}
-		if err := m.pokerKeeper.AdvanceAfterHoleSharesReady(ctx, req.TableId, req.HandId, nowUnix); err != nil {
-			return nil, err
+		if err := m.pokerKeeper.AdvanceAfterHoleSharesReady(ctx, tableID, handID, nowUnix); err != nil {
+			return err
 		}
 
 		// Re-load for event attributes.
-		t2, err := m.pokerKeeper.GetTable(ctx, req.TableId)
+		t2, err := m.pokerKeeper.GetTable(ctx, tableID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		phase := ""
 		if t2 != nil && t2.Hand != nil {
@@ -1564,31 +1965,36 @@ func (m msgServer) SubmitEncShare(ctx context.Context, req *dealertypes.MsgSubmi
 		}
 		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
 			dealertypes.EventTypeHoleCardsReady,
-			sdk.NewAttribute("tableId", fmt.Sprintf("%d", req.TableId)),
-			sdk.NewAttribute("handId", fmt.Sprintf("%d", req.HandId)),
+			sdk.NewAttribute("tableId", fmt.Sprintf("%d", tableID)),
+			sdk.NewAttribute("handId", fmt.Sprintf("%d", handID)),
 			sdk.NewAttribute("phase", phase),
 		))
 	}
 
-	if err := m.SetHand(ctx, req.TableId, req.HandId, dh); err != nil {
-		return nil, err
+	if err := m.SetHand(ctx, tableID, handID, dh); err != nil {
+		return err
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
-		dealertypes.EventTypeEncShareAccepted,
-		sdk.NewAttribute("tableId", fmt.Sprintf("%d", req.TableId)),
-		sdk.NewAttribute("handId", fmt.Sprintf("%d", req.HandId)),
-		sdk.NewAttribute("pos", fmt.Sprintf("%d", req.Pos)),
-		sdk.NewAttribute("validator", req.Validator),
-	))
-	return &dealertypes.MsgSubmitEncShareResponse{}, nil
+	for _, e := range entries {
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			dealertypes.EventTypeEncShareAccepted,
+			sdk.NewAttribute("tableId", fmt.Sprintf("%d", tableID)),
+			sdk.NewAttribute("handId", fmt.Sprintf("%d", handID)),
+			sdk.NewAttribute("pos", fmt.Sprintf("%d", e.Pos)),
+			sdk.NewAttribute("validator", validator),
+		))
+	}
+	return nil
 }
 
 func (m msgServer) SubmitPubShare(ctx context.Context, req *dealertypes.MsgSubmitPubShare) (*dealertypes.MsgSubmitPubShareResponse, error) {
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), req.TableId); err != nil {
+		return nil, err
+	}
 	if req.Validator == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing validator")
 	}
@@ -1637,11 +2043,11 @@ func (m msgServer) SubmitPubShare(ctx context.Context, req *dealertypes.MsgSubmi
 		return nil, dealertypes.ErrInvalidRequest.Wrap("pos out of bounds")
 	}
 
-	epoch, err := m.GetEpoch(ctx)
+	epoch, err := m.EpochByID(ctx, dh.EpochId)
 	if err != nil {
 		return nil, err
 	}
-	if epoch == nil || epoch.EpochId != dh.EpochId {
+	if epoch == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("epoch not available")
 	}
 	mem := findEpochMember(epoch, req.Validator)
@@ -1717,6 +2123,9 @@ func (m msgServer) FinalizeReveal(ctx context.Context, req *dealertypes.MsgFinal
 	if req == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), req.TableId); err != nil {
+		return nil, err
+	}
 	if req.Caller == "" {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("missing caller")
 	}
@@ -1752,17 +2161,85 @@ func (m msgServer) Timeout(ctx context.Context, req *dealertypes.MsgTimeout) (*d
 		return nil, dealertypes.ErrInvalidRequest.Wrap("table_id and hand_id must be > 0")
 	}
 
+	// The caller is rewarded for any slash the timeout applies, and the
+	// players are compensated if it aborts their hand. The slashed
+	// validators' own accounts are paid neither.
+	t, err := m.pokerKeeper.GetTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+	ctx, payout, err := m.beginSlashPayout(ctx, req.Caller)
+	if err != nil {
+		return nil, err
+	}
 	events, err := m.timeout(ctx, req.TableId, req.HandId)
 	if err != nil {
 		return nil, err
 	}
+	if handAborted(events) {
+		payout.victims = handPlayers(t)
+	}
+	payout.culprits = slashedValidators(events)
+	payoutEvents, err := m.settleSlashPayout(ctx, payout)
+	if err != nil {
+		return nil, err
+	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	for _, ev := range events {
+	for _, ev := range append(events, payoutEvents...) {
 		sdkCtx.EventManager().EmitEvent(ev)
 	}
 	return &dealertypes.MsgTimeoutResponse{}, nil
 }
 
+// ReportDealerWithholding slashes one member that missed its share deadline for
+// a hand position. The reporter is rewarded as for Timeout.
+func (m msgServer) ReportDealerWithholding(ctx context.Context, req *dealertypes.MsgReportDealerWithholding) (*dealertypes.MsgReportDealerWithholdingResponse, error) {
+	if req == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
+	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), req.TableId); err != nil {
+		return nil, err
+	}
+	if req.Reporter == "" {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("missing reporter")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Reporter); err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("invalid reporter address")
+	}
+	if _, err := sdk.ValAddressFromBech32(req.Validator); err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("invalid validator address")
+	}
+	if req.TableId == 0 || req.HandId == 0 {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("table_id and hand_id must be > 0")
+	}
+
+	t, err := m.pokerKeeper.GetTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+	ctx, payout, err := m.beginSlashPayout(ctx, req.Reporter)
+	if err != nil {
+		return nil, err
+	}
+	events, err := m.reportDealerWithholding(ctx, req.TableId, req.HandId, req.Pos, req.Validator)
+	if err != nil {
+		return nil, err
+	}
+	if handAborted(events) {
+		payout.victims = handPlayers(t)
+	}
+	payout.culprits = slashedValidators(events)
+	payoutEvents, err := m.settleSlashPayout(ctx, payout)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, ev := range append(events, payoutEvents...) {
+		sdkCtx.EventManager().EmitEvent(ev)
+	}
+	return &dealertypes.MsgReportDealerWithholdingResponse{}, nil
+}
+
 // applyPenalty is a thin wrapper around the shared slashing helper.
 func (m msgServer) applyPenalty(
 	ctx context.Context,
@@ -137,10 +137,14 @@ func setBlockHeight(ctx context.Context, h int64) context.Context {
 
 func TestDkgComplaintAEADBad_DealerSlashedOnAEADFail(t *testing.T) {
 	f, ctx, k, ms := newAeadFixture(t, 2)
+	enableSlashPayouts(t, ctx, k)
 
 	dkg, err := k.GetDKG(ctx)
 	require.NoError(t, err)
 	dkg.EncryptedShares[0].ScalarCt = corruptCt(dkg.EncryptedShares[0].ScalarCt)
+	for i := range dkg.Members {
+		dkg.Members[i].Power = 1
+	}
 	require.NoError(t, k.SetDKG(ctx, dkg))
 
 	dh, proof := dleqProveDh(t, f)
@@ -160,6 +164,12 @@ func TestDkgComplaintAEADBad_DealerSlashedOnAEADFail(t *testing.T) {
 	require.NotContains(t, got.Slashed, f.complainer, "complainer should NOT be slashed")
 	require.Len(t, got.Complaints, 1)
 	require.Equal(t, "aead-bad", got.Complaints[0].Kind)
+
+	// The complainer earns 10% of the dealer's 50% slash of 1_000_000.
+	complainerAcc, err := sdk.ValAddressFromBech32(f.complainer)
+	require.NoError(t, err)
+	bank := k.bankKeeper.(*fakeDealerBankKeeper)
+	require.Equal(t, int64(50_000), bank.balance(sdk.AccAddress(complainerAcc).String()).Int64())
 }
 
 func TestDkgComplaintAEADBad_DealerSlashedOnScalarMismatch(t *testing.T) {
@@ -193,8 +203,16 @@ func TestDkgComplaintAEADBad_ComplainerSlashedOnSpurious(t *testing.T) {
 
 	// Ciphertext is the valid encShareTestSetup one — AEAD succeeds AND
 	// the scalar matches the share point. Filing a complaint = griefing.
+	dkg, err := k.GetDKG(ctx)
+	require.NoError(t, err)
+	for i := range dkg.Members {
+		dkg.Members[i].Power = 1
+	}
+	require.NoError(t, k.SetDKG(ctx, dkg))
+	supply := k.bankKeeper.(*fakeDealerBankKeeper).supply
+
 	dh, proof := dleqProveDh(t, f)
-	_, err := ms.DkgComplaintAEADBad(ctx, &dealertypes.MsgDkgComplaintAEADBad{
+	_, err = ms.DkgComplaintAEADBad(ctx, &dealertypes.MsgDkgComplaintAEADBad{
 		Complainer:     f.complainer,
 		EpochId:        42,
 		Dealer:         f.dealer,
@@ -210,6 +228,14 @@ func TestDkgComplaintAEADBad_ComplainerSlashedOnSpurious(t *testing.T) {
 	require.NotContains(t, got.Slashed, f.dealer)
 	require.Len(t, got.Complaints, 1)
 	require.Equal(t, "aead-spurious", got.Complaints[0].Kind)
+
+	// A spurious complaint earns nothing; the whole slash stays burned.
+	complainerAcc, err := sdk.ValAddressFromBech32(f.complainer)
+	require.NoError(t, err)
+	bank := k.bankKeeper.(*fakeDealerBankKeeper)
+	require.True(t, bank.balance(sdk.AccAddress(complainerAcc).String()).IsZero())
+	require.Equal(t, supply.SubRaw(500_000), bank.supply)
+	require.Equal(t, 0, countEvents(sdk.UnwrapSDKContext(ctx), dealertypes.EventTypeSlashProceedsPaid))
 }
 
 func TestDkgComplaintAEADBad_RejectsBadDLEQ(t *testing.T) {
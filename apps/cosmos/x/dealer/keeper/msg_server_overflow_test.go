@@ -3,7 +3,9 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"math"
+	"sort"
 	"testing"
 	"time"
 
@@ -48,17 +50,79 @@ func (f fakeDealerStakingKeeper) GetBondedValidatorsByPower(_ context.Context) (
 	return f.bonded, nil
 }
 
-type fakeDealerSlashingKeeper struct{}
+func (fakeDealerStakingKeeper) BondDenom(context.Context) (string, error) {
+	return sdk.DefaultBondDenom, nil
+}
+
+// fakeDealerBankKeeper tracks the bond denom's supply and the balances of
+// module and account addresses, keyed by module name or bech32 address. It
+// embeds the staking bank keeper interface only so x/staking's wrapper can be
+// built around it.
+type fakeDealerBankKeeper struct {
+	stakingtypes.BankKeeper
+
+	supply   sdkmath.Int
+	balances map[string]sdkmath.Int
+}
+
+func newFakeDealerBankKeeper() *fakeDealerBankKeeper {
+	supply := sdkmath.NewInt(1_000_000_000_000)
+	return &fakeDealerBankKeeper{supply: supply, balances: map[string]sdkmath.Int{stakingtypes.BondedPoolName: supply}}
+}
+
+func (b *fakeDealerBankKeeper) balance(name string) sdkmath.Int {
+	if amt, ok := b.balances[name]; ok {
+		return amt
+	}
+	return sdkmath.ZeroInt()
+}
+
+func (b *fakeDealerBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	if addr.Equals(dealerModuleAddr) {
+		return sdk.NewCoin(denom, b.balance(dealertypes.ModuleName))
+	}
+	return sdk.NewCoin(denom, b.balance(addr.String()))
+}
+
+func (b *fakeDealerBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	b.supply = b.supply.Sub(amt.AmountOf(sdk.DefaultBondDenom))
+	b.balances[moduleName] = b.balance(moduleName).Sub(amt.AmountOf(sdk.DefaultBondDenom))
+	return nil
+}
+
+func (b *fakeDealerBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	b.balances[senderModule] = b.balance(senderModule).Sub(amt.AmountOf(sdk.DefaultBondDenom))
+	b.balances[recipientModule] = b.balance(recipientModule).Add(amt.AmountOf(sdk.DefaultBondDenom))
+	return nil
+}
+
+func (b *fakeDealerBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, addr sdk.AccAddress, amt sdk.Coins) error {
+	b.balances[senderModule] = b.balance(senderModule).Sub(amt.AmountOf(sdk.DefaultBondDenom))
+	b.balances[addr.String()] = b.balance(addr.String()).Add(amt.AmountOf(sdk.DefaultBondDenom))
+	return nil
+}
 
-func (fakeDealerSlashingKeeper) SlashWithInfractionReason(
-	_ context.Context,
+func (b *fakeDealerBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, b.balance(addr.String())))
+}
+
+// fakeDealerSlashingKeeper burns the slashed share of a validator's
+// consensus-power tokens from the bonded pool through x/staking's bank keeper,
+// as x/staking would.
+type fakeDealerSlashingKeeper struct {
+	bank StakingBankKeeper
+}
+
+func (f fakeDealerSlashingKeeper) SlashWithInfractionReason(
+	ctx context.Context,
 	_ sdk.ConsAddress,
-	_ sdkmath.LegacyDec,
-	_ int64,
+	fraction sdkmath.LegacyDec,
+	power int64,
 	_ int64,
 	_ stakingtypes.Infraction,
 ) error {
-	return nil
+	burned := fraction.MulInt(sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)).TruncateInt()
+	return f.bank.BurnCoins(ctx, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, burned)))
 }
 
 func (fakeDealerSlashingKeeper) Jail(_ context.Context, _ sdk.ConsAddress) error { return nil }
@@ -74,6 +138,7 @@ func (fakeDealerSlashingKeeper) JailUntil(_ context.Context, _ sdk.ConsAddress,
 type fakeDealerPokerKeeper struct {
 	tables   map[uint64]*pokertypes.Table
 	setCalls int
+	pause    pokertypes.PauseState
 }
 
 func clonePokerTable(t *pokertypes.Table) *pokertypes.Table {
@@ -96,15 +161,41 @@ func (f *fakeDealerPokerKeeper) SetTable(_ context.Context, t *pokertypes.Table)
 	return nil
 }
 
-func (f *fakeDealerPokerKeeper) AbortHandRefundAllCommits(_ context.Context, _, _ uint64, _ string) ([]sdk.Event, error) {
-	return nil, nil
+func (f *fakeDealerPokerKeeper) AbortHand(_ context.Context, tableID, handID uint64, reason string) ([]sdk.Event, error) {
+	return []sdk.Event{sdk.NewEvent(
+		pokertypes.EventTypeHandAborted,
+		sdk.NewAttribute("tableId", fmt.Sprintf("%d", tableID)),
+		sdk.NewAttribute("handId", fmt.Sprintf("%d", handID)),
+		sdk.NewAttribute("reason", reason),
+	)}, nil
 }
 
 func (f *fakeDealerPokerKeeper) ApplyDealerReveal(_ context.Context, _, _ uint64, _ uint32, _ uint32, _ int64) ([]sdk.Event, error) {
 	return nil, nil
 }
 
-func (f *fakeDealerPokerKeeper) AdvanceAfterHoleSharesReady(_ context.Context, _, _ uint64, _ int64) error {
+func (f *fakeDealerPokerKeeper) AdvanceAfterHoleSharesReady(_ context.Context, tableID, _ uint64, _ int64) error {
+	if t := f.tables[tableID]; t != nil && t.Hand != nil {
+		t.Hand.Phase = pokertypes.HandPhase_HAND_PHASE_BETTING
+	}
+	return nil
+}
+
+func (f *fakeDealerPokerKeeper) CheckNotPaused(_ context.Context, msgTypeURL string, tableID uint64) error {
+	return f.pause.Check(msgTypeURL, tableID)
+}
+
+func (f *fakeDealerPokerKeeper) IterateTables(_ context.Context, cb func(id uint64) bool) error {
+	ids := make([]uint64, 0, len(f.tables))
+	for id := range f.tables {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		if cb(id) {
+			break
+		}
+	}
 	return nil
 }
 
@@ -129,18 +220,32 @@ func newDealerMsgServerForOverflowTests(t *testing.T, blockTime time.Time, block
 
 	stakingKeeper := fakeDealerStakingKeeper{bonded: bonded}
 	pokerKeeper := &fakeDealerPokerKeeper{tables: map[uint64]*pokertypes.Table{}}
+	bankKeeper := newFakeDealerBankKeeper()
 	k := NewKeeper(
 		cdc,
 		storeService,
 		stakingKeeper,
 		stakingKeeper,
-		fakeDealerSlashingKeeper{},
+		fakeDealerSlashingKeeper{bank: NewStakingBankKeeper(bankKeeper)},
+		bankKeeper,
 		pokerKeeper,
+		testAuthority,
 	)
 
 	return ctx, k, NewMsgServerImpl(k), pokerKeeper
 }
 
+// enableSlashPayouts sets the slash payout shares governance would opt into:
+// 10% to the reporter and 50% to the players of an aborted hand.
+func enableSlashPayouts(t *testing.T, ctx context.Context, k Keeper) {
+	t.Helper()
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.ReporterRewardBps = 1000
+	params.VictimCompensationBps = 5000
+	require.NoError(t, k.SetParams(ctx, params))
+}
+
 func makeBondedValidatorForDealerTest(t *testing.T, valoper string, power int64, pkByte byte) stakingtypes.Validator {
 	t.Helper()
 	pk := &sdked25519.PubKey{Key: bytes.Repeat([]byte{pkByte}, 32)}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+)
+
+// UpdateParams replaces the module params. Only the module authority (x/gov by
+// default) may call it.
+func (m msgServer) UpdateParams(ctx context.Context, req *dealertypes.MsgUpdateParams) (*dealertypes.MsgUpdateParamsResponse, error) {
+	if req == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
+	}
+	if req.Authority != m.authority {
+		return nil, dealertypes.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", m.authority, req.Authority)
+	}
+	if err := req.Params.Validate(); err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err := m.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		dealertypes.EventTypeParamsUpdated,
+		sdk.NewAttribute("authority", req.Authority),
+	))
+	return &dealertypes.MsgUpdateParamsResponse{}, nil
+}
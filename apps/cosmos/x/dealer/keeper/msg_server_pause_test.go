@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+func TestCircuitBreaker_RejectsPausedDealerMsgs(t *testing.T) {
+	caller := sdk.AccAddress(bytes.Repeat([]byte{0xc1}, 20)).String()
+	valoper := sdk.ValAddress(bytes.Repeat([]byte{0xd1}, 20)).String()
+	bonded := []stakingtypes.Validator{
+		makeBondedValidatorForDealerTest(t, valoper, 1, 0xbb),
+	}
+	ctx, k, ms, pokerKeeper := newDealerMsgServerForOverflowTests(t, time.Unix(100, 0).UTC(), 1, bonded)
+
+	pokerKeeper.pause = pokertypes.PauseState{
+		MsgTypeUrls: []string{sdk.MsgTypeURL(&dealertypes.MsgBeginEpoch{})},
+		TableIds:    []uint64{7},
+	}
+
+	_, err := ms.BeginEpoch(ctx, &dealertypes.MsgBeginEpoch{Caller: caller, CommitteeSize: 2, Threshold: 2})
+	require.ErrorIs(t, err, pokertypes.ErrPaused)
+	dkg, err := k.GetDKG(ctx)
+	require.NoError(t, err)
+	require.Nil(t, dkg)
+
+	_, err = ms.InitHand(ctx, &dealertypes.MsgInitHand{Caller: caller, TableId: 7, HandId: 1, EpochId: 1, DeckSize: 2})
+	require.ErrorIs(t, err, pokertypes.ErrPaused)
+
+	// Timeouts are the refund path and stay available while paused.
+	_, err = ms.Timeout(ctx, &dealertypes.MsgTimeout{Caller: caller, TableId: 7, HandId: 1})
+	require.NotErrorIs(t, err, pokertypes.ErrPaused)
+}
+
+func TestTimeout_PausedShareStepSlashesNobody(t *testing.T) {
+	ctx, k, ms, vals := newReportFixture(t,
+		pokertypes.HandPhase_HAND_PHASE_SHUFFLE,
+		&pokertypes.DealerMeta{RevealPos: 255, DeckFinalized: true},
+		&dealertypes.DealerHand{Finalized: true, HoleSharesDeadline: 1_000},
+	)
+	k.pokerKeeper.(*fakeDealerPokerKeeper).pause = pokertypes.PauseState{
+		MsgTypeUrls: []string{sdk.MsgTypeURL(&dealertypes.MsgSubmitEncShare{})},
+	}
+	caller := sdk.AccAddress(bytes.Repeat([]byte{0xc2}, 20)).String()
+
+	// Nobody can be reported for a share they were blocked from sending.
+	_, err := ms.ReportDealerWithholding(ctx, &dealertypes.MsgReportDealerWithholding{
+		Reporter: caller, TableId: 1, HandId: 1, Pos: 0, Validator: vals[0],
+	})
+	require.ErrorIs(t, err, pokertypes.ErrPaused)
+
+	// The timeout refunds the hand without slashing the members that owe
+	// hole shares.
+	_, err = ms.Timeout(ctx, &dealertypes.MsgTimeout{Caller: caller, TableId: 1, HandId: 1})
+	require.NoError(t, err)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	require.Zero(t, countEvents(sdkCtx, dealertypes.EventTypeValidatorSlashed))
+	require.Equal(t, 1, countEvents(sdkCtx, pokertypes.EventTypeHandAborted))
+	epoch, err := k.GetEpoch(ctx)
+	require.NoError(t, err)
+	require.Empty(t, epoch.Slashed)
+	dh, err := k.GetHand(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Nil(t, dh)
+}
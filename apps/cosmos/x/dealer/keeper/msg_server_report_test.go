@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+var reportFixturePlayerPk = ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(7))
+
+// newReportFixture sets up a 4-member, threshold-2 epoch with unit power and
+// table 1 running hand 1 in the given phase. Pos 0 and 1 are seat 0's hole
+// cards, dealt to reportFixturePlayerPk.
+func newReportFixture(t *testing.T, phase pokertypes.HandPhase, meta *pokertypes.DealerMeta, dh *dealertypes.DealerHand) (context.Context, Keeper, dealertypes.MsgServer, []string) {
+	t.Helper()
+	vals := sortedValopers(4, 0x71)
+	bonded := make([]stakingtypes.Validator, len(vals))
+	for i, v := range vals {
+		bonded[i] = makeBondedValidatorForDealerTest(t, v, 1, byte(0xc0+i))
+	}
+	ctx, k, ms, pokerKeeper := newDealerMsgServerForOverflowTests(t, time.Unix(1_000, 0).UTC(), 10, bonded)
+
+	epoch := hookTestEpoch(1, 1, vals)
+	for i := range epoch.Members {
+		epoch.Members[i].Power = 1
+	}
+	require.NoError(t, k.SetEpoch(ctx, epoch))
+
+	meta.HolePos = make([]uint32, 18)
+	for i := range meta.HolePos {
+		meta.HolePos[i] = 255
+	}
+	meta.HolePos[0], meta.HolePos[1] = 0, 1
+	inHand := make([]bool, 9)
+	inHand[0] = true
+	seats := make([]*pokertypes.Seat, 9)
+	seats[0] = &pokertypes.Seat{Pk: reportFixturePlayerPk.Bytes()}
+	require.NoError(t, pokerKeeper.SetTable(ctx, &pokertypes.Table{
+		Id:     1,
+		Params: pokertypes.TableParams{MaxPlayers: 9, DealerTimeoutSecs: 60},
+		Seats:  seats,
+		Hand: &pokertypes.Hand{
+			HandId: 1,
+			Phase:  phase,
+			InHand: inHand,
+			Dealer: meta,
+		},
+	}))
+
+	dh.EpochId = 1
+	dh.DeckSize = 4
+	dh.Deck = make([]dealertypes.DealerCiphertext, 4)
+	require.NoError(t, k.SetHand(ctx, 1, 1, dh))
+	return ctx, k, ms, vals
+}
+
+func TestReportDealerWithholding_RevealSlashesOnlyNamedMember(t *testing.T) {
+	ctx, k, ms, vals := newReportFixture(t,
+		pokertypes.HandPhase_HAND_PHASE_AWAIT_FLOP,
+		&pokertypes.DealerMeta{RevealPos: 2, RevealDeadline: 1_000},
+		&dealertypes.DealerHand{
+			Finalized: true,
+			PubShares: []dealertypes.DealerPubShare{{Pos: 2, Validator: sortedValopers(4, 0x71)[0], Index: 1}},
+		},
+	)
+	enableSlashPayouts(t, ctx, k)
+	reporter := sdk.AccAddress(bytes.Repeat([]byte{0x43}, 20)).String()
+	bank := k.bankKeeper.(*fakeDealerBankKeeper)
+	report := func(ctx context.Context, pos uint32, v string) error {
+		_, err := ms.ReportDealerWithholding(ctx, &dealertypes.MsgReportDealerWithholding{
+			Reporter: reporter, TableId: 1, HandId: 1, Pos: pos, Validator: v,
+		})
+		return err
+	}
+
+	early := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Unix(999, 0).UTC())
+	require.ErrorContains(t, report(early, 2, vals[1]), "reveal not timed out")
+	require.ErrorContains(t, report(ctx, 3, vals[1]), "no dealer share due at pos")
+	require.ErrorContains(t, report(ctx, 2, vals[0]), "pub share already submitted")
+
+	// Only the named member is slashed, and the reporter gets 10% of the
+	// 100_000 it loses. vals[2] and vals[3] still owe their shares, but the
+	// hand goes on.
+	require.NoError(t, report(ctx, 2, vals[1]))
+	epoch, err := k.GetEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{vals[1]}, epoch.Slashed)
+	require.Equal(t, int64(10_000), bank.balance(reporter).Int64())
+	require.ErrorContains(t, report(ctx, 2, vals[1]), "not qualified")
+
+	require.NoError(t, report(ctx, 2, vals[2]))
+	dh, err := k.GetHand(ctx, 1, 1)
+	require.NoError(t, err)
+	require.NotNil(t, dh)
+	require.Zero(t, countEvents(sdk.UnwrapSDKContext(ctx), pokertypes.EventTypeHandAborted))
+
+	// A third report leaves one qualified member, below threshold.
+	require.NoError(t, report(ctx, 2, vals[3]))
+	dh, err = k.GetHand(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Nil(t, dh)
+	require.Equal(t, 1, countEvents(sdk.UnwrapSDKContext(ctx), pokertypes.EventTypeHandAborted))
+}
+
+func TestReportDealerWithholding_HoleShares(t *testing.T) {
+	ctx, k, ms, vals := newReportFixture(t,
+		pokertypes.HandPhase_HAND_PHASE_SHUFFLE,
+		&pokertypes.DealerMeta{RevealPos: 255, DeckFinalized: true},
+		&dealertypes.DealerHand{
+			Finalized:          true,
+			HoleSharesDeadline: 1_000,
+			EncShares:          []dealertypes.DealerEncShare{{Pos: 0, Validator: sortedValopers(4, 0x71)[0], Index: 1}},
+		},
+	)
+	reporter := sdk.AccAddress(bytes.Repeat([]byte{0x44}, 20)).String()
+	report := func(pos uint32, v string) error {
+		_, err := ms.ReportDealerWithholding(ctx, &dealertypes.MsgReportDealerWithholding{
+			Reporter: reporter, TableId: 1, HandId: 1, Pos: pos, Validator: v,
+		})
+		return err
+	}
+
+	require.ErrorContains(t, report(2, vals[0]), "not a dealt hole card")
+	require.ErrorContains(t, report(0, vals[0]), "enc share already submitted")
+	require.NoError(t, report(1, vals[0]))
+
+	epoch, err := k.GetEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{vals[0]}, epoch.Slashed)
+	dh, err := k.GetHand(ctx, 1, 1)
+	require.NoError(t, err)
+	require.NotNil(t, dh)
+}
+
+func TestReportDealerWithholding_HoleSharesResumeAfterReport(t *testing.T) {
+	ctx, k, ms, vals := newReportFixture(t,
+		pokertypes.HandPhase_HAND_PHASE_SHUFFLE,
+		&pokertypes.DealerMeta{RevealPos: 255, DeckFinalized: true},
+		&dealertypes.DealerHand{
+			Finalized:          true,
+			HoleSharesDeadline: 1_000,
+			InitHeight:         10,
+			InitHashSalt:       bytes.Repeat([]byte{0x5a}, 32),
+		},
+	)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Member i holds epoch secret i+1; the hole cards get real ciphertexts so
+	// enc share proofs verify.
+	epoch, err := k.GetEpoch(ctx)
+	require.NoError(t, err)
+	for i := range epoch.Members {
+		epoch.Members[i].PubShare = ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(uint64(i + 1))).Bytes()
+	}
+	require.NoError(t, k.SetEpoch(ctx, epoch))
+	dh, err := k.GetHand(ctx, 1, 1)
+	require.NoError(t, err)
+	for pos := range dh.Deck {
+		dh.Deck[pos].C1 = ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(uint64(100 + pos))).Bytes()
+	}
+	require.NoError(t, k.SetHand(ctx, 1, 1, dh))
+	handScalar, err := deriveHandScalar(1, 1, 1, dh.InitHeight, dh.InitHashSalt)
+	require.NoError(t, err)
+
+	submit := func(i int) error {
+		x := ocpcrypto.ScalarMul(ocpcrypto.ScalarFromUint64(uint64(i+1)), handScalar)
+		var shares []dealertypes.EncShareEntry
+		for pos := uint32(0); pos < 2; pos++ {
+			c1, err := ocpcrypto.PointFromBytesCanonical(dh.Deck[pos].C1)
+			require.NoError(t, err)
+			r := ocpcrypto.ScalarFromUint64(uint64(1_000 + 10*i + int(pos)))
+			u := ocpcrypto.MulBase(r)
+			v := ocpcrypto.PointAdd(ocpcrypto.MulPoint(c1, x), ocpcrypto.MulPoint(reportFixturePlayerPk, r))
+			proof, err := ocpcrypto.EncShareProve(ocpcrypto.MulBase(x), c1, reportFixturePlayerPk, u, v, x, r,
+				ocpcrypto.ScalarFromUint64(uint64(2_000+i)), ocpcrypto.ScalarFromUint64(uint64(3_000+i)))
+			require.NoError(t, err)
+			shares = append(shares, dealertypes.EncShareEntry{
+				Pos:           pos,
+				PkPlayer:      reportFixturePlayerPk.Bytes(),
+				EncShare:      append(u.Bytes(), v.Bytes()...),
+				ProofEncShare: ocpcrypto.EncodeEncShareProof(proof),
+			})
+		}
+		_, err := ms.SubmitEncShares(ctx, &dealertypes.MsgSubmitEncShares{Validator: vals[i], TableId: 1, HandId: 1, Shares: shares})
+		return err
+	}
+	require.ErrorContains(t, submit(1), "hole shares deadline passed")
+
+	// The report reopens the window for the members still qualified.
+	reporter := sdk.AccAddress(bytes.Repeat([]byte{0x46}, 20)).String()
+	_, err = ms.ReportDealerWithholding(ctx, &dealertypes.MsgReportDealerWithholding{
+		Reporter: reporter, TableId: 1, HandId: 1, Pos: 0, Validator: vals[0],
+	})
+	require.NoError(t, err)
+	dh, err = k.GetHand(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1_000+60), dh.HoleSharesDeadline)
+
+	require.NoError(t, submit(1))
+	require.NoError(t, submit(2))
+	require.Equal(t, 1, countEvents(sdkCtx, dealertypes.EventTypeHoleCardsReady))
+	tbl, err := k.pokerKeeper.GetTable(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, pokertypes.HandPhase_HAND_PHASE_BETTING, tbl.Hand.Phase)
+	require.Zero(t, countEvents(sdkCtx, pokertypes.EventTypeHandAborted))
+}
+
+func TestReportDKGWithholding(t *testing.T) {
+	vals := sortedValopers(3, 0x91)
+	bonded := make([]stakingtypes.Validator, len(vals))
+	for i, v := range vals {
+		bonded[i] = makeBondedValidatorForDealerTest(t, v, 1, byte(0xb0+i))
+	}
+	ctx, k, ms, _ := newDealerMsgServerForOverflowTests(t, time.Unix(100, 0).UTC(), 11, bonded)
+	require.NoError(t, k.SetDKG(ctx, &dealertypes.DealerDKG{
+		EpochId:   5,
+		Threshold: 2,
+		Members: []dealertypes.DealerMember{
+			{Validator: vals[0], Index: 1},
+			{Validator: vals[1], Index: 2},
+			{Validator: vals[2], Index: 3},
+		},
+		StartHeight:       1,
+		CommitDeadline:    10,
+		ComplaintDeadline: 20,
+		RevealDeadline:    30,
+		FinalizeDeadline:  40,
+		Commits:           []dealertypes.DealerDKGCommit{{Dealer: vals[0]}},
+	}))
+	reporter := sdk.AccAddress(bytes.Repeat([]byte{0x45}, 20)).String()
+	report := func(ctx context.Context, v string) error {
+		_, err := ms.ReportDKGWithholding(ctx, &dealertypes.MsgReportDKGWithholding{Reporter: reporter, EpochId: 5, Validator: v})
+		return err
+	}
+
+	require.ErrorContains(t, report(sdk.UnwrapSDKContext(ctx).WithBlockHeight(10), vals[1]), "too early")
+	require.ErrorContains(t, report(ctx, vals[0]), "dkg commit already submitted")
+
+	// The DKG keeps going with two of three members.
+	require.NoError(t, report(ctx, vals[1]))
+	dkg, err := k.GetDKG(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, dkg)
+	require.Equal(t, []string{vals[1]}, dkg.Slashed)
+	require.ErrorContains(t, report(ctx, vals[1]), "already slashed")
+
+	require.NoError(t, report(ctx, vals[2]))
+	dkg, err = k.GetDKG(ctx)
+	require.NoError(t, err)
+	require.Nil(t, dkg)
+	require.Equal(t, 1, countEvents(sdk.UnwrapSDKContext(ctx), dealertypes.EventTypeDealerEpochAborted))
+}
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"onchainpoker/apps/cosmos/internal/ocpcrypto"
@@ -234,7 +237,7 @@ func (m msgServer) finalizeEpoch(ctx context.Context, dkg *dealertypes.DealerDKG
 		Members:        membersOut,
 	}
 
-	if err := m.SetEpoch(ctx, epoch); err != nil {
+	if err := m.activateEpoch(ctx, epoch); err != nil {
 		return nil, err
 	}
 	if err := m.SetDKG(ctx, nil); err != nil {
@@ -280,19 +283,19 @@ func (m msgServer) finalizeDeck(ctx context.Context, tableID, handID uint64) ([]
 		return nil, dealertypes.ErrInvalidRequest.Wrap("deck already finalized")
 	}
 
-	epoch, err := m.GetEpoch(ctx)
+	epoch, err := m.EpochByID(ctx, dh.EpochId)
 	if err != nil {
 		return nil, err
 	}
-	if epoch == nil || epoch.EpochId != dh.EpochId {
+	if epoch == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("epoch not available")
 	}
 	qual := epochQualMembers(epoch)
 	if len(qual) < int(epoch.Threshold) {
 		return nil, dealertypes.ErrInvalidRequest.Wrapf("insufficient qualified members: have %d need %d", len(qual), epoch.Threshold)
 	}
-	if int(dh.ShuffleStep) != len(qual) {
-		return nil, dealertypes.ErrInvalidRequest.Wrapf("deck must be shuffled by all qualified members before finalization: have %d need %d", dh.ShuffleStep, len(qual))
+	if next, ok := nextShuffler(epoch, dh); ok {
+		return nil, dealertypes.ErrInvalidRequest.Wrapf("deck must be shuffled by all qualified members before finalization: %s has not shuffled", next)
 	}
 
 	nowUnix := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
@@ -377,11 +380,11 @@ func (m msgServer) finalizeReveal(ctx context.Context, tableID, handID uint64, p
 		return nil, dealertypes.ErrInvalidRequest.Wrap("pos out of bounds")
 	}
 
-	epoch, err := m.GetEpoch(ctx)
+	epoch, err := m.EpochByID(ctx, dh.EpochId)
 	if err != nil {
 		return nil, err
 	}
-	if epoch == nil || epoch.EpochId != dh.EpochId {
+	if epoch == nil {
 		return nil, dealertypes.ErrInvalidRequest.Wrap("epoch not available")
 	}
 
@@ -482,23 +485,57 @@ func (m msgServer) finalizeReveal(ctx context.Context, tableID, handID uint64, p
 		return nil, err
 	}
 	if t2 == nil || t2.Hand == nil {
-		if err := m.SetHand(ctx, tableID, handID, nil); err != nil {
+		releaseEvents, err := m.releaseHand(ctx, tableID, handID)
+		if err != nil {
 			return nil, err
 		}
+		events = append(events, releaseEvents...)
 	}
 
 	return events, nil
 }
 
+// pausedStep returns the type URL of the first of msgs that the circuit
+// breaker pauses for tableID, or "" if none is paused.
+func (m msgServer) pausedStep(ctx context.Context, tableID uint64, msgs ...sdk.Msg) (string, error) {
+	for _, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		err := m.pokerKeeper.CheckNotPaused(ctx, msgTypeURL, tableID)
+		if errors.Is(err, pokertypes.ErrPaused) {
+			return msgTypeURL, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+// abortIfStepPaused aborts the hand, slashing nobody, when the circuit breaker
+// pauses one of msgs, the messages the timed-out step is submitted with.
+// Members blocked from submitting have not withheld anything.
+func (m msgServer) abortIfStepPaused(ctx context.Context, tableID, handID uint64, msgs ...sdk.Msg) ([]sdk.Event, bool, error) {
+	paused, err := m.pausedStep(ctx, tableID, msgs...)
+	if err != nil || paused == "" {
+		return nil, false, err
+	}
+	events, err := m.abortHand(ctx, tableID, handID, fmt.Sprintf("dealer: %s is paused", paused))
+	if err != nil {
+		return nil, false, err
+	}
+	return events, true, nil
+}
+
 func (m msgServer) abortHand(ctx context.Context, tableID, handID uint64, reason string) ([]sdk.Event, error) {
-	events, err := m.pokerKeeper.AbortHandRefundAllCommits(ctx, tableID, handID, reason)
+	events, err := m.pokerKeeper.AbortHand(ctx, tableID, handID, reason)
 	if err != nil {
 		return nil, err
 	}
-	if err := m.SetHand(ctx, tableID, handID, nil); err != nil {
+	releaseEvents, err := m.releaseHand(ctx, tableID, handID)
+	if err != nil {
 		return nil, err
 	}
-	return events, nil
+	return append(events, releaseEvents...), nil
 }
 
 func (m msgServer) timeout(ctx context.Context, tableID, handID uint64) ([]sdk.Event, error) {
@@ -522,13 +559,14 @@ func (m msgServer) timeout(ctx context.Context, tableID, handID uint64) ([]sdk.E
 		return nil, dealertypes.ErrHandNotFound.Wrap("dealer hand not initialized")
 	}
 
-	epoch, err := m.GetEpoch(ctx)
+	epoch, err := m.EpochByID(ctx, dh.EpochId)
 	if err != nil {
 		return nil, err
 	}
-	if epoch == nil || epoch.EpochId != dh.EpochId {
-		// Epoch has been rotated — the hand is unrecoverable. Abort and refund.
-		abortEvents, err := m.abortHand(ctx, tableID, handID, "dealer: epoch rotated (hand epoch mismatch)")
+	if epoch == nil {
+		// The hand's epoch is gone (superseded epochs are normally retained
+		// until their hands finish) — the hand is unrecoverable. Abort and refund.
+		abortEvents, err := m.abortHand(ctx, tableID, handID, "dealer: hand epoch unavailable")
 		if err != nil {
 			return nil, err
 		}
@@ -581,19 +619,32 @@ func (m msgServer) timeout(ctx context.Context, tableID, handID uint64) ([]sdk.E
 		}
 
 		// If all qualified members already shuffled, allow anyone to finalize deterministically.
-		if int(dh.ShuffleStep) == len(qual) {
+		expectID, ok := nextShuffler(epoch, dh)
+		if !ok {
 			deckEvents, err := m.finalizeDeck(ctx, tableID, handID)
 			if err != nil {
 				return nil, err
 			}
 			return append(events, deckEvents...), nil
 		}
-		if int(dh.ShuffleStep) > len(qual) {
-			return nil, dealertypes.ErrInvalidRequest.Wrapf("shuffle_step out of range: step=%d qual=%d", dh.ShuffleStep, len(qual))
+
+		abortEvents, aborted, err := m.abortIfStepPaused(ctx, tableID, handID, &dealertypes.MsgSubmitShuffle{})
+		if err != nil {
+			return nil, err
+		}
+		if aborted {
+			return append(events, abortEvents...), nil
 		}
 
-		// Slash the expected shuffler for the next round (shuffle_step starts at 0).
-		expectID := qual[dh.ShuffleStep].Validator
+		if params.MinShufflers != 0 {
+			slotEvents, err := m.expireShuffleSlot(ctx, tableID, handID, epoch, dh, params, handSlashFraction, handJailDuration)
+			if err != nil {
+				return nil, err
+			}
+			return append(events, slotEvents...), nil
+		}
+
+		// Slash the expected shuffler for the next round.
 		if epochSlash(epoch, expectID) {
 			mem := findEpochMember(epoch, expectID)
 			power := int64(0)
@@ -620,7 +671,7 @@ func (m msgServer) timeout(ctx context.Context, tableID, handID uint64) ([]sdk.E
 			))
 		}
 
-		if err := m.SetEpoch(ctx, epoch); err != nil {
+		if err := m.setEpochByID(ctx, epoch); err != nil {
 			return nil, err
 		}
 
@@ -634,7 +685,7 @@ func (m msgServer) timeout(ctx context.Context, tableID, handID uint64) ([]sdk.E
 		}
 
 		// If slashing reduced QUAL enough that all remaining members already shuffled, finalize now.
-		if int(dh.ShuffleStep) == len(qual) {
+		if _, ok := nextShuffler(epoch, dh); !ok {
 			deckEvents, err := m.finalizeDeck(ctx, tableID, handID)
 			if err != nil {
 				return nil, err
@@ -662,6 +713,14 @@ func (m msgServer) timeout(ctx context.Context, tableID, handID uint64) ([]sdk.E
 			return nil, dealertypes.ErrInvalidRequest.Wrap("hole shares not timed out")
 		}
 
+		abortEvents, aborted, err := m.abortIfStepPaused(ctx, tableID, handID, &dealertypes.MsgSubmitEncShare{}, &dealertypes.MsgSubmitEncShares{})
+		if err != nil {
+			return nil, err
+		}
+		if aborted {
+			return append(events, abortEvents...), nil
+		}
+
 		missing, err := dealerMissingHoleEncShares(epoch, t, dh)
 		if err != nil {
 			return nil, err
@@ -695,7 +754,7 @@ func (m msgServer) timeout(ctx context.Context, tableID, handID uint64) ([]sdk.E
 			))
 		}
 
-		if err := m.SetEpoch(ctx, epoch); err != nil {
+		if err := m.setEpochByID(ctx, epoch); err != nil {
 			return nil, err
 		}
 
@@ -756,6 +815,14 @@ func (m msgServer) timeout(ctx context.Context, tableID, handID uint64) ([]sdk.E
 		return nil, dealertypes.ErrInvalidRequest.Wrap("reveal not timed out")
 	}
 
+	abortEvents, aborted, err := m.abortIfStepPaused(ctx, tableID, handID, &dealertypes.MsgSubmitPubShare{})
+	if err != nil {
+		return nil, err
+	}
+	if aborted {
+		return append(events, abortEvents...), nil
+	}
+
 	pos := meta.RevealPos
 	missing := dealerMissingPubShares(epoch, dh, pos)
 	for _, id := range missing {
@@ -788,7 +855,7 @@ func (m msgServer) timeout(ctx context.Context, tableID, handID uint64) ([]sdk.E
 		))
 	}
 
-	if err := m.SetEpoch(ctx, epoch); err != nil {
+	if err := m.setEpochByID(ctx, epoch); err != nil {
 		return nil, err
 	}
 
@@ -806,3 +873,263 @@ func (m msgServer) timeout(ctx context.Context, tableID, handID uint64) ([]sdk.E
 	}
 	return append(events, revealEvents...), nil
 }
+
+// expireShuffleSlot handles a lapsed shuffle slot in open shuffle order. Every
+// qualified member could have taken the slot, so all members that have not
+// shuffled are slashed. The deck is finalized if enough members shuffled
+// before the slot lapsed and the committee still meets its threshold;
+// otherwise the hand is aborted.
+func (m msgServer) expireShuffleSlot(
+	ctx context.Context,
+	tableID, handID uint64,
+	epoch *dealertypes.DealerEpoch,
+	dh *dealertypes.DealerHand,
+	params dealertypes.Params,
+	slashFraction sdkmath.LegacyDec,
+	jailDuration time.Duration,
+) ([]sdk.Event, error) {
+	quorum := shuffleQuorum(params, epoch)
+	shuffled := len(handShufflers(epoch, dh))
+	missing := pendingShufflers(epoch, dh)
+
+	var events []sdk.Event
+	for _, id := range missing {
+		if !epochSlash(epoch, id) {
+			continue
+		}
+		mem := findEpochMember(epoch, id)
+		power := int64(0)
+		if mem != nil {
+			power = mem.Power
+		}
+		distH := epoch.StartHeight
+		if distH == 0 {
+			distH = sdk.UnwrapSDKContext(ctx).BlockHeight()
+		}
+		if err := m.applyPenalty(ctx, id, distH, power, slashFraction, jailDuration); err != nil {
+			return nil, err
+		}
+		events = append(events, sdk.NewEvent(
+			dealertypes.EventTypeValidatorSlashed,
+			sdk.NewAttribute("tableId", fmt.Sprintf("%d", tableID)),
+			sdk.NewAttribute("handId", fmt.Sprintf("%d", handID)),
+			sdk.NewAttribute("epochId", fmt.Sprintf("%d", epoch.EpochId)),
+			sdk.NewAttribute("validator", id),
+			sdk.NewAttribute("reason", "shuffle-timeout"),
+			sdk.NewAttribute("slashFraction", slashFraction.String()),
+			sdk.NewAttribute("distributionHeight", fmt.Sprintf("%d", distH)),
+			sdk.NewAttribute("power", fmt.Sprintf("%d", power)),
+		))
+	}
+	if err := m.setEpochByID(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	reason := ""
+	if shuffled < quorum {
+		reason = fmt.Sprintf("dealer: %d of %d required shuffles before the shuffle slot expired", shuffled, quorum)
+	} else if len(epochQualMembers(epoch)) < int(epoch.Threshold) {
+		reason = "dealer: committee below threshold after shuffle timeout"
+	}
+	if reason != "" {
+		abortEvents, err := m.abortHand(ctx, tableID, handID, reason)
+		if err != nil {
+			return nil, err
+		}
+		return append(events, abortEvents...), nil
+	}
+	deckEvents, err := m.finalizeDeck(ctx, tableID, handID)
+	if err != nil {
+		return nil, err
+	}
+	return append(events, deckEvents...), nil
+}
+
+// reportDealerWithholding slashes a single qualified member that missed the
+// deadline for its share of pos: an encrypted share of a dealt hole card while
+// hole shares are being collected, or a public share of the card awaiting
+// reveal. Unlike timeout, the rest of the committee is left alone. The hand is
+// aborted only if the committee falls below threshold; a reveal whose
+// remaining members have all submitted is finalized right away.
+func (m msgServer) reportDealerWithholding(ctx context.Context, tableID, handID uint64, pos uint32, validator string) ([]sdk.Event, error) {
+	t, err := m.pokerKeeper.GetTable(ctx, tableID)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.Hand == nil || t.Hand.Dealer == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("no active dealer hand")
+	}
+	h := t.Hand
+	if h.HandId != handID {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("hand_id mismatch")
+	}
+	meta := h.Dealer
+
+	dh, err := m.GetHand(ctx, tableID, handID)
+	if err != nil {
+		return nil, err
+	}
+	if dh == nil {
+		return nil, dealertypes.ErrHandNotFound.Wrap("dealer hand not initialized")
+	}
+	epoch, err := m.EpochByID(ctx, dh.EpochId)
+	if err != nil {
+		return nil, err
+	}
+	if epoch == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("epoch not available")
+	}
+	mem := findEpochMember(epoch, validator)
+	if mem == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("validator not in epoch committee")
+	}
+	if epochIsSlashed(epoch, validator) || epochIsInactive(epoch, validator) {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("validator not qualified for this epoch")
+	}
+
+	nowUnix := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	holePhase := h.Phase == pokertypes.HandPhase_HAND_PHASE_SHUFFLE && dh.Finalized
+	reason := ""
+	var shareMsgs []sdk.Msg
+	switch {
+	case holePhase:
+		if dh.HoleSharesDeadline == 0 || nowUnix < dh.HoleSharesDeadline {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("hole shares not timed out")
+		}
+		if _, ok := isHolePos(meta, h, pos); !ok {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("pos is not a dealt hole card")
+		}
+		for _, es := range dh.EncShares {
+			if es.Pos == pos && es.Validator == validator {
+				return nil, dealertypes.ErrInvalidRequest.Wrap("enc share already submitted")
+			}
+		}
+		reason = "hole-enc-share-withheld"
+		shareMsgs = []sdk.Msg{&dealertypes.MsgSubmitEncShare{}, &dealertypes.MsgSubmitEncShares{}}
+	case meta.RevealPos != 255 && meta.RevealDeadline != 0 && pos == meta.RevealPos:
+		if nowUnix < meta.RevealDeadline {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("reveal not timed out")
+		}
+		withheld := false
+		for _, id := range dealerMissingPubShares(epoch, dh, pos) {
+			if id == validator {
+				withheld = true
+				break
+			}
+		}
+		if !withheld {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("pub share already submitted")
+		}
+		reason = "pub-share-withheld"
+		shareMsgs = []sdk.Msg{&dealertypes.MsgSubmitPubShare{}}
+	default:
+		return nil, dealertypes.ErrInvalidRequest.Wrap("no dealer share due at pos")
+	}
+	// A member cannot be blamed for a share it was blocked from submitting.
+	paused, err := m.pausedStep(ctx, tableID, shareMsgs...)
+	if err != nil {
+		return nil, err
+	}
+	if paused != "" {
+		return nil, pokertypes.ErrPaused.Wrapf("%s is paused", paused)
+	}
+
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slashFraction := bpsToDec(params.SlashBpsHandDealer)
+	jailDuration := time.Duration(params.JailSecondsHandDealer) * time.Second
+
+	epochSlash(epoch, validator)
+	distH := epoch.StartHeight
+	if distH == 0 {
+		distH = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	}
+	if err := m.applyPenalty(ctx, validator, distH, mem.Power, slashFraction, jailDuration); err != nil {
+		return nil, err
+	}
+	events := []sdk.Event{sdk.NewEvent(
+		dealertypes.EventTypeValidatorSlashed,
+		sdk.NewAttribute("tableId", fmt.Sprintf("%d", tableID)),
+		sdk.NewAttribute("handId", fmt.Sprintf("%d", handID)),
+		sdk.NewAttribute("epochId", fmt.Sprintf("%d", epoch.EpochId)),
+		sdk.NewAttribute("validator", validator),
+		sdk.NewAttribute("reason", reason),
+		sdk.NewAttribute("slashFraction", slashFraction.String()),
+		sdk.NewAttribute("distributionHeight", fmt.Sprintf("%d", distH)),
+		sdk.NewAttribute("power", fmt.Sprintf("%d", mem.Power)),
+		sdk.NewAttribute("pos", fmt.Sprintf("%d", pos)),
+	)}
+	if err := m.setEpochByID(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	if len(epochQualMembers(epoch)) < int(epoch.Threshold) {
+		abortEvents, err := m.abortHand(ctx, tableID, handID, "dealer: committee below threshold after withholding report")
+		if err != nil {
+			return nil, err
+		}
+		return append(events, abortEvents...), nil
+	}
+
+	if holePhase {
+		holeEvents, err := m.resumeHoleShares(ctx, t, dh, epoch, nowUnix)
+		if err != nil {
+			return nil, err
+		}
+		return append(events, holeEvents...), nil
+	}
+	if len(dealerMissingPubShares(epoch, dh, pos)) == 0 {
+		revealEvents, err := m.finalizeReveal(ctx, tableID, handID, pos)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, revealEvents...)
+	}
+	return events, nil
+}
+
+// resumeHoleShares keeps a hand going after a hole-share withholding report.
+// Enc shares are not accepted past the hole shares deadline, so unless the
+// shares already submitted are enough to deal, the remaining qualified
+// members get a fresh deadline.
+func (m msgServer) resumeHoleShares(ctx context.Context, t *pokertypes.Table, dh *dealertypes.DealerHand, epoch *dealertypes.DealerEpoch, nowUnix int64) ([]sdk.Event, error) {
+	tableID, handID := t.Id, t.Hand.HandId
+	ready, err := dealerHoleEncSharesReady(epoch, t, dh)
+	if err != nil {
+		return nil, err
+	}
+	if !ready {
+		deadline, err := addInt64AndU64Checked(nowUnix, tableDealerTimeoutSecs(t), "dealer hole shares deadline")
+		if err != nil {
+			return nil, err
+		}
+		dh.HoleSharesDeadline = deadline
+		return nil, m.SetHand(ctx, tableID, handID, dh)
+	}
+
+	dh.HoleSharesDeadline = 0
+	if err := m.SetHand(ctx, tableID, handID, dh); err != nil {
+		return nil, err
+	}
+	if err := m.pokerKeeper.AdvanceAfterHoleSharesReady(ctx, tableID, handID, nowUnix); err != nil {
+		return nil, err
+	}
+
+	// Re-load for phase attribute.
+	t2, err := m.pokerKeeper.GetTable(ctx, tableID)
+	if err != nil {
+		return nil, err
+	}
+	phase := ""
+	if t2 != nil && t2.Hand != nil {
+		phase = t2.Hand.Phase.String()
+	}
+	return []sdk.Event{sdk.NewEvent(
+		dealertypes.EventTypeHoleCardsReady,
+		sdk.NewAttribute("tableId", fmt.Sprintf("%d", tableID)),
+		sdk.NewAttribute("handId", fmt.Sprintf("%d", handID)),
+		sdk.NewAttribute("phase", phase),
+	)}, nil
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
 	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
@@ -61,3 +63,99 @@ func TestKeeperParams_SetGetRoundTrip(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, want, got)
 }
+
+var testAuthority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+func TestUpdateParams_Authority(t *testing.T) {
+	sdkCtx, k := newParamsKeeper(t)
+	k.authority = testAuthority
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	ms := NewMsgServerImpl(k)
+
+	want := dealertypes.DefaultParams()
+	want.SlashBpsDkg = 2500
+	want.JailSecondsDkg = 600
+
+	_, err := ms.UpdateParams(ctx, &dealertypes.MsgUpdateParams{
+		Authority: sdk.AccAddress([]byte("not-the-gov-account!")).String(),
+		Params:    want,
+	})
+	require.ErrorIs(t, err, dealertypes.ErrUnauthorized)
+
+	bad := want
+	bad.DkgVersion = 99
+	_, err = ms.UpdateParams(ctx, &dealertypes.MsgUpdateParams{Authority: testAuthority, Params: bad})
+	require.ErrorIs(t, err, dealertypes.ErrInvalidRequest)
+
+	got, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, dealertypes.DefaultParams(), got)
+
+	_, err = ms.UpdateParams(ctx, &dealertypes.MsgUpdateParams{Authority: testAuthority, Params: want})
+	require.NoError(t, err)
+
+	got, err = k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestParams_EpochRotation(t *testing.T) {
+	// Automatic rotation must be switched on by governance.
+	p := dealertypes.DefaultParams()
+	require.Zero(t, p.EpochLengthBlocks)
+
+	p.EpochLengthBlocks = 14_400
+	require.NoError(t, p.Validate())
+	require.Equal(t, uint32(4), p.EpochThreshold(5))
+	require.Equal(t, uint32(2), p.EpochThreshold(1))
+
+	p.TargetCommitteeSize = 1
+	require.ErrorContains(t, p.Validate(), "target_committee_size")
+
+	p = dealertypes.DefaultParams()
+	p.EpochLengthBlocks = 14_400
+	p.ThresholdBps = 0
+	require.ErrorContains(t, p.Validate(), "threshold_bps")
+
+	// Rotation off: the other rotation fields are not checked.
+	p.EpochLengthBlocks = 0
+	p.TargetCommitteeSize = 0
+	require.NoError(t, p.Validate())
+}
+
+func TestParams_ShuffleSlot(t *testing.T) {
+	// Open shuffle order must be switched on by governance.
+	p := dealertypes.DefaultParams()
+	require.Zero(t, p.MinShufflers)
+	require.Zero(t, p.ShuffleSlotSecs)
+
+	p.MinShufflers = dealertypes.MinEpochThreshold
+	p.ShuffleSlotSecs = 60 * 60
+	require.NoError(t, p.Validate())
+	p.ShuffleSlotSecs++
+	require.ErrorContains(t, p.Validate(), "shuffle_slot_secs")
+}
+
+func TestParams_MinShuffleProofVersion(t *testing.T) {
+	p := dealertypes.DefaultParams()
+	require.Zero(t, p.MinShuffleProofVersion)
+	p.MinShuffleProofVersion = 3
+	require.NoError(t, p.Validate())
+	p.MinShuffleProofVersion++
+	require.ErrorContains(t, p.Validate(), "min_shuffle_proof_version")
+}
+
+func TestParams_SlashPayoutShares(t *testing.T) {
+	// Slash payouts must be switched on by governance.
+	p := dealertypes.DefaultParams()
+	require.Zero(t, p.ReporterRewardBps)
+	require.Zero(t, p.VictimCompensationBps)
+
+	p.ReporterRewardBps = 4000
+	p.VictimCompensationBps = 6000
+	require.NoError(t, p.Validate())
+	p.VictimCompensationBps++
+	require.ErrorContains(t, p.Validate(), "victim_compensation_bps")
+	p.ReporterRewardBps = ^uint32(0)
+	require.ErrorContains(t, p.Validate(), "reporter_reward_bps")
+}
@@ -9,11 +9,16 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
 )
 
+// dealerModuleAddr holds slash proceeds between a slash and its payout.
+var dealerModuleAddr = authtypes.NewModuleAddress(dealertypes.ModuleName)
+
 // SlashAndJailValidator applies an application-level penalty to a validator.
 //
 // Key design point: pass a past "distributionHeight" (e.g. the DKG start height / obligation start height),
@@ -100,3 +105,192 @@ func SlashAndJailValidator(
 
 	return nil
 }
+
+// slashProceedsKey marks a context whose x/staking burns are collected by the
+// dealer module account; see StakingBankKeeper.
+type slashProceedsKey struct{}
+
+// StakingBankKeeper is the bank keeper x/staking is wired with. It sends stake
+// burned from the staking pools during a dealer slash to the dealer module
+// account, so part of it can be paid out rather than burned.
+type StakingBankKeeper interface {
+	stakingtypes.BankKeeper
+
+	// collectsSlashProceeds keeps the plain bank keeper from satisfying this
+	// interface, so depinject only hands the wrapper to modules bound to it.
+	collectsSlashProceeds()
+}
+
+type stakingBankKeeper struct {
+	stakingtypes.BankKeeper
+}
+
+// NewStakingBankKeeper wraps the bank keeper given to x/staking.
+func NewStakingBankKeeper(bk stakingtypes.BankKeeper) StakingBankKeeper {
+	return stakingBankKeeper{BankKeeper: bk}
+}
+
+func (stakingBankKeeper) collectsSlashProceeds() {}
+
+// BurnCoins moves coins burned from a staking pool to the dealer module account
+// while x/dealer is slashing, and burns them otherwise.
+func (b stakingBankKeeper) BurnCoins(ctx context.Context, name string, amt sdk.Coins) error {
+	collect, _ := ctx.Value(slashProceedsKey{}).(bool)
+	if collect && (name == stakingtypes.BondedPoolName || name == stakingtypes.NotBondedPoolName) {
+		return b.SendCoinsFromModuleToModule(ctx, name, dealertypes.ModuleName, amt)
+	}
+	return b.BankKeeper.BurnCoins(ctx, name, amt)
+}
+
+// slashPayout tracks the stake slashed while one message is handled so part
+// of it can be paid out rather than burned. Slashes applied through the
+// context returned by beginSlashPayout land in the dealer module account, so
+// the slashed total is that account's gain in the bond denom.
+type slashPayout struct {
+	denom   string
+	balance sdkmath.Int
+
+	// reporter is the account that reported the fault; empty pays no reward.
+	reporter string
+	// victims are the players of the hand the fault aborted, if any.
+	victims []string
+	// culprits are the operators of the validators slashed; their accounts
+	// are paid neither the reward nor compensation.
+	culprits []string
+}
+
+// beginSlashPayout starts collecting slash proceeds. Penalties must be applied
+// with the returned context for their proceeds to be paid out.
+func (k Keeper) beginSlashPayout(ctx context.Context, reporter string) (context.Context, *slashPayout, error) {
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	p := &slashPayout{
+		denom:    denom,
+		balance:  k.bankKeeper.GetBalance(ctx, dealerModuleAddr, denom).Amount,
+		reporter: reporter,
+	}
+	return sdk.UnwrapSDKContext(ctx).WithValue(slashProceedsKey{}, true), p, nil
+}
+
+// settleSlashPayout pays reporter_reward_bps of the stake slashed since p was
+// begun to the reporter and victim_compensation_bps of it in equal parts to
+// the victims, and burns the rest.
+func (k Keeper) settleSlashPayout(ctx context.Context, p *slashPayout) ([]sdk.Event, error) {
+	slashed := k.bankKeeper.GetBalance(ctx, dealerModuleAddr, p.denom).Amount.Sub(p.balance)
+	if !slashed.IsPositive() {
+		return nil, nil
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	culprits := map[string]bool{}
+	for _, valoper := range p.culprits {
+		valAddr, err := sdk.ValAddressFromBech32(valoper)
+		if err != nil {
+			return nil, err
+		}
+		culprits[sdk.AccAddress(valAddr).String()] = true
+	}
+	reporter := p.reporter
+	if culprits[reporter] {
+		reporter = ""
+	}
+	var victims []string
+	for _, v := range p.victims {
+		if !culprits[v] {
+			victims = append(victims, v)
+		}
+	}
+
+	reward := sdkmath.ZeroInt()
+	if reporter != "" {
+		reward = slashed.MulRaw(int64(params.ReporterRewardBps)).QuoRaw(int64(dealertypes.MaxBps))
+	}
+	compensation := sdkmath.ZeroInt()
+	if len(victims) != 0 {
+		compensation = slashed.MulRaw(int64(params.VictimCompensationBps)).QuoRaw(int64(dealertypes.MaxBps)).QuoRaw(int64(len(victims)))
+	}
+	paid := reward.Add(compensation.MulRaw(int64(len(victims))))
+
+	pay := func(addr string, amt sdkmath.Int) error {
+		if !amt.IsPositive() {
+			return nil
+		}
+		acc, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, dealertypes.ModuleName, acc, sdk.NewCoins(sdk.NewCoin(p.denom, amt)))
+	}
+	if err := pay(reporter, reward); err != nil {
+		return nil, err
+	}
+	for _, v := range victims {
+		if err := pay(v, compensation); err != nil {
+			return nil, err
+		}
+	}
+	burned := slashed.Sub(paid)
+	if burned.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, dealertypes.ModuleName, sdk.NewCoins(sdk.NewCoin(p.denom, burned))); err != nil {
+			return nil, err
+		}
+	}
+	if !paid.IsPositive() {
+		return nil, nil
+	}
+
+	return []sdk.Event{sdk.NewEvent(
+		dealertypes.EventTypeSlashProceedsPaid,
+		sdk.NewAttribute("slashed", slashed.String()),
+		sdk.NewAttribute("reporter", reporter),
+		sdk.NewAttribute("reporterReward", reward.String()),
+		sdk.NewAttribute("victims", fmt.Sprintf("%d", len(victims))),
+		sdk.NewAttribute("victimCompensation", compensation.String()),
+		sdk.NewAttribute("burned", burned.String()),
+		sdk.NewAttribute("denom", p.denom),
+	)}, nil
+}
+
+// handPlayers returns the accounts of the players dealt into the table's
+// current hand.
+func handPlayers(t *pokertypes.Table) []string {
+	if t == nil || t.Hand == nil {
+		return nil
+	}
+	var out []string
+	for i, in := range t.Hand.InHand {
+		if in && i < len(t.Seats) && t.Seats[i] != nil && t.Seats[i].Player != "" {
+			out = append(out, t.Seats[i].Player)
+		}
+	}
+	return out
+}
+
+// slashedValidators returns the operators of the validators slashed in events.
+func slashedValidators(events []sdk.Event) []string {
+	var out []string
+	for _, ev := range events {
+		if ev.Type != dealertypes.EventTypeValidatorSlashed {
+			continue
+		}
+		if attr, ok := ev.GetAttribute("validator"); ok {
+			out = append(out, attr.Value)
+		}
+	}
+	return out
+}
+
+// handAborted reports whether events include the poker module aborting a hand.
+func handAborted(events []sdk.Event) bool {
+	for _, ev := range events {
+		if ev.Type == pokertypes.EventTypeHandAborted {
+			return true
+		}
+	}
+	return false
+}
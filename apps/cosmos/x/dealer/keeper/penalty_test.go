@@ -13,6 +13,8 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
+
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
 )
 
 type fakeValidator struct {
@@ -68,6 +70,8 @@ func (k fakeStakingKeeper) GetBondedValidatorsByPower(_ context.Context) ([]stak
 	return k.bonded, nil
 }
 
+func (fakeStakingKeeper) BondDenom(context.Context) (string, error) { return sdk.DefaultBondDenom, nil }
+
 type fakeSlashingKeeper struct {
 	slashCalls int
 	jailCalls  int
@@ -250,3 +254,27 @@ func TestSlashAndJailValidator_DoesNotJailSoleBondedValidator(t *testing.T) {
 	require.Equal(t, 0, slashingKeeper.jailCalls)
 	require.Nil(t, slashingKeeper.jailUntil)
 }
+
+func TestStakingBankKeeper_CollectsOnlyDealerSlashBurns(t *testing.T) {
+	bank := newFakeDealerBankKeeper()
+	sbk := NewStakingBankKeeper(bank)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	ctx := sdk.Context{}.WithContext(context.Background())
+
+	// Outside a dealer slash the pool burn goes through.
+	require.NoError(t, sbk.BurnCoins(ctx, stakingtypes.BondedPoolName, coins))
+	require.Equal(t, sdkmath.NewInt(1_000_000_000_000-1_000), bank.supply)
+	require.True(t, bank.balance(dealertypes.ModuleName).IsZero())
+
+	// During one, pool burns move to the dealer module account.
+	slashCtx := ctx.WithValue(slashProceedsKey{}, true)
+	require.NoError(t, sbk.BurnCoins(slashCtx, stakingtypes.BondedPoolName, coins))
+	require.NoError(t, sbk.BurnCoins(slashCtx, stakingtypes.NotBondedPoolName, coins))
+	require.Equal(t, sdkmath.NewInt(1_000_000_000_000-1_000), bank.supply)
+	require.Equal(t, int64(2_000), bank.balance(dealertypes.ModuleName).Int64())
+
+	// Other modules' burns are never collected.
+	require.NoError(t, sbk.BurnCoins(slashCtx, dealertypes.ModuleName, coins))
+	require.Equal(t, int64(1_000), bank.balance(dealertypes.ModuleName).Int64())
+	require.Equal(t, sdkmath.NewInt(1_000_000_000_000-2_000), bank.supply)
+}
@@ -0,0 +1,758 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	"onchainpoker/apps/cosmos/x/dealer/committee"
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+)
+
+// Proactive share refresh.
+//
+// A reshare re-deals the active epoch's secret to a new committee without
+// changing pk_epoch. The dealers are the active epoch's qualified members.
+// Each dealer i posts Feldman commitments C_i to a polynomial f_i of degree
+// threshold-1 and one DKG v2 encrypted share f_i(j) per new member j, checked
+// with the same DkgEncShareVerify NIZK as the DKG.
+//
+// In refresh mode (zero_secret: the new committee is a subset of the active
+// members and the threshold is unchanged) f_i(0) = 0, so C_i[0] must be the
+// identity and member j's new share is s_j + sum_{i in Q} f_i(j).
+//
+// Otherwise f_i(0) = s_i, so C_i[0] must equal dealer i's pub share Y_i, and
+// member j's new share is sum_{i in Q} lambda_i * f_i(j) with lambda the
+// Lagrange coefficients at zero over the qualified dealers Q.
+//
+// A dealer is qualified if it is still an unslashed member of the active
+// epoch, committed, and delivered a share to every other new member.
+// Finalization needs at least the active epoch's threshold of qualified
+// dealers. The result is installed as a new epoch id with the same pk_epoch,
+// so hands bound to the old epoch finish against the old shares.
+
+const (
+	reshareCommitBlocksDefault = dkgCommitBlocksDefault
+	reshareShareBlocksDefault  = dkgRevealBlocksDefault
+
+	// reshareMaxRefreshWindowBlocks bounds each phase of a refresh started by
+	// a bonded validator. A reshare holds off DKGs until it is finalized, so
+	// only the module authority may ask for longer windows.
+	reshareMaxRefreshWindowBlocks uint64 = 100
+
+	reshareTranscriptDomain = "ocp/v1/dealer/reshare/transcript"
+)
+
+func validateReshareWindow(name string, blocks, maxBlocks uint64) error {
+	if blocks > maxBlocks {
+		return fmt.Errorf("%s exceeds max window of %d blocks", name, maxBlocks)
+	}
+	return nil
+}
+
+func findReshareMember(r *dealertypes.DealerReshare, valoper string) *dealertypes.DealerMember {
+	for i := range r.Members {
+		if r.Members[i].Validator == valoper {
+			return &r.Members[i]
+		}
+	}
+	return nil
+}
+
+func findReshareCommit(r *dealertypes.DealerReshare, dealer string) *dealertypes.DealerDKGCommit {
+	for i := range r.Commits {
+		if r.Commits[i].Dealer == dealer {
+			return &r.Commits[i]
+		}
+	}
+	return nil
+}
+
+func hasReshareShare(r *dealertypes.DealerReshare, dealer string, recipientIndex uint32) bool {
+	for _, es := range r.EncryptedShares {
+		if es.Dealer == dealer && es.RecipientIndex == recipientIndex {
+			return true
+		}
+	}
+	return false
+}
+
+// loadReshare returns the in-flight reshare together with the active epoch it
+// refreshes, checking that reqEpochID names the reshare.
+func (m msgServer) loadReshare(ctx context.Context, reqEpochID uint64) (*dealertypes.DealerReshare, *dealertypes.DealerEpoch, error) {
+	r, err := m.GetReshare(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if r == nil {
+		return nil, nil, dealertypes.ErrInvalidRequest.Wrap("no reshare in progress")
+	}
+	if reqEpochID != r.EpochId {
+		return nil, nil, dealertypes.ErrInvalidRequest.Wrap("epoch_id mismatch")
+	}
+	from, err := m.GetEpoch(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if from == nil || from.EpochId != r.FromEpochId {
+		return nil, nil, dealertypes.ErrNoActiveEpoch.Wrapf("source epoch %d is no longer active", r.FromEpochId)
+	}
+	return r, from, nil
+}
+
+// reshareCommittee resolves the committee for a reshare of epoch. It returns
+// the members and whether the zero-secret refresh mode applies.
+func (m msgServer) reshareCommittee(ctx context.Context, epoch *dealertypes.DealerEpoch, requested []string, threshold uint32) ([]dealertypes.DealerMember, bool, error) {
+	snaps, err := committee.BondedMemberSnapshots(ctx, m.committeeStakingKeeper)
+	if err != nil {
+		return nil, false, err
+	}
+	bonded := make(map[string]committee.MemberSnapshot, len(snaps))
+	for _, s := range snaps {
+		bonded[s.Operator] = s
+	}
+
+	qual := epochQualMembers(epoch)
+	want := requested
+	if len(want) == 0 {
+		// Refresh the qualified members that are still bonded.
+		for _, mem := range qual {
+			if _, ok := bonded[mem.Validator]; ok {
+				want = append(want, mem.Validator)
+			}
+		}
+	}
+
+	seen := make(map[string]bool, len(want))
+	picked := make([]committee.MemberSnapshot, 0, len(want))
+	for _, v := range want {
+		if _, err := sdk.ValAddressFromBech32(v); err != nil {
+			return nil, false, dealertypes.ErrInvalidRequest.Wrapf("invalid member address %q", v)
+		}
+		if seen[v] {
+			return nil, false, dealertypes.ErrInvalidRequest.Wrapf("duplicate member %s", v)
+		}
+		seen[v] = true
+		s, ok := bonded[v]
+		if !ok {
+			return nil, false, dealertypes.ErrInvalidRequest.Wrapf("member %s is not a bonded validator", v)
+		}
+		picked = append(picked, s)
+	}
+	if len(picked) < int(threshold) {
+		return nil, false, dealertypes.ErrInvalidRequest.Wrapf("committee size %d below threshold %d", len(picked), threshold)
+	}
+
+	isQual := make(map[string]bool, len(qual))
+	for _, mem := range qual {
+		isQual[mem.Validator] = true
+	}
+	zeroSecret := threshold == epoch.Threshold
+	for _, s := range picked {
+		zeroSecret = zeroSecret && isQual[s.Operator]
+	}
+
+	if !zeroSecret {
+		members, err := committee.DealerMembersFromSnapshots(picked)
+		if err != nil {
+			return nil, false, err
+		}
+		return members, false, nil
+	}
+
+	// Refresh keeps each member's Shamir index, since new shares are added
+	// to the existing ones.
+	members := make([]dealertypes.DealerMember, 0, len(picked))
+	for _, s := range picked {
+		old := findEpochMember(epoch, s.Operator)
+		members = append(members, dealertypes.DealerMember{
+			Validator:  s.Operator,
+			Index:      old.Index,
+			ConsPubkey: append([]byte(nil), s.ConsPubKey...),
+			Power:      s.Power,
+		})
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Validator < members[j].Validator })
+	return members, true, nil
+}
+
+func (m msgServer) BeginReshare(ctx context.Context, req *dealertypes.MsgBeginReshare) (*dealertypes.MsgBeginReshareResponse, error) {
+	if req == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
+	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
+	if req.Caller == "" {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("missing caller")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Caller); err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("invalid caller address")
+	}
+
+	epoch, err := m.GetEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if epoch == nil {
+		return nil, dealertypes.ErrNoActiveEpoch.Wrap("no active dealer epoch")
+	}
+	if req.EpochId != epoch.EpochId {
+		return nil, dealertypes.ErrInvalidRequest.Wrapf("epoch_id mismatch: expected %d got %d", epoch.EpochId, req.EpochId)
+	}
+
+	threshold := epoch.Threshold
+	if req.Threshold != 0 {
+		threshold = req.Threshold
+	}
+	if threshold < 2 {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("threshold must be >= 2")
+	}
+	// A plain refresh is harmless and any bonded validator may ask for one;
+	// choosing a committee or threshold is a governance decision.
+	maxWindow := dkgMaxWindowBlocks
+	if len(req.Members) != 0 || threshold != epoch.Threshold {
+		if req.Caller != m.authority {
+			return nil, dealertypes.ErrUnauthorized.Wrapf("changing the committee requires the module authority %s", m.authority)
+		}
+	} else {
+		if err := m.requireActiveBondedCaller(ctx, req.Caller); err != nil {
+			return nil, err
+		}
+		maxWindow = reshareMaxRefreshWindowBlocks
+	}
+
+	if cur, err := m.GetDKG(ctx); err != nil {
+		return nil, err
+	} else if cur != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("dkg already in progress")
+	}
+	if cur, err := m.GetReshare(ctx); err != nil {
+		return nil, err
+	} else if cur != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("reshare already in progress")
+	}
+	if len(epochQualMembers(epoch)) < int(epoch.Threshold) {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("active epoch has too few qualified members to reshare")
+	}
+
+	members, zeroSecret, err := m.reshareCommittee(ctx, epoch, req.Members, threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	epochID, err := m.GetNextEpochID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nextEpochID, err := addUint64Checked(epochID, 1, "next epoch id")
+	if err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	commitBlocks := req.CommitBlocks
+	if commitBlocks == 0 {
+		commitBlocks = reshareCommitBlocksDefault
+	}
+	if err := validateReshareWindow("commitBlocks", commitBlocks, maxWindow); err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap(err.Error())
+	}
+	shareBlocks := req.ShareBlocks
+	if shareBlocks == 0 {
+		shareBlocks = reshareShareBlocksDefault
+	}
+	if err := validateReshareWindow("shareBlocks", shareBlocks, maxWindow); err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	startH := sdkCtx.BlockHeight()
+	commitDL, err := addInt64AndU64Checked(startH, commitBlocks, "reshare commit deadline")
+	if err != nil {
+		return nil, err
+	}
+	shareDL, err := addInt64AndU64Checked(commitDL, shareBlocks, "reshare share deadline")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &dealertypes.DealerReshare{
+		EpochId:         epochID,
+		FromEpochId:     epoch.EpochId,
+		Threshold:       threshold,
+		ZeroSecret:      zeroSecret,
+		Members:         members,
+		StartHeight:     startH,
+		CommitDeadline:  commitDL,
+		ShareDeadline:   shareDL,
+		Commits:         []dealertypes.DealerDKGCommit{},
+		EncryptedShares: []dealertypes.DealerDKGEncryptedShare{},
+	}
+	if err := m.SetReshare(ctx, r); err != nil {
+		return nil, err
+	}
+	if err := m.SetNextEpochID(ctx, nextEpochID); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		dealertypes.EventTypeReshareBegun,
+		sdk.NewAttribute("epochId", fmt.Sprintf("%d", epochID)),
+		sdk.NewAttribute("fromEpochId", fmt.Sprintf("%d", epoch.EpochId)),
+		sdk.NewAttribute("threshold", fmt.Sprintf("%d", threshold)),
+		sdk.NewAttribute("committeeSize", fmt.Sprintf("%d", len(members))),
+		sdk.NewAttribute("zeroSecret", fmt.Sprintf("%t", zeroSecret)),
+		sdk.NewAttribute("commitDeadline", fmt.Sprintf("%d", commitDL)),
+		sdk.NewAttribute("shareDeadline", fmt.Sprintf("%d", shareDL)),
+	))
+	return &dealertypes.MsgBeginReshareResponse{}, nil
+}
+
+// ReshareCommit mirrors DkgCommit. Dealers post their commitments; members of
+// the new committee post their ephemeral pubkey (dealers that stay on the
+// committee do both in one message).
+func (m msgServer) ReshareCommit(ctx context.Context, req *dealertypes.MsgReshareCommit) (*dealertypes.MsgReshareCommitResponse, error) {
+	if req == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
+	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
+	if req.Validator == "" {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("missing validator")
+	}
+	if _, err := sdk.ValAddressFromBech32(req.Validator); err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("invalid validator address")
+	}
+
+	r, from, err := m.loadReshare(ctx, req.EpochId)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() > r.CommitDeadline {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("commit deadline passed")
+	}
+
+	var dealer *dealertypes.DealerMember
+	if mem := findEpochMember(from, req.Validator); mem != nil && !epochIsSlashed(from, req.Validator) {
+		dealer = mem
+	}
+	recipient := findReshareMember(r, req.Validator)
+	if dealer == nil && recipient == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("validator is neither a dealer nor a new committee member")
+	}
+
+	if recipient != nil {
+		if len(recipient.EphemeralPubkey) != 0 {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("commit already submitted")
+		}
+		if len(req.EphemeralPubkey) != ocpcrypto.PointBytes {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("ephemeral_pubkey must be 32 bytes")
+		}
+		if _, err := ocpcrypto.PointFromBytesCanonical(req.EphemeralPubkey); err != nil {
+			return nil, dealertypes.ErrInvalidRequest.Wrapf("ephemeral_pubkey invalid: %v", err)
+		}
+		recipient.EphemeralPubkey = append([]byte(nil), req.EphemeralPubkey...)
+	} else if len(req.EphemeralPubkey) != 0 {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("ephemeral_pubkey is only accepted from new committee members")
+	}
+
+	if dealer == nil {
+		if len(req.Commitments) != 0 {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("validator is not a dealer")
+		}
+	} else {
+		if findReshareCommit(r, req.Validator) != nil {
+			return nil, dealertypes.ErrInvalidRequest.Wrap("commit already submitted")
+		}
+		if len(req.Commitments) != int(r.Threshold) {
+			return nil, dealertypes.ErrInvalidRequest.Wrapf("commitments length mismatch: expected %d got %d", r.Threshold, len(req.Commitments))
+		}
+		commitments := make([][]byte, 0, len(req.Commitments))
+		for i, c := range req.Commitments {
+			if len(c) != ocpcrypto.PointBytes {
+				return nil, dealertypes.ErrInvalidRequest.Wrapf("commitment[%d] must be 32 bytes", i)
+			}
+			if _, err := ocpcrypto.PointFromBytesCanonical(c); err != nil {
+				return nil, dealertypes.ErrInvalidRequest.Wrapf("commitment[%d] invalid: %v", i, err)
+			}
+			commitments = append(commitments, append([]byte(nil), c...))
+		}
+
+		// The constant term pins what the dealer is sharing: zero for a
+		// refresh, its own current share otherwise.
+		want := ocpcrypto.PointZero().Bytes()
+		if !r.ZeroSecret {
+			want = dealer.PubShare
+		}
+		if !bytes.Equal(commitments[0], want) {
+			if r.ZeroSecret {
+				return nil, dealertypes.ErrInvalidRequest.Wrap("commitment[0] must be the identity for a zero-secret refresh")
+			}
+			return nil, dealertypes.ErrInvalidRequest.Wrap("commitment[0] must equal the dealer's pub share")
+		}
+
+		r.Commits = append(r.Commits, dealertypes.DealerDKGCommit{
+			Dealer:      req.Validator,
+			Commitments: commitments,
+		})
+		sortReshareCommits(r)
+	}
+
+	if err := m.SetReshare(ctx, r); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		dealertypes.EventTypeReshareCommitAccepted,
+		sdk.NewAttribute("epochId", fmt.Sprintf("%d", r.EpochId)),
+		sdk.NewAttribute("validator", req.Validator),
+		sdk.NewAttribute("dealer", fmt.Sprintf("%t", dealer != nil)),
+	))
+	return &dealertypes.MsgReshareCommitResponse{}, nil
+}
+
+// ReshareEncryptedShare mirrors DkgEncryptedShare: the share is verified with
+// DkgEncShareVerify against the dealer's reshare commitments at the
+// recipient's index under the recipient's reshare ephemeral pubkey.
+func (m msgServer) ReshareEncryptedShare(ctx context.Context, req *dealertypes.MsgReshareEncryptedShare) (*dealertypes.MsgReshareEncryptedShareResponse, error) {
+	if req == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
+	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
+	if req.Dealer == "" {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("missing dealer")
+	}
+	if _, err := sdk.ValAddressFromBech32(req.Dealer); err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("invalid dealer address")
+	}
+
+	r, _, err := m.loadReshare(ctx, req.EpochId)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() > r.ShareDeadline {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("share deadline passed")
+	}
+
+	commit := findReshareCommit(r, req.Dealer)
+	if commit == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("dealer has not committed")
+	}
+
+	var recipient *dealertypes.DealerMember
+	for i := range r.Members {
+		if r.Members[i].Index == req.RecipientIndex {
+			recipient = &r.Members[i]
+			break
+		}
+	}
+	if recipient == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("recipient_index not in committee")
+	}
+	if recipient.Validator == req.Dealer {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("dealer cannot address itself")
+	}
+	if len(recipient.EphemeralPubkey) == 0 {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("recipient has no ephemeral_pubkey yet")
+	}
+	pkR, err := ocpcrypto.PointFromBytesCanonical(recipient.EphemeralPubkey)
+	if err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrapf("recipient ephemeral_pubkey invalid: %v", err)
+	}
+
+	if err := verifyEncryptedShare(commit.Commitments, req.RecipientIndex, pkR, req.U, req.V, req.Proof, req.ScalarCt); err != nil {
+		return nil, err
+	}
+
+	if hasReshareShare(r, req.Dealer, req.RecipientIndex) {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("encrypted share already submitted for this (dealer, recipient)")
+	}
+	r.EncryptedShares = append(r.EncryptedShares, dealertypes.DealerDKGEncryptedShare{
+		Dealer:         req.Dealer,
+		RecipientIndex: req.RecipientIndex,
+		U:              append([]byte(nil), req.U...),
+		V:              append([]byte(nil), req.V...),
+		Proof:          append([]byte(nil), req.Proof...),
+		ScalarCt:       append([]byte(nil), req.ScalarCt...),
+	})
+	sortReshareEncryptedShares(r)
+
+	if err := m.SetReshare(ctx, r); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		dealertypes.EventTypeReshareEncryptedShare,
+		sdk.NewAttribute("epochId", fmt.Sprintf("%d", r.EpochId)),
+		sdk.NewAttribute("dealer", req.Dealer),
+		sdk.NewAttribute("recipientIndex", fmt.Sprintf("%d", req.RecipientIndex)),
+	))
+	return &dealertypes.MsgReshareEncryptedShareResponse{}, nil
+}
+
+func (m msgServer) FinalizeReshare(ctx context.Context, req *dealertypes.MsgFinalizeReshare) (*dealertypes.MsgFinalizeReshareResponse, error) {
+	if req == nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("nil request")
+	}
+	if err := m.pokerKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
+	if req.Caller == "" {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("missing caller")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Caller); err != nil {
+		return nil, dealertypes.ErrInvalidRequest.Wrap("invalid caller address")
+	}
+
+	r, from, err := m.loadReshare(ctx, req.EpochId)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() <= r.ShareDeadline {
+		return nil, dealertypes.ErrInvalidRequest.Wrapf("too early to finalize: height=%d shareDeadline=%d", sdkCtx.BlockHeight(), r.ShareDeadline)
+	}
+
+	events, err := m.finalizeReshare(ctx, r, from)
+	if err != nil {
+		return nil, err
+	}
+	for _, ev := range events {
+		sdkCtx.EventManager().EmitEvent(ev)
+	}
+	return &dealertypes.MsgFinalizeReshareResponse{}, nil
+}
+
+// MaybeAutoFinalizeReshare is invoked from the dealer module's BeginBlocker
+// ahead of MaybeAutoBeginEpoch. Once the in-flight reshare's share deadline
+// has passed, it finalizes the reshare exactly as MsgFinalizeReshare would,
+// installing the refreshed epoch or aborting the reshare, so a reshare no one
+// finalizes stops holding off DKGs a block after its deadline. A reshare that
+// cannot be finalized is cancelled instead.
+func (k Keeper) MaybeAutoFinalizeReshare(ctx context.Context) error {
+	r, err := k.GetReshare(ctx)
+	if err != nil || r == nil {
+		return err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() <= r.ShareDeadline {
+		return nil
+	}
+
+	m := msgServer{Keeper: k}
+	cacheCtx, write := sdkCtx.CacheContext()
+	_, from, err := m.loadReshare(cacheCtx, r.EpochId)
+	var events []sdk.Event
+	if err == nil {
+		events, err = m.finalizeReshare(cacheCtx, r, from)
+	}
+	if err != nil {
+		// BeginBlock errors are fatal; drop the reshare instead.
+		k.Logger(ctx).Error("MaybeAutoFinalizeReshare: finalize failed", "epochId", r.EpochId, "err", err)
+		if events, err = k.cancelReshare(ctx, r, "finalize failed"); err != nil {
+			return err
+		}
+	} else {
+		write()
+	}
+	sdkCtx.EventManager().EmitEvents(events)
+	return nil
+}
+
+// cancelReshare drops the in-flight reshare without installing anything. Its
+// epoch id is handed back, so a beacon opened for that id can still seed the
+// next DKG. It returns events to be emitted by the caller.
+func (k Keeper) cancelReshare(ctx context.Context, r *dealertypes.DealerReshare, reason string) ([]sdk.Event, error) {
+	if err := k.SetReshare(ctx, nil); err != nil {
+		return nil, err
+	}
+	next, err := k.GetNextEpochID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if next == r.EpochId+1 {
+		if err := k.SetNextEpochID(ctx, r.EpochId); err != nil {
+			return nil, err
+		}
+	}
+	return []sdk.Event{sdk.NewEvent(
+		dealertypes.EventTypeReshareAborted,
+		sdk.NewAttribute("epochId", fmt.Sprintf("%d", r.EpochId)),
+		sdk.NewAttribute("fromEpochId", fmt.Sprintf("%d", r.FromEpochId)),
+		sdk.NewAttribute("reason", reason),
+	)}, nil
+}
+
+// reshareQualifiedDealers returns the dealers whose contribution counts,
+// ordered by Shamir index.
+func reshareQualifiedDealers(r *dealertypes.DealerReshare, from *dealertypes.DealerEpoch) []dealertypes.DealerMember {
+	out := []dealertypes.DealerMember{}
+	for _, d := range epochQualMembers(from) {
+		if findReshareCommit(r, d.Validator) == nil {
+			continue
+		}
+		complete := true
+		for _, mem := range r.Members {
+			if mem.Validator != d.Validator && !hasReshareShare(r, d.Validator, mem.Index) {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			out = append(out, d)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Index < out[j].Index })
+	return out
+}
+
+// finalizeReshare installs the refreshed epoch, or drops the reshare if too
+// few dealers qualified. It returns events to be emitted by the caller.
+func (m msgServer) finalizeReshare(ctx context.Context, r *dealertypes.DealerReshare, from *dealertypes.DealerEpoch) ([]sdk.Event, error) {
+	qual := reshareQualifiedDealers(r, from)
+	if len(qual) < int(from.Threshold) {
+		if err := m.SetReshare(ctx, nil); err != nil {
+			return nil, err
+		}
+		return []sdk.Event{sdk.NewEvent(
+			dealertypes.EventTypeReshareAborted,
+			sdk.NewAttribute("epochId", fmt.Sprintf("%d", r.EpochId)),
+			sdk.NewAttribute("fromEpochId", fmt.Sprintf("%d", r.FromEpochId)),
+			sdk.NewAttribute("threshold", fmt.Sprintf("%d", from.Threshold)),
+			sdk.NewAttribute("qual", fmt.Sprintf("%d", len(qual))),
+		)}, nil
+	}
+
+	// Per-dealer weight: 1 for a zero-secret refresh, lambda_i otherwise.
+	weights := make([]ocpcrypto.Scalar, len(qual))
+	if r.ZeroSecret {
+		for i := range weights {
+			weights[i] = ocpcrypto.ScalarFromUint64(1)
+		}
+	} else {
+		indices := make([]uint32, len(qual))
+		for i, d := range qual {
+			indices[i] = d.Index
+		}
+		lambdas, err := ocpcrypto.LagrangeAtZero(indices)
+		if err != nil {
+			return nil, err
+		}
+		copy(weights, lambdas)
+
+		// Each C_i[0] equals Y_i (checked at commit), so this only fails if
+		// the source epoch's pub shares were inconsistent with pk_epoch.
+		pk := ocpcrypto.PointZero()
+		for i, d := range qual {
+			c0, err := ocpcrypto.PointFromBytesCanonical(findReshareCommit(r, d.Validator).Commitments[0])
+			if err != nil {
+				return nil, err
+			}
+			pk = ocpcrypto.PointAdd(pk, ocpcrypto.MulPoint(c0, weights[i]))
+		}
+		if !bytes.Equal(pk.Bytes(), from.PkEpoch) {
+			return nil, fmt.Errorf("reshare %d: recombined key does not match pk_epoch", r.EpochId)
+		}
+	}
+
+	membersOut := make([]dealertypes.DealerMember, 0, len(r.Members))
+	for _, mem := range r.Members {
+		Y := ocpcrypto.PointZero()
+		if r.ZeroSecret {
+			old := findEpochMember(from, mem.Validator)
+			if old == nil {
+				return nil, fmt.Errorf("reshare %d: refresh member %s not in source epoch", r.EpochId, mem.Validator)
+			}
+			prev, err := ocpcrypto.PointFromBytesCanonical(old.PubShare)
+			if err != nil {
+				return nil, err
+			}
+			Y = prev
+		}
+		for i, d := range qual {
+			pt, err := dkgEvalCommitment(findReshareCommit(r, d.Validator).Commitments, mem.Index)
+			if err != nil {
+				return nil, err
+			}
+			Y = ocpcrypto.PointAdd(Y, ocpcrypto.MulPoint(pt, weights[i]))
+		}
+		mem.PubShare = Y.Bytes()
+		membersOut = append(membersOut, mem)
+	}
+
+	epoch := &dealertypes.DealerEpoch{
+		EpochId:        r.EpochId,
+		Threshold:      r.Threshold,
+		PkEpoch:        append([]byte(nil), from.PkEpoch...),
+		TranscriptRoot: reshareTranscriptRoot(r, from, qual),
+		StartHeight:    r.StartHeight,
+		Slashed:        []string{},
+		Members:        membersOut,
+	}
+	if err := m.activateEpoch(ctx, epoch); err != nil {
+		return nil, err
+	}
+	if err := m.SetReshare(ctx, nil); err != nil {
+		return nil, err
+	}
+	// The refreshed epoch took the id a pending beacon was opened for; no DKG
+	// can consume that beacon now, so drop it and let auto-open start one for
+	// the next id.
+	if bs, err := m.GetBeaconState(ctx); err != nil {
+		return nil, err
+	} else if bs != nil && bs.EpochId == r.EpochId && len(bs.Final) == 0 {
+		if err := m.SetBeaconState(ctx, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	dealers := make([]string, 0, len(qual))
+	for _, d := range qual {
+		dealers = append(dealers, d.Validator)
+	}
+	return []sdk.Event{sdk.NewEvent(
+		dealertypes.EventTypeReshareFinalized,
+		sdk.NewAttribute("epochId", fmt.Sprintf("%d", r.EpochId)),
+		sdk.NewAttribute("fromEpochId", fmt.Sprintf("%d", r.FromEpochId)),
+		sdk.NewAttribute("threshold", fmt.Sprintf("%d", r.Threshold)),
+		sdk.NewAttribute("zeroSecret", fmt.Sprintf("%t", r.ZeroSecret)),
+		sdk.NewAttribute("dealers", strings.Join(dealers, ",")),
+	)}, nil
+}
+
+// reshareTranscriptRoot commits to the source epoch, the new committee and
+// the qualified dealers' commitments.
+func reshareTranscriptRoot(r *dealertypes.DealerReshare, from *dealertypes.DealerEpoch, qual []dealertypes.DealerMember) []byte {
+	var buf bytes.Buffer
+	writeLenBytes(&buf, []byte(reshareTranscriptDomain))
+	writeLenBytes(&buf, from.TranscriptRoot)
+	writeLenBytes(&buf, from.PkEpoch)
+	buf.Write(u64le(r.FromEpochId))
+	buf.Write(u64le(r.EpochId))
+	buf.Write(u32leLocal(r.Threshold))
+	buf.Write(u32leLocal(uint32(len(r.Members))))
+	for _, mem := range r.Members {
+		writeLenBytes(&buf, []byte(mem.Validator))
+		buf.Write(u32leLocal(mem.Index))
+	}
+	buf.Write(u32leLocal(uint32(len(qual))))
+	for _, d := range qual {
+		writeLenBytes(&buf, []byte(d.Validator))
+		for _, c := range findReshareCommit(r, d.Validator).Commitments {
+			writeLenBytes(&buf, c)
+		}
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	return sum[:]
+}
@@ -0,0 +1,401 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+)
+
+func evalPoly(coeffs []ocpcrypto.Scalar, x uint32) ocpcrypto.Scalar {
+	out := ocpcrypto.ScalarZero()
+	pow := ocpcrypto.ScalarFromUint64(1)
+	xs := ocpcrypto.ScalarFromUint64(uint64(x))
+	for _, a := range coeffs {
+		out = ocpcrypto.ScalarAdd(out, ocpcrypto.ScalarMul(a, pow))
+		pow = ocpcrypto.ScalarMul(pow, xs)
+	}
+	return out
+}
+
+// reshareFixture is an active 2-of-3 epoch over validators[0:3] with known
+// secret shares, plus a fourth bonded validator that is not on the committee.
+type reshareFixture struct {
+	ctx        context.Context
+	k          Keeper
+	ms         dealertypes.MsgServer
+	validators []string
+	sk         ocpcrypto.Scalar
+	shares     map[string]ocpcrypto.Scalar
+}
+
+func newReshareFixture(t *testing.T) *reshareFixture {
+	t.Helper()
+	validators := make([]string, 4)
+	for i := range validators {
+		validators[i] = sdk.ValAddress(bytes.Repeat([]byte{byte(0x51 + i)}, 20)).String()
+	}
+	sort.Strings(validators)
+	bonded := make([]stakingtypes.Validator, 4)
+	for i, v := range validators {
+		bonded[i] = makeBondedValidatorForDealerTest(t, v, 10, byte(0x61+i))
+	}
+	ctx, k, ms, _ := newDealerMsgServerForOverflowTests(t, time.Unix(100, 0).UTC(), 10, bonded)
+
+	poly := []ocpcrypto.Scalar{ocpcrypto.ScalarFromUint64(777), ocpcrypto.ScalarFromUint64(31)}
+	f := &reshareFixture{ctx: ctx, k: k, ms: ms, validators: validators, sk: poly[0], shares: map[string]ocpcrypto.Scalar{}}
+	epoch := &dealertypes.DealerEpoch{
+		EpochId:        1,
+		Threshold:      2,
+		PkEpoch:        ocpcrypto.MulBase(poly[0]).Bytes(),
+		TranscriptRoot: bytes.Repeat([]byte{0x01}, 32),
+		StartHeight:    1,
+	}
+	for i, v := range validators[:3] {
+		idx := uint32(i + 1)
+		f.shares[v] = evalPoly(poly, idx)
+		epoch.Members = append(epoch.Members, dealertypes.DealerMember{
+			Validator:  v,
+			Index:      idx,
+			PubShare:   ocpcrypto.MulBase(f.shares[v]).Bytes(),
+			ConsPubkey: bytes.Repeat([]byte{byte(0x61 + i)}, 32),
+			Power:      10,
+		})
+	}
+	require.NoError(t, epoch.Validate())
+	require.NoError(t, k.SetEpoch(ctx, epoch))
+	require.NoError(t, k.SetNextEpochID(ctx, 2))
+	return f
+}
+
+func (f *reshareFixture) atHeight(h int64) {
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(h)
+}
+
+// run plays every dealer and member of the in-flight reshare honestly and
+// returns each new member's expected share.
+func (f *reshareFixture) run(t *testing.T) map[string]ocpcrypto.Scalar {
+	t.Helper()
+	r, err := f.k.GetReshare(f.ctx)
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	from, err := f.k.GetEpoch(f.ctx)
+	require.NoError(t, err)
+
+	polys := map[string][]ocpcrypto.Scalar{}
+	commits := map[string][]ocpcrypto.Point{}
+	for n, d := range from.Members {
+		c0 := ocpcrypto.ScalarZero()
+		if !r.ZeroSecret {
+			c0 = f.shares[d.Validator]
+		}
+		poly := []ocpcrypto.Scalar{c0}
+		for i := 1; i < int(r.Threshold); i++ {
+			poly = append(poly, ocpcrypto.ScalarFromUint64(uint64(100*(n+1)+i)))
+		}
+		polys[d.Validator] = poly
+		for _, a := range poly {
+			commits[d.Validator] = append(commits[d.Validator], ocpcrypto.MulBase(a))
+		}
+	}
+
+	skR := map[string]ocpcrypto.Scalar{}
+	for i, v := range f.validators {
+		msg := &dealertypes.MsgReshareCommit{Validator: v, EpochId: r.EpochId}
+		if pts, ok := commits[v]; ok {
+			for _, p := range pts {
+				msg.Commitments = append(msg.Commitments, p.Bytes())
+			}
+		}
+		if findReshareMember(r, v) != nil {
+			skR[v] = ocpcrypto.ScalarFromUint64(uint64(9000 + i))
+			msg.EphemeralPubkey = ocpcrypto.MulBase(skR[v]).Bytes()
+		}
+		if len(msg.Commitments) == 0 && len(msg.EphemeralPubkey) == 0 {
+			continue
+		}
+		_, err := f.ms.ReshareCommit(f.ctx, msg)
+		require.NoError(t, err)
+	}
+
+	for n, d := range from.Members {
+		for _, mem := range r.Members {
+			if mem.Validator == d.Validator {
+				continue
+			}
+			s := evalPoly(polys[d.Validator], mem.Index)
+			pkR := ocpcrypto.MulBase(skR[mem.Validator])
+			rr := ocpcrypto.ScalarFromUint64(uint64(5000 + 10*n + int(mem.Index)))
+			U := ocpcrypto.MulBase(rr)
+			V := ocpcrypto.PointAdd(ocpcrypto.MulBase(s), ocpcrypto.MulPoint(pkR, rr))
+			proof, err := ocpcrypto.DkgEncShareProve(commits[d.Validator], mem.Index, pkR, U, V, s, rr,
+				ocpcrypto.ScalarFromUint64(17), ocpcrypto.ScalarFromUint64(19))
+			require.NoError(t, err)
+			proofBytes := ocpcrypto.EncodeDkgEncShareProof(proof)
+			ct, err := ocpcrypto.EncryptShareScalar(pkR, rr, s, proofBytes)
+			require.NoError(t, err)
+			_, err = f.ms.ReshareEncryptedShare(f.ctx, &dealertypes.MsgReshareEncryptedShare{
+				Dealer:         d.Validator,
+				EpochId:        r.EpochId,
+				RecipientIndex: mem.Index,
+				U:              U.Bytes(),
+				V:              V.Bytes(),
+				Proof:          proofBytes,
+				ScalarCt:       ct,
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	// What each new member computes locally from the shares it decrypted.
+	indices := make([]uint32, 0, len(from.Members))
+	for _, d := range from.Members {
+		indices = append(indices, d.Index)
+	}
+	lambdas, err := ocpcrypto.LagrangeAtZero(indices)
+	require.NoError(t, err)
+	out := map[string]ocpcrypto.Scalar{}
+	for _, mem := range r.Members {
+		s := ocpcrypto.ScalarZero()
+		if r.ZeroSecret {
+			s = f.shares[mem.Validator]
+		}
+		for i, d := range from.Members {
+			sub := evalPoly(polys[d.Validator], mem.Index)
+			if !r.ZeroSecret {
+				sub = ocpcrypto.ScalarMul(sub, lambdas[i])
+			}
+			s = ocpcrypto.ScalarAdd(s, sub)
+		}
+		out[mem.Validator] = s
+	}
+	return out
+}
+
+func requireSharesReconstruct(t *testing.T, epoch *dealertypes.DealerEpoch, shares map[string]ocpcrypto.Scalar, sk ocpcrypto.Scalar) {
+	t.Helper()
+	for _, mem := range epoch.Members {
+		require.Equal(t, ocpcrypto.MulBase(shares[mem.Validator]).Bytes(), mem.PubShare, mem.Validator)
+	}
+	quorum := epoch.Members[len(epoch.Members)-int(epoch.Threshold):]
+	indices := make([]uint32, 0, len(quorum))
+	for _, mem := range quorum {
+		indices = append(indices, mem.Index)
+	}
+	lambdas, err := ocpcrypto.LagrangeAtZero(indices)
+	require.NoError(t, err)
+	got := ocpcrypto.ScalarZero()
+	for i, mem := range quorum {
+		got = ocpcrypto.ScalarAdd(got, ocpcrypto.ScalarMul(lambdas[i], shares[mem.Validator]))
+	}
+	require.Equal(t, sk.Bytes(), got.Bytes())
+}
+
+func TestReshare_ZeroSecretRefreshKeepsKeyAndHands(t *testing.T) {
+	f := newReshareFixture(t)
+	caller := sdk.AccAddress(mustValAddr(t, f.validators[0])).String()
+	require.NoError(t, f.k.SetHand(f.ctx, 1, 1, &dealertypes.DealerHand{EpochId: 1}))
+
+	_, err := f.ms.BeginReshare(f.ctx, &dealertypes.MsgBeginReshare{Caller: caller, EpochId: 1})
+	require.NoError(t, err)
+	r, err := f.k.GetReshare(f.ctx)
+	require.NoError(t, err)
+	require.True(t, r.ZeroSecret)
+	require.Equal(t, uint64(2), r.EpochId)
+
+	// A DKG cannot start while the reshare is pending.
+	_, err = f.ms.BeginEpoch(f.ctx, &dealertypes.MsgBeginEpoch{Caller: caller, CommitteeSize: 3, Threshold: 2})
+	require.ErrorContains(t, err, "reshare in progress")
+
+	newShares := f.run(t)
+
+	_, err = f.ms.FinalizeReshare(f.ctx, &dealertypes.MsgFinalizeReshare{Caller: caller, EpochId: 2})
+	require.ErrorContains(t, err, "too early to finalize")
+	f.atHeight(r.ShareDeadline + 1)
+	_, err = f.ms.FinalizeReshare(f.ctx, &dealertypes.MsgFinalizeReshare{Caller: caller, EpochId: 2})
+	require.NoError(t, err)
+
+	epoch, err := f.k.GetEpoch(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), epoch.EpochId)
+	require.Equal(t, ocpcrypto.MulBase(f.sk).Bytes(), epoch.PkEpoch)
+	require.NoError(t, epoch.Validate())
+	requireSharesReconstruct(t, epoch, newShares, f.sk)
+	for _, mem := range epoch.Members {
+		require.NotEqual(t, ocpcrypto.MulBase(f.shares[mem.Validator]).Bytes(), mem.PubShare)
+	}
+
+	// The hand bound to epoch 1 still resolves the old shares.
+	old, err := f.k.EpochByID(f.ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, old)
+	require.Equal(t, ocpcrypto.MulBase(f.shares[f.validators[0]]).Bytes(), old.Members[0].PubShare)
+
+	rs, err := f.k.GetReshare(f.ctx)
+	require.NoError(t, err)
+	require.Nil(t, rs)
+}
+
+func TestReshare_ChangesCommitteeAndThreshold(t *testing.T) {
+	f := newReshareFixture(t)
+	members := f.validators[1:]
+
+	_, err := f.ms.BeginReshare(f.ctx, &dealertypes.MsgBeginReshare{
+		Caller:    sdk.AccAddress(mustValAddr(t, f.validators[0])).String(),
+		EpochId:   1,
+		Members:   members,
+		Threshold: 3,
+	})
+	require.ErrorIs(t, err, dealertypes.ErrUnauthorized)
+
+	_, err = f.ms.BeginReshare(f.ctx, &dealertypes.MsgBeginReshare{
+		Caller:    testAuthority,
+		EpochId:   1,
+		Members:   members,
+		Threshold: 3,
+	})
+	require.NoError(t, err)
+	r, err := f.k.GetReshare(f.ctx)
+	require.NoError(t, err)
+	require.False(t, r.ZeroSecret)
+	require.Len(t, r.Members, 3)
+
+	newShares := f.run(t)
+	f.atHeight(r.ShareDeadline + 1)
+	_, err = f.ms.FinalizeReshare(f.ctx, &dealertypes.MsgFinalizeReshare{Caller: testAuthority, EpochId: r.EpochId})
+	require.NoError(t, err)
+
+	epoch, err := f.k.GetEpoch(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), epoch.Threshold)
+	require.Equal(t, ocpcrypto.MulBase(f.sk).Bytes(), epoch.PkEpoch)
+	require.Nil(t, findEpochMember(epoch, f.validators[0]))
+	require.NotNil(t, findEpochMember(epoch, f.validators[3]))
+	requireSharesReconstruct(t, epoch, newShares, f.sk)
+}
+
+func TestReshareCommit_ConstantTermBindsSecret(t *testing.T) {
+	f := newReshareFixture(t)
+	caller := sdk.AccAddress(mustValAddr(t, f.validators[0])).String()
+	_, err := f.ms.BeginReshare(f.ctx, &dealertypes.MsgBeginReshare{Caller: caller, EpochId: 1})
+	require.NoError(t, err)
+
+	// A refresh dealer that shares a non-zero secret would shift pk_epoch.
+	one := ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(1)).Bytes()
+	_, err = f.ms.ReshareCommit(f.ctx, &dealertypes.MsgReshareCommit{
+		Validator:       f.validators[0],
+		EpochId:         2,
+		Commitments:     [][]byte{one, one},
+		EphemeralPubkey: one,
+	})
+	require.ErrorContains(t, err, "must be the identity")
+
+	// Validators outside both committees cannot take part.
+	_, err = f.ms.ReshareCommit(f.ctx, &dealertypes.MsgReshareCommit{
+		Validator:       f.validators[3],
+		EpochId:         2,
+		EphemeralPubkey: one,
+	})
+	require.ErrorContains(t, err, "neither a dealer nor a new committee member")
+}
+
+func TestFinalizeReshare_AbortsBelowThreshold(t *testing.T) {
+	f := newReshareFixture(t)
+	caller := sdk.AccAddress(mustValAddr(t, f.validators[0])).String()
+	_, err := f.ms.BeginReshare(f.ctx, &dealertypes.MsgBeginReshare{Caller: caller, EpochId: 1})
+	require.NoError(t, err)
+	r, err := f.k.GetReshare(f.ctx)
+	require.NoError(t, err)
+
+	f.atHeight(r.ShareDeadline + 1)
+	_, err = f.ms.FinalizeReshare(f.ctx, &dealertypes.MsgFinalizeReshare{Caller: caller, EpochId: r.EpochId})
+	require.NoError(t, err)
+
+	epoch, err := f.k.GetEpoch(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), epoch.EpochId)
+	rs, err := f.k.GetReshare(f.ctx)
+	require.NoError(t, err)
+	require.Nil(t, rs)
+}
+
+func TestMaybeAutoFinalizeReshare(t *testing.T) {
+	// A reshare nobody delivers for is aborted a block after its deadline,
+	// without anyone sending MsgFinalizeReshare.
+	f := newReshareFixture(t)
+	caller := sdk.AccAddress(mustValAddr(t, f.validators[0])).String()
+	_, err := f.ms.BeginReshare(f.ctx, &dealertypes.MsgBeginReshare{Caller: caller, EpochId: 1})
+	require.NoError(t, err)
+	r, err := f.k.GetReshare(f.ctx)
+	require.NoError(t, err)
+
+	f.atHeight(r.ShareDeadline)
+	require.NoError(t, f.k.MaybeAutoFinalizeReshare(f.ctx))
+	rs, err := f.k.GetReshare(f.ctx)
+	require.NoError(t, err)
+	require.NotNil(t, rs)
+
+	f.atHeight(r.ShareDeadline + 1)
+	require.NoError(t, f.k.MaybeAutoFinalizeReshare(f.ctx))
+	rs, err = f.k.GetReshare(f.ctx)
+	require.NoError(t, err)
+	require.Nil(t, rs)
+	require.Equal(t, 1, countEvents(sdk.UnwrapSDKContext(f.ctx), dealertypes.EventTypeReshareAborted))
+	epoch, err := f.k.GetEpoch(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), epoch.EpochId)
+
+	// A completed reshare is installed, and the beacon opened for the id it
+	// took is dropped.
+	f = newReshareFixture(t)
+	_, err = f.ms.BeginReshare(f.ctx, &dealertypes.MsgBeginReshare{Caller: caller, EpochId: 1})
+	require.NoError(t, err)
+	r, err = f.k.GetReshare(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, f.k.SetBeaconState(f.ctx, &dealertypes.BeaconState{EpochId: r.EpochId, Threshold: 2}))
+	newShares := f.run(t)
+	f.atHeight(r.ShareDeadline + 1)
+	require.NoError(t, f.k.MaybeAutoFinalizeReshare(f.ctx))
+	epoch, err = f.k.GetEpoch(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), epoch.EpochId)
+	requireSharesReconstruct(t, epoch, newShares, f.sk)
+	require.Equal(t, 1, countEvents(sdk.UnwrapSDKContext(f.ctx), dealertypes.EventTypeReshareFinalized))
+	bs, err := f.k.GetBeaconState(f.ctx)
+	require.NoError(t, err)
+	require.Nil(t, bs)
+}
+
+func TestBeginReshare_RefreshWindowsCapped(t *testing.T) {
+	f := newReshareFixture(t)
+	caller := sdk.AccAddress(mustValAddr(t, f.validators[0])).String()
+	_, err := f.ms.BeginReshare(f.ctx, &dealertypes.MsgBeginReshare{Caller: caller, EpochId: 1, ShareBlocks: reshareMaxRefreshWindowBlocks + 1})
+	require.ErrorContains(t, err, "shareBlocks exceeds max window of 100 blocks")
+	_, err = f.ms.BeginReshare(f.ctx, &dealertypes.MsgBeginReshare{Caller: caller, EpochId: 1, CommitBlocks: reshareMaxRefreshWindowBlocks + 1})
+	require.ErrorContains(t, err, "commitBlocks exceeds max window of 100 blocks")
+
+	_, err = f.ms.BeginReshare(f.ctx, &dealertypes.MsgBeginReshare{
+		Caller: caller, EpochId: 1,
+		CommitBlocks: reshareMaxRefreshWindowBlocks, ShareBlocks: reshareMaxRefreshWindowBlocks,
+	})
+	require.NoError(t, err)
+	r, err := f.k.GetReshare(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(10+200), r.ShareDeadline)
+}
+
+func mustValAddr(t *testing.T, valoper string) sdk.ValAddress {
+	t.Helper()
+	va, err := sdk.ValAddressFromBech32(valoper)
+	require.NoError(t, err)
+	return va
+}
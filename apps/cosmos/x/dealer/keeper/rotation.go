@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/x/dealer/committee"
+)
+
+// MaybeAutoBeginEpoch is invoked from the dealer module's BeginBlocker ahead
+// of MaybeAutoOpenBeacon. It begins the next epoch's DKG, exactly as
+// MsgBeginEpoch would, when all of these hold:
+//   - params.EpochLengthBlocks is non-zero,
+//   - no DKG is in flight,
+//   - there is no current epoch, it started at least EpochLengthBlocks
+//     ago, or the staking hooks marked enough members inactive that fewer
+//     than its threshold are still qualified, and
+//   - the beacon for the next epoch has closed and not yet been consumed.
+//
+// A reshare in flight is cancelled when rotation is due, so a refresh cannot
+// hold off a rotation, least of all one forced by a degraded committee.
+//
+// The committee is capped at the bonded validator count and the threshold is
+// derived from params.ThresholdBps. Failures are logged and rolled back; the
+// next block retries, and MaybeAutoOpenBeacon replaces a beacon that closed
+// without enough reveals.
+func (k Keeper) MaybeAutoBeginEpoch(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.EpochLengthBlocks == 0 {
+		return nil
+	}
+	dkg, err := k.GetDKG(ctx)
+	if err != nil {
+		return err
+	}
+	if dkg != nil {
+		return nil
+	}
+	reshare, err := k.GetReshare(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	h := sdkCtx.BlockHeight()
+	epoch, err := k.GetEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	degraded := epoch != nil && len(epoch.Inactive) != 0 && len(epochQualMembers(epoch)) < int(epoch.Threshold)
+	if epoch != nil && !degraded && (h < epoch.StartHeight || uint64(h-epoch.StartHeight) < params.EpochLengthBlocks) {
+		return nil
+	}
+
+	nextEpoch, err := k.pendingEpochID(ctx)
+	if err != nil {
+		return err
+	}
+	bs, err := k.GetBeaconState(ctx)
+	if err != nil {
+		return err
+	}
+	if bs == nil || bs.EpochId != nextEpoch || len(bs.Final) != 0 || h <= bs.RevealCloseHeight {
+		return nil // beacon not opened, still open, or already consumed
+	}
+
+	snaps, err := committee.BondedMemberSnapshots(ctx, k.committeeStakingKeeper)
+	if err != nil {
+		k.Logger(ctx).Error("MaybeAutoBeginEpoch: read bonded validators", "err", err)
+		return nil
+	}
+	size := min(params.TargetCommitteeSize, uint32(len(snaps)))
+	threshold := params.EpochThreshold(size)
+	if size < threshold {
+		k.Logger(ctx).Info("MaybeAutoBeginEpoch: not enough bonded validators for a committee",
+			"epochId", nextEpoch, "bonded", len(snaps), "threshold", threshold)
+		return nil
+	}
+
+	cacheCtx, write := sdkCtx.CacheContext()
+	var events []sdk.Event
+	if reshare != nil {
+		if events, err = k.cancelReshare(cacheCtx, reshare, "epoch rotation"); err != nil {
+			k.Logger(ctx).Error("MaybeAutoBeginEpoch: cancel reshare failed", "epochId", reshare.EpochId, "err", err)
+			return nil
+		}
+	}
+	if _, err := (msgServer{Keeper: k}).beginEpoch(cacheCtx, beginEpochArgs{
+		epochID:       nextEpoch,
+		threshold:     threshold,
+		committeeSize: size,
+		origin:        "auto",
+	}); err != nil {
+		// BeginBlock errors are fatal; log and retry next block instead.
+		k.Logger(ctx).Error("MaybeAutoBeginEpoch: beginEpoch failed", "epochId", nextEpoch, "err", err)
+		return nil
+	}
+	write()
+	sdkCtx.EventManager().EmitEvents(events)
+	return nil
+}
+
+// pendingEpochID is the epoch id the next DKG will use: NextEpochID, or the
+// id held by an in-flight reshare, which a due rotation cancels and takes
+// back.
+func (k Keeper) pendingEpochID(ctx context.Context) (uint64, error) {
+	r, err := k.GetReshare(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if r != nil {
+		return r.EpochId, nil
+	}
+	return k.GetNextEpochID(ctx)
+}
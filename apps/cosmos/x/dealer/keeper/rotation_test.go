@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+)
+
+func rotationFixture(t *testing.T, height int64, validators int) (context.Context, Keeper) {
+	t.Helper()
+	bonded := make([]stakingtypes.Validator, 0, validators)
+	for i := byte(0); i < byte(validators); i++ {
+		valoper := sdk.ValAddress(bytes.Repeat([]byte{0x40 + i}, 20)).String()
+		bonded = append(bonded, makeBondedValidatorForDealerTest(t, valoper, 1, 0xc0+i))
+	}
+	ctx, k, _, _ := newDealerMsgServerForOverflowTests(t, time.Unix(100, 0).UTC(), height, bonded)
+	return ctx, k
+}
+
+// closedBeacon is a beacon for epochID whose reveal window ended before
+// height. With no reveals it falls back to DevnetRandEpoch on the devnet
+// chain id the fixture uses.
+func closedBeacon(epochID uint64, height int64) *dealertypes.BeaconState {
+	return &dealertypes.BeaconState{
+		EpochId:           epochID,
+		CommitOpenHeight:  height - 10,
+		CommitCloseHeight: height - 5,
+		RevealCloseHeight: height - 1,
+		Threshold:         2,
+	}
+}
+
+func TestMaybeAutoBeginEpoch_BeginsDKGOnceBeaconClosesAndEpochAgesOut(t *testing.T) {
+	const h int64 = 1_000
+	ctx, k := rotationFixture(t, h, 3)
+
+	params := dealertypes.DefaultParams()
+	params.EpochLengthBlocks = 500
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetNextEpochID(ctx, 2))
+	require.NoError(t, k.SetEpoch(ctx, &dealertypes.DealerEpoch{EpochId: 1, Threshold: 2, StartHeight: h - 499}))
+
+	// Beacon still open: nothing happens.
+	open := closedBeacon(2, h)
+	open.RevealCloseHeight = h
+	require.NoError(t, k.SetBeaconState(ctx, open))
+	require.NoError(t, k.MaybeAutoBeginEpoch(ctx))
+	dkg, err := k.GetDKG(ctx)
+	require.NoError(t, err)
+	require.Nil(t, dkg)
+
+	// Beacon closed but the current epoch is one block short of aging out.
+	require.NoError(t, k.SetBeaconState(ctx, closedBeacon(2, h)))
+	require.NoError(t, k.MaybeAutoBeginEpoch(ctx))
+	dkg, err = k.GetDKG(ctx)
+	require.NoError(t, err)
+	require.Nil(t, dkg)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(h + 1)
+	require.NoError(t, k.SetBeaconState(sdkCtx, closedBeacon(2, h+1)))
+	require.NoError(t, k.MaybeAutoBeginEpoch(sdkCtx))
+	dkg, err = k.GetDKG(sdkCtx)
+	require.NoError(t, err)
+	require.NotNil(t, dkg)
+	require.Equal(t, uint64(2), dkg.EpochId)
+	// Target size 5 is capped at the 3 bonded validators; ceil(3 * 66.67%) = 3.
+	require.Len(t, dkg.Members, 3)
+	require.Equal(t, uint32(3), dkg.Threshold)
+	require.Equal(t, h+1, dkg.StartHeight)
+
+	next, err := k.GetNextEpochID(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), next)
+
+	var origin string
+	for _, ev := range sdkCtx.EventManager().Events() {
+		if ev.Type != dealertypes.EventTypeDealerEpochBegun {
+			continue
+		}
+		for _, a := range ev.Attributes {
+			if a.Key == "origin" {
+				origin = a.Value
+			}
+		}
+	}
+	require.Equal(t, "auto", origin)
+
+	// A DKG in flight blocks further rotation.
+	require.NoError(t, k.MaybeAutoBeginEpoch(sdkCtx))
+	next, err = k.GetNextEpochID(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), next)
+}
+
+func TestMaybeAutoBeginEpoch_DisabledOrTooFewValidators(t *testing.T) {
+	const h int64 = 100
+
+	// No current epoch counts as aged out, but rotation is off by default.
+	ctx, k := rotationFixture(t, h, 3)
+	require.NoError(t, k.SetBeaconState(ctx, closedBeacon(1, h)))
+	require.NoError(t, k.MaybeAutoBeginEpoch(ctx))
+	dkg, err := k.GetDKG(ctx)
+	require.NoError(t, err)
+	require.Nil(t, dkg, "rotation disabled")
+
+	params := dealertypes.DefaultParams()
+	params.EpochLengthBlocks = 500
+
+	// One bonded validator cannot meet the threshold floor of 2.
+	ctx, k = rotationFixture(t, h, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetBeaconState(ctx, closedBeacon(1, h)))
+	require.NoError(t, k.MaybeAutoBeginEpoch(ctx))
+	dkg, err = k.GetDKG(ctx)
+	require.NoError(t, err)
+	require.Nil(t, dkg, "not enough validators")
+
+	ctx, k = rotationFixture(t, h, 2)
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetBeaconState(ctx, closedBeacon(1, h)))
+	require.NoError(t, k.MaybeAutoBeginEpoch(ctx))
+	dkg, err = k.GetDKG(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, dkg)
+	require.Equal(t, uint64(1), dkg.EpochId)
+	require.Len(t, dkg.Members, 2)
+	require.Equal(t, uint32(2), dkg.Threshold)
+}
+
+func TestMaybeAutoBeginEpoch_CancelsPendingReshare(t *testing.T) {
+	const h = postUpgradeHeight
+	ctx, k := rotationFixture(t, h, 3)
+
+	params := dealertypes.DefaultParams()
+	params.EpochLengthBlocks = 500
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetEpoch(ctx, &dealertypes.DealerEpoch{EpochId: 1, Threshold: 2, StartHeight: h - 500}))
+	// The beacon was opened for epoch 2 before a refresh took that id.
+	require.NoError(t, k.SetBeaconState(ctx, closedBeacon(2, h)))
+	require.NoError(t, k.SetReshare(ctx, &dealertypes.DealerReshare{
+		EpochId:        2,
+		FromEpochId:    1,
+		Threshold:      2,
+		ZeroSecret:     true,
+		StartHeight:    h - 10,
+		CommitDeadline: h + 90,
+		ShareDeadline:  h + 190,
+	}))
+	require.NoError(t, k.SetNextEpochID(ctx, 3))
+
+	require.NoError(t, k.MaybeAutoBeginEpoch(ctx))
+	rs, err := k.GetReshare(ctx)
+	require.NoError(t, err)
+	require.Nil(t, rs)
+	dkg, err := k.GetDKG(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, dkg)
+	require.Equal(t, uint64(2), dkg.EpochId)
+	next, err := k.GetNextEpochID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), next)
+	require.Equal(t, 1, countEvents(sdk.UnwrapSDKContext(ctx), dealertypes.EventTypeReshareAborted))
+}
@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	"onchainpoker/apps/cosmos/internal/ocpshuffle"
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+type openShuffleFixture struct {
+	ctx    context.Context
+	k      Keeper
+	ms     dealertypes.MsgServer
+	vals   []string
+	pkHand ocpcrypto.Point
+	caller string
+}
+
+// newOpenShuffleFixture sets up a 4-member, threshold-2 epoch and a hand
+// waiting for its first shuffle, with open shuffle order requiring 3
+// shufflers and a 15s slot.
+func newOpenShuffleFixture(t *testing.T) openShuffleFixture {
+	t.Helper()
+	vals := sortedValopers(4, 0x61)
+	bonded := make([]stakingtypes.Validator, len(vals))
+	for i, v := range vals {
+		bonded[i] = makeBondedValidatorForDealerTest(t, v, 1, byte(0xd0+i))
+	}
+	ctx, k, ms, pokerKeeper := newDealerMsgServerForOverflowTests(t, time.Unix(1_000, 0).UTC(), 10, bonded)
+
+	params := dealertypes.DefaultParams()
+	params.MinShufflers = 3
+	params.ShuffleSlotSecs = 15
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetEpoch(ctx, hookTestEpoch(1, 1, vals)))
+
+	holePos := make([]uint32, 18)
+	for i := range holePos {
+		holePos[i] = 255
+	}
+	caller := sdk.AccAddress(bytes.Repeat([]byte{0x41}, 20)).String()
+	require.NoError(t, pokerKeeper.SetTable(ctx, &pokertypes.Table{
+		Id:      1,
+		Creator: caller,
+		Params:  pokertypes.TableParams{MaxPlayers: 9, DealerTimeoutSecs: 60},
+		Seats:   make([]*pokertypes.Seat, 9),
+		Hand: &pokertypes.Hand{
+			HandId: 1,
+			Phase:  pokertypes.HandPhase_HAND_PHASE_SHUFFLE,
+			Dealer: &pokertypes.DealerMeta{HolePos: holePos, RevealPos: 255},
+		},
+	}))
+
+	pkHand := ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(17))
+	deck := make([]dealertypes.DealerCiphertext, 4)
+	for i := range deck {
+		ct, err := ocpcrypto.ElGamalEncrypt(pkHand, cardPoint(i), ocpcrypto.ScalarFromUint64(uint64(i+1)))
+		require.NoError(t, err)
+		deck[i] = dealertypes.DealerCiphertext{C1: ct.C1.Bytes(), C2: ct.C2.Bytes()}
+	}
+	require.NoError(t, k.SetHand(ctx, 1, 1, &dealertypes.DealerHand{
+		EpochId:         1,
+		PkHand:          pkHand.Bytes(),
+		DeckSize:        4,
+		Deck:            deck,
+		ShuffleDeadline: 1_015,
+	}))
+	return openShuffleFixture{ctx: ctx, k: k, ms: ms, vals: vals, pkHand: pkHand, caller: caller}
+}
+
+func (f openShuffleFixture) shuffle(t *testing.T, shuffler string) error {
+	t.Helper()
+	return f.shuffleWith(t, shuffler, ocpshuffle.ShuffleProveV3)
+}
+
+func (f openShuffleFixture) shuffleWith(t *testing.T, shuffler string, prove func(ocpcrypto.Point, []ocpcrypto.ElGamalCiphertext, ocpshuffle.ShuffleProveOpts) (ocpshuffle.ShuffleProveResult, error)) error {
+	t.Helper()
+	dh, err := f.k.GetHand(f.ctx, 1, 1)
+	require.NoError(t, err)
+	deckIn := make([]ocpcrypto.ElGamalCiphertext, len(dh.Deck))
+	for i, ct := range dh.Deck {
+		c1, err := ocpcrypto.PointFromBytesCanonical(ct.C1)
+		require.NoError(t, err)
+		c2, err := ocpcrypto.PointFromBytesCanonical(ct.C2)
+		require.NoError(t, err)
+		deckIn[i] = ocpcrypto.ElGamalCiphertext{C1: c1, C2: c2}
+	}
+	round := dh.ShuffleStep + 1
+	shuffleCtx, err := ocpshuffle.BuildShuffleContext(1, 1, uint16(round), shuffler)
+	require.NoError(t, err)
+	res, err := prove(f.pkHand, deckIn, ocpshuffle.ShuffleProveOpts{Seed: []byte(shuffler), Context: shuffleCtx})
+	require.NoError(t, err)
+	_, err = f.ms.SubmitShuffle(f.ctx, &dealertypes.MsgSubmitShuffle{
+		Shuffler:     shuffler,
+		TableId:      1,
+		HandId:       1,
+		Round:        round,
+		ProofShuffle: res.ProofBytes,
+	})
+	return err
+}
+
+func (f openShuffleFixture) at(unix int64) openShuffleFixture {
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(unix, 0).UTC())
+	return f
+}
+
+func TestOpenShuffle_AnyOrderThenSlotExpiryFinalizes(t *testing.T) {
+	f := newOpenShuffleFixture(t)
+
+	// Members shuffle in any order, each at most once, and each round opens
+	// a fresh slot.
+	require.NoError(t, f.shuffle(t, f.vals[3]))
+	require.ErrorContains(t, f.shuffle(t, f.vals[3]), "already shuffled")
+	f = f.at(1_010)
+	require.NoError(t, f.shuffle(t, f.vals[1]))
+	require.NoError(t, f.shuffle(t, f.vals[0]))
+	dh, err := f.k.GetHand(f.ctx, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{f.vals[3], f.vals[1], f.vals[0]}, dh.Shufflers)
+	require.Equal(t, int64(1_025), dh.ShuffleDeadline)
+
+	// vals[2] may still take the open slot, so the deck cannot be finalized
+	// and the timeout does not apply yet.
+	_, err = f.ms.FinalizeDeck(f.ctx, &dealertypes.MsgFinalizeDeck{Caller: f.caller, TableId: 1, HandId: 1})
+	require.Error(t, err)
+	_, err = f.ms.Timeout(f.ctx, &dealertypes.MsgTimeout{Caller: f.caller, TableId: 1, HandId: 1})
+	require.ErrorContains(t, err, "shuffle not timed out")
+
+	// Once the slot lapses the non-participant is slashed and the hand goes on.
+	f = f.at(1_025)
+	_, err = f.ms.Timeout(f.ctx, &dealertypes.MsgTimeout{Caller: f.caller, TableId: 1, HandId: 1})
+	require.NoError(t, err)
+	dh, err = f.k.GetHand(f.ctx, 1, 1)
+	require.NoError(t, err)
+	require.NotNil(t, dh)
+	require.True(t, dh.Finalized)
+	epoch, err := f.k.GetEpoch(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{f.vals[2]}, epoch.Slashed)
+}
+
+func TestOpenShuffle_AbortsBelowQuorum(t *testing.T) {
+	f := newOpenShuffleFixture(t)
+
+	require.NoError(t, f.shuffle(t, f.vals[2]))
+	require.NoError(t, f.shuffle(t, f.vals[0]))
+
+	f = f.at(1_015)
+	_, err := f.ms.Timeout(f.ctx, &dealertypes.MsgTimeout{Caller: f.caller, TableId: 1, HandId: 1})
+	require.NoError(t, err)
+	dh, err := f.k.GetHand(f.ctx, 1, 1)
+	require.NoError(t, err)
+	require.Nil(t, dh)
+	epoch, err := f.k.GetEpoch(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{f.vals[1], f.vals[3]}, epoch.Slashed)
+}
+
+func TestOpenShuffle_FinalizesOnceEveryoneShuffled(t *testing.T) {
+	f := newOpenShuffleFixture(t)
+	for _, i := range []int{1, 3, 0, 2} {
+		require.NoError(t, f.shuffle(t, f.vals[i]))
+	}
+	_, err := f.ms.FinalizeDeck(f.ctx, &dealertypes.MsgFinalizeDeck{Caller: f.caller, TableId: 1, HandId: 1})
+	require.NoError(t, err)
+}
+
+func TestOpenShuffle_TimeoutPaysReporterAndPlayers(t *testing.T) {
+	f := newOpenShuffleFixture(t)
+	enableSlashPayouts(t, f.ctx, f.k)
+	pokerKeeper := f.k.pokerKeeper.(*fakeDealerPokerKeeper)
+	bank := f.k.bankKeeper.(*fakeDealerBankKeeper)
+
+	players := []string{
+		sdk.AccAddress(bytes.Repeat([]byte{0x51}, 20)).String(),
+		sdk.AccAddress(bytes.Repeat([]byte{0x52}, 20)).String(),
+	}
+	tbl, err := pokerKeeper.GetTable(f.ctx, 1)
+	require.NoError(t, err)
+	tbl.Seats[2] = &pokertypes.Seat{Player: players[0]}
+	tbl.Seats[6] = &pokertypes.Seat{Player: players[1]}
+	tbl.Hand.InHand = make([]bool, 9)
+	tbl.Hand.InHand[2], tbl.Hand.InHand[6] = true, true
+	require.NoError(t, pokerKeeper.SetTable(f.ctx, tbl))
+	epoch, err := f.k.GetEpoch(f.ctx)
+	require.NoError(t, err)
+	for i := range epoch.Members {
+		epoch.Members[i].Power = 1
+	}
+	require.NoError(t, f.k.SetEpoch(f.ctx, epoch))
+	supply := bank.supply
+
+	// Two of four members shuffle, so the expired slot slashes the other two
+	// (10% of 1_000_000 each) and aborts the hand.
+	require.NoError(t, f.shuffle(t, f.vals[2]))
+	require.NoError(t, f.shuffle(t, f.vals[0]))
+	f = f.at(1_015)
+	_, err = f.ms.Timeout(f.ctx, &dealertypes.MsgTimeout{Caller: f.caller, TableId: 1, HandId: 1})
+	require.NoError(t, err)
+
+	// 10% of the 200_000 slashed goes to the caller and 50% is split between
+	// the two players; the remaining 80_000 stays burned.
+	require.Equal(t, int64(20_000), bank.balance(f.caller).Int64())
+	require.Equal(t, int64(50_000), bank.balance(players[0]).Int64())
+	require.Equal(t, int64(50_000), bank.balance(players[1]).Int64())
+	require.True(t, bank.balance(dealertypes.ModuleName).IsZero())
+	require.Equal(t, supply.SubRaw(80_000), bank.supply)
+	require.Equal(t, 1, countEvents(sdk.UnwrapSDKContext(f.ctx), dealertypes.EventTypeSlashProceedsPaid))
+}
+
+func TestSubmitShuffle_MinProofVersion(t *testing.T) {
+	f := newOpenShuffleFixture(t)
+	params, err := f.k.GetParams(f.ctx)
+	require.NoError(t, err)
+	params.MinShuffleProofVersion = dealertypes.MaxShuffleProofVersion
+	require.NoError(t, f.k.SetParams(f.ctx, params))
+
+	require.ErrorContains(t, f.shuffleWith(t, f.vals[0], ocpshuffle.ShuffleProveV1), "below min_shuffle_proof_version 3")
+	require.NoError(t, f.shuffle(t, f.vals[0]))
+
+	// With the floor lowered again the same switching-network proof goes
+	// through.
+	params.MinShuffleProofVersion = 0
+	require.NoError(t, f.k.SetParams(f.ctx, params))
+	require.NoError(t, f.shuffleWith(t, f.vals[1], ocpshuffle.ShuffleProveV1))
+}
+
+func TestOpenShuffle_TimeoutPaysNothingToSlashedValidators(t *testing.T) {
+	f := newOpenShuffleFixture(t)
+	enableSlashPayouts(t, f.ctx, f.k)
+	pokerKeeper := f.k.pokerKeeper.(*fakeDealerPokerKeeper)
+	bank := f.k.bankKeeper.(*fakeDealerBankKeeper)
+
+	// vals[1] will be slashed; its operator account reports the timeout and
+	// holds a seat next to an honest player.
+	valAddr, err := sdk.ValAddressFromBech32(f.vals[1])
+	require.NoError(t, err)
+	culprit := sdk.AccAddress(valAddr).String()
+	player := sdk.AccAddress(bytes.Repeat([]byte{0x51}, 20)).String()
+	tbl, err := pokerKeeper.GetTable(f.ctx, 1)
+	require.NoError(t, err)
+	tbl.Seats[2] = &pokertypes.Seat{Player: player}
+	tbl.Seats[6] = &pokertypes.Seat{Player: culprit}
+	tbl.Hand.InHand = make([]bool, 9)
+	tbl.Hand.InHand[2], tbl.Hand.InHand[6] = true, true
+	require.NoError(t, pokerKeeper.SetTable(f.ctx, tbl))
+	epoch, err := f.k.GetEpoch(f.ctx)
+	require.NoError(t, err)
+	for i := range epoch.Members {
+		epoch.Members[i].Power = 1
+	}
+	require.NoError(t, f.k.SetEpoch(f.ctx, epoch))
+	supply := bank.supply
+
+	require.NoError(t, f.shuffle(t, f.vals[2]))
+	require.NoError(t, f.shuffle(t, f.vals[0]))
+	f = f.at(1_015)
+	_, err = f.ms.Timeout(f.ctx, &dealertypes.MsgTimeout{Caller: culprit, TableId: 1, HandId: 1})
+	require.NoError(t, err)
+
+	// The honest player alone takes the 50% compensation of the 200_000
+	// slashed; the culprit gets no reward and no share.
+	require.True(t, bank.balance(culprit).IsZero())
+	require.Equal(t, int64(100_000), bank.balance(player).Int64())
+	require.Equal(t, supply.SubRaw(100_000), bank.supply)
+}
@@ -49,6 +49,25 @@ func sortDKGEncryptedShares(dkg *dealertypes.DealerDKG) {
 	})
 }
 
+func sortReshareCommits(r *dealertypes.DealerReshare) {
+	if r == nil {
+		return
+	}
+	sort.Slice(r.Commits, func(i, j int) bool { return r.Commits[i].Dealer < r.Commits[j].Dealer })
+}
+
+func sortReshareEncryptedShares(r *dealertypes.DealerReshare) {
+	if r == nil {
+		return
+	}
+	sort.Slice(r.EncryptedShares, func(i, j int) bool {
+		if r.EncryptedShares[i].Dealer != r.EncryptedShares[j].Dealer {
+			return r.EncryptedShares[i].Dealer < r.EncryptedShares[j].Dealer
+		}
+		return r.EncryptedShares[i].RecipientIndex < r.EncryptedShares[j].RecipientIndex
+	})
+}
+
 func sortPubShares(h *dealertypes.DealerHand) {
 	if h == nil {
 		return
@@ -18,14 +18,23 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"onchainpoker/apps/cosmos/x/dealer/committee"
 	"onchainpoker/apps/cosmos/x/dealer/keeper"
+	"onchainpoker/apps/cosmos/x/dealer/simulation"
 	"onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
 )
 
 // ConsensusVersion defines the current x/dealer module consensus version.
-const ConsensusVersion = 1
+//
+// v2 counts dealer hands per epoch, backfilled by
+// keeper.Migrator.Migrate1to2.
+const ConsensusVersion = 2
 
 var (
 	_ module.AppModuleBasic = AppModule{}
@@ -33,6 +42,8 @@ var (
 	_ module.HasGenesis     = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
 
+	_ module.AppModuleSimulation = AppModule{}
+
 	_ appmodule.AppModule = AppModule{}
 )
 
@@ -74,10 +85,31 @@ type AppModule struct {
 
 	cdc    codec.Codec
 	keeper keeper.Keeper
+
+	// Only used by the module simulation.
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	pokerKeeper   types.PokerKeeper
+	stakingKeeper types.StakingKeeper
 }
 
-func NewAppModule(cdc codec.Codec, k keeper.Keeper) AppModule {
-	return AppModule{AppModuleBasic: AppModuleBasic{}, cdc: cdc, keeper: k}
+func NewAppModule(
+	cdc codec.Codec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	pk types.PokerKeeper,
+	sk types.StakingKeeper,
+) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		cdc:            cdc,
+		keeper:         k,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		pokerKeeper:    pk,
+		stakingKeeper:  sk,
+	}
 }
 
 func (AppModule) IsOnePerModuleType() {}
@@ -86,6 +118,11 @@ func (AppModule) IsAppModule()        {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Errorf("x/dealer: failed to register Migrate1to2: %w", err))
+	}
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
@@ -114,6 +151,26 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 	if err := am.keeper.SetDKG(gctx, gs.Dkg); err != nil {
 		panic(err)
 	}
+	if gs.Beacon != nil {
+		if err := am.keeper.SetBeaconState(gctx, gs.Beacon); err != nil {
+			panic(err)
+		}
+	}
+	for i := range gs.Hands {
+		h := gs.Hands[i]
+		if err := am.keeper.SetHand(gctx, h.TableId, h.HandId, &h.Hand); err != nil {
+			panic(err)
+		}
+	}
+	if err := am.keeper.SetReshare(gctx, gs.Reshare); err != nil {
+		panic(err)
+	}
+	for i := range gs.RetiringEpochs {
+		e := gs.RetiringEpochs[i]
+		if err := am.keeper.SetRetiringEpoch(gctx, e.EpochId, &e); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
@@ -135,41 +192,112 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	if err != nil {
 		panic(err)
 	}
+	beacon, err := am.keeper.GetBeaconState(gctx)
+	if err != nil {
+		panic(err)
+	}
+	var hands []types.GenesisDealerHand
+	if err := am.keeper.IterateHands(gctx, func(tableID, handID uint64, h types.DealerHand) bool {
+		hands = append(hands, types.GenesisDealerHand{TableId: tableID, HandId: handID, Hand: h})
+		return false
+	}); err != nil {
+		panic(err)
+	}
+	reshare, err := am.keeper.GetReshare(gctx)
+	if err != nil {
+		panic(err)
+	}
+	var retiring []types.DealerEpoch
+	if err := am.keeper.IterateRetiringEpochs(gctx, func(e types.DealerEpoch) bool {
+		retiring = append(retiring, e)
+		return false
+	}); err != nil {
+		panic(err)
+	}
 
 	gs := types.GenesisState{
-		NextEpochId: next,
-		Epoch:       epoch,
-		Dkg:         dkg,
-		Params:      params,
+		NextEpochId:    next,
+		Epoch:          epoch,
+		Dkg:            dkg,
+		Params:         params,
+		Beacon:         beacon,
+		Hands:          hands,
+		RetiringEpochs: retiring,
+		Reshare:        reshare,
 	}
 	return cdc.MustMarshalJSON(&gs)
 }
 
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
-// BeginBlock auto-opens a randomness-beacon window for the next epoch when
-// the chain is idle (no beacon in flight and no DKG in progress). This
-// replaces the requirement that some off-chain operator manually submit a
-// MsgOpenBeaconWindow each epoch. MsgOpenBeaconWindow remains available for
-// bootstrapping, recovery, or specific tuning; see
-// x/dealer/keeper/beacon.go for the auto-open policy.
+// BeginBlock begins the next epoch's DKG once the current epoch has aged out
+// and its beacon has closed, then auto-opens a randomness-beacon window for
+// the next epoch when the chain is idle (no beacon in flight and no DKG in
+// progress). Together these replace the requirement that some off-chain
+// operator submit MsgBeginEpoch and MsgOpenBeaconWindow each epoch; both
+// messages remain available for bootstrapping, recovery, or specific tuning.
+// See x/dealer/keeper/rotation.go and beacon.go for the policies.
 //
-// BeginBlock is intentionally cheap on the hot path: the inner
+// BeginBlock is intentionally cheap on the hot path: MaybeAutoBeginEpoch
+// returns after a params and DKG read when rotation is not due, and
 // MaybeAutoOpenBeacon short-circuits on its first store read when a beacon
-// is already open (the common case) and returns without allocating.
+// is already open (the common case).
 func (am AppModule) BeginBlock(ctx context.Context) error {
+	// A reshare past its share deadline is settled first, so it neither
+	// delays rotation nor survives into a new epoch.
+	if err := am.keeper.MaybeAutoFinalizeReshare(ctx); err != nil {
+		return err
+	}
+
+	// Rotation runs first: beginning a DKG here keeps MaybeAutoOpenBeacon
+	// from opening the following epoch's beacon until that DKG is done.
+	if err := am.keeper.MaybeAutoBeginEpoch(ctx); err != nil {
+		return err
+	}
 	return am.keeper.MaybeAutoOpenBeacon(ctx)
 }
 
+// ---- Simulation ----
+
+// GenerateGenesisState creates a randomized GenState of the dealer module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for dealer module's types.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the simulated honest-committee operations.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams,
+		simState.Cdc,
+		simState.TxConfig,
+		am.accountKeeper,
+		am.bankKeeper,
+		am.keeper,
+		am.pokerKeeper,
+		am.stakingKeeper,
+	)
+}
+
 // ---- App Wiring Setup ----
 
 func init() {
 	appmodule.Register(
 		&modulev1.Module{},
-		appmodule.Provide(ProvideModule),
+		appmodule.Provide(ProvideModule, ProvideStakingBankKeeper),
 	)
 }
 
+// ProvideStakingBankKeeper wraps the bank keeper so dealer slashes pay out of
+// the stake x/staking would burn. The app binds x/staking's BankKeeper to it.
+func ProvideStakingBankKeeper(bk stakingtypes.BankKeeper) keeper.StakingBankKeeper {
+	return keeper.NewStakingBankKeeper(bk)
+}
+
 type ModuleInputs struct {
 	depinject.In
 
@@ -182,6 +310,9 @@ type ModuleInputs struct {
 	SlashingKeeper         types.SlashingKeeper
 
 	PokerKeeper types.PokerKeeper
+
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
 }
 
 type ModuleOutputs struct {
@@ -189,17 +320,32 @@ type ModuleOutputs struct {
 
 	DealerKeeper keeper.Keeper
 	Module       appmodule.AppModule
+	StakingHooks stakingtypes.StakingHooksWrapper
+	PokerHooks   pokertypes.PokerHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
+	// Default to x/gov as the params authority unless the app config overrides it.
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.StoreService,
 		in.StakingKeeper,
 		in.CommitteeStakingKeeper,
 		in.SlashingKeeper,
+		in.BankKeeper,
 		in.PokerKeeper,
+		authority.String(),
 	)
-	m := NewAppModule(in.Cdc, k)
-	return ModuleOutputs{DealerKeeper: k, Module: m}
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.PokerKeeper, in.StakingKeeper)
+	return ModuleOutputs{
+		DealerKeeper: k,
+		Module:       m,
+		StakingHooks: stakingtypes.StakingHooksWrapper{StakingHooks: k.Hooks()},
+		PokerHooks:   pokertypes.PokerHooksWrapper{PokerHooks: k.PokerHooks()},
+	}
 }
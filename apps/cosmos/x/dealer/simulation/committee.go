@@ -0,0 +1,192 @@
+package simulation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	"onchainpoker/apps/cosmos/x/dealer/types"
+)
+
+// The simulated committee is "honest but deterministic": every secret a
+// validator would normally keep off-chain (DKG polynomial coefficients,
+// beacon salts) is derived from public data under a sim-only domain. That
+// lets any operation reconstruct a member's key material from chain state
+// alone, without threading secrets between operations or blocks.
+const (
+	dkgCoeffDomain   = "ocp/sim/dealer/dkg-coeff"
+	beaconSaltDomain = "ocp/sim/dealer/beacon-salt"
+	proofNonceDomain = "ocp/sim/dealer/proof-nonce"
+)
+
+func u32le(x uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, x)
+	return b
+}
+
+func u64le(x uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, x)
+	return b
+}
+
+// dkgCoefficients returns dealer's degree threshold-1 polynomial for epochID.
+func dkgCoefficients(epochID uint64, dealer string, threshold uint32) ([]ocpcrypto.Scalar, error) {
+	coeffs := make([]ocpcrypto.Scalar, 0, threshold)
+	for k := uint32(0); k < threshold; k++ {
+		a, err := ocpcrypto.HashToScalar(dkgCoeffDomain, u64le(epochID), []byte(dealer), u32le(k))
+		if err != nil {
+			return nil, err
+		}
+		coeffs = append(coeffs, a)
+	}
+	return coeffs, nil
+}
+
+// dkgCommitments returns the Feldman commitments C_k = a_k*G that dealer
+// submits in MsgDkgCommit.
+func dkgCommitments(epochID uint64, dealer string, threshold uint32) ([][]byte, error) {
+	coeffs, err := dkgCoefficients(epochID, dealer, threshold)
+	if err != nil {
+		return nil, err
+	}
+	out := make([][]byte, 0, len(coeffs))
+	for _, a := range coeffs {
+		out = append(out, ocpcrypto.MulBase(a).Bytes())
+	}
+	return out, nil
+}
+
+// evalPoly evaluates the polynomial with the given coefficients at x.
+func evalPoly(coeffs []ocpcrypto.Scalar, x uint32) ocpcrypto.Scalar {
+	xs := ocpcrypto.ScalarFromUint64(uint64(x))
+	acc := ocpcrypto.ScalarZero()
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		acc = ocpcrypto.ScalarAdd(ocpcrypto.ScalarMul(acc, xs), coeffs[i])
+	}
+	return acc
+}
+
+// memberSecret sums the shares dealt to index by each of dealers.
+func memberSecret(epochID uint64, threshold uint32, dealers []string, index uint32) (ocpcrypto.Scalar, error) {
+	x := ocpcrypto.ScalarZero()
+	for _, d := range dealers {
+		coeffs, err := dkgCoefficients(epochID, d, threshold)
+		if err != nil {
+			return ocpcrypto.Scalar{}, err
+		}
+		x = ocpcrypto.ScalarAdd(x, evalPoly(coeffs, index))
+	}
+	return x, nil
+}
+
+// epochSecret recovers the epoch secret share x_i of valoper and checks it
+// against the member's on-chain pub_share. Every simulated dealer commits,
+// so QUAL is normally the whole committee; if a dealer was slashed at DKG
+// time the share excluding the slashed dealers is tried as well.
+func epochSecret(epoch *types.DealerEpoch, valoper string) (ocpcrypto.Scalar, *types.DealerMember, bool) {
+	if epoch == nil {
+		return ocpcrypto.Scalar{}, nil, false
+	}
+	var mem *types.DealerMember
+	for i := range epoch.Members {
+		if epoch.Members[i].Validator == valoper {
+			mem = &epoch.Members[i]
+			break
+		}
+	}
+	if mem == nil {
+		return ocpcrypto.Scalar{}, nil, false
+	}
+
+	slashed := make(map[string]bool, len(epoch.Slashed))
+	for _, s := range epoch.Slashed {
+		slashed[s] = true
+	}
+	all := make([]string, 0, len(epoch.Members))
+	qual := make([]string, 0, len(epoch.Members))
+	for _, m := range epoch.Members {
+		all = append(all, m.Validator)
+		if !slashed[m.Validator] {
+			qual = append(qual, m.Validator)
+		}
+	}
+
+	for _, dealers := range [][]string{all, qual} {
+		x, err := memberSecret(epoch.EpochId, epoch.Threshold, dealers, mem.Index)
+		if err != nil {
+			return ocpcrypto.Scalar{}, nil, false
+		}
+		if bytes.Equal(ocpcrypto.MulBase(x).Bytes(), mem.PubShare) {
+			return x, mem, true
+		}
+	}
+	return ocpcrypto.Scalar{}, nil, false
+}
+
+// honestEpoch builds the DealerEpoch FinalizeEpoch would produce if every
+// member committed with its simulated polynomial. members must carry their
+// final indices.
+func honestEpoch(epochID uint64, threshold uint32, members []types.DealerMember, startHeight int64) (*types.DealerEpoch, error) {
+	if int(threshold) > len(members) || threshold < 2 {
+		return nil, fmt.Errorf("invalid threshold %d for %d members", threshold, len(members))
+	}
+	dealers := make([]string, 0, len(members))
+	for _, m := range members {
+		dealers = append(dealers, m.Validator)
+	}
+
+	pk := ocpcrypto.PointZero()
+	for _, d := range dealers {
+		coeffs, err := dkgCoefficients(epochID, d, threshold)
+		if err != nil {
+			return nil, err
+		}
+		pk = ocpcrypto.PointAdd(pk, ocpcrypto.MulBase(coeffs[0]))
+	}
+
+	out := make([]types.DealerMember, 0, len(members))
+	for _, m := range members {
+		x, err := memberSecret(epochID, threshold, dealers, m.Index)
+		if err != nil {
+			return nil, err
+		}
+		m.PubShare = ocpcrypto.MulBase(x).Bytes()
+		out = append(out, m)
+	}
+
+	root := sha256.Sum256(append([]byte("ocp/sim/dealer/genesis-transcript"), u64le(epochID)...))
+	return &types.DealerEpoch{
+		EpochId:        epochID,
+		Threshold:      threshold,
+		PkEpoch:        pk.Bytes(),
+		TranscriptRoot: root[:],
+		StartHeight:    startHeight,
+		Members:        out,
+	}, nil
+}
+
+// beaconSalt is the salt valoper commits to (and later reveals) for epochID.
+func beaconSalt(valoper string, epochID uint64) []byte {
+	h := sha256.New()
+	h.Write([]byte(beaconSaltDomain))
+	h.Write([]byte(valoper))
+	h.Write(u64le(epochID))
+	return h.Sum(nil)
+}
+
+// randomScalar draws a nonzero scalar from seed bytes supplied by the
+// simulation's *rand.Rand, so proofs are reproducible per seed.
+func randomScalar(seed []byte) (ocpcrypto.Scalar, error) {
+	s, err := ocpcrypto.HashToScalar(proofNonceDomain, seed)
+	if err != nil {
+		return ocpcrypto.Scalar{}, err
+	}
+	if s.IsZero() {
+		return ocpcrypto.ScalarFromUint64(1), nil
+	}
+	return s, nil
+}
@@ -0,0 +1,100 @@
+package simulation
+
+import (
+	"bytes"
+	"testing"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	"onchainpoker/apps/cosmos/x/dealer/committee"
+	"onchainpoker/apps/cosmos/x/dealer/types"
+)
+
+func simMembers(n int) []types.DealerMember {
+	out := make([]types.DealerMember, 0, n)
+	for i := 0; i < n; i++ {
+		out = append(out, types.DealerMember{
+			Validator: "cosmosvaloper1sim" + string(rune('a'+i)),
+			Index:     uint32(i + 1),
+			Power:     1,
+		})
+	}
+	return out
+}
+
+func TestHonestEpoch_SecretsMatchPubShares(t *testing.T) {
+	epoch, err := honestEpoch(3, 3, simMembers(4), 10)
+	if err != nil {
+		t.Fatalf("honestEpoch: %v", err)
+	}
+
+	// Lagrange-interpolate any threshold subset back to the epoch secret.
+	xs := make([]ocpcrypto.Scalar, 0, 3)
+	for _, m := range epoch.Members[:3] {
+		x, mem, ok := epochSecret(epoch, m.Validator)
+		if !ok {
+			t.Fatalf("epochSecret(%s) not recovered", m.Validator)
+		}
+		if mem.Index != m.Index {
+			t.Fatalf("member index: got %d want %d", mem.Index, m.Index)
+		}
+		xs = append(xs, x)
+	}
+	sk := ocpcrypto.ScalarZero()
+	for i := range xs {
+		num, den := ocpcrypto.ScalarFromUint64(1), ocpcrypto.ScalarFromUint64(1)
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			xj := ocpcrypto.ScalarFromUint64(uint64(j + 1))
+			num = ocpcrypto.ScalarMul(num, xj)
+			den = ocpcrypto.ScalarMul(den, ocpcrypto.ScalarSub(xj, ocpcrypto.ScalarFromUint64(uint64(i+1))))
+		}
+		inv, err := ocpcrypto.ScalarInv(den)
+		if err != nil {
+			t.Fatalf("ScalarInv: %v", err)
+		}
+		lambda := ocpcrypto.ScalarMul(num, inv)
+		sk = ocpcrypto.ScalarAdd(sk, ocpcrypto.ScalarMul(lambda, xs[i]))
+	}
+	if !bytes.Equal(ocpcrypto.MulBase(sk).Bytes(), epoch.PkEpoch) {
+		t.Fatalf("interpolated secret does not match pk_epoch")
+	}
+}
+
+func TestEpochSecret_UnknownMember(t *testing.T) {
+	epoch, err := honestEpoch(1, 2, simMembers(2), 0)
+	if err != nil {
+		t.Fatalf("honestEpoch: %v", err)
+	}
+	if _, _, ok := epochSecret(epoch, "cosmosvaloper1nobody"); ok {
+		t.Fatalf("expected no secret for non-member")
+	}
+	if _, _, ok := epochSecret(nil, epoch.Members[0].Validator); ok {
+		t.Fatalf("expected no secret without an epoch")
+	}
+}
+
+func TestHonestEpoch_InvalidThreshold(t *testing.T) {
+	if _, err := honestEpoch(1, 1, simMembers(3), 0); err == nil {
+		t.Fatalf("expected error for threshold 1")
+	}
+	if _, err := honestEpoch(1, 4, simMembers(3), 0); err == nil {
+		t.Fatalf("expected error for threshold above committee size")
+	}
+}
+
+func TestBeaconSalt_CommitRevealRoundTrip(t *testing.T) {
+	val := "cosmosvaloper1sima"
+	salt := beaconSalt(val, 9)
+	c, err := committee.Commit(val, 9, salt)
+	if err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	if err := committee.Reveal(val, 9, salt, c[:]); err != nil {
+		t.Fatalf("Reveal: %v", err)
+	}
+	if bytes.Equal(salt, beaconSalt(val, 10)) {
+		t.Fatalf("salt must differ across epochs")
+	}
+}
@@ -0,0 +1,71 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"onchainpoker/apps/cosmos/x/dealer/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// value to the corresponding dealer type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.NextEpochIDKey):
+			return fmt.Sprintf("NextEpochID A: %d\nNextEpochID B: %d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.EpochKey):
+			var epochA, epochB types.DealerEpoch
+			cdc.MustUnmarshal(kvA.Value, &epochA)
+			cdc.MustUnmarshal(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA, epochB)
+
+		case bytes.Equal(kvA.Key[:1], types.DKGKey):
+			var dkgA, dkgB types.DealerDKG
+			cdc.MustUnmarshal(kvA.Value, &dkgA)
+			cdc.MustUnmarshal(kvB.Value, &dkgB)
+			return fmt.Sprintf("%v\n%v", dkgA, dkgB)
+
+		case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], types.BeaconStateKey):
+			var beaconA, beaconB types.BeaconState
+			cdc.MustUnmarshal(kvA.Value, &beaconA)
+			cdc.MustUnmarshal(kvB.Value, &beaconB)
+			return fmt.Sprintf("%v\n%v", beaconA, beaconB)
+
+		case bytes.Equal(kvA.Key[:1], types.RetiringEpochKeyPrefix):
+			var epochA, epochB types.DealerEpoch
+			cdc.MustUnmarshal(kvA.Value, &epochA)
+			cdc.MustUnmarshal(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA, epochB)
+
+		case bytes.Equal(kvA.Key[:1], types.ReshareKey):
+			var reshareA, reshareB types.DealerReshare
+			cdc.MustUnmarshal(kvA.Value, &reshareA)
+			cdc.MustUnmarshal(kvB.Value, &reshareB)
+			return fmt.Sprintf("%v\n%v", reshareA, reshareB)
+
+		case bytes.Equal(kvA.Key[:1], types.EpochHandCountKeyPrefix):
+			return fmt.Sprintf("EpochHandCount A: %d\nEpochHandCount B: %d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.HandKeyPrefix):
+			var handA, handB types.DealerHand
+			cdc.MustUnmarshal(kvA.Value, &handA)
+			cdc.MustUnmarshal(kvB.Value, &handB)
+			return fmt.Sprintf("%v\n%v", handA, handB)
+
+		default:
+			panic(fmt.Sprintf("invalid dealer key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package simulation
+
+import (
+	"math/rand"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"onchainpoker/apps/cosmos/x/dealer/types"
+)
+
+// Simulation parameter constants.
+const (
+	SlashBpsDkg           = "slash_bps_dkg"
+	SlashBpsHandDealer    = "slash_bps_hand_dealer"
+	JailSecondsDkg        = "jail_seconds_dkg"
+	JailSecondsHandDealer = "jail_seconds_hand_dealer"
+	GenesisEpoch          = "genesis_epoch"
+
+	// maxSimCommitteeSize bounds the committee so shuffle proving (one
+	// proof per member per hand) stays cheap enough for long runs.
+	maxSimCommitteeSize = 4
+)
+
+// RandomizedGenState generates a random GenesisState for x/dealer.
+//
+// Unless disabled via app params, genesis also carries a finalized epoch 1
+// whose committee is drawn from the genesis bonded validators and whose
+// shares follow the simulated committee's deterministic polynomials. That
+// lets hands be dealt from the first block instead of waiting out a full
+// beacon + DKG cycle.
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		slashBpsDkg, slashBpsHandDealer       uint32
+		jailSecondsDkg, jailSecondsHandDealer uint64
+		withEpoch                             bool
+	)
+	simState.AppParams.GetOrGenerate(SlashBpsDkg, &slashBpsDkg, simState.Rand, func(r *rand.Rand) {
+		slashBpsDkg = uint32(r.Intn(1001))
+	})
+	simState.AppParams.GetOrGenerate(SlashBpsHandDealer, &slashBpsHandDealer, simState.Rand, func(r *rand.Rand) {
+		slashBpsHandDealer = uint32(r.Intn(501))
+	})
+	simState.AppParams.GetOrGenerate(JailSecondsDkg, &jailSecondsDkg, simState.Rand, func(r *rand.Rand) {
+		jailSecondsDkg = uint64(r.Intn(24*60*60 + 1))
+	})
+	simState.AppParams.GetOrGenerate(JailSecondsHandDealer, &jailSecondsHandDealer, simState.Rand, func(r *rand.Rand) {
+		jailSecondsHandDealer = uint64(r.Intn(60*60 + 1))
+	})
+	simState.AppParams.GetOrGenerate(GenesisEpoch, &withEpoch, simState.Rand, func(r *rand.Rand) {
+		withEpoch = r.Intn(4) != 0
+	})
+
+	gs := types.DefaultGenesisState()
+	gs.Params = types.Params{
+		SlashBpsDkg:           slashBpsDkg,
+		SlashBpsHandDealer:    slashBpsHandDealer,
+		JailSecondsDkg:        jailSecondsDkg,
+		JailSecondsHandDealer: jailSecondsHandDealer,
+		DkgVersion:            types.DkgVersionV1,
+	}
+
+	if withEpoch {
+		if epoch := randomGenesisEpoch(simState); epoch != nil {
+			gs.Epoch = epoch
+			gs.NextEpochId = epoch.EpochId + 1
+		}
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gs)
+}
+
+func randomGenesisEpoch(simState *module.SimulationState) *types.DealerEpoch {
+	n := int(simState.NumBonded)
+	if n > len(simState.Accounts) {
+		n = len(simState.Accounts)
+	}
+	if n > maxSimCommitteeSize {
+		n = maxSimCommitteeSize
+	}
+	if n < 2 {
+		return nil
+	}
+
+	power := sdk.TokensToConsensusPower(simState.InitialStake, sdk.DefaultPowerReduction)
+	members := make([]types.DealerMember, 0, n)
+	for _, acc := range simState.Accounts[:n] {
+		members = append(members, types.DealerMember{
+			Validator:  sdk.ValAddress(acc.Address).String(),
+			ConsPubkey: acc.ConsKey.PubKey().Bytes(),
+			Power:      power,
+		})
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Validator < members[j].Validator })
+	for i := range members {
+		members[i].Index = uint32(i + 1)
+	}
+
+	threshold := uint32(2 + simState.Rand.Intn(n-1))
+	epoch, err := honestEpoch(1, threshold, members, 0)
+	if err != nil {
+		panic(err)
+	}
+	return epoch
+}
@@ -0,0 +1,39 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"onchainpoker/apps/cosmos/x/dealer/simulation"
+	"onchainpoker/apps/cosmos/x/dealer/types"
+)
+
+func TestRandomizedGenState_Valid(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 5),
+			InitialStake: sdkmath.NewInt(1_000_000),
+			GenState:     make(map[string]json.RawMessage),
+		}
+		simulation.RandomizedGenState(&simState)
+
+		var gs types.GenesisState
+		cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &gs)
+		require.NoError(t, types.ValidateGenesis(&gs), "seed %d", seed)
+	}
+}
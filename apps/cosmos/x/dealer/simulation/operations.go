@@ -0,0 +1,755 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	"onchainpoker/apps/cosmos/internal/ocpshuffle"
+	"onchainpoker/apps/cosmos/x/dealer/committee"
+	"onchainpoker/apps/cosmos/x/dealer/keeper"
+	"onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightMsgBeaconCommit  = "op_weight_msg_beacon_commit"
+	OpWeightMsgBeaconReveal  = "op_weight_msg_beacon_reveal"
+	OpWeightMsgBeginEpoch    = "op_weight_msg_begin_epoch"
+	OpWeightMsgFinalizeEpoch = "op_weight_msg_finalize_epoch"
+	OpWeightMsgInitHand      = "op_weight_msg_init_hand"
+	OpWeightMsgReveal        = "op_weight_msg_reveal"
+	OpWeightMsgTimeout       = "op_weight_msg_timeout"
+
+	DefaultWeightMsgBeaconCommit  = 20
+	DefaultWeightMsgBeaconReveal  = 20
+	DefaultWeightMsgBeginEpoch    = 5
+	DefaultWeightMsgFinalizeEpoch = 10
+	DefaultWeightMsgInitHand      = 40
+	DefaultWeightMsgReveal        = 80
+	DefaultWeightMsgTimeout       = 5
+
+	// simDkgWindowBlocks keeps the commit/complaint/reveal windows of a
+	// simulated DKG short; all commits land in the BeginEpoch operation.
+	simDkgWindowBlocks = 1
+	// simDkgFinalizeBlocks is the grace period after the reveal window.
+	simDkgFinalizeBlocks = 10
+	// maxRevealsPerOp bounds one reveal operation (5 board cards plus up to
+	// 18 hole cards at showdown).
+	maxRevealsPerOp = 23
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	pk types.PokerKeeper,
+	sk types.StakingKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgBeaconCommit  int
+		weightMsgBeaconReveal  int
+		weightMsgBeginEpoch    int
+		weightMsgFinalizeEpoch int
+		weightMsgInitHand      int
+		weightMsgReveal        int
+		weightMsgTimeout       int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgBeaconCommit, &weightMsgBeaconCommit, nil, func(_ *rand.Rand) {
+		weightMsgBeaconCommit = DefaultWeightMsgBeaconCommit
+	})
+	appParams.GetOrGenerate(OpWeightMsgBeaconReveal, &weightMsgBeaconReveal, nil, func(_ *rand.Rand) {
+		weightMsgBeaconReveal = DefaultWeightMsgBeaconReveal
+	})
+	appParams.GetOrGenerate(OpWeightMsgBeginEpoch, &weightMsgBeginEpoch, nil, func(_ *rand.Rand) {
+		weightMsgBeginEpoch = DefaultWeightMsgBeginEpoch
+	})
+	appParams.GetOrGenerate(OpWeightMsgFinalizeEpoch, &weightMsgFinalizeEpoch, nil, func(_ *rand.Rand) {
+		weightMsgFinalizeEpoch = DefaultWeightMsgFinalizeEpoch
+	})
+	appParams.GetOrGenerate(OpWeightMsgInitHand, &weightMsgInitHand, nil, func(_ *rand.Rand) {
+		weightMsgInitHand = DefaultWeightMsgInitHand
+	})
+	appParams.GetOrGenerate(OpWeightMsgReveal, &weightMsgReveal, nil, func(_ *rand.Rand) {
+		weightMsgReveal = DefaultWeightMsgReveal
+	})
+	appParams.GetOrGenerate(OpWeightMsgTimeout, &weightMsgTimeout, nil, func(_ *rand.Rand) {
+		weightMsgTimeout = DefaultWeightMsgTimeout
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgBeaconCommit, SimulateMsgBeaconCommit(txGen, ak, bk, k, sk)),
+		simulation.NewWeightedOperation(weightMsgBeaconReveal, SimulateMsgBeaconReveal(txGen, ak, bk, k, sk)),
+		simulation.NewWeightedOperation(weightMsgBeginEpoch, SimulateMsgBeginEpoch(txGen, ak, bk, k, sk)),
+		simulation.NewWeightedOperation(weightMsgFinalizeEpoch, SimulateMsgFinalizeEpoch(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgInitHand, SimulateMsgInitHand(txGen, ak, bk, k, pk, sk)),
+		simulation.NewWeightedOperation(weightMsgReveal, SimulateMsgReveal(txGen, ak, bk, k, pk)),
+		simulation.NewWeightedOperation(weightMsgTimeout, SimulateMsgTimeout(txGen, ak, bk, k, pk)),
+	}
+}
+
+// SimulateMsgBeaconCommit commits a bonded validator's deterministic salt to
+// the open randomness-beacon window.
+func SimulateMsgBeaconCommit(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk types.StakingKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgBeaconCommit{})
+		bs, err := k.GetBeaconState(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read beacon"), nil, err
+		}
+		h := ctx.BlockHeight()
+		if bs == nil || len(bs.Final) != 0 || h < bs.CommitOpenHeight || h > bs.CommitCloseHeight {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no beacon commit window open"), nil, nil
+		}
+		committed := make(map[string]bool, len(bs.Commits))
+		for _, c := range bs.Commits {
+			committed[c.Validator] = true
+		}
+		valoper, acc, ok := randomBondedValidator(r, ctx, sk, accs, func(v string) bool { return !committed[v] })
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no uncommitted bonded validator"), nil, nil
+		}
+		commit, err := committee.Commit(valoper, bs.EpochId, beaconSalt(valoper, bs.EpochId))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		msg := &types.MsgBeaconCommit{Validator: valoper, EpochId: bs.EpochId, Commit: commit[:]}
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, acc, msg)
+	}
+}
+
+// SimulateMsgBeaconReveal reveals a committed validator's salt once the
+// beacon commit window has closed.
+func SimulateMsgBeaconReveal(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk types.StakingKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgBeaconReveal{})
+		bs, err := k.GetBeaconState(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read beacon"), nil, err
+		}
+		h := ctx.BlockHeight()
+		if bs == nil || len(bs.Final) != 0 || h <= bs.CommitCloseHeight || h > bs.RevealCloseHeight {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no beacon reveal window open"), nil, nil
+		}
+		committed := make(map[string]bool, len(bs.Commits))
+		for _, c := range bs.Commits {
+			committed[c.Validator] = true
+		}
+		for _, rv := range bs.Reveals {
+			delete(committed, rv.Validator)
+		}
+		valoper, acc, ok := randomBondedValidator(r, ctx, sk, accs, func(v string) bool { return committed[v] })
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no pending beacon reveal"), nil, nil
+		}
+		msg := &types.MsgBeaconReveal{Validator: valoper, EpochId: bs.EpochId, Salt: beaconSalt(valoper, bs.EpochId)}
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, acc, msg)
+	}
+}
+
+// SimulateMsgBeginEpoch starts a DKG from a closed beacon window and has
+// every sampled committee member submit its commitments in the same
+// operation.
+func SimulateMsgBeginEpoch(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk types.StakingKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgBeginEpoch{})
+		if dkg, err := k.GetDKG(ctx); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read dkg"), nil, err
+		} else if dkg != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "dkg already in progress"), nil, nil
+		}
+		bs, err := k.GetBeaconState(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read beacon"), nil, err
+		}
+		if bs == nil || len(bs.Final) != 0 || ctx.BlockHeight() <= bs.RevealCloseHeight {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no closed beacon window"), nil, nil
+		}
+
+		bonded, err := sk.GetBondedValidatorsByPower(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read bonded validators"), nil, err
+		}
+		size := len(bonded)
+		if size > maxSimCommitteeSize {
+			size = maxSimCommitteeSize
+		}
+		if size < 2 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough bonded validators"), nil, nil
+		}
+		_, caller, ok := randomBondedValidator(r, ctx, sk, accs, nil)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded simulated validator"), nil, nil
+		}
+
+		msg := &types.MsgBeginEpoch{
+			Caller:          caller.Address.String(),
+			EpochId:         bs.EpochId,
+			CommitteeSize:   uint32(size),
+			Threshold:       uint32(2 + r.Intn(size-1)),
+			CommitBlocks:    simDkgWindowBlocks,
+			ComplaintBlocks: simDkgWindowBlocks,
+			RevealBlocks:    simDkgWindowBlocks,
+			FinalizeBlocks:  simDkgFinalizeBlocks,
+		}
+		opMsg, fops, err := deliverIfValid(r, app, ctx, txGen, ak, bk, caller, msg)
+		if err != nil || !opMsg.OK {
+			return opMsg, fops, err
+		}
+
+		dkg, err := k.GetDKG(ctx)
+		if err != nil || dkg == nil {
+			return opMsg, nil, err
+		}
+		for _, mem := range dkg.Members {
+			acc, ok := validatorAccount(accs, mem.Validator)
+			if !ok {
+				continue
+			}
+			commitments, err := dkgCommitments(dkg.EpochId, mem.Validator, dkg.Threshold)
+			if err != nil {
+				return opMsg, nil, err
+			}
+			commitMsg := &types.MsgDkgCommit{Dealer: mem.Validator, EpochId: dkg.EpochId, Commitments: commitments}
+			if _, _, err := deliverIfValid(r, app, ctx, txGen, ak, bk, acc, commitMsg); err != nil {
+				return opMsg, nil, err
+			}
+		}
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgFinalizeEpoch finalizes an in-flight DKG once its reveal window has passed.
+func SimulateMsgFinalizeEpoch(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgFinalizeEpoch{})
+		dkg, err := k.GetDKG(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read dkg"), nil, err
+		}
+		if dkg == nil || ctx.BlockHeight() <= dkg.RevealDeadline {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no dkg ready to finalize"), nil, nil
+		}
+		caller, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgFinalizeEpoch{Caller: caller.Address.String(), EpochId: dkg.EpochId}
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, caller, msg)
+	}
+}
+
+// SimulateMsgInitHand deals a hand that is waiting in the shuffle phase:
+// InitHand, one verifiable shuffle per qualified member, FinalizeDeck, and
+// encrypted hole-card shares from a threshold of members. The whole
+// pipeline runs within one operation because simulated block times advance
+// far past any dealer timeout between blocks.
+func SimulateMsgInitHand(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, pk types.PokerKeeper, sk types.StakingKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgInitHand{})
+		epoch, err := k.GetEpoch(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read epoch"), nil, err
+		}
+		if epoch == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active epoch"), nil, nil
+		}
+		t, err := randomTable(r, ctx, pk, func(t *pokertypes.Table) bool {
+			if t.Hand == nil || t.Hand.Phase != pokertypes.HandPhase_HAND_PHASE_SHUFFLE || t.Hand.Dealer == nil {
+				return false
+			}
+			dh, err := k.GetHand(ctx, t.Id, t.Hand.HandId)
+			return err == nil && dh == nil
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read tables"), nil, err
+		}
+		if t == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no hand waiting for the dealer"), nil, nil
+		}
+		tableID, handID := t.Id, t.Hand.HandId
+
+		caller, ok := accountFromBech32(accs, t.Creator)
+		if !ok {
+			if _, caller, ok = randomBondedValidator(r, ctx, sk, accs, nil); !ok {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "no authorized caller"), nil, nil
+			}
+		}
+		inHand := 0
+		for _, in := range t.Hand.InHand {
+			if in {
+				inHand++
+			}
+		}
+		msg := &types.MsgInitHand{
+			Caller:   caller.Address.String(),
+			TableId:  tableID,
+			HandId:   handID,
+			EpochId:  epoch.EpochId,
+			DeckSize: uint32(2*inHand + 5),
+		}
+		opMsg, fops, err := deliverIfValid(r, app, ctx, txGen, ak, bk, caller, msg)
+		if err != nil || !opMsg.OK {
+			return opMsg, fops, err
+		}
+
+		// Shuffle: one round per qualified member, in QUAL order or, when
+		// the params allow open shuffle order, in a random order.
+		qual := qualMembers(epoch)
+		order := make([]int, len(qual))
+		for i := range order {
+			order[i] = i
+		}
+		if params, err := k.GetParams(ctx); err != nil {
+			return opMsg, nil, err
+		} else if params.MinShufflers != 0 {
+			order = r.Perm(len(qual))
+		}
+		for {
+			dh, err := k.GetHand(ctx, tableID, handID)
+			if err != nil || dh == nil {
+				return opMsg, nil, err
+			}
+			if int(dh.ShuffleStep) >= len(qual) {
+				break
+			}
+			shuffler := qual[order[dh.ShuffleStep]].Validator
+			acc, ok := validatorAccount(accs, shuffler)
+			if !ok {
+				return opMsg, nil, nil
+			}
+			proof, err := proveShuffle(r, dh, tableID, handID, shuffler)
+			if err != nil {
+				return opMsg, nil, err
+			}
+			shuffleMsg := &types.MsgSubmitShuffle{
+				Shuffler:     shuffler,
+				TableId:      tableID,
+				HandId:       handID,
+				Round:        dh.ShuffleStep + 1,
+				ProofShuffle: proof,
+			}
+			sOp, _, err := deliverIfValid(r, app, ctx, txGen, ak, bk, acc, shuffleMsg)
+			if err != nil || !sOp.OK {
+				return opMsg, nil, err
+			}
+		}
+
+		finalizeMsg := &types.MsgFinalizeDeck{Caller: caller.Address.String(), TableId: tableID, HandId: handID}
+		if fOp, _, err := deliverIfValid(r, app, ctx, txGen, ak, bk, caller, finalizeMsg); err != nil || !fOp.OK {
+			return opMsg, nil, err
+		}
+
+		// Encrypted hole-card shares from a random threshold subset.
+		t, err = pk.GetTable(ctx, tableID)
+		if err != nil || t == nil || t.Hand == nil || t.Hand.Dealer == nil {
+			return opMsg, nil, err
+		}
+		dh, err := k.GetHand(ctx, tableID, handID)
+		if err != nil || dh == nil {
+			return opMsg, nil, err
+		}
+		kHand, err := keeper.DeriveHandScalar(dh.EpochId, tableID, handID, dh.InitHeight, dh.InitHashSalt)
+		if err != nil {
+			return opMsg, nil, err
+		}
+		signers := 0
+		for _, i := range r.Perm(len(qual)) {
+			if signers >= int(epoch.Threshold) {
+				break
+			}
+			mem := qual[i]
+			x, _, ok := epochSecret(epoch, mem.Validator)
+			acc, accOK := validatorAccount(accs, mem.Validator)
+			if !ok || !accOK {
+				continue
+			}
+			xHand := ocpcrypto.ScalarMul(x, kHand)
+			encMsg := &types.MsgSubmitEncShares{Validator: mem.Validator, TableId: tableID, HandId: handID}
+			for seat := 0; seat < 9 && seat < len(t.Hand.InHand); seat++ {
+				if !t.Hand.InHand[seat] || t.Seats[seat] == nil {
+					continue
+				}
+				for c := 0; c < 2; c++ {
+					entry, err := encShareEntry(r, t.Hand.Dealer.HolePos[seat*2+c], dh, t.Seats[seat].Pk, xHand)
+					if err != nil {
+						return opMsg, nil, err
+					}
+					encMsg.Shares = append(encMsg.Shares, entry)
+				}
+			}
+			if eOp, _, err := deliverIfValid(r, app, ctx, txGen, ak, bk, acc, encMsg); err != nil || !eOp.OK {
+				return opMsg, nil, err
+			}
+			signers++
+		}
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgReveal answers the reveal the table is waiting for (board card
+// or showdown hole card): every qualified member publishes its decryption
+// share, then the reveal is finalized. It keeps going while the hand asks
+// for further reveals within the same block.
+func SimulateMsgReveal(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, pk types.PokerKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgFinalizeReveal{})
+		nowUnix := ctx.BlockTime().Unix()
+		awaitingReveal := func(t *pokertypes.Table) bool {
+			return t.Hand != nil && t.Hand.Dealer != nil && t.Hand.Dealer.RevealPos != 255 &&
+				t.Hand.Dealer.RevealDeadline != 0 && nowUnix < t.Hand.Dealer.RevealDeadline
+		}
+		t, err := randomTable(r, ctx, pk, awaitingReveal)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read tables"), nil, err
+		}
+		if t == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no hand awaiting a reveal"), nil, nil
+		}
+		tableID, handID := t.Id, t.Hand.HandId
+		dh, err := k.GetHand(ctx, tableID, handID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read dealer hand"), nil, err
+		}
+		if dh == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "dealer hand not initialized"), nil, nil
+		}
+		epoch, err := k.EpochByID(ctx, dh.EpochId)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read epoch"), nil, err
+		}
+		if epoch == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "hand epoch not available"), nil, nil
+		}
+		kHand, err := keeper.DeriveHandScalar(dh.EpochId, tableID, handID, dh.InitHeight, dh.InitHashSalt)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		opMsg := simtypes.NoOpMsg(types.ModuleName, msgType, "no reveal finalized")
+		for i := 0; i < maxRevealsPerOp && t != nil && t.Hand != nil && t.Hand.HandId == handID && awaitingReveal(t); i++ {
+			pos := t.Hand.Dealer.RevealPos
+			if dh, err = k.GetHand(ctx, tableID, handID); err != nil || dh == nil || int(pos) >= len(dh.Deck) {
+				return opMsg, nil, err
+			}
+			submitted := make(map[string]bool)
+			for _, ps := range dh.PubShares {
+				if ps.Pos == pos {
+					submitted[ps.Validator] = true
+				}
+			}
+			for _, mem := range qualMembers(epoch) {
+				if submitted[mem.Validator] {
+					continue
+				}
+				x, _, ok := epochSecret(epoch, mem.Validator)
+				acc, accOK := validatorAccount(accs, mem.Validator)
+				if !ok || !accOK {
+					continue
+				}
+				shareMsg, err := pubShareMsg(r, mem.Validator, tableID, handID, pos, dh, ocpcrypto.ScalarMul(x, kHand))
+				if err != nil {
+					return opMsg, nil, err
+				}
+				if _, _, err := deliverIfValid(r, app, ctx, txGen, ak, bk, acc, shareMsg); err != nil {
+					return opMsg, nil, err
+				}
+			}
+
+			caller, _ := simtypes.RandomAcc(r, accs)
+			finalizeMsg := &types.MsgFinalizeReveal{Caller: caller.Address.String(), TableId: tableID, HandId: handID, Pos: pos}
+			fOp, _, err := deliverIfValid(r, app, ctx, txGen, ak, bk, caller, finalizeMsg)
+			if err != nil || !fOp.OK {
+				return opMsg, nil, err
+			}
+			opMsg = fOp
+			if t, err = pk.GetTable(ctx, tableID); err != nil {
+				return opMsg, nil, err
+			}
+		}
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgTimeout pokes a random hand with a dealer state; only hands
+// whose dealer deadline has passed produce a delivered MsgTimeout.
+func SimulateMsgTimeout(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, pk types.PokerKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgTimeout{})
+		t, err := randomTable(r, ctx, pk, func(t *pokertypes.Table) bool {
+			if t.Hand == nil || t.Hand.Dealer == nil {
+				return false
+			}
+			dh, err := k.GetHand(ctx, t.Id, t.Hand.HandId)
+			return err == nil && dh != nil
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read tables"), nil, err
+		}
+		if t == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no dealer hand in flight"), nil, nil
+		}
+		caller, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgTimeout{Caller: caller.Address.String(), TableId: t.Id, HandId: t.Hand.HandId}
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, caller, msg)
+	}
+}
+
+// proveShuffle re-encrypts and permutes the current deck under pk_hand and
+// returns the context-bound (v2) shuffle proof.
+func proveShuffle(r *rand.Rand, dh *types.DealerHand, tableID, handID uint64, shuffler string) ([]byte, error) {
+	pkHand, err := ocpcrypto.PointFromBytesCanonical(dh.PkHand)
+	if err != nil {
+		return nil, err
+	}
+	deck := make([]ocpcrypto.ElGamalCiphertext, 0, len(dh.Deck))
+	for _, c := range dh.Deck {
+		c1, err := ocpcrypto.PointFromBytesCanonical(c.C1)
+		if err != nil {
+			return nil, err
+		}
+		c2, err := ocpcrypto.PointFromBytesCanonical(c.C2)
+		if err != nil {
+			return nil, err
+		}
+		deck = append(deck, ocpcrypto.ElGamalCiphertext{C1: c1, C2: c2})
+	}
+	shuffleCtx, err := ocpshuffle.BuildShuffleContext(tableID, handID, uint16(dh.ShuffleStep+1), shuffler)
+	if err != nil {
+		return nil, err
+	}
+	seed := make([]byte, 32)
+	r.Read(seed)
+	res, err := ocpshuffle.ShuffleProveV3(pkHand, deck, ocpshuffle.ShuffleProveOpts{Seed: seed, Context: shuffleCtx})
+	if err != nil {
+		return nil, err
+	}
+	return res.ProofBytes, nil
+}
+
+// encShareMsg encrypts the member's decryption share for the card at pos to
+// the seat's pk_player and proves it.
+func encShareMsg(r *rand.Rand, valoper string, tableID, handID uint64, pos uint32, dh *types.DealerHand, pkPlayer []byte, xHand ocpcrypto.Scalar) (*types.MsgSubmitEncShare, error) {
+	e, err := encShareEntry(r, pos, dh, pkPlayer, xHand)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitEncShare{
+		Validator:     valoper,
+		TableId:       tableID,
+		HandId:        handID,
+		Pos:           e.Pos,
+		PkPlayer:      e.PkPlayer,
+		EncShare:      e.EncShare,
+		ProofEncShare: e.ProofEncShare,
+	}, nil
+}
+
+// encShareEntry is the MsgSubmitEncShares form of encShareMsg.
+func encShareEntry(r *rand.Rand, pos uint32, dh *types.DealerHand, pkPlayer []byte, xHand ocpcrypto.Scalar) (types.EncShareEntry, error) {
+	c1, err := ocpcrypto.PointFromBytesCanonical(dh.Deck[pos].C1)
+	if err != nil {
+		return types.EncShareEntry{}, err
+	}
+	pkp, err := ocpcrypto.PointFromBytesCanonical(pkPlayer)
+	if err != nil {
+		return types.EncShareEntry{}, err
+	}
+	rho, err := randomScalar(randBytes(r))
+	if err != nil {
+		return types.EncShareEntry{}, err
+	}
+	wx, err := randomScalar(randBytes(r))
+	if err != nil {
+		return types.EncShareEntry{}, err
+	}
+	wr, err := randomScalar(randBytes(r))
+	if err != nil {
+		return types.EncShareEntry{}, err
+	}
+	u := ocpcrypto.MulBase(rho)
+	v := ocpcrypto.PointAdd(ocpcrypto.MulPoint(c1, xHand), ocpcrypto.MulPoint(pkp, rho))
+	proof, err := ocpcrypto.EncShareProve(ocpcrypto.MulBase(xHand), c1, pkp, u, v, xHand, rho, wx, wr)
+	if err != nil {
+		return types.EncShareEntry{}, err
+	}
+	return types.EncShareEntry{
+		Pos:           pos,
+		PkPlayer:      append([]byte(nil), pkPlayer...),
+		EncShare:      append(u.Bytes(), v.Bytes()...),
+		ProofEncShare: ocpcrypto.EncodeEncShareProof(proof),
+	}, nil
+}
+
+// pubShareMsg publishes the member's decryption share for the card at pos.
+func pubShareMsg(r *rand.Rand, valoper string, tableID, handID uint64, pos uint32, dh *types.DealerHand, xHand ocpcrypto.Scalar) (*types.MsgSubmitPubShare, error) {
+	c1, err := ocpcrypto.PointFromBytesCanonical(dh.Deck[pos].C1)
+	if err != nil {
+		return nil, err
+	}
+	w, err := randomScalar(randBytes(r))
+	if err != nil {
+		return nil, err
+	}
+	share := ocpcrypto.MulPoint(c1, xHand)
+	proof, err := ocpcrypto.ChaumPedersenProve(ocpcrypto.MulBase(xHand), c1, share, xHand, w)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitPubShare{
+		Validator:  valoper,
+		TableId:    tableID,
+		HandId:     handID,
+		Pos:        pos,
+		PubShare:   share.Bytes(),
+		ProofShare: ocpcrypto.EncodeChaumPedersenProof(proof),
+	}, nil
+}
+
+// deliverIfValid dry-runs msg through the app's msg router on a cached
+// context and, only if that succeeds, signs and delivers it as a real
+// transaction. Dealer msgs are tightly bound to windows and deadlines, so a
+// rejected msg is reported as a no-op rather than failing the simulation.
+func deliverIfValid(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(msg)
+	handler := app.MsgServiceRouter().Handler(msg)
+	if handler == nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no msg handler"), nil, nil
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	if _, err := handler(cacheCtx, msg); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         txGen,
+		Msg:           msg,
+		Context:       ctx,
+		SimAccount:    simAccount,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    types.ModuleName,
+	})
+}
+
+func randBytes(r *rand.Rand) []byte {
+	b := make([]byte, 64)
+	r.Read(b)
+	return b
+}
+
+// qualMembers mirrors the keeper's QUAL set: epoch members that are not slashed.
+func qualMembers(epoch *types.DealerEpoch) []types.DealerMember {
+	excluded := make(map[string]bool, len(epoch.Slashed)+len(epoch.Inactive))
+	for _, s := range epoch.Slashed {
+		excluded[s] = true
+	}
+	for _, s := range epoch.Inactive {
+		excluded[s] = true
+	}
+	out := make([]types.DealerMember, 0, len(epoch.Members))
+	for _, m := range epoch.Members {
+		if !excluded[m.Validator] {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// validatorAccount returns the simulated account behind a validator operator address.
+func validatorAccount(accs []simtypes.Account, valoper string) (simtypes.Account, bool) {
+	valAddr, err := sdk.ValAddressFromBech32(valoper)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, sdk.AccAddress(valAddr))
+}
+
+func accountFromBech32(accs []simtypes.Account, addr string) (simtypes.Account, bool) {
+	acc, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, acc)
+}
+
+// randomBondedValidator returns a random bonded validator backed by a
+// simulated account and accepted by filter (nil accepts all).
+func randomBondedValidator(r *rand.Rand, ctx sdk.Context, sk types.StakingKeeper, accs []simtypes.Account, filter func(valoper string) bool) (string, simtypes.Account, bool) {
+	bonded, err := sk.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return "", simtypes.Account{}, false
+	}
+	for _, i := range r.Perm(len(bonded)) {
+		valoper := bonded[i].GetOperator()
+		if filter != nil && !filter(valoper) {
+			continue
+		}
+		if acc, ok := validatorAccount(accs, valoper); ok {
+			return valoper, acc, true
+		}
+	}
+	return "", simtypes.Account{}, false
+}
+
+// randomTable returns a uniformly random table matching filter, or nil.
+func randomTable(r *rand.Rand, ctx sdk.Context, pk types.PokerKeeper, filter func(t *pokertypes.Table) bool) (*pokertypes.Table, error) {
+	var (
+		matches []*pokertypes.Table
+		iterErr error
+	)
+	if err := pk.IterateTables(ctx, func(id uint64) bool {
+		t, err := pk.GetTable(ctx, id)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		if t != nil && filter(t) {
+			matches = append(matches, t)
+		}
+		return false
+	}); err != nil {
+		return nil, err
+	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	return matches[r.Intn(len(matches))], nil
+}
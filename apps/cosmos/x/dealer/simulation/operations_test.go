@@ -0,0 +1,400 @@
+package simulation
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdked25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	"onchainpoker/apps/cosmos/x/dealer/keeper"
+	"onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// The tests below drive the simulated committee's msgs through the real
+// dealer msg server, so a drift between the simulation and the keeper's
+// verification shows up in `go test` rather than only in long sim runs.
+
+type simStakingKeeper struct{ bonded []stakingtypes.Validator }
+
+func (s simStakingKeeper) Validator(_ context.Context, addr sdk.ValAddress) (stakingtypes.ValidatorI, error) {
+	for _, v := range s.bonded {
+		if v.GetOperator() == addr.String() {
+			return v, nil
+		}
+	}
+	return nil, stakingtypes.ErrNoValidatorFound
+}
+
+func (s simStakingKeeper) GetBondedValidatorsByPower(_ context.Context) ([]stakingtypes.Validator, error) {
+	return s.bonded, nil
+}
+
+func (simStakingKeeper) BondDenom(context.Context) (string, error) { return sdk.DefaultBondDenom, nil }
+
+// simBankKeeper never collects slash proceeds, so none are paid out.
+type simBankKeeper struct{}
+
+func (simBankKeeper) GetBalance(_ context.Context, _ sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewInt64Coin(denom, 0)
+}
+
+func (simBankKeeper) BurnCoins(context.Context, string, sdk.Coins) error { return nil }
+
+func (simBankKeeper) SendCoinsFromModuleToAccount(context.Context, string, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+func (simBankKeeper) SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins { return nil }
+
+type simSlashingKeeper struct{}
+
+func (simSlashingKeeper) SlashWithInfractionReason(context.Context, sdk.ConsAddress, sdkmath.LegacyDec, int64, int64, stakingtypes.Infraction) error {
+	return nil
+}
+
+func (simSlashingKeeper) Jail(context.Context, sdk.ConsAddress) error { return nil }
+
+func (simSlashingKeeper) GetValidatorSigningInfo(context.Context, sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, error) {
+	return slashingtypes.ValidatorSigningInfo{}, nil
+}
+
+func (simSlashingKeeper) JailUntil(context.Context, sdk.ConsAddress, time.Time) error { return nil }
+
+type simPokerKeeper struct{ tables map[uint64]*pokertypes.Table }
+
+func (p *simPokerKeeper) GetTable(_ context.Context, id uint64) (*pokertypes.Table, error) {
+	if t := p.tables[id]; t != nil {
+		return proto.Clone(t).(*pokertypes.Table), nil
+	}
+	return nil, nil
+}
+
+func (p *simPokerKeeper) SetTable(_ context.Context, t *pokertypes.Table) error {
+	p.tables[t.Id] = proto.Clone(t).(*pokertypes.Table)
+	return nil
+}
+
+func (p *simPokerKeeper) AbortHand(context.Context, uint64, uint64, string) ([]sdk.Event, error) {
+	return nil, nil
+}
+
+func (p *simPokerKeeper) ApplyDealerReveal(_ context.Context, tableID, _ uint64, _ uint32, _ uint32, _ int64) ([]sdk.Event, error) {
+	p.tables[tableID].Hand.Dealer.RevealPos = 255
+	return nil, nil
+}
+
+func (p *simPokerKeeper) AdvanceAfterHoleSharesReady(_ context.Context, tableID, _ uint64, _ int64) error {
+	p.tables[tableID].Hand.Phase = pokertypes.HandPhase_HAND_PHASE_BETTING
+	return nil
+}
+
+func (p *simPokerKeeper) CheckNotPaused(context.Context, string, uint64) error {
+	return nil
+}
+
+func (p *simPokerKeeper) IterateTables(_ context.Context, cb func(id uint64) bool) error {
+	ids := make([]uint64, 0, len(p.tables))
+	for id := range p.tables {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		if cb(id) {
+			break
+		}
+	}
+	return nil
+}
+
+func setupSimCommittee(t *testing.T, n int) (sdk.Context, keeper.Keeper, types.MsgServer, *simPokerKeeper, []types.DealerMember) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.
+		WithEventManager(sdk.NewEventManager()).
+		WithBlockTime(time.Unix(1_000, 0).UTC()).
+		WithBlockHeight(10).
+		WithChainID("ocp-devnet-1")
+
+	bonded := make([]stakingtypes.Validator, 0, n)
+	members := make([]types.DealerMember, 0, n)
+	for i := 0; i < n; i++ {
+		valoper := sdk.ValAddress(bytes.Repeat([]byte{byte(0xa0 + i)}, 20)).String()
+		pk := &sdked25519.PubKey{Key: bytes.Repeat([]byte{byte(0x10 + i)}, 32)}
+		v, err := stakingtypes.NewValidator(valoper, pk, stakingtypes.NewDescription("", "", "", "", ""))
+		require.NoError(t, err)
+		v.Status = stakingtypes.Bonded
+		v.Tokens = sdkmath.NewInt(1_000_000)
+		bonded = append(bonded, v)
+		members = append(members, types.DealerMember{Validator: valoper, ConsPubkey: pk.Key, Power: 1})
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Validator < members[j].Validator })
+	for i := range members {
+		members[i].Index = uint32(i + 1)
+	}
+
+	sk := simStakingKeeper{bonded: bonded}
+	pk := &simPokerKeeper{tables: map[uint64]*pokertypes.Table{}}
+	k := keeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), runtime.NewKVStoreService(key), sk, sk, simSlashingKeeper{}, simBankKeeper{}, pk, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	return ctx, k, keeper.NewMsgServerImpl(k), pk, members
+}
+
+func TestSimCommittee_DkgMatchesHonestEpoch(t *testing.T) {
+	ctx, k, ms, _, members := setupSimCommittee(t, 3)
+	require.NoError(t, k.SetNextEpochID(ctx, 1))
+
+	valAddr, err := sdk.ValAddressFromBech32(members[0].Validator)
+	require.NoError(t, err)
+	caller := sdk.AccAddress(valAddr).String()
+	_, err = ms.BeginEpoch(ctx, &types.MsgBeginEpoch{
+		Caller:          caller,
+		EpochId:         1,
+		CommitteeSize:   3,
+		Threshold:       2,
+		CommitBlocks:    simDkgWindowBlocks,
+		ComplaintBlocks: simDkgWindowBlocks,
+		RevealBlocks:    simDkgWindowBlocks,
+		FinalizeBlocks:  simDkgFinalizeBlocks,
+	})
+	require.NoError(t, err)
+
+	dkg, err := k.GetDKG(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, dkg)
+	for _, mem := range dkg.Members {
+		commitments, err := dkgCommitments(dkg.EpochId, mem.Validator, dkg.Threshold)
+		require.NoError(t, err)
+		_, err = ms.DkgCommit(ctx, &types.MsgDkgCommit{Dealer: mem.Validator, EpochId: dkg.EpochId, Commitments: commitments})
+		require.NoError(t, err)
+	}
+
+	ctx = ctx.WithBlockHeight(dkg.RevealDeadline + 1)
+	_, err = ms.FinalizeEpoch(ctx, &types.MsgFinalizeEpoch{Caller: caller, EpochId: dkg.EpochId})
+	require.NoError(t, err)
+
+	epoch, err := k.GetEpoch(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, epoch)
+	require.Empty(t, epoch.Slashed)
+
+	want, err := honestEpoch(epoch.EpochId, epoch.Threshold, members, 0)
+	require.NoError(t, err)
+	require.Equal(t, want.PkEpoch, epoch.PkEpoch)
+	for i, m := range epoch.Members {
+		require.Equal(t, want.Members[i].PubShare, m.PubShare, "member %s", m.Validator)
+		_, _, ok := epochSecret(epoch, m.Validator)
+		require.True(t, ok, "member %s secret not recoverable", m.Validator)
+	}
+}
+
+// simHand is a table whose hand has a finalized deck and is waiting for
+// hole-card encrypted shares.
+type simHand struct {
+	ctx     sdk.Context
+	k       keeper.Keeper
+	ms      types.MsgServer
+	pk      *simPokerKeeper
+	epoch   *types.DealerEpoch
+	qual    []types.DealerMember
+	dh      *types.DealerHand
+	kHand   ocpcrypto.Scalar
+	seats   []*pokertypes.Seat
+	creator string
+	r       *rand.Rand
+}
+
+func shuffledSimHand(t *testing.T) simHand {
+	t.Helper()
+	ctx, k, ms, pk, members := setupSimCommittee(t, 3)
+
+	epoch, err := honestEpoch(1, 2, members, 1)
+	require.NoError(t, err)
+	require.NoError(t, k.SetEpoch(ctx, epoch))
+
+	seats := make([]*pokertypes.Seat, 9)
+	inHand := make([]bool, 9)
+	for _, s := range []int{0, 3} {
+		skPlayer := ocpcrypto.ScalarFromUint64(uint64(100 + s))
+		seats[s] = &pokertypes.Seat{
+			Player: sdk.AccAddress(bytes.Repeat([]byte{byte(0x30 + s)}, 20)).String(),
+			Pk:     ocpcrypto.MulBase(skPlayer).Bytes(),
+			Stack:  100,
+		}
+		inHand[s] = true
+	}
+	holePos := make([]uint32, 18)
+	for i := range holePos {
+		holePos[i] = 255
+	}
+	creator := sdk.AccAddress(bytes.Repeat([]byte{0xc1}, 20)).String()
+	require.NoError(t, pk.SetTable(ctx, &pokertypes.Table{
+		Id:      1,
+		Creator: creator,
+		Params: pokertypes.TableParams{
+			MaxPlayers:        9,
+			SmallBlind:        1,
+			BigBlind:          2,
+			MinBuyIn:          1,
+			MaxBuyIn:          1000,
+			DealerTimeoutSecs: 30,
+		},
+		Seats:      seats,
+		NextHandId: 2,
+		ButtonSeat: 0,
+		Hand: &pokertypes.Hand{
+			HandId: 1,
+			Phase:  pokertypes.HandPhase_HAND_PHASE_SHUFFLE,
+			Street: pokertypes.Street_STREET_PREFLOP,
+			InHand: inHand,
+			Dealer: &pokertypes.DealerMeta{HolePos: holePos, RevealPos: 255},
+		},
+	}))
+
+	_, err = ms.InitHand(ctx, &types.MsgInitHand{Caller: creator, TableId: 1, HandId: 1, EpochId: 1, DeckSize: 9})
+	require.NoError(t, err)
+
+	r := rand.New(rand.NewSource(7))
+	qual := qualMembers(epoch)
+	for step := range qual {
+		dh, err := k.GetHand(ctx, 1, 1)
+		require.NoError(t, err)
+		require.Equal(t, uint32(step), dh.ShuffleStep)
+		proof, err := proveShuffle(r, dh, 1, 1, qual[step].Validator)
+		require.NoError(t, err)
+		_, err = ms.SubmitShuffle(ctx, &types.MsgSubmitShuffle{
+			Shuffler:     qual[step].Validator,
+			TableId:      1,
+			HandId:       1,
+			Round:        uint32(step + 1),
+			ProofShuffle: proof,
+		})
+		require.NoError(t, err)
+	}
+	_, err = ms.FinalizeDeck(ctx, &types.MsgFinalizeDeck{Caller: creator, TableId: 1, HandId: 1})
+	require.NoError(t, err)
+
+	dh, err := k.GetHand(ctx, 1, 1)
+	require.NoError(t, err)
+	kHand, err := keeper.DeriveHandScalar(dh.EpochId, 1, 1, dh.InitHeight, dh.InitHashSalt)
+	require.NoError(t, err)
+	return simHand{ctx: ctx, k: k, ms: ms, pk: pk, epoch: epoch, qual: qual, dh: dh, kHand: kHand, seats: seats, creator: creator, r: r}
+}
+
+// memberHandSecret returns mem's per-hand key share.
+func (s simHand) memberHandSecret(t *testing.T, mem types.DealerMember) ocpcrypto.Scalar {
+	t.Helper()
+	x, _, ok := epochSecret(s.epoch, mem.Validator)
+	require.True(t, ok)
+	return ocpcrypto.ScalarMul(x, s.kHand)
+}
+
+func TestSimCommittee_DealsAndRevealsHand(t *testing.T) {
+	h := shuffledSimHand(t)
+	ctx, ms, pk, epoch, qual, dh, seats, creator, r := h.ctx, h.ms, h.pk, h.epoch, h.qual, h.dh, h.seats, h.creator, h.r
+
+	tbl := pk.tables[1]
+	for _, mem := range qual[:epoch.Threshold] {
+		xHand := h.memberHandSecret(t, mem)
+		for _, s := range []int{0, 3} {
+			for c := 0; c < 2; c++ {
+				msg, err := encShareMsg(r, mem.Validator, 1, 1, tbl.Hand.Dealer.HolePos[s*2+c], dh, seats[s].Pk, xHand)
+				require.NoError(t, err)
+				_, err = ms.SubmitEncShare(ctx, msg)
+				require.NoError(t, err)
+			}
+		}
+	}
+	require.Equal(t, pokertypes.HandPhase_HAND_PHASE_BETTING, pk.tables[1].Hand.Phase)
+
+	// Ask for the first board card, as the poker keeper would on the flop.
+	meta := pk.tables[1].Hand.Dealer
+	meta.RevealPos = meta.Cursor
+	meta.RevealDeadline = ctx.BlockTime().Unix() + 30
+	for _, mem := range qual {
+		msg, err := pubShareMsg(r, mem.Validator, 1, 1, meta.RevealPos, dh, h.memberHandSecret(t, mem))
+		require.NoError(t, err)
+		_, err = ms.SubmitPubShare(ctx, msg)
+		require.NoError(t, err)
+	}
+	_, err := ms.FinalizeReveal(ctx, &types.MsgFinalizeReveal{Caller: creator, TableId: 1, HandId: 1, Pos: meta.RevealPos})
+	require.NoError(t, err)
+	require.Equal(t, uint32(255), pk.tables[1].Hand.Dealer.RevealPos)
+}
+
+func TestSimCommittee_BatchedEncShares(t *testing.T) {
+	h := shuffledSimHand(t)
+	tbl := h.pk.tables[1]
+
+	batch := func(mem types.DealerMember) *types.MsgSubmitEncShares {
+		xHand := h.memberHandSecret(t, mem)
+		msg := &types.MsgSubmitEncShares{Validator: mem.Validator, TableId: 1, HandId: 1}
+		for _, seat := range []int{0, 3} {
+			for c := 0; c < 2; c++ {
+				e, err := encShareEntry(h.r, tbl.Hand.Dealer.HolePos[seat*2+c], h.dh, h.seats[seat].Pk, xHand)
+				require.NoError(t, err)
+				msg.Shares = append(msg.Shares, e)
+			}
+		}
+		return msg
+	}
+
+	// A single bad proof rejects the whole batch and stores nothing.
+	bad := batch(h.qual[0])
+	bad.Shares[2].ProofEncShare = append([]byte(nil), bad.Shares[1].ProofEncShare...)
+	_, err := h.ms.SubmitEncShares(h.ctx, bad)
+	require.ErrorContains(t, err, "invalid enc share proof")
+
+	repeated := batch(h.qual[0])
+	repeated.Shares[1] = repeated.Shares[0]
+	_, err = h.ms.SubmitEncShares(h.ctx, repeated)
+	require.ErrorContains(t, err, "repeated in batch")
+
+	dh, err := h.k.GetHand(h.ctx, 1, 1)
+	require.NoError(t, err)
+	require.Empty(t, dh.EncShares)
+
+	// A share already delivered on its own cannot be batched again.
+	first := batch(h.qual[0])
+	e := first.Shares[0]
+	_, err = h.ms.SubmitEncShare(h.ctx, &types.MsgSubmitEncShare{
+		Validator: first.Validator, TableId: 1, HandId: 1,
+		Pos: e.Pos, PkPlayer: e.PkPlayer, EncShare: e.EncShare, ProofEncShare: e.ProofEncShare,
+	})
+	require.NoError(t, err)
+	_, err = h.ms.SubmitEncShares(h.ctx, first)
+	require.ErrorContains(t, err, "duplicate enc share")
+	first.Shares = first.Shares[1:]
+	_, err = h.ms.SubmitEncShares(h.ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, pokertypes.HandPhase_HAND_PHASE_SHUFFLE, h.pk.tables[1].Hand.Phase)
+
+	// The threshold-th member's batch opens betting.
+	_, err = h.ms.SubmitEncShares(h.ctx, batch(h.qual[1]))
+	require.NoError(t, err)
+	require.Equal(t, pokertypes.HandPhase_HAND_PHASE_BETTING, h.pk.tables[1].Hand.Phase)
+	dh, err = h.k.GetHand(h.ctx, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, dh.EncShares, 8)
+}
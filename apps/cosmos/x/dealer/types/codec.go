@@ -22,6 +22,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgDkgShareReveal{}, "ocp/dealer/DkgShareReveal")
 	legacy.RegisterAminoMsg(cdc, &MsgFinalizeEpoch{}, "ocp/dealer/FinalizeEpoch")
 	legacy.RegisterAminoMsg(cdc, &MsgDkgTimeout{}, "ocp/dealer/DkgTimeout")
+	legacy.RegisterAminoMsg(cdc, &MsgReportDKGWithholding{}, "ocp/dealer/ReportDKGWithholding")
+	legacy.RegisterAminoMsg(cdc, &MsgBeginReshare{}, "ocp/dealer/BeginReshare")
+	legacy.RegisterAminoMsg(cdc, &MsgReshareCommit{}, "ocp/dealer/ReshareCommit")
+	legacy.RegisterAminoMsg(cdc, &MsgReshareEncryptedShare{}, "ocp/dealer/ReshareEncShare")
+	legacy.RegisterAminoMsg(cdc, &MsgFinalizeReshare{}, "ocp/dealer/FinalizeReshare")
 
 	// Randomness-beacon messages. Registration lives in codec_beacon.go
 	// alongside the message types.
@@ -32,8 +37,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgFinalizeDeck{}, "ocp/dealer/FinalizeDeck")
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitPubShare{}, "ocp/dealer/SubmitPubShare")
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitEncShare{}, "ocp/dealer/SubmitEncShare")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitEncShares{}, "ocp/dealer/SubmitEncShares")
 	legacy.RegisterAminoMsg(cdc, &MsgFinalizeReveal{}, "ocp/dealer/FinalizeReveal")
 	legacy.RegisterAminoMsg(cdc, &MsgTimeout{}, "ocp/dealer/Timeout")
+	legacy.RegisterAminoMsg(cdc, &MsgReportDealerWithholding{}, "ocp/dealer/ReportDealerWithholding")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "ocp/dealer/UpdateParams")
 }
 
 // RegisterInterfaces registers the x/dealer module's interface implementations.
@@ -47,13 +55,21 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDkgShareReveal{},
 		&MsgFinalizeEpoch{},
 		&MsgDkgTimeout{},
+		&MsgReportDKGWithholding{},
+		&MsgBeginReshare{},
+		&MsgReshareCommit{},
+		&MsgReshareEncryptedShare{},
+		&MsgFinalizeReshare{},
 		&MsgInitHand{},
 		&MsgSubmitShuffle{},
 		&MsgFinalizeDeck{},
 		&MsgSubmitPubShare{},
 		&MsgSubmitEncShare{},
+		&MsgSubmitEncShares{},
 		&MsgFinalizeReveal{},
 		&MsgTimeout{},
+		&MsgReportDealerWithholding{},
+		&MsgUpdateParams{},
 	)
 
 	// Randomness-beacon messages. Registration lives in codec_beacon.go
@@ -30,12 +30,17 @@ type GenesisState struct {
 	Params      Params       `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 	// Randomness-beacon commit-reveal state for the upcoming epoch (if any).
 	// NOTE: after editing this file, regenerate generated Go code with:
-	//
-	//	cd apps/cosmos/proto && buf generate
-	Beacon               *BeaconState `protobuf:"bytes,5,opt,name=beacon,proto3" json:"beacon,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	//   cd apps/cosmos/proto && buf generate
+	Beacon *BeaconState `protobuf:"bytes,5,opt,name=beacon,proto3" json:"beacon,omitempty"`
+	// In-flight per-hand dealer state, keyed by (table_id, hand_id).
+	Hands []GenesisDealerHand `protobuf:"bytes,6,rep,name=hands,proto3" json:"hands"`
+	// Superseded epochs kept alive until every hand bound to them finishes.
+	RetiringEpochs []DealerEpoch `protobuf:"bytes,7,rep,name=retiring_epochs,json=retiringEpochs,proto3" json:"retiring_epochs"`
+	// In-flight share refresh of the active epoch (if any).
+	Reshare              *DealerReshare `protobuf:"bytes,8,opt,name=reshare,proto3" json:"reshare,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,6 +102,82 @@ func (m *GenesisState) GetBeacon() *BeaconState {
 	return nil
 }
 
+func (m *GenesisState) GetHands() []GenesisDealerHand {
+	if m != nil {
+		return m.Hands
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetiringEpochs() []DealerEpoch {
+	if m != nil {
+		return m.RetiringEpochs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReshare() *DealerReshare {
+	if m != nil {
+		return m.Reshare
+	}
+	return nil
+}
+
+// GenesisDealerHand is a DealerHand together with its store key.
+type GenesisDealerHand struct {
+	TableId              uint64     `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	HandId               uint64     `protobuf:"varint,2,opt,name=hand_id,json=handId,proto3" json:"hand_id,omitempty"`
+	Hand                 DealerHand `protobuf:"bytes,3,opt,name=hand,proto3" json:"hand"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GenesisDealerHand) Reset()         { *m = GenesisDealerHand{} }
+func (m *GenesisDealerHand) String() string { return proto.CompactTextString(m) }
+func (*GenesisDealerHand) ProtoMessage()    {}
+func (*GenesisDealerHand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34672eba2f8d03b5, []int{1}
+}
+func (m *GenesisDealerHand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenesisDealerHand.Unmarshal(m, b)
+}
+func (m *GenesisDealerHand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenesisDealerHand.Marshal(b, m, deterministic)
+}
+func (m *GenesisDealerHand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisDealerHand.Merge(m, src)
+}
+func (m *GenesisDealerHand) XXX_Size() int {
+	return xxx_messageInfo_GenesisDealerHand.Size(m)
+}
+func (m *GenesisDealerHand) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisDealerHand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisDealerHand proto.InternalMessageInfo
+
+func (m *GenesisDealerHand) GetTableId() uint64 {
+	if m != nil {
+		return m.TableId
+	}
+	return 0
+}
+
+func (m *GenesisDealerHand) GetHandId() uint64 {
+	if m != nil {
+		return m.HandId
+	}
+	return 0
+}
+
+func (m *GenesisDealerHand) GetHand() DealerHand {
+	if m != nil {
+		return m.Hand
+	}
+	return DealerHand{}
+}
+
 // Params defines the x/dealer module parameters.
 //
 // NOTE: Dealer faults are intended to map to real PoS stake risk:
@@ -121,17 +202,52 @@ type Params struct {
 	//
 	// See docs/DKG-V2.md §6 for the migration plan. Any value other than 1 or
 	// 2 is rejected by Params.Validate.
-	DkgVersion           uint32   `protobuf:"varint,5,opt,name=dkg_version,json=dkgVersion,proto3" json:"dkg_version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	DkgVersion uint32 `protobuf:"varint,5,opt,name=dkg_version,json=dkgVersion,proto3" json:"dkg_version,omitempty"`
+	// Scheduled epoch rotation. When epoch_length_blocks is non-zero,
+	// BeginBlock begins the next epoch's DKG itself once the current epoch is
+	// at least this many blocks old (counted from its DKG start) and the
+	// randomness beacon for the next epoch has closed. 0 disables rotation;
+	// MsgBeginEpoch stays available either way.
+	EpochLengthBlocks uint64 `protobuf:"varint,6,opt,name=epoch_length_blocks,json=epochLengthBlocks,proto3" json:"epoch_length_blocks,omitempty"`
+	// Committee size for automatically begun epochs. Capped at the number of
+	// bonded validators with voting power.
+	TargetCommitteeSize uint32 `protobuf:"varint,7,opt,name=target_committee_size,json=targetCommitteeSize,proto3" json:"target_committee_size,omitempty"`
+	// Threshold for automatically begun epochs as a share of the committee
+	// size, in basis points, rounded up and floored at 2.
+	ThresholdBps uint32 `protobuf:"varint,8,opt,name=threshold_bps,json=thresholdBps,proto3" json:"threshold_bps,omitempty"`
+	// Open shuffle order. When non-zero, any qualified member that has not yet
+	// shuffled a hand's deck may submit the next round, and each accepted round
+	// opens a new slot of shuffle_slot_secs. When a slot passes with no shuffle,
+	// dealer/timeout slashes the members that have not shuffled and, if at
+	// least max(min_shufflers, threshold) members (capped at the QUAL size)
+	// have shuffled, finalizes the deck instead of aborting the hand.
+	// 0 keeps the fixed QUAL order in which every qualified member must shuffle.
+	MinShufflers uint32 `protobuf:"varint,9,opt,name=min_shufflers,json=minShufflers,proto3" json:"min_shufflers,omitempty"`
+	// Shuffle slot length in seconds for open shuffle order. 0 uses the
+	// table's dealer timeout.
+	ShuffleSlotSecs uint64 `protobuf:"varint,10,opt,name=shuffle_slot_secs,json=shuffleSlotSecs,proto3" json:"shuffle_slot_secs,omitempty"`
+	// Shares of stake slashed for a dealer fault, in basis points, that are
+	// paid out instead of burned: reporter_reward_bps to the signer of the
+	// dealer/timeout or DKG complaint that triggered the slash, and
+	// victim_compensation_bps split evenly among the players of a hand the
+	// fault aborted. The rest is burned. Their sum must not exceed 10000.
+	ReporterRewardBps     uint32 `protobuf:"varint,11,opt,name=reporter_reward_bps,json=reporterRewardBps,proto3" json:"reporter_reward_bps,omitempty"`
+	VictimCompensationBps uint32 `protobuf:"varint,12,opt,name=victim_compensation_bps,json=victimCompensationBps,proto3" json:"victim_compensation_bps,omitempty"`
+	// Oldest shuffle proof version MsgSubmitShuffle accepts. 0 accepts every
+	// version ShuffleVerify knows (v1, v2 and v3); 3 rejects the O(n^2)
+	// switching-network proofs. Governance should raise it only once every
+	// dealer daemon proves v3.
+	MinShuffleProofVersion uint32   `protobuf:"varint,13,opt,name=min_shuffle_proof_version,json=minShuffleProofVersion,proto3" json:"min_shuffle_proof_version,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{1}
+	return fileDescriptor_34672eba2f8d03b5, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Params.Unmarshal(m, b)
@@ -186,6 +302,62 @@ func (m *Params) GetDkgVersion() uint32 {
 	return 0
 }
 
+func (m *Params) GetEpochLengthBlocks() uint64 {
+	if m != nil {
+		return m.EpochLengthBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetTargetCommitteeSize() uint32 {
+	if m != nil {
+		return m.TargetCommitteeSize
+	}
+	return 0
+}
+
+func (m *Params) GetThresholdBps() uint32 {
+	if m != nil {
+		return m.ThresholdBps
+	}
+	return 0
+}
+
+func (m *Params) GetMinShufflers() uint32 {
+	if m != nil {
+		return m.MinShufflers
+	}
+	return 0
+}
+
+func (m *Params) GetShuffleSlotSecs() uint64 {
+	if m != nil {
+		return m.ShuffleSlotSecs
+	}
+	return 0
+}
+
+func (m *Params) GetReporterRewardBps() uint32 {
+	if m != nil {
+		return m.ReporterRewardBps
+	}
+	return 0
+}
+
+func (m *Params) GetVictimCompensationBps() uint32 {
+	if m != nil {
+		return m.VictimCompensationBps
+	}
+	return 0
+}
+
+func (m *Params) GetMinShuffleProofVersion() uint32 {
+	if m != nil {
+		return m.MinShuffleProofVersion
+	}
+	return 0
+}
+
 type DealerMember struct {
 	// Validator operator address (valoper).
 	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
@@ -213,7 +385,7 @@ func (m *DealerMember) Reset()         { *m = DealerMember{} }
 func (m *DealerMember) String() string { return proto.CompactTextString(m) }
 func (*DealerMember) ProtoMessage()    {}
 func (*DealerMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{2}
+	return fileDescriptor_34672eba2f8d03b5, []int{3}
 }
 func (m *DealerMember) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerMember.Unmarshal(m, b)
@@ -286,18 +458,22 @@ type DealerEpoch struct {
 	// Used for slashing so validators cannot evade by unbonding immediately after being selected.
 	StartHeight int64 `protobuf:"varint,7,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
 	// Canonical ordering: lexicographically ascending by validator.
-	Slashed              []string       `protobuf:"bytes,5,rep,name=slashed,proto3" json:"slashed,omitempty"`
-	Members              []DealerMember `protobuf:"bytes,6,rep,name=members,proto3" json:"members"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Slashed []string       `protobuf:"bytes,5,rep,name=slashed,proto3" json:"slashed,omitempty"`
+	Members []DealerMember `protobuf:"bytes,6,rep,name=members,proto3" json:"members"`
+	// Members whose validators were jailed or began unbonding after the epoch
+	// formed. Like slashed members they are not expected to shuffle or submit
+	// shares, but they are not penalized. Same canonical ordering as slashed.
+	Inactive             []string `protobuf:"bytes,8,rep,name=inactive,proto3" json:"inactive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DealerEpoch) Reset()         { *m = DealerEpoch{} }
 func (m *DealerEpoch) String() string { return proto.CompactTextString(m) }
 func (*DealerEpoch) ProtoMessage()    {}
 func (*DealerEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{3}
+	return fileDescriptor_34672eba2f8d03b5, []int{4}
 }
 func (m *DealerEpoch) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerEpoch.Unmarshal(m, b)
@@ -366,6 +542,13 @@ func (m *DealerEpoch) GetMembers() []DealerMember {
 	return nil
 }
 
+func (m *DealerEpoch) GetInactive() []string {
+	if m != nil {
+		return m.Inactive
+	}
+	return nil
+}
+
 type DealerDKGCommit struct {
 	Dealer               string   `protobuf:"bytes,1,opt,name=dealer,proto3" json:"dealer,omitempty"`
 	Commitments          [][]byte `protobuf:"bytes,2,rep,name=commitments,proto3" json:"commitments,omitempty"`
@@ -378,7 +561,7 @@ func (m *DealerDKGCommit) Reset()         { *m = DealerDKGCommit{} }
 func (m *DealerDKGCommit) String() string { return proto.CompactTextString(m) }
 func (*DealerDKGCommit) ProtoMessage()    {}
 func (*DealerDKGCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{4}
+	return fileDescriptor_34672eba2f8d03b5, []int{5}
 }
 func (m *DealerDKGCommit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerDKGCommit.Unmarshal(m, b)
@@ -433,7 +616,7 @@ func (m *DealerDKGEncryptedShare) Reset()         { *m = DealerDKGEncryptedShare
 func (m *DealerDKGEncryptedShare) String() string { return proto.CompactTextString(m) }
 func (*DealerDKGEncryptedShare) ProtoMessage()    {}
 func (*DealerDKGEncryptedShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{5}
+	return fileDescriptor_34672eba2f8d03b5, []int{6}
 }
 func (m *DealerDKGEncryptedShare) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerDKGEncryptedShare.Unmarshal(m, b)
@@ -510,7 +693,7 @@ func (m *DealerDKGComplaint) Reset()         { *m = DealerDKGComplaint{} }
 func (m *DealerDKGComplaint) String() string { return proto.CompactTextString(m) }
 func (*DealerDKGComplaint) ProtoMessage()    {}
 func (*DealerDKGComplaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{6}
+	return fileDescriptor_34672eba2f8d03b5, []int{7}
 }
 func (m *DealerDKGComplaint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerDKGComplaint.Unmarshal(m, b)
@@ -579,7 +762,7 @@ func (m *DealerDKGShareReveal) Reset()         { *m = DealerDKGShareReveal{} }
 func (m *DealerDKGShareReveal) String() string { return proto.CompactTextString(m) }
 func (*DealerDKGShareReveal) ProtoMessage()    {}
 func (*DealerDKGShareReveal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{7}
+	return fileDescriptor_34672eba2f8d03b5, []int{8}
 }
 func (m *DealerDKGShareReveal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerDKGShareReveal.Unmarshal(m, b)
@@ -654,7 +837,7 @@ func (m *DealerDKG) Reset()         { *m = DealerDKG{} }
 func (m *DealerDKG) String() string { return proto.CompactTextString(m) }
 func (*DealerDKG) ProtoMessage()    {}
 func (*DealerDKG) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{8}
+	return fileDescriptor_34672eba2f8d03b5, []int{9}
 }
 func (m *DealerDKG) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerDKG.Unmarshal(m, b)
@@ -772,6 +955,127 @@ func (m *DealerDKG) GetEncryptedShares() []DealerDKGEncryptedShare {
 	return nil
 }
 
+// DealerReshare is an in-flight proactive refresh of the active epoch's key
+// shares. It finalizes into a new epoch (epoch_id) with the same pk_epoch;
+// the source epoch is retained for its in-flight hands like any rotation.
+type DealerReshare struct {
+	// Id the refreshed epoch will take.
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// Active epoch whose key is being re-dealt.
+	FromEpochId uint64 `protobuf:"varint,2,opt,name=from_epoch_id,json=fromEpochId,proto3" json:"from_epoch_id,omitempty"`
+	Threshold   uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Refresh mode: the new committee is a subset of the source members with
+	// the same threshold, so dealers share zero-secret polynomials that are
+	// added to existing shares. Otherwise dealers re-share their own share and
+	// the chain recombines with Lagrange coefficients.
+	ZeroSecret bool `protobuf:"varint,4,opt,name=zero_secret,json=zeroSecret,proto3" json:"zero_secret,omitempty"`
+	// New committee. In refresh mode members keep their source index.
+	Members        []DealerMember    `protobuf:"bytes,5,rep,name=members,proto3" json:"members"`
+	StartHeight    int64             `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	CommitDeadline int64             `protobuf:"varint,7,opt,name=commit_deadline,json=commitDeadline,proto3" json:"commit_deadline,omitempty"`
+	ShareDeadline  int64             `protobuf:"varint,8,opt,name=share_deadline,json=shareDeadline,proto3" json:"share_deadline,omitempty"`
+	Commits        []DealerDKGCommit `protobuf:"bytes,9,rep,name=commits,proto3" json:"commits"`
+	// Sorted by (dealer, recipient_index).
+	EncryptedShares      []DealerDKGEncryptedShare `protobuf:"bytes,10,rep,name=encrypted_shares,json=encryptedShares,proto3" json:"encrypted_shares"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *DealerReshare) Reset()         { *m = DealerReshare{} }
+func (m *DealerReshare) String() string { return proto.CompactTextString(m) }
+func (*DealerReshare) ProtoMessage()    {}
+func (*DealerReshare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34672eba2f8d03b5, []int{10}
+}
+func (m *DealerReshare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DealerReshare.Unmarshal(m, b)
+}
+func (m *DealerReshare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DealerReshare.Marshal(b, m, deterministic)
+}
+func (m *DealerReshare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DealerReshare.Merge(m, src)
+}
+func (m *DealerReshare) XXX_Size() int {
+	return xxx_messageInfo_DealerReshare.Size(m)
+}
+func (m *DealerReshare) XXX_DiscardUnknown() {
+	xxx_messageInfo_DealerReshare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DealerReshare proto.InternalMessageInfo
+
+func (m *DealerReshare) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *DealerReshare) GetFromEpochId() uint64 {
+	if m != nil {
+		return m.FromEpochId
+	}
+	return 0
+}
+
+func (m *DealerReshare) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *DealerReshare) GetZeroSecret() bool {
+	if m != nil {
+		return m.ZeroSecret
+	}
+	return false
+}
+
+func (m *DealerReshare) GetMembers() []DealerMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *DealerReshare) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *DealerReshare) GetCommitDeadline() int64 {
+	if m != nil {
+		return m.CommitDeadline
+	}
+	return 0
+}
+
+func (m *DealerReshare) GetShareDeadline() int64 {
+	if m != nil {
+		return m.ShareDeadline
+	}
+	return 0
+}
+
+func (m *DealerReshare) GetCommits() []DealerDKGCommit {
+	if m != nil {
+		return m.Commits
+	}
+	return nil
+}
+
+func (m *DealerReshare) GetEncryptedShares() []DealerDKGEncryptedShare {
+	if m != nil {
+		return m.EncryptedShares
+	}
+	return nil
+}
+
 type DealerCiphertext struct {
 	C1                   []byte   `protobuf:"bytes,1,opt,name=c1,proto3" json:"c1,omitempty"`
 	C2                   []byte   `protobuf:"bytes,2,opt,name=c2,proto3" json:"c2,omitempty"`
@@ -784,7 +1088,7 @@ func (m *DealerCiphertext) Reset()         { *m = DealerCiphertext{} }
 func (m *DealerCiphertext) String() string { return proto.CompactTextString(m) }
 func (*DealerCiphertext) ProtoMessage()    {}
 func (*DealerCiphertext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{9}
+	return fileDescriptor_34672eba2f8d03b5, []int{11}
 }
 func (m *DealerCiphertext) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerCiphertext.Unmarshal(m, b)
@@ -833,7 +1137,7 @@ func (m *DealerPubShare) Reset()         { *m = DealerPubShare{} }
 func (m *DealerPubShare) String() string { return proto.CompactTextString(m) }
 func (*DealerPubShare) ProtoMessage()    {}
 func (*DealerPubShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{10}
+	return fileDescriptor_34672eba2f8d03b5, []int{12}
 }
 func (m *DealerPubShare) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerPubShare.Unmarshal(m, b)
@@ -904,7 +1208,7 @@ func (m *DealerEncShare) Reset()         { *m = DealerEncShare{} }
 func (m *DealerEncShare) String() string { return proto.CompactTextString(m) }
 func (*DealerEncShare) ProtoMessage()    {}
 func (*DealerEncShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{11}
+	return fileDescriptor_34672eba2f8d03b5, []int{13}
 }
 func (m *DealerEncShare) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerEncShare.Unmarshal(m, b)
@@ -978,7 +1282,7 @@ func (m *DealerReveal) Reset()         { *m = DealerReveal{} }
 func (m *DealerReveal) String() string { return proto.CompactTextString(m) }
 func (*DealerReveal) ProtoMessage()    {}
 func (*DealerReveal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{12}
+	return fileDescriptor_34672eba2f8d03b5, []int{14}
 }
 func (m *DealerReveal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerReveal.Unmarshal(m, b)
@@ -1051,7 +1355,7 @@ func (m *BeaconState) Reset()         { *m = BeaconState{} }
 func (m *BeaconState) String() string { return proto.CompactTextString(m) }
 func (*BeaconState) ProtoMessage()    {}
 func (*BeaconState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{13}
+	return fileDescriptor_34672eba2f8d03b5, []int{15}
 }
 func (m *BeaconState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BeaconState.Unmarshal(m, b)
@@ -1147,7 +1451,7 @@ func (m *BeaconCommitEntry) Reset()         { *m = BeaconCommitEntry{} }
 func (m *BeaconCommitEntry) String() string { return proto.CompactTextString(m) }
 func (*BeaconCommitEntry) ProtoMessage()    {}
 func (*BeaconCommitEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{14}
+	return fileDescriptor_34672eba2f8d03b5, []int{16}
 }
 func (m *BeaconCommitEntry) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BeaconCommitEntry.Unmarshal(m, b)
@@ -1194,7 +1498,7 @@ func (m *BeaconRevealEntry) Reset()         { *m = BeaconRevealEntry{} }
 func (m *BeaconRevealEntry) String() string { return proto.CompactTextString(m) }
 func (*BeaconRevealEntry) ProtoMessage()    {}
 func (*BeaconRevealEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{15}
+	return fileDescriptor_34672eba2f8d03b5, []int{17}
 }
 func (m *BeaconRevealEntry) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BeaconRevealEntry.Unmarshal(m, b)
@@ -1246,8 +1550,12 @@ type DealerHand struct {
 	// without these, k_hand is publicly recomputable from (epochId, tableId, handId) alone,
 	// so leaking the epoch secret decrypts every past hand in the epoch. Binding to
 	// per-hand block entropy that the attacker cannot grind after the fact raises the bar.
-	InitHeight           int64    `protobuf:"varint,20,opt,name=init_height,json=initHeight,proto3" json:"init_height,omitempty"`
-	InitHashSalt         []byte   `protobuf:"bytes,21,opt,name=init_hash_salt,json=initHashSalt,proto3" json:"init_hash_salt,omitempty"`
+	InitHeight   int64  `protobuf:"varint,20,opt,name=init_height,json=initHeight,proto3" json:"init_height,omitempty"`
+	InitHashSalt []byte `protobuf:"bytes,21,opt,name=init_hash_salt,json=initHashSalt,proto3" json:"init_hash_salt,omitempty"`
+	// Validators whose shuffles were accepted, in round order. The next
+	// shuffler is the first qualified member not listed here, so members that
+	// drop out of QUAL mid-shuffle do not shift the order.
+	Shufflers            []string `protobuf:"bytes,22,rep,name=shufflers,proto3" json:"shufflers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1257,7 +1565,7 @@ func (m *DealerHand) Reset()         { *m = DealerHand{} }
 func (m *DealerHand) String() string { return proto.CompactTextString(m) }
 func (*DealerHand) ProtoMessage()    {}
 func (*DealerHand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34672eba2f8d03b5, []int{16}
+	return fileDescriptor_34672eba2f8d03b5, []int{18}
 }
 func (m *DealerHand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerHand.Unmarshal(m, b)
@@ -1368,8 +1676,16 @@ func (m *DealerHand) GetInitHashSalt() []byte {
 	return nil
 }
 
+func (m *DealerHand) GetShufflers() []string {
+	if m != nil {
+		return m.Shufflers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "onchainpoker.dealer.v1.GenesisState")
+	proto.RegisterType((*GenesisDealerHand)(nil), "onchainpoker.dealer.v1.GenesisDealerHand")
 	proto.RegisterType((*Params)(nil), "onchainpoker.dealer.v1.Params")
 	proto.RegisterType((*DealerMember)(nil), "onchainpoker.dealer.v1.DealerMember")
 	proto.RegisterType((*DealerEpoch)(nil), "onchainpoker.dealer.v1.DealerEpoch")
@@ -1378,6 +1694,7 @@ func init() {
 	proto.RegisterType((*DealerDKGComplaint)(nil), "onchainpoker.dealer.v1.DealerDKGComplaint")
 	proto.RegisterType((*DealerDKGShareReveal)(nil), "onchainpoker.dealer.v1.DealerDKGShareReveal")
 	proto.RegisterType((*DealerDKG)(nil), "onchainpoker.dealer.v1.DealerDKG")
+	proto.RegisterType((*DealerReshare)(nil), "onchainpoker.dealer.v1.DealerReshare")
 	proto.RegisterType((*DealerCiphertext)(nil), "onchainpoker.dealer.v1.DealerCiphertext")
 	proto.RegisterType((*DealerPubShare)(nil), "onchainpoker.dealer.v1.DealerPubShare")
 	proto.RegisterType((*DealerEncShare)(nil), "onchainpoker.dealer.v1.DealerEncShare")
@@ -1393,103 +1710,127 @@ func init() {
 }
 
 var fileDescriptor_34672eba2f8d03b5 = []byte{
-	// 1521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4b, 0x6f, 0x1c, 0xc5,
-	0x13, 0xff, 0xef, 0xc3, 0xfb, 0xa8, 0x5d, 0xaf, 0xed, 0xfe, 0x3b, 0xc9, 0xe4, 0xed, 0x4c, 0x22,
-	0xec, 0x40, 0x62, 0x63, 0x73, 0x40, 0x91, 0x90, 0xa2, 0xf8, 0xa1, 0xc4, 0x0a, 0x11, 0xd6, 0xac,
-	0x94, 0x03, 0x97, 0x61, 0x76, 0xa6, 0xbd, 0x33, 0xcc, 0xec, 0xf4, 0x68, 0xba, 0xbd, 0xd8, 0x39,
-	0x72, 0xe7, 0xce, 0x81, 0x33, 0xe2, 0xc6, 0x85, 0x2f, 0xc0, 0x8d, 0x03, 0x27, 0x3e, 0x00, 0x48,
-	0x20, 0xf1, 0x39, 0x50, 0x57, 0x77, 0xcf, 0xce, 0xda, 0xce, 0xda, 0x0a, 0xdc, 0xa6, 0xaa, 0xeb,
-	0xdd, 0x55, 0xbf, 0xea, 0x81, 0xfb, 0x2c, 0xf5, 0x43, 0x2f, 0x4a, 0x33, 0x16, 0xd3, 0x7c, 0x23,
-	0xa0, 0x5e, 0x42, 0xf3, 0x8d, 0xf1, 0xa6, 0xfe, 0x5a, 0xcf, 0x72, 0x26, 0x18, 0xb9, 0x5a, 0x16,
-	0x5a, 0xd7, 0x47, 0xe3, 0xcd, 0x1b, 0xcb, 0x43, 0x36, 0x64, 0x28, 0xb2, 0x21, 0xbf, 0x94, 0xf4,
-	0x8d, 0xeb, 0x3e, 0xe3, 0x23, 0xc6, 0x5d, 0x75, 0xa0, 0x08, 0x75, 0x64, 0xff, 0x58, 0x85, 0xee,
-	0x73, 0x9a, 0x52, 0x1e, 0xf1, 0xbe, 0xf0, 0x04, 0x25, 0x36, 0xcc, 0xa7, 0xf4, 0x58, 0xb8, 0x34,
-	0x63, 0x7e, 0xe8, 0x46, 0x81, 0x55, 0x59, 0xa9, 0xac, 0xd5, 0x9d, 0x8e, 0x64, 0xee, 0x49, 0xde,
-	0x7e, 0x40, 0x9e, 0xc2, 0x1c, 0x1e, 0x5b, 0xd5, 0x95, 0xca, 0x5a, 0x67, 0xeb, 0xfe, 0xfa, 0xf9,
-	0xd1, 0xac, 0xef, 0xe2, 0x17, 0x6a, 0x6d, 0xd7, 0x7f, 0xf9, 0xfd, 0x6e, 0xc5, 0x51, 0x7a, 0xe4,
-	0x09, 0xd4, 0x82, 0x78, 0x68, 0xd5, 0x50, 0xfd, 0xde, 0x6c, 0xf5, 0xdd, 0x97, 0xcf, 0xb5, 0xb2,
-	0xd4, 0x21, 0x9f, 0x40, 0x23, 0xf3, 0x72, 0x6f, 0xc4, 0xad, 0x3a, 0x6a, 0xdf, 0x79, 0x9b, 0xf6,
-	0x01, 0x4a, 0xa1, 0xea, 0xff, 0x1c, 0xad, 0x43, 0x9e, 0x41, 0x63, 0x40, 0x3d, 0x9f, 0xa5, 0xd6,
-	0xdc, 0xec, 0xd0, 0xb7, 0x51, 0x0a, 0x4b, 0xa2, 0xbd, 0x6b, 0x45, 0xfb, 0xaf, 0x0a, 0x34, 0x94,
-	0x6d, 0x59, 0x2b, 0x9e, 0x78, 0x3c, 0x74, 0x07, 0x19, 0x77, 0x65, 0x42, 0xb2, 0x56, 0xf3, 0x4e,
-	0x07, 0x99, 0xdb, 0x19, 0xdf, 0x8d, 0x87, 0x64, 0x13, 0xae, 0x4c, 0x64, 0x42, 0x2f, 0x0d, 0x5c,
-	0xe5, 0x04, 0x6b, 0x37, 0xef, 0x10, 0x23, 0xfb, 0xc2, 0x4b, 0x03, 0x95, 0x30, 0x59, 0x83, 0xc5,
-	0x2f, 0xbd, 0x28, 0x71, 0x39, 0xf5, 0x59, 0x1a, 0x28, 0xcb, 0x35, 0xbc, 0x85, 0x9e, 0xe4, 0xf7,
-	0x15, 0x5b, 0x1a, 0xff, 0x18, 0xac, 0x29, 0xc9, 0xb2, 0xfd, 0x3a, 0x6a, 0x5c, 0x29, 0x69, 0x94,
-	0x5c, 0xdc, 0x85, 0x4e, 0x10, 0x0f, 0xdd, 0x31, 0xcd, 0x79, 0xa4, 0x8b, 0x31, 0xef, 0x40, 0x10,
-	0x0f, 0x5f, 0x2b, 0x8e, 0xfd, 0x73, 0x05, 0xba, 0x4a, 0xf6, 0x15, 0x1d, 0x0d, 0x68, 0x4e, 0x6e,
-	0x41, 0x7b, 0xec, 0x25, 0x51, 0xe0, 0x09, 0x96, 0x63, 0x9e, 0x6d, 0x67, 0xc2, 0x20, 0xcb, 0x30,
-	0x17, 0xa5, 0x01, 0x3d, 0xd6, 0x59, 0x29, 0x82, 0xdc, 0x84, 0x76, 0x76, 0x34, 0x70, 0x79, 0xe8,
-	0xe5, 0x14, 0x33, 0xe8, 0x3a, 0xad, 0xec, 0x68, 0xd0, 0x97, 0xb4, 0x0c, 0xc1, 0x67, 0x29, 0x77,
-	0xb3, 0xa3, 0x41, 0x4c, 0x4f, 0x30, 0xdc, 0xae, 0x03, 0x92, 0x75, 0x80, 0x1c, 0x69, 0x33, 0x63,
-	0x5f, 0xd1, 0x1c, 0xa3, 0xab, 0x39, 0x8a, 0x20, 0x0f, 0x61, 0x91, 0x66, 0x21, 0x1d, 0xd1, 0xdc,
-	0x4b, 0x8c, 0x6e, 0x03, 0x75, 0x17, 0x0a, 0xbe, 0x32, 0x60, 0x7f, 0x53, 0x85, 0x4e, 0xa9, 0x05,
-	0xc9, 0x75, 0x68, 0x9d, 0xea, 0xea, 0x26, 0xd5, 0x1d, 0x7d, 0x0b, 0xda, 0x22, 0xcc, 0x29, 0x0f,
-	0x59, 0x12, 0xe8, 0x1c, 0x26, 0x0c, 0xa9, 0x98, 0xc5, 0x6a, 0x22, 0x74, 0x1a, 0xcd, 0x2c, 0x56,
-	0x36, 0x57, 0x61, 0x41, 0xe4, 0x5e, 0xca, 0xfd, 0x3c, 0xca, 0x84, 0x9b, 0x33, 0x26, 0x74, 0x26,
-	0xbd, 0x09, 0xdb, 0x61, 0x4c, 0x90, 0x7b, 0xd0, 0xe5, 0xc2, 0xcb, 0x85, 0x1b, 0xd2, 0x68, 0x18,
-	0x0a, 0xab, 0x89, 0x49, 0x75, 0x90, 0xf7, 0x02, 0x59, 0xc4, 0x82, 0x26, 0x76, 0x03, 0x0d, 0xac,
-	0xb9, 0x95, 0xda, 0x5a, 0xdb, 0x31, 0x24, 0xd9, 0x85, 0xe6, 0x08, 0xaf, 0x81, 0x5b, 0x8d, 0x95,
-	0xda, 0x5a, 0x67, 0xeb, 0xc1, 0xec, 0x99, 0x51, 0x77, 0xa6, 0x7b, 0xdf, 0xa8, 0xda, 0x2f, 0x61,
-	0xa1, 0x18, 0xa9, 0x1d, 0x36, 0x1a, 0x45, 0x82, 0x5c, 0x85, 0x86, 0x6e, 0x17, 0x75, 0xa5, 0x9a,
-	0x22, 0x2b, 0xf2, 0x72, 0xa4, 0xc4, 0x88, 0xa6, 0x82, 0x5b, 0xd5, 0x95, 0xda, 0x5a, 0xd7, 0x29,
-	0xb3, 0xec, 0x5f, 0x2b, 0x70, 0xad, 0xb0, 0xb6, 0x97, 0xfa, 0xf9, 0x49, 0x26, 0x68, 0xa0, 0xae,
-	0xf6, 0xc9, 0xb4, 0xd5, 0xed, 0x7b, 0xbf, 0xfd, 0xf4, 0xf8, 0xb6, 0x86, 0x9d, 0xd7, 0xa6, 0x67,
-	0x9e, 0x05, 0x41, 0x4e, 0x39, 0xef, 0x8b, 0x3c, 0x4a, 0x87, 0x85, 0xe3, 0x55, 0x58, 0xc8, 0xa9,
-	0x1f, 0x65, 0x11, 0x4d, 0x85, 0x5b, 0x6e, 0xa9, 0x5e, 0xc1, 0xde, 0xc7, 0xde, 0xea, 0x42, 0xe5,
-	0x48, 0x5f, 0x46, 0xe5, 0x48, 0x52, 0x63, 0x5d, 0xf8, 0xca, 0x18, 0x3b, 0x27, 0x67, 0xec, 0x10,
-	0x3b, 0xa7, 0xeb, 0x28, 0x42, 0x76, 0x23, 0xf7, 0xbd, 0xc4, 0xcb, 0x5d, 0x5f, 0xe8, 0x96, 0x69,
-	0x29, 0xc6, 0x8e, 0xb0, 0xbf, 0xad, 0x00, 0x29, 0x17, 0x27, 0x4b, 0xbc, 0x28, 0x15, 0xb3, 0x5a,
-	0xe6, 0x0e, 0x80, 0xaf, 0xe5, 0xf4, 0x34, 0xb7, 0x9d, 0x12, 0xa7, 0x54, 0xda, 0xda, 0x54, 0x69,
-	0x09, 0xd4, 0xe3, 0x28, 0x0d, 0x30, 0xda, 0xb6, 0x83, 0xdf, 0x18, 0x9a, 0xac, 0x9c, 0x3b, 0xe2,
-	0x43, 0x1d, 0x74, 0x0b, 0x19, 0xaf, 0xf8, 0xd0, 0x66, 0xb0, 0x5c, 0x44, 0x86, 0xf5, 0x75, 0xe8,
-	0x98, 0x7a, 0xc9, 0xac, 0xd8, 0x26, 0xbe, 0xab, 0x53, 0xbe, 0x7b, 0x50, 0x15, 0x4c, 0xc7, 0x53,
-	0x15, 0x4c, 0x16, 0x4a, 0x0d, 0xa7, 0x2a, 0x9d, 0x22, 0xec, 0x3f, 0xe6, 0xa0, 0x5d, 0x78, 0x7c,
-	0xf7, 0xa9, 0x29, 0x35, 0x6d, 0xed, 0x9d, 0x9b, 0xf6, 0xcc, 0xdc, 0xd4, 0xcf, 0xce, 0xcd, 0x2a,
-	0x2c, 0xa8, 0xce, 0x94, 0xd0, 0x17, 0x24, 0x51, 0x4a, 0x35, 0x64, 0xf4, 0x14, 0x7b, 0x57, 0x73,
-	0xc9, 0x63, 0x20, 0xe6, 0x82, 0x4a, 0xb2, 0x0d, 0x94, 0x5d, 0x2a, 0x4e, 0x0a, 0x71, 0xec, 0x45,
-	0x59, 0xea, 0x89, 0xac, 0x9a, 0xda, 0x9e, 0x62, 0x17, 0x82, 0x1f, 0xc0, 0xd2, 0x61, 0x94, 0x7a,
-	0x49, 0xf4, 0x86, 0x4e, 0x44, 0x5b, 0x28, 0xba, 0x68, 0x0e, 0x0a, 0xe1, 0xdb, 0x00, 0xb9, 0x84,
-	0x69, 0x05, 0x27, 0x6d, 0x2c, 0x7c, 0x5b, 0x72, 0x14, 0xa0, 0x3c, 0x87, 0xa6, 0x8a, 0x9a, 0x5b,
-	0x80, 0x55, 0x5b, 0xbd, 0x70, 0x3d, 0xaa, 0x59, 0x36, 0x85, 0xd3, 0xda, 0xe4, 0x60, 0xd2, 0x9f,
-	0x82, 0x5b, 0x1d, 0xb4, 0xf5, 0xfe, 0x65, 0x6c, 0x29, 0x15, 0x6d, 0xae, 0x64, 0x83, 0x7c, 0x0a,
-	0x4d, 0x95, 0x38, 0xb7, 0xba, 0x68, 0xee, 0xd1, 0x85, 0xe6, 0x4a, 0xfd, 0x6a, 0xe2, 0xd3, 0x26,
-	0xca, 0x68, 0x37, 0x3f, 0x8d, 0x76, 0x5f, 0xc0, 0x22, 0x35, 0x80, 0xa2, 0x96, 0x07, 0xb7, 0x7a,
-	0xe8, 0x70, 0xe3, 0x42, 0x87, 0xd3, 0x48, 0xa4, 0x7d, 0x2e, 0xd0, 0x29, 0x2e, 0xb7, 0xb7, 0x60,
-	0x51, 0x69, 0xec, 0x44, 0x59, 0x48, 0x73, 0x41, 0x8f, 0x85, 0x9c, 0x0d, 0x7f, 0x13, 0x3b, 0xbc,
-	0xeb, 0x54, 0xfd, 0x4d, 0xa4, 0xb7, 0xb0, 0xab, 0x25, 0xbd, 0x65, 0x7f, 0x5d, 0x81, 0x9e, 0x52,
-	0x3a, 0x30, 0x2b, 0x6c, 0x11, 0x6a, 0x19, 0xe3, 0x7a, 0xeb, 0xcb, 0xcf, 0xe9, 0x2d, 0x59, 0x7d,
-	0xeb, 0x96, 0xac, 0x95, 0xb7, 0xe4, 0xb9, 0x43, 0x78, 0x3e, 0x86, 0xd9, 0xdf, 0x17, 0x41, 0xec,
-	0xa5, 0xfe, 0x7f, 0x19, 0x84, 0x5c, 0xd5, 0xb1, 0x9b, 0x25, 0xde, 0x89, 0x7e, 0x3a, 0xc8, 0x55,
-	0x1d, 0x1f, 0x20, 0x2d, 0x0f, 0x69, 0xea, 0xeb, 0x3d, 0xae, 0xe1, 0x89, 0x1a, 0xff, 0x45, 0xa0,
-	0x8d, 0x72, 0xa0, 0x4f, 0xcc, 0xf3, 0x41, 0x83, 0xd5, 0xd9, 0x28, 0xaf, 0x41, 0xd3, 0xf7, 0xf2,
-	0x40, 0xc2, 0x8a, 0x82, 0x8e, 0x86, 0x24, 0xf7, 0x03, 0xfb, 0xbb, 0x1a, 0x74, 0x4a, 0xcf, 0xaf,
-	0x59, 0x00, 0xf4, 0x08, 0x07, 0x5a, 0x4e, 0x3e, 0xcb, 0x68, 0x6a, 0x20, 0xa2, 0xaa, 0x26, 0x4f,
-	0x9d, 0x7c, 0x96, 0xd1, 0x54, 0xe3, 0xc4, 0x3a, 0xfc, 0x5f, 0x4b, 0xfb, 0x09, 0xe3, 0xd4, 0x88,
-	0xd7, 0x8a, 0xf9, 0x1f, 0x45, 0x62, 0x47, 0x9e, 0x4c, 0xe4, 0xf5, 0xfc, 0x4f, 0xc9, 0x2b, 0x04,
-	0x5a, 0x52, 0x47, 0x65, 0xf9, 0x29, 0x38, 0x9c, 0x3b, 0x0d, 0x87, 0xfb, 0x93, 0xc1, 0x56, 0x3b,
-	0xfc, 0xe1, 0xec, 0xb7, 0xa7, 0x9a, 0xea, 0xbd, 0x54, 0xe4, 0x27, 0xa7, 0x47, 0x7b, 0x7f, 0x32,
-	0x88, 0xcd, 0xcb, 0x98, 0x52, 0x77, 0x30, 0x65, 0xca, 0x4c, 0xe1, 0x32, 0xcc, 0x21, 0x42, 0x21,
-	0x5c, 0x75, 0x1d, 0x45, 0x90, 0x1b, 0xd0, 0x3a, 0xf4, 0x92, 0x64, 0xe0, 0xf9, 0x31, 0x22, 0x54,
-	0xcb, 0x29, 0x68, 0x3b, 0x81, 0xa5, 0x33, 0x01, 0x92, 0xa7, 0x67, 0x5e, 0x87, 0x97, 0x59, 0xfa,
-	0xa5, 0xae, 0xbc, 0x0a, 0x0d, 0x95, 0x9d, 0x9e, 0x38, 0x4d, 0xd9, 0xa1, 0xf1, 0x56, 0xca, 0xe1,
-	0xdf, 0x7b, 0x23, 0x50, 0xe7, 0x5e, 0x62, 0x7c, 0xe1, 0xb7, 0xfd, 0x77, 0x1d, 0x40, 0xb5, 0xac,
-	0x7c, 0x27, 0xcf, 0xea, 0xba, 0x6b, 0xd0, 0xcc, 0x62, 0x7c, 0x6b, 0x9b, 0x60, 0xb3, 0x18, 0x75,
-	0x6e, 0x42, 0x3b, 0xa0, 0x7e, 0xec, 0xf2, 0xe8, 0x0d, 0xd5, 0xe3, 0xd5, 0x92, 0x8c, 0x7e, 0xf4,
-	0x86, 0x92, 0x6d, 0xa8, 0xcb, 0x6f, 0xab, 0x8e, 0x37, 0xb6, 0x36, 0x1b, 0xc9, 0x26, 0xb8, 0xa4,
-	0x2f, 0x0c, 0x75, 0x71, 0x19, 0x86, 0x47, 0x87, 0x87, 0x09, 0x75, 0xb9, 0xa0, 0x99, 0x6e, 0xb2,
-	0x8e, 0xe6, 0xf5, 0x05, 0xcd, 0x64, 0x13, 0x9a, 0x95, 0x13, 0xe0, 0x48, 0xb6, 0x9c, 0x09, 0x43,
-	0xbe, 0x9e, 0x8d, 0x81, 0x53, 0x3b, 0x6d, 0x41, 0xf3, 0x8b, 0x3d, 0xf5, 0x21, 0x2c, 0x87, 0x4c,
-	0x3a, 0x42, 0xc8, 0x3c, 0xbd, 0xd7, 0x88, 0x3c, 0x53, 0x68, 0x5a, 0x68, 0xbc, 0x04, 0x28, 0x9e,
-	0xfb, 0xdc, 0x6a, 0x63, 0x9e, 0xef, 0xcd, 0xce, 0xd3, 0x40, 0xa9, 0xce, 0xb2, 0x6d, 0xfe, 0x0e,
-	0xb8, 0x34, 0x56, 0x60, 0x8e, 0x59, 0x85, 0x17, 0x18, 0x33, 0x90, 0x68, 0x8c, 0x19, 0x88, 0xe2,
-	0xf2, 0x29, 0x62, 0x06, 0xa6, 0x73, 0x99, 0xa7, 0xc8, 0xf9, 0x1b, 0xeb, 0x2e, 0x74, 0xa2, 0x34,
-	0x2a, 0x5e, 0x22, 0xcb, 0x58, 0x08, 0x90, 0x2c, 0x0d, 0x00, 0x0f, 0xa0, 0xa7, 0x04, 0xe4, 0xff,
-	0x1e, 0x36, 0xd8, 0x15, 0xec, 0x8f, 0x2e, 0xca, 0x78, 0x3c, 0xec, 0x7b, 0x89, 0xd8, 0x5e, 0xfe,
-	0xe1, 0xcf, 0x3b, 0x95, 0xcf, 0x7b, 0xc7, 0xe6, 0xdf, 0x5e, 0x9c, 0x64, 0x94, 0x0f, 0x1a, 0xf8,
-	0x3f, 0xfe, 0xd1, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x3d, 0xe9, 0xcd, 0xff, 0x0f, 0x00,
-	0x00,
+	// 1915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6e, 0xe4, 0xc6,
+	0x11, 0xf6, 0xfc, 0xcf, 0xd4, 0xfc, 0x48, 0xd3, 0x96, 0x56, 0xdc, 0xb5, 0xbd, 0xab, 0xe5, 0xda,
+	0x59, 0xd9, 0xb1, 0xb5, 0x91, 0x02, 0x24, 0x58, 0xc0, 0x80, 0x61, 0xfd, 0x60, 0x2d, 0xac, 0x8d,
+	0x08, 0x1c, 0xc0, 0x87, 0x5c, 0x18, 0x0e, 0xd9, 0x9a, 0x61, 0x86, 0x43, 0x12, 0xec, 0xd6, 0x78,
+	0xb5, 0xc7, 0x20, 0x79, 0x80, 0xdc, 0x72, 0xc8, 0x39, 0x48, 0xee, 0x79, 0x81, 0xdc, 0x72, 0xc8,
+	0x29, 0x0f, 0x90, 0x04, 0x79, 0x83, 0xbc, 0x41, 0x50, 0xd5, 0xdd, 0x24, 0x67, 0xa4, 0x1d, 0x09,
+	0xf6, 0xde, 0xd8, 0xf5, 0xd7, 0xd5, 0xd5, 0x55, 0x5f, 0x55, 0x13, 0x9e, 0x24, 0xb1, 0x3f, 0xf5,
+	0xc2, 0x38, 0x4d, 0x66, 0x3c, 0x7b, 0x16, 0x70, 0x2f, 0xe2, 0xd9, 0xb3, 0xc5, 0x81, 0xfe, 0xda,
+	0x4f, 0xb3, 0x44, 0x26, 0xec, 0x5e, 0x59, 0x68, 0x5f, 0xb3, 0x16, 0x07, 0x0f, 0xb6, 0x26, 0xc9,
+	0x24, 0x21, 0x91, 0x67, 0xf8, 0xa5, 0xa4, 0x1f, 0xdc, 0xf7, 0x13, 0x31, 0x4f, 0x84, 0xab, 0x18,
+	0x6a, 0xa1, 0x58, 0xf6, 0xef, 0xeb, 0xd0, 0x7b, 0xc1, 0x63, 0x2e, 0x42, 0x31, 0x92, 0x9e, 0xe4,
+	0xcc, 0x86, 0x7e, 0xcc, 0x5f, 0x49, 0x97, 0xa7, 0x89, 0x3f, 0x75, 0xc3, 0xc0, 0xaa, 0xec, 0x56,
+	0xf6, 0xea, 0x4e, 0x17, 0x89, 0xa7, 0x48, 0x3b, 0x0b, 0xd8, 0x17, 0xd0, 0x20, 0xb6, 0x55, 0xdd,
+	0xad, 0xec, 0x75, 0x0f, 0x9f, 0xec, 0xdf, 0xec, 0xcd, 0xfe, 0x09, 0x7d, 0x91, 0xd6, 0x51, 0xfd,
+	0xef, 0xff, 0x7a, 0x54, 0x71, 0x94, 0x1e, 0x7b, 0x0e, 0xb5, 0x60, 0x36, 0xb1, 0x6a, 0xa4, 0xfe,
+	0x78, 0xbd, 0xfa, 0xc9, 0xcb, 0x17, 0x5a, 0x19, 0x75, 0xd8, 0xe7, 0xd0, 0x4c, 0xbd, 0xcc, 0x9b,
+	0x0b, 0xab, 0x4e, 0xda, 0x0f, 0xdf, 0xa4, 0x7d, 0x4e, 0x52, 0xa4, 0xfa, 0x8e, 0xa3, 0x75, 0xd8,
+	0x97, 0xd0, 0x1c, 0x73, 0xcf, 0x4f, 0x62, 0xab, 0xb1, 0xde, 0xf5, 0x23, 0x92, 0xa2, 0x90, 0xe8,
+	0xdd, 0xb5, 0x22, 0x3b, 0x85, 0xc6, 0xd4, 0x8b, 0x03, 0x61, 0x35, 0x77, 0x6b, 0x7b, 0xdd, 0xc3,
+	0x8f, 0xdf, 0x64, 0x41, 0x47, 0x55, 0x1d, 0xe2, 0x2b, 0x2f, 0x0e, 0xb4, 0x2b, 0x4a, 0x9b, 0x39,
+	0xb0, 0x91, 0x71, 0x19, 0x66, 0x61, 0x3c, 0x51, 0xb1, 0x16, 0x56, 0x6b, 0xb7, 0xb6, 0xce, 0xa5,
+	0xd5, 0x68, 0xbe, 0xe3, 0x0c, 0x8c, 0x05, 0x22, 0x0a, 0x76, 0x0a, 0xad, 0x8c, 0x8b, 0xa9, 0x97,
+	0x71, 0xab, 0x4d, 0xc7, 0xfb, 0x68, 0xbd, 0x2d, 0x47, 0x09, 0xeb, 0x03, 0x1a, 0x5d, 0xfb, 0x77,
+	0x15, 0x18, 0x5e, 0xf3, 0x9e, 0xdd, 0x87, 0xb6, 0xf4, 0xc6, 0x11, 0x2f, 0x72, 0xa2, 0x45, 0xeb,
+	0xb3, 0x80, 0xed, 0x40, 0x0b, 0x0f, 0x85, 0x9c, 0x2a, 0x71, 0x9a, 0xb8, 0x3c, 0x0b, 0xd8, 0xe7,
+	0x50, 0xc7, 0x2f, 0x7d, 0xd1, 0xf6, 0x7a, 0x6f, 0x4a, 0x31, 0x22, 0x2d, 0xfb, 0x3f, 0x75, 0x68,
+	0xaa, 0x5b, 0xc4, 0xac, 0x14, 0x91, 0x27, 0xa6, 0xee, 0x38, 0x15, 0x2e, 0xa6, 0x0e, 0x7a, 0xd0,
+	0x77, 0xba, 0x44, 0x3c, 0x4a, 0xc5, 0xc9, 0x6c, 0xc2, 0x0e, 0x60, 0xbb, 0x90, 0x21, 0x7f, 0xd4,
+	0x0e, 0xe4, 0x53, 0xdf, 0x61, 0x46, 0x16, 0xf7, 0x51, 0x3b, 0xb2, 0x3d, 0xd8, 0xfc, 0xb5, 0x17,
+	0x46, 0xae, 0xe0, 0x7e, 0x12, 0x07, 0xca, 0x72, 0x8d, 0x4e, 0x30, 0x40, 0xfa, 0x48, 0x91, 0xd1,
+	0xf8, 0xcf, 0xc1, 0x5a, 0x92, 0x2c, 0xdb, 0xaf, 0x93, 0xc6, 0x76, 0x49, 0xa3, 0xb4, 0xc5, 0x23,
+	0xe8, 0x06, 0xb3, 0x89, 0xbb, 0xe0, 0x99, 0x08, 0x75, 0xda, 0xf5, 0x1d, 0x08, 0x66, 0x93, 0x6f,
+	0x15, 0x85, 0xed, 0xc3, 0xbb, 0xaa, 0xd6, 0x22, 0x1e, 0x4f, 0xe4, 0xd4, 0x1d, 0x47, 0x89, 0x3f,
+	0xc3, 0xec, 0x42, 0xa3, 0x43, 0x62, 0x7d, 0x4d, 0x9c, 0x23, 0x62, 0xb0, 0x43, 0xd8, 0x96, 0x5e,
+	0x36, 0xe1, 0xd2, 0xf5, 0x93, 0xf9, 0x3c, 0x94, 0x92, 0x73, 0x57, 0x84, 0xaf, 0xb9, 0xd5, 0x22,
+	0xd3, 0xef, 0x2a, 0xe6, 0xb1, 0xe1, 0x8d, 0xc2, 0xd7, 0x9c, 0x3d, 0x81, 0xbe, 0x9c, 0xe2, 0xf5,
+	0x26, 0x51, 0x80, 0xe1, 0xa1, 0xf4, 0xe8, 0x3b, 0xbd, 0x9c, 0x78, 0x94, 0x0a, 0x14, 0x9a, 0x87,
+	0xb1, 0x2b, 0xa6, 0x97, 0x17, 0x17, 0x11, 0xcf, 0x84, 0xd5, 0x51, 0x42, 0xf3, 0x30, 0x1e, 0x19,
+	0x1a, 0xfb, 0x04, 0x86, 0x5a, 0xc0, 0x15, 0x51, 0x22, 0x31, 0x1e, 0xc2, 0x02, 0xf2, 0x75, 0x43,
+	0x33, 0x46, 0x51, 0x22, 0x47, 0xdc, 0x17, 0x78, 0xb2, 0x8c, 0xa7, 0x49, 0x26, 0x79, 0xe6, 0x66,
+	0xfc, 0x3b, 0x2f, 0x53, 0x7b, 0x77, 0xc9, 0xec, 0xd0, 0xb0, 0x1c, 0xe2, 0xa0, 0x03, 0x3f, 0x83,
+	0x9d, 0x45, 0xe8, 0xcb, 0x70, 0x8e, 0x27, 0x4b, 0x79, 0x2c, 0x3c, 0x19, 0x26, 0x31, 0xe9, 0xf4,
+	0x48, 0x67, 0x5b, 0xb1, 0x8f, 0x4b, 0x5c, 0xd4, 0x7b, 0x0e, 0xf7, 0x4b, 0x8e, 0x23, 0xca, 0x25,
+	0x17, 0x79, 0xc0, 0xfb, 0xa4, 0x79, 0xaf, 0x38, 0xc4, 0x39, 0xb2, 0x75, 0xf0, 0xed, 0xbf, 0x55,
+	0xa0, 0xa7, 0x2e, 0xea, 0x1b, 0x3e, 0x1f, 0xf3, 0x8c, 0xbd, 0x0f, 0x9d, 0x85, 0x17, 0x85, 0x81,
+	0x27, 0x93, 0x8c, 0x92, 0xac, 0xe3, 0x14, 0x04, 0xb6, 0x05, 0x8d, 0x30, 0x0e, 0xf8, 0x2b, 0x9d,
+	0x52, 0x6a, 0xc1, 0xde, 0x83, 0x4e, 0x7a, 0x39, 0x76, 0x55, 0xe1, 0x61, 0xfa, 0xf4, 0x9c, 0x76,
+	0x7a, 0x39, 0x1e, 0xe1, 0x1a, 0xef, 0xdf, 0x4f, 0x62, 0xe1, 0xa6, 0x97, 0xe3, 0x19, 0xbf, 0xa2,
+	0x5c, 0xe9, 0x39, 0x80, 0xa4, 0x73, 0xa2, 0xa0, 0xcd, 0x34, 0xf9, 0x8e, 0x67, 0x94, 0x1a, 0x35,
+	0x47, 0x2d, 0xd8, 0xc7, 0xb0, 0xc9, 0xd3, 0x29, 0x9f, 0xf3, 0xcc, 0x8b, 0x8c, 0x6e, 0x93, 0x74,
+	0x37, 0x72, 0xba, 0x32, 0x60, 0xff, 0xa5, 0x0a, 0xdd, 0x12, 0x36, 0x60, 0xa1, 0xae, 0x80, 0x77,
+	0x8b, 0x6b, 0xe0, 0x7e, 0x1f, 0x3a, 0xf9, 0x95, 0xeb, 0x33, 0x14, 0x04, 0x54, 0x4c, 0x67, 0x0a,
+	0x8c, 0xf4, 0x31, 0x5a, 0xe9, 0x4c, 0xd9, 0x7c, 0x0a, 0x1b, 0x32, 0xf3, 0x62, 0xe1, 0x67, 0x61,
+	0x2a, 0xdd, 0x2c, 0x49, 0xa4, 0x3e, 0xc9, 0xa0, 0x20, 0x3b, 0x49, 0x22, 0xd9, 0x63, 0xe8, 0x09,
+	0xe9, 0x65, 0xd2, 0x9d, 0xf2, 0x70, 0x32, 0x95, 0x94, 0x94, 0x35, 0xa7, 0x4b, 0xb4, 0xaf, 0x88,
+	0xc4, 0x2c, 0x68, 0x51, 0x29, 0xf2, 0xc0, 0x6a, 0xec, 0xd6, 0xf6, 0x3a, 0x8e, 0x59, 0xb2, 0x13,
+	0x68, 0xcd, 0xe9, 0x1a, 0x0c, 0xb8, 0x7e, 0xb8, 0x1e, 0x31, 0xd4, 0x9d, 0x69, 0xcc, 0x30, 0xaa,
+	0xec, 0x01, 0xb4, 0xc3, 0xd8, 0xf3, 0x65, 0xb8, 0x40, 0x18, 0xc4, 0x0d, 0xf2, 0xb5, 0xfd, 0x12,
+	0x36, 0xf2, 0xae, 0xa2, 0x4a, 0x84, 0xdd, 0x83, 0xa6, 0xae, 0x63, 0x75, 0xdd, 0x7a, 0xc5, 0x76,
+	0xf1, 0xe2, 0x50, 0x62, 0xce, 0x63, 0x29, 0xac, 0xea, 0x6e, 0x6d, 0xaf, 0xe7, 0x94, 0x49, 0xf6,
+	0x3f, 0x2a, 0xb0, 0x93, 0x5b, 0x3b, 0x8d, 0xfd, 0xec, 0x2a, 0x95, 0x3c, 0x50, 0xd7, 0xfe, 0x7c,
+	0xd9, 0xea, 0xd1, 0xe3, 0x7f, 0xfe, 0xf5, 0xb3, 0x0f, 0x74, 0xe7, 0xfd, 0xd6, 0xe4, 0xd3, 0x97,
+	0x41, 0x90, 0x71, 0x21, 0x46, 0x12, 0x31, 0x3c, 0xdf, 0xf8, 0x29, 0x76, 0x06, 0x3f, 0x4c, 0x43,
+	0x1e, 0x4b, 0xb7, 0x9c, 0x6e, 0x83, 0x9c, 0x7c, 0x86, 0x54, 0xd6, 0x83, 0xca, 0xa5, 0xbe, 0xa8,
+	0xca, 0x25, 0xae, 0x16, 0xfa, 0x52, 0x2a, 0x0b, 0xca, 0x2a, 0x4c, 0x74, 0xca, 0xaa, 0x9e, 0xa3,
+	0x16, 0x98, 0xa9, 0xc2, 0xf7, 0x22, 0x2f, 0x73, 0x7d, 0xa9, 0xd3, 0xa9, 0xad, 0x08, 0xc7, 0xd2,
+	0xfe, 0x43, 0x05, 0x58, 0x39, 0x38, 0x69, 0xe4, 0x85, 0xb1, 0x5c, 0x97, 0x4e, 0x0f, 0x01, 0x7c,
+	0x2d, 0xa7, 0x61, 0xb6, 0xe3, 0x94, 0x28, 0xa5, 0xd0, 0xd6, 0x96, 0x42, 0xcb, 0xa0, 0x3e, 0x0b,
+	0xe3, 0x80, 0xbc, 0xed, 0x38, 0xf4, 0x4d, 0xae, 0x61, 0xe4, 0xdc, 0xb9, 0x98, 0x68, 0xa7, 0xdb,
+	0x44, 0xf8, 0x46, 0x4c, 0xec, 0x04, 0xb6, 0x72, 0xcf, 0x28, 0xbe, 0x0e, 0x5f, 0x70, 0x2f, 0x5a,
+	0xe7, 0x5b, 0xb1, 0x77, 0x75, 0x69, 0xef, 0x01, 0x54, 0x65, 0xa2, 0xfd, 0xa9, 0xca, 0x04, 0x03,
+	0xa5, 0x0a, 0x57, 0x85, 0x4e, 0x2d, 0xec, 0x7f, 0x37, 0xa0, 0x93, 0xef, 0xf8, 0xfd, 0x2b, 0xaa,
+	0x94, 0xd0, 0xb5, 0xef, 0x9f, 0xd0, 0xab, 0x35, 0x55, 0xbf, 0x5e, 0x53, 0x4f, 0x61, 0x43, 0x65,
+	0x26, 0xf6, 0xa4, 0x20, 0x0a, 0x63, 0xae, 0xe1, 0x64, 0xa0, 0xc8, 0x27, 0x9a, 0xca, 0x3e, 0x03,
+	0x66, 0x2e, 0xa8, 0x24, 0xdb, 0x24, 0xd9, 0x61, 0xce, 0xc9, 0xc5, 0x29, 0x17, 0x31, 0xd4, 0x85,
+	0xac, 0xaa, 0xe8, 0x81, 0x22, 0xe7, 0x82, 0x3f, 0x86, 0xe1, 0x45, 0x18, 0x7b, 0x51, 0xf8, 0x9a,
+	0x17, 0xa2, 0x6d, 0x12, 0xdd, 0x34, 0x8c, 0x5c, 0xf8, 0x03, 0x80, 0x0c, 0xfb, 0xa7, 0x82, 0x9a,
+	0x0e, 0x05, 0xbe, 0x83, 0x14, 0x05, 0x36, 0x2f, 0xa0, 0xa5, 0xbc, 0xc6, 0xce, 0x82, 0x51, 0x7b,
+	0x7a, 0xeb, 0x84, 0xa8, 0x6a, 0xd9, 0x04, 0x4e, 0x6b, 0xb3, 0xf3, 0x22, 0x3f, 0x25, 0xf6, 0x1d,
+	0xb4, 0xf5, 0xc9, 0x5d, 0x6c, 0x29, 0x15, 0x6d, 0xae, 0x64, 0x83, 0x7d, 0x0d, 0x2d, 0x75, 0x70,
+	0x6c, 0x49, 0x68, 0xee, 0xd3, 0x5b, 0xcd, 0x95, 0xf2, 0xd5, 0xf8, 0xa7, 0x4d, 0x94, 0x91, 0xb0,
+	0xbf, 0x8c, 0x84, 0xbf, 0x82, 0x4d, 0x6e, 0x00, 0x45, 0x35, 0x16, 0x61, 0x0d, 0x68, 0xc3, 0x67,
+	0xb7, 0x6e, 0xb8, 0x8c, 0x44, 0x7a, 0xcf, 0x0d, 0xbe, 0x44, 0x15, 0xf6, 0xff, 0x6a, 0xd0, 0x5f,
+	0x9a, 0x02, 0xd7, 0x65, 0xb9, 0x0d, 0xfd, 0x8b, 0x2c, 0x99, 0x17, 0x8f, 0x02, 0x35, 0xe6, 0x75,
+	0x91, 0x78, 0x7a, 0x53, 0x25, 0xd4, 0x56, 0x2b, 0xe1, 0x11, 0x74, 0x5f, 0xf3, 0x2c, 0xc1, 0x79,
+	0x21, 0xe3, 0x2a, 0x85, 0xdb, 0x0e, 0x20, 0x69, 0x44, 0x94, 0x72, 0xa9, 0x34, 0xde, 0x5e, 0xa9,
+	0x34, 0xef, 0x54, 0x2a, 0xad, 0x1b, 0x4b, 0xe5, 0x23, 0x18, 0x28, 0x44, 0x5a, 0xc9, 0xe7, 0x3e,
+	0x51, 0x73, 0xb1, 0x52, 0xb6, 0x76, 0x7e, 0x50, 0xb6, 0xde, 0x74, 0xe7, 0xf0, 0x56, 0xef, 0xfc,
+	0x10, 0x36, 0x95, 0xc6, 0x71, 0x98, 0x4e, 0x79, 0x26, 0xf9, 0x2b, 0x89, 0x78, 0xe8, 0x1f, 0xd0,
+	0x7d, 0xf7, 0x9c, 0xaa, 0x7f, 0x40, 0xeb, 0x43, 0xab, 0xaa, 0xd7, 0x87, 0xf6, 0x6f, 0x2a, 0x30,
+	0x50, 0x4a, 0xe7, 0x66, 0xa4, 0xd9, 0x84, 0x5a, 0x9a, 0x08, 0x3d, 0x82, 0xe3, 0xe7, 0xf2, 0xd4,
+	0x54, 0x7d, 0xe3, 0xd4, 0x54, 0x2b, 0x4f, 0x4d, 0x37, 0x02, 0xef, 0xcd, 0x7d, 0xcb, 0xfe, 0x53,
+	0xee, 0xc4, 0x69, 0xec, 0xbf, 0x4d, 0x27, 0x70, 0x74, 0x9b, 0xb9, 0x69, 0xe4, 0x5d, 0xe9, 0x39,
+	0x1e, 0x47, 0xb7, 0xd9, 0x39, 0xad, 0x91, 0xc9, 0x63, 0x5f, 0xcf, 0x75, 0xba, 0x25, 0x71, 0xb3,
+	0x7f, 0xee, 0x68, 0xb3, 0xec, 0xe8, 0x73, 0x33, 0x4e, 0xea, 0x06, 0x75, 0xdd, 0xcb, 0x1d, 0x68,
+	0xf9, 0x38, 0x09, 0x87, 0xa6, 0x5d, 0x34, 0x71, 0x79, 0x16, 0xd8, 0x7f, 0xac, 0x41, 0xb7, 0xf4,
+	0xea, 0x5c, 0x57, 0x8e, 0x9f, 0x02, 0xd3, 0x29, 0x9c, 0xa4, 0x3c, 0x36, 0xb9, 0x5e, 0x55, 0x68,
+	0xab, 0x38, 0xbf, 0x48, 0x79, 0xac, 0x13, 0x7e, 0x1f, 0xde, 0xd5, 0xd2, 0x7e, 0x94, 0x08, 0x6e,
+	0xc4, 0x6b, 0x39, 0xe6, 0xcf, 0x43, 0x79, 0x8c, 0x9c, 0x42, 0x5e, 0x63, 0xfe, 0x92, 0xbc, 0xea,
+	0x3a, 0x43, 0xc5, 0x2a, 0xcb, 0x2f, 0x15, 0x7e, 0x63, 0xb5, 0xf0, 0xcf, 0x8a, 0xf2, 0xb8, 0xe5,
+	0xc1, 0xac, 0x0e, 0xaf, 0x6a, 0xe3, 0x34, 0x96, 0xd9, 0xd5, 0x6a, 0x81, 0x9c, 0x15, 0xe0, 0xdb,
+	0xba, 0x8b, 0x29, 0x75, 0x07, 0x4b, 0xa6, 0x0c, 0xf2, 0x6e, 0x41, 0x83, 0xba, 0x12, 0x95, 0x74,
+	0xcf, 0x51, 0x0b, 0x9c, 0x1c, 0x2f, 0xbc, 0x28, 0x1a, 0x7b, 0xfe, 0x8c, 0xba, 0x52, 0xdb, 0xc9,
+	0xd7, 0x76, 0x04, 0xc3, 0x6b, 0x0e, 0xb2, 0x2f, 0xae, 0xbd, 0x16, 0xee, 0x32, 0xe8, 0x15, 0x3a,
+	0x38, 0xa5, 0xa8, 0xd3, 0xe9, 0x8a, 0xd3, 0x2b, 0x7b, 0x0a, 0xc3, 0x6b, 0x67, 0xf8, 0xe1, 0xbb,
+	0x31, 0xa8, 0x0b, 0x2f, 0x32, 0x7b, 0xd1, 0xb7, 0xfd, 0xdb, 0x06, 0xc0, 0xf2, 0x2b, 0xff, 0x4d,
+	0x59, 0xb7, 0x03, 0xad, 0x74, 0x46, 0x0f, 0x5f, 0xe3, 0x6c, 0x3a, 0x23, 0x9d, 0xf7, 0xa0, 0x13,
+	0x70, 0x7f, 0xa6, 0x5e, 0xa1, 0xaa, 0xbc, 0xda, 0x48, 0xa0, 0xa7, 0xe7, 0x11, 0xd4, 0xf1, 0xdb,
+	0xaa, 0xd3, 0x8d, 0xed, 0xad, 0x47, 0xb2, 0x02, 0x97, 0xcc, 0x8f, 0x00, 0xd4, 0x25, 0x54, 0x37,
+	0x8f, 0x4e, 0xc9, 0x53, 0x9d, 0x64, 0x5d, 0x4d, 0x1b, 0x49, 0x9e, 0x62, 0x12, 0x9a, 0x31, 0x23,
+	0xa0, 0x92, 0x6c, 0x3b, 0x05, 0x01, 0x5f, 0x53, 0xc6, 0xc0, 0x0a, 0xe8, 0x9b, 0x47, 0x6b, 0x0e,
+	0xe7, 0x3f, 0x81, 0xad, 0x69, 0x82, 0x1b, 0x11, 0x64, 0xae, 0x62, 0x3f, 0x43, 0x9e, 0x42, 0xd3,
+	0x5c, 0xe3, 0x25, 0x40, 0xfe, 0xfc, 0x33, 0x3d, 0xe0, 0x47, 0xeb, 0xcf, 0x69, 0xa0, 0x54, 0x9f,
+	0xb2, 0x63, 0x5e, 0x8b, 0x02, 0x8d, 0xe5, 0x98, 0x63, 0xe0, 0xff, 0x16, 0x63, 0x06, 0x12, 0x8d,
+	0x31, 0x03, 0x51, 0x02, 0x7b, 0xaa, 0x29, 0x98, 0xee, 0x5d, 0x7a, 0xea, 0xcd, 0x53, 0xca, 0x23,
+	0xe8, 0x86, 0x71, 0x98, 0xb7, 0xd4, 0x2d, 0x0a, 0x04, 0x20, 0x49, 0x03, 0xc0, 0x87, 0x30, 0x50,
+	0x02, 0xf8, 0xf3, 0x85, 0x12, 0x6c, 0x9b, 0xf2, 0xa3, 0x47, 0x32, 0x9e, 0x98, 0x8e, 0xbc, 0x88,
+	0x60, 0xa2, 0xf8, 0xb5, 0x70, 0x8f, 0xc6, 0x9d, 0x82, 0x70, 0xb4, 0xf5, 0xe7, 0xff, 0x3e, 0xac,
+	0xfc, 0x72, 0xf0, 0xca, 0xfc, 0xf0, 0x94, 0x57, 0x29, 0x17, 0xe3, 0x26, 0xfd, 0xa4, 0xfc, 0xe9,
+	0xff, 0x07, 0x00, 0xcf, 0x7e, 0xfd, 0x04, 0x14, 0x15, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -1526,6 +1867,58 @@ func (this *GenesisState) Equal(that interface{}) bool {
 	if !this.Beacon.Equal(that1.Beacon) {
 		return false
 	}
+	if len(this.Hands) != len(that1.Hands) {
+		return false
+	}
+	for i := range this.Hands {
+		if !this.Hands[i].Equal(&that1.Hands[i]) {
+			return false
+		}
+	}
+	if len(this.RetiringEpochs) != len(that1.RetiringEpochs) {
+		return false
+	}
+	for i := range this.RetiringEpochs {
+		if !this.RetiringEpochs[i].Equal(&that1.RetiringEpochs[i]) {
+			return false
+		}
+	}
+	if !this.Reshare.Equal(that1.Reshare) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *GenesisDealerHand) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GenesisDealerHand)
+	if !ok {
+		that2, ok := that.(GenesisDealerHand)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TableId != that1.TableId {
+		return false
+	}
+	if this.HandId != that1.HandId {
+		return false
+	}
+	if !this.Hand.Equal(&that1.Hand) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1565,6 +1958,30 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DkgVersion != that1.DkgVersion {
 		return false
 	}
+	if this.EpochLengthBlocks != that1.EpochLengthBlocks {
+		return false
+	}
+	if this.TargetCommitteeSize != that1.TargetCommitteeSize {
+		return false
+	}
+	if this.ThresholdBps != that1.ThresholdBps {
+		return false
+	}
+	if this.MinShufflers != that1.MinShufflers {
+		return false
+	}
+	if this.ShuffleSlotSecs != that1.ShuffleSlotSecs {
+		return false
+	}
+	if this.ReporterRewardBps != that1.ReporterRewardBps {
+		return false
+	}
+	if this.VictimCompensationBps != that1.VictimCompensationBps {
+		return false
+	}
+	if this.MinShuffleProofVersion != that1.MinShuffleProofVersion {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1662,6 +2079,14 @@ func (this *DealerEpoch) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Inactive) != len(that1.Inactive) {
+		return false
+	}
+	for i := range this.Inactive {
+		if this.Inactive[i] != that1.Inactive[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1915,6 +2340,75 @@ func (this *DealerDKG) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DealerReshare) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DealerReshare)
+	if !ok {
+		that2, ok := that.(DealerReshare)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochId != that1.EpochId {
+		return false
+	}
+	if this.FromEpochId != that1.FromEpochId {
+		return false
+	}
+	if this.Threshold != that1.Threshold {
+		return false
+	}
+	if this.ZeroSecret != that1.ZeroSecret {
+		return false
+	}
+	if len(this.Members) != len(that1.Members) {
+		return false
+	}
+	for i := range this.Members {
+		if !this.Members[i].Equal(&that1.Members[i]) {
+			return false
+		}
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.CommitDeadline != that1.CommitDeadline {
+		return false
+	}
+	if this.ShareDeadline != that1.ShareDeadline {
+		return false
+	}
+	if len(this.Commits) != len(that1.Commits) {
+		return false
+	}
+	for i := range this.Commits {
+		if !this.Commits[i].Equal(&that1.Commits[i]) {
+			return false
+		}
+	}
+	if len(this.EncryptedShares) != len(that1.EncryptedShares) {
+		return false
+	}
+	for i := range this.EncryptedShares {
+		if !this.EncryptedShares[i].Equal(&that1.EncryptedShares[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *DealerCiphertext) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2255,6 +2749,14 @@ func (this *DealerHand) Equal(that interface{}) bool {
 	if !bytes.Equal(this.InitHashSalt, that1.InitHashSalt) {
 		return false
 	}
+	if len(this.Shufflers) != len(that1.Shufflers) {
+		return false
+	}
+	for i := range this.Shufflers {
+		if this.Shufflers[i] != that1.Shufflers[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -5,6 +5,11 @@ const (
 	EventTypeDealerEpochBegun   = "DealerEpochBegun"
 	EventTypeDealerEpochFinal   = "DealerEpochFinalized"
 	EventTypeDealerEpochAborted = "DealerEpochAborted"
+	EventTypeDealerEpochRetired = "DealerEpochRetired"
+
+	// Emitted from the staking hooks when a committee member leaves the bonded set.
+	EventTypeDealerMemberInactive   = "DealerMemberInactive"
+	EventTypeDealerEpochRotationDue = "DealerEpochRotationDue"
 
 	EventTypeDKGCommitAccepted     = "DKGCommitAccepted"
 	EventTypeDKGComplaintAccepted  = "DKGComplaintAccepted"
@@ -12,6 +17,14 @@ const (
 	EventTypeDKGEncryptedShare     = "DKGEncryptedShareAccepted"
 	EventTypeDKGTimeoutApplied     = "DKGTimeoutApplied"
 	EventTypeDealerHandInitialized = "DealerHandInitialized"
+	EventTypeDealerDeckPrepared    = "DealerDeckPrepared"
+	EventTypeDealerDeckDiscarded   = "DealerDeckDiscarded"
+
+	EventTypeReshareBegun          = "ReshareBegun"
+	EventTypeReshareCommitAccepted = "ReshareCommitAccepted"
+	EventTypeReshareEncryptedShare = "ReshareEncryptedShareAccepted"
+	EventTypeReshareFinalized      = "ReshareFinalized"
+	EventTypeReshareAborted        = "ReshareAborted"
 
 	EventTypeShuffleAccepted   = "ShuffleAccepted"
 	EventTypeDeckFinalized     = "DeckFinalized"
@@ -23,6 +36,8 @@ const (
 
 	// ValidatorSlashed is also emitted by other modules; keep the legacy name for tooling.
 	EventTypeValidatorSlashed = "ValidatorSlashed"
+	// Emitted when part of a dealer slash is paid to the reporter or victims.
+	EventTypeSlashProceedsPaid = "DealerSlashProceedsPaid"
 
 	// Randomness beacon events.
 	EventTypeBeaconOpened    = "BeaconOpened"
@@ -30,4 +45,6 @@ const (
 	EventTypeBeaconRevealed  = "BeaconRevealed"
 	EventTypeBeaconFinalized = "BeaconFinalized"
 	EventTypeBeaconFallback  = "BeaconFallback"
+
+	EventTypeParamsUpdated = "DealerParamsUpdated"
 )
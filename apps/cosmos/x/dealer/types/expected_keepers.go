@@ -20,6 +20,8 @@ type StakingKeeper interface {
 	// GetBondedValidatorsByPower returns the active validator set, sorted by power.
 	// Used for "don't brick the chain" guards when jailing.
 	GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error)
+	// BondDenom is the denom slashing burns, used to measure slash proceeds.
+	BondDenom(ctx context.Context) (string, error)
 }
 
 type SlashingKeeper interface {
@@ -52,12 +54,35 @@ type PokerKeeper interface {
 	GetTable(ctx context.Context, tableID uint64) (*pokertypes.Table, error)
 	SetTable(ctx context.Context, t *pokertypes.Table) error
 
-	// AbortHandRefundAllCommits clears the active hand and refunds committed chips to stacks.
-	AbortHandRefundAllCommits(ctx context.Context, tableID, handID uint64, reason string) ([]sdk.Event, error)
+	// AbortHand clears the active hand, resolving committed chips under the table's abort refund policy.
+	AbortHand(ctx context.Context, tableID, handID uint64, reason string) ([]sdk.Event, error)
 
 	// ApplyDealerReveal applies a dealer reveal (board card or showdown hole card), and updates deadlines.
 	ApplyDealerReveal(ctx context.Context, tableID, handID uint64, pos uint32, cardID uint32, nowUnix int64) ([]sdk.Event, error)
 
 	// AdvanceAfterHoleSharesReady transitions out of SHUFFLE once encrypted hole shares are ready.
 	AdvanceAfterHoleSharesReady(ctx context.Context, tableID, handID uint64, nowUnix int64) error
+
+	// CheckNotPaused returns an error if the x/poker circuit breaker blocks a message
+	// of type msgTypeURL touching tableID (0 for none).
+	CheckNotPaused(ctx context.Context, msgTypeURL string, tableID uint64) error
+
+	// IterateTables walks all table ids; only used by the module simulation.
+	IterateTables(ctx context.Context, cb func(id uint64) (stop bool)) error
+}
+
+// AccountKeeper defines the expected account keeper; only used by the module simulation.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// BankKeeper defines the expected bank keeper. The keeper uses it to pay out
+// slash proceeds collected in the dealer module account; SpendableCoins is
+// only used by the module simulation.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
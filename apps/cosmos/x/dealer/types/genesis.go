@@ -8,6 +8,8 @@ func DefaultGenesisState() *GenesisState {
 		Epoch:       nil,
 		Dkg:         nil,
 		Params:      DefaultParams(),
+		Beacon:      nil,
+		Hands:       nil,
 	}
 }
 
@@ -25,6 +27,12 @@ func ValidateGenesis(gs *GenesisState) error {
 		if gs.Epoch.EpochId == 0 {
 			return fmt.Errorf("epoch_id must be > 0")
 		}
+		if gs.Epoch.EpochId >= gs.NextEpochId {
+			return fmt.Errorf("epoch_id %d >= next_epoch_id %d", gs.Epoch.EpochId, gs.NextEpochId)
+		}
+		if err := gs.Epoch.Validate(); err != nil {
+			return fmt.Errorf("epoch %d: %w", gs.Epoch.EpochId, err)
+		}
 	}
 	if gs.Dkg != nil {
 		if gs.Dkg.EpochId == 0 {
@@ -33,6 +41,74 @@ func ValidateGenesis(gs *GenesisState) error {
 		if gs.Dkg.EpochId >= gs.NextEpochId {
 			return fmt.Errorf("dkg epoch_id %d >= next_epoch_id %d", gs.Dkg.EpochId, gs.NextEpochId)
 		}
+		if gs.Epoch != nil && gs.Dkg.EpochId <= gs.Epoch.EpochId {
+			return fmt.Errorf("dkg epoch_id %d must be newer than active epoch %d", gs.Dkg.EpochId, gs.Epoch.EpochId)
+		}
+		if err := gs.Dkg.Validate(); err != nil {
+			return fmt.Errorf("dkg %d: %w", gs.Dkg.EpochId, err)
+		}
+	}
+	if gs.Beacon != nil {
+		if err := gs.Beacon.Validate(); err != nil {
+			return fmt.Errorf("beacon: %w", err)
+		}
+	}
+	if gs.Reshare != nil {
+		if gs.Epoch == nil || gs.Reshare.FromEpochId != gs.Epoch.EpochId {
+			return fmt.Errorf("reshare must refresh the active epoch")
+		}
+		if gs.Dkg != nil {
+			return fmt.Errorf("reshare and dkg cannot both be in flight")
+		}
+		if gs.Reshare.EpochId >= gs.NextEpochId {
+			return fmt.Errorf("reshare epoch_id %d >= next_epoch_id %d", gs.Reshare.EpochId, gs.NextEpochId)
+		}
+		if err := gs.Reshare.Validate(); err != nil {
+			return fmt.Errorf("reshare %d: %w", gs.Reshare.EpochId, err)
+		}
+		for _, c := range gs.Reshare.Commits {
+			found := false
+			for _, m := range gs.Epoch.Members {
+				found = found || m.Validator == c.Dealer
+			}
+			if !found {
+				return fmt.Errorf("reshare commit from %s, not a member of epoch %d", c.Dealer, gs.Epoch.EpochId)
+			}
+		}
+	}
+	retiring := make(map[uint64]bool, len(gs.RetiringEpochs))
+	for _, e := range gs.RetiringEpochs {
+		if e.EpochId == 0 {
+			return fmt.Errorf("retiring epoch_id must be > 0")
+		}
+		if gs.Epoch == nil || e.EpochId >= gs.Epoch.EpochId {
+			return fmt.Errorf("retiring epoch %d must be older than the active epoch", e.EpochId)
+		}
+		if retiring[e.EpochId] {
+			return fmt.Errorf("duplicate retiring epoch %d", e.EpochId)
+		}
+		retiring[e.EpochId] = true
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("retiring epoch %d: %w", e.EpochId, err)
+		}
+	}
+	type handKey struct{ tableID, handID uint64 }
+	seen := make(map[handKey]bool, len(gs.Hands))
+	for _, h := range gs.Hands {
+		if h.TableId == 0 || h.HandId == 0 {
+			return fmt.Errorf("hand table_id and hand_id must be > 0")
+		}
+		k := handKey{h.TableId, h.HandId}
+		if seen[k] {
+			return fmt.Errorf("duplicate dealer hand %d/%d", h.TableId, h.HandId)
+		}
+		seen[k] = true
+		if h.Hand.EpochId >= gs.NextEpochId {
+			return fmt.Errorf("dealer hand %d/%d: epoch_id %d >= next_epoch_id %d", h.TableId, h.HandId, h.Hand.EpochId, gs.NextEpochId)
+		}
+		if err := h.Hand.Validate(); err != nil {
+			return fmt.Errorf("dealer hand %d/%d: %w", h.TableId, h.HandId, err)
+		}
 	}
 	return nil
 }
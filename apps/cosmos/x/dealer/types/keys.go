@@ -17,6 +17,17 @@ var (
 	// for the upcoming epoch (see x/dealer/committee/beacon.go).
 	BeaconStateKey = []byte{0x05} // BeaconState
 
+	// RetiringEpochKeyPrefix stores superseded epochs that still have
+	// in-flight hands bound to them.
+	RetiringEpochKeyPrefix = []byte{0x06} // RetiringEpochKeyPrefix || u64be(epochID) -> DealerEpoch
+
+	// ReshareKey stores the in-flight proactive share refresh, if any.
+	ReshareKey = []byte{0x07} // DealerReshare
+
+	// EpochHandCountKeyPrefix counts the dealer hands bound to each epoch,
+	// so a superseded epoch is retired without scanning every hand.
+	EpochHandCountKeyPrefix = []byte{0x08} // EpochHandCountKeyPrefix || u64be(epochID) -> u64be
+
 	HandKeyPrefix = []byte{0x10} // HandKeyPrefix || u64be(tableID) || u64be(handID)
 )
 
@@ -27,3 +38,25 @@ func HandKey(tableID, handID uint64) []byte {
 	binary.BigEndian.PutUint64(bz[1+8:], handID)
 	return bz
 }
+
+func RetiringEpochKey(epochID uint64) []byte {
+	bz := make([]byte, 1+8)
+	bz[0] = RetiringEpochKeyPrefix[0]
+	binary.BigEndian.PutUint64(bz[1:], epochID)
+	return bz
+}
+
+func EpochHandCountKey(epochID uint64) []byte {
+	bz := make([]byte, 1+8)
+	bz[0] = EpochHandCountKeyPrefix[0]
+	binary.BigEndian.PutUint64(bz[1:], epochID)
+	return bz
+}
+
+// TableHandsPrefix is the HandKey prefix shared by every hand of a table.
+func TableHandsPrefix(tableID uint64) []byte {
+	bz := make([]byte, 1+8)
+	bz[0] = HandKeyPrefix[0]
+	binary.BigEndian.PutUint64(bz[1:], tableID)
+	return bz
+}
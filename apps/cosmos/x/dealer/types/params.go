@@ -13,6 +13,22 @@ const (
 	// DKG protocol versions. See docs/DKG-V2.md §6.
 	DkgVersionV1 uint32 = 1 // plaintext reveals accepted (+ encrypted shares)
 	DkgVersionV2 uint32 = 2 // only encrypted shares; plaintext rejected
+
+	// MinEpochThreshold is the smallest DKG threshold MsgBeginEpoch accepts;
+	// automatic rotation never derives a lower one.
+	MinEpochThreshold uint32 = 2
+
+	// maxEpochLengthBlocks bounds epoch_length_blocks to roughly a year at
+	// 6-second blocks.
+	maxEpochLengthBlocks uint64 = 5_256_000
+
+	// maxShuffleSlotSecs bounds shuffle_slot_secs; a slot longer than an hour
+	// defeats its purpose of not stalling hands on one slow member.
+	maxShuffleSlotSecs uint64 = 60 * 60
+
+	// MaxShuffleProofVersion is the newest shuffle proof version the chain
+	// verifies (ocpshuffle.ShuffleProofV3Version).
+	MaxShuffleProofVersion uint32 = 3
 )
 
 func DefaultParams() Params {
@@ -26,9 +42,38 @@ func DefaultParams() Params {
 		// v1 keeps the legacy reveal path alive so existing daemons continue
 		// to work. Governance flips to v2 once all dealers have migrated.
 		DkgVersion: DkgVersionV1,
+
+		// Automatic rotation is off until governance sets
+		// epoch_length_blocks (14_400 is about a day at 6-second blocks). It
+		// then uses a 5-member committee with a two-thirds threshold.
+		EpochLengthBlocks:   0,
+		TargetCommitteeSize: 5,
+		ThresholdBps:        6667,
+
+		// Every qualified member shuffles in the fixed QUAL order until
+		// governance sets min_shufflers to open the order.
+		MinShufflers:    0,
+		ShuffleSlotSecs: 0,
+
+		// Dealer slashes stay fully burned until governance sets a share
+		// for the reporter and for the players of the hand it aborted.
+		ReporterRewardBps:     0,
+		VictimCompensationBps: 0,
+
+		// Every proof version stays accepted until governance raises the
+		// floor; the dealer daemon still proves v1.
+		MinShuffleProofVersion: 0,
 	}
 }
 
+// EpochThreshold derives the DKG threshold for an automatically begun epoch
+// with the given committee size: ceil(size * threshold_bps / 10000), but at
+// least MinEpochThreshold.
+func (p Params) EpochThreshold(committeeSize uint32) uint32 {
+	t := uint32((uint64(committeeSize)*uint64(p.ThresholdBps) + uint64(MaxBps) - 1) / uint64(MaxBps))
+	return max(t, MinEpochThreshold)
+}
+
 // DkgVersionOrDefault treats a zero value as DkgVersionV1. This lets chains
 // that haven't explicitly set the param (e.g. pre-upgrade state) behave as
 // if the migration hasn't started, which is the safe default.
@@ -58,5 +103,30 @@ func (p Params) Validate() error {
 	if p.DkgVersion > DkgVersionV2 {
 		return fmt.Errorf("dkg_version must be 0 (legacy default), 1, or 2; got %d", p.DkgVersion)
 	}
+	if p.ThresholdBps > MaxBps {
+		return fmt.Errorf("threshold_bps must be <= %d", MaxBps)
+	}
+	if uint64(p.ReporterRewardBps)+uint64(p.VictimCompensationBps) > uint64(MaxBps) {
+		return fmt.Errorf("reporter_reward_bps + victim_compensation_bps must be <= %d", MaxBps)
+	}
+	if p.MinShuffleProofVersion > MaxShuffleProofVersion {
+		return fmt.Errorf("min_shuffle_proof_version must be <= %d; got %d", MaxShuffleProofVersion, p.MinShuffleProofVersion)
+	}
+	if p.ShuffleSlotSecs > maxShuffleSlotSecs {
+		return fmt.Errorf("shuffle_slot_secs too large: %d > %d", p.ShuffleSlotSecs, maxShuffleSlotSecs)
+	}
+	// Rotation settings are only checked while rotation is on, so chains
+	// upgrading with the new fields unset keep validating.
+	if p.EpochLengthBlocks != 0 {
+		if p.EpochLengthBlocks > maxEpochLengthBlocks {
+			return fmt.Errorf("epoch_length_blocks too large: %d > %d", p.EpochLengthBlocks, maxEpochLengthBlocks)
+		}
+		if p.TargetCommitteeSize < MinEpochThreshold {
+			return fmt.Errorf("target_committee_size must be >= %d", MinEpochThreshold)
+		}
+		if p.ThresholdBps == 0 {
+			return fmt.Errorf("threshold_bps must be > 0")
+		}
+	}
 	return nil
 }
@@ -0,0 +1,480 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+)
+
+// Wire sizes of stored dealer fields. These mirror the checks the msg server
+// applies on ingestion so that genesis cannot smuggle in state the chain
+// would never have written itself.
+const (
+	beaconDigestBytes    = 32
+	initHashSaltBytes    = 32
+	encShareBytes        = 2 * ocpcrypto.PointBytes
+	dkgEncShareProofSize = 160
+	maxDeckSize          = 52
+)
+
+func validatePoint(field string, b []byte) error {
+	if _, err := ocpcrypto.PointFromBytesCanonical(b); err != nil {
+		return fmt.Errorf("%s: %w", field, err)
+	}
+	return nil
+}
+
+func validateValoper(field, addr string) error {
+	if _, err := sdk.ValAddressFromBech32(addr); err != nil {
+		return fmt.Errorf("%s: invalid validator address %q: %w", field, addr, err)
+	}
+	return nil
+}
+
+// validateSortedSet checks that vals are valid operator addresses in strictly
+// increasing order (which also rules out duplicates).
+func validateSortedSet(field string, vals []string) error {
+	for i, v := range vals {
+		if err := validateValoper(field, v); err != nil {
+			return err
+		}
+		if i > 0 && vals[i-1] >= v {
+			return fmt.Errorf("%s must be sorted and unique", field)
+		}
+	}
+	return nil
+}
+
+// validateMembers checks a committee: canonical ordering by operator, unique
+// nonzero indices, and well-formed per-member key material. requirePubShare is
+// set for finalized epochs, where every member carries its derived pub share.
+func validateMembers(members []DealerMember, threshold uint32, requirePubShare bool) (map[string]DealerMember, error) {
+	if threshold < 2 {
+		return nil, fmt.Errorf("threshold must be >= 2")
+	}
+	if int(threshold) > len(members) {
+		return nil, fmt.Errorf("threshold %d exceeds committee size %d", threshold, len(members))
+	}
+	byVal := make(map[string]DealerMember, len(members))
+	seenIdx := make(map[uint32]bool, len(members))
+	for i, m := range members {
+		if err := validateValoper("member", m.Validator); err != nil {
+			return nil, err
+		}
+		if i > 0 && members[i-1].Validator >= m.Validator {
+			return nil, fmt.Errorf("members must be sorted by validator and unique")
+		}
+		if m.Index == 0 {
+			return nil, fmt.Errorf("member %s: index must be > 0", m.Validator)
+		}
+		if seenIdx[m.Index] {
+			return nil, fmt.Errorf("member %s: duplicate index %d", m.Validator, m.Index)
+		}
+		seenIdx[m.Index] = true
+		if m.Power <= 0 {
+			return nil, fmt.Errorf("member %s: power must be > 0", m.Validator)
+		}
+		if len(m.ConsPubkey) != 32 {
+			return nil, fmt.Errorf("member %s: cons_pubkey must be 32 bytes", m.Validator)
+		}
+		if requirePubShare || len(m.PubShare) != 0 {
+			if err := validatePoint(fmt.Sprintf("member %s pub_share", m.Validator), m.PubShare); err != nil {
+				return nil, err
+			}
+		}
+		if len(m.EphemeralPubkey) != 0 {
+			if err := validatePoint(fmt.Sprintf("member %s ephemeral_pubkey", m.Validator), m.EphemeralPubkey); err != nil {
+				return nil, err
+			}
+		}
+		byVal[m.Validator] = m
+	}
+	return byVal, nil
+}
+
+func validateMemberSet(name string, set []string, members map[string]DealerMember) error {
+	if err := validateSortedSet(name, set); err != nil {
+		return err
+	}
+	for _, v := range set {
+		if _, ok := members[v]; !ok {
+			return fmt.Errorf("%s validator %s is not a member", name, v)
+		}
+	}
+	return nil
+}
+
+// Validate checks a finalized dealer epoch.
+func (e DealerEpoch) Validate() error {
+	if e.EpochId == 0 {
+		return fmt.Errorf("epoch_id must be > 0")
+	}
+	if err := validatePoint("pk_epoch", e.PkEpoch); err != nil {
+		return err
+	}
+	if len(e.TranscriptRoot) != 32 {
+		return fmt.Errorf("transcript_root must be 32 bytes")
+	}
+	if e.StartHeight < 0 {
+		return fmt.Errorf("start_height must be >= 0")
+	}
+	members, err := validateMembers(e.Members, e.Threshold, true)
+	if err != nil {
+		return err
+	}
+	if err := validateMemberSet("slashed", e.Slashed, members); err != nil {
+		return err
+	}
+	return validateMemberSet("inactive", e.Inactive, members)
+}
+
+// Validate checks an in-flight DKG, including every commit, complaint,
+// reveal and encrypted share recorded so far.
+func (d DealerDKG) Validate() error {
+	if d.EpochId == 0 {
+		return fmt.Errorf("epoch_id must be > 0")
+	}
+	if d.StartHeight < 0 ||
+		d.CommitDeadline < d.StartHeight ||
+		d.ComplaintDeadline < d.CommitDeadline ||
+		d.RevealDeadline < d.ComplaintDeadline ||
+		d.FinalizeDeadline < d.RevealDeadline {
+		return fmt.Errorf("deadlines must be non-decreasing from start_height")
+	}
+	if len(d.RandEpoch) != 0 && len(d.RandEpoch) != 32 {
+		return fmt.Errorf("rand_epoch must be empty or 32 bytes")
+	}
+	members, err := validateMembers(d.Members, d.Threshold, false)
+	if err != nil {
+		return err
+	}
+	byIndex := make(map[uint32]string, len(members))
+	for _, m := range d.Members {
+		byIndex[m.Index] = m.Validator
+	}
+
+	committed := make(map[string]bool, len(d.Commits))
+	for i, c := range d.Commits {
+		if _, ok := members[c.Dealer]; !ok {
+			return fmt.Errorf("commit from non-member %s", c.Dealer)
+		}
+		if i > 0 && d.Commits[i-1].Dealer >= c.Dealer {
+			return fmt.Errorf("commits must be sorted by dealer and unique")
+		}
+		if len(c.Commitments) != int(d.Threshold) {
+			return fmt.Errorf("commit %s: expected %d commitments got %d", c.Dealer, d.Threshold, len(c.Commitments))
+		}
+		for j, cb := range c.Commitments {
+			if err := validatePoint(fmt.Sprintf("commit %s commitment[%d]", c.Dealer, j), cb); err != nil {
+				return err
+			}
+		}
+		committed[c.Dealer] = true
+	}
+
+	for i, c := range d.Complaints {
+		if c.EpochId != d.EpochId {
+			return fmt.Errorf("complaint %s/%s: epoch_id mismatch", c.Dealer, c.Complainer)
+		}
+		if _, ok := members[c.Dealer]; !ok {
+			return fmt.Errorf("complaint against non-member %s", c.Dealer)
+		}
+		if _, ok := members[c.Complainer]; !ok {
+			return fmt.Errorf("complaint from non-member %s", c.Complainer)
+		}
+		if c.Dealer == c.Complainer {
+			return fmt.Errorf("complaint %s: dealer cannot complain about itself", c.Dealer)
+		}
+		if c.Kind == "" {
+			return fmt.Errorf("complaint %s/%s: kind must be set", c.Dealer, c.Complainer)
+		}
+		if i > 0 {
+			p := d.Complaints[i-1]
+			if p.Dealer > c.Dealer || (p.Dealer == c.Dealer && p.Complainer >= c.Complainer) {
+				return fmt.Errorf("complaints must be sorted by (dealer, complainer) and unique")
+			}
+		}
+	}
+
+	for i, r := range d.Reveals {
+		if r.EpochId != d.EpochId {
+			return fmt.Errorf("reveal %s/%s: epoch_id mismatch", r.Dealer, r.To)
+		}
+		if !committed[r.Dealer] {
+			return fmt.Errorf("reveal %s/%s: dealer has not committed", r.Dealer, r.To)
+		}
+		if _, ok := members[r.To]; !ok {
+			return fmt.Errorf("reveal to non-member %s", r.To)
+		}
+		if _, err := ocpcrypto.ScalarFromBytesCanonical(r.Share); err != nil {
+			return fmt.Errorf("reveal %s/%s share: %w", r.Dealer, r.To, err)
+		}
+		if i > 0 {
+			p := d.Reveals[i-1]
+			if p.Dealer > r.Dealer || (p.Dealer == r.Dealer && p.To >= r.To) {
+				return fmt.Errorf("reveals must be sorted by (dealer, to) and unique")
+			}
+		}
+	}
+
+	for i, es := range d.EncryptedShares {
+		if !committed[es.Dealer] {
+			return fmt.Errorf("encrypted share %s/%d: dealer has not committed", es.Dealer, es.RecipientIndex)
+		}
+		to, ok := byIndex[es.RecipientIndex]
+		if !ok {
+			return fmt.Errorf("encrypted share %s/%d: recipient_index not in committee", es.Dealer, es.RecipientIndex)
+		}
+		if to == es.Dealer {
+			return fmt.Errorf("encrypted share %s/%d: dealer cannot address itself", es.Dealer, es.RecipientIndex)
+		}
+		if err := validatePoint("encrypted share u", es.U); err != nil {
+			return err
+		}
+		if err := validatePoint("encrypted share v", es.V); err != nil {
+			return err
+		}
+		if len(es.Proof) != dkgEncShareProofSize {
+			return fmt.Errorf("encrypted share %s/%d: proof must be %d bytes", es.Dealer, es.RecipientIndex, dkgEncShareProofSize)
+		}
+		if len(es.ScalarCt) != ocpcrypto.DkgScalarAeadCtBytes {
+			return fmt.Errorf("encrypted share %s/%d: scalar_ct must be %d bytes", es.Dealer, es.RecipientIndex, ocpcrypto.DkgScalarAeadCtBytes)
+		}
+		if i > 0 {
+			p := d.EncryptedShares[i-1]
+			if p.Dealer > es.Dealer || (p.Dealer == es.Dealer && p.RecipientIndex >= es.RecipientIndex) {
+				return fmt.Errorf("encrypted shares must be sorted by (dealer, recipient_index) and unique")
+			}
+		}
+	}
+
+	return validateMemberSet("slashed", d.Slashed, members)
+}
+
+// Validate checks an in-flight reshare. Whether each dealer belongs to the
+// source epoch is checked against that epoch in ValidateGenesis.
+func (r DealerReshare) Validate() error {
+	if r.EpochId == 0 || r.FromEpochId == 0 {
+		return fmt.Errorf("epoch_id and from_epoch_id must be > 0")
+	}
+	if r.EpochId <= r.FromEpochId {
+		return fmt.Errorf("epoch_id %d must be newer than from_epoch_id %d", r.EpochId, r.FromEpochId)
+	}
+	if r.StartHeight < 0 || r.CommitDeadline < r.StartHeight || r.ShareDeadline < r.CommitDeadline {
+		return fmt.Errorf("deadlines must be non-decreasing from start_height")
+	}
+	members, err := validateMembers(r.Members, r.Threshold, false)
+	if err != nil {
+		return err
+	}
+	byIndex := make(map[uint32]string, len(members))
+	for _, m := range r.Members {
+		byIndex[m.Index] = m.Validator
+	}
+
+	committed := make(map[string]bool, len(r.Commits))
+	for i, c := range r.Commits {
+		if err := validateValoper("reshare commit", c.Dealer); err != nil {
+			return err
+		}
+		if i > 0 && r.Commits[i-1].Dealer >= c.Dealer {
+			return fmt.Errorf("reshare commits must be sorted by dealer and unique")
+		}
+		if len(c.Commitments) != int(r.Threshold) {
+			return fmt.Errorf("reshare commit %s: expected %d commitments got %d", c.Dealer, r.Threshold, len(c.Commitments))
+		}
+		for j, cb := range c.Commitments {
+			if err := validatePoint(fmt.Sprintf("reshare commit %s commitment[%d]", c.Dealer, j), cb); err != nil {
+				return err
+			}
+		}
+		committed[c.Dealer] = true
+	}
+
+	for i, es := range r.EncryptedShares {
+		if !committed[es.Dealer] {
+			return fmt.Errorf("reshare share %s/%d: dealer has not committed", es.Dealer, es.RecipientIndex)
+		}
+		to, ok := byIndex[es.RecipientIndex]
+		if !ok {
+			return fmt.Errorf("reshare share %s/%d: recipient_index not in committee", es.Dealer, es.RecipientIndex)
+		}
+		if to == es.Dealer {
+			return fmt.Errorf("reshare share %s/%d: dealer cannot address itself", es.Dealer, es.RecipientIndex)
+		}
+		if err := validatePoint("reshare share u", es.U); err != nil {
+			return err
+		}
+		if err := validatePoint("reshare share v", es.V); err != nil {
+			return err
+		}
+		if len(es.Proof) != dkgEncShareProofSize {
+			return fmt.Errorf("reshare share %s/%d: proof must be %d bytes", es.Dealer, es.RecipientIndex, dkgEncShareProofSize)
+		}
+		if len(es.ScalarCt) != ocpcrypto.DkgScalarAeadCtBytes {
+			return fmt.Errorf("reshare share %s/%d: scalar_ct must be %d bytes", es.Dealer, es.RecipientIndex, ocpcrypto.DkgScalarAeadCtBytes)
+		}
+		if i > 0 {
+			p := r.EncryptedShares[i-1]
+			if p.Dealer > es.Dealer || (p.Dealer == es.Dealer && p.RecipientIndex >= es.RecipientIndex) {
+				return fmt.Errorf("reshare shares must be sorted by (dealer, recipient_index) and unique")
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks a randomness-beacon window.
+func (b BeaconState) Validate() error {
+	if b.EpochId == 0 {
+		return fmt.Errorf("epoch_id must be > 0")
+	}
+	if b.CommitOpenHeight < 0 ||
+		b.CommitCloseHeight < b.CommitOpenHeight ||
+		b.RevealCloseHeight < b.CommitCloseHeight {
+		return fmt.Errorf("window heights must be non-decreasing")
+	}
+	if b.Threshold < 2 {
+		return fmt.Errorf("threshold must be >= 2")
+	}
+	commits := make(map[string]bool, len(b.Commits))
+	for i, c := range b.Commits {
+		if err := validateValoper("beacon commit", c.Validator); err != nil {
+			return err
+		}
+		if i > 0 && b.Commits[i-1].Validator >= c.Validator {
+			return fmt.Errorf("beacon commits must be sorted by validator and unique")
+		}
+		if len(c.Commit) != beaconDigestBytes {
+			return fmt.Errorf("beacon commit %s: commit must be %d bytes", c.Validator, beaconDigestBytes)
+		}
+		commits[c.Validator] = true
+	}
+	for i, r := range b.Reveals {
+		if !commits[r.Validator] {
+			return fmt.Errorf("beacon reveal %s has no matching commit", r.Validator)
+		}
+		if i > 0 && b.Reveals[i-1].Validator >= r.Validator {
+			return fmt.Errorf("beacon reveals must be sorted by validator and unique")
+		}
+		if len(r.Salt) != beaconDigestBytes {
+			return fmt.Errorf("beacon reveal %s: salt must be %d bytes", r.Validator, beaconDigestBytes)
+		}
+	}
+	if len(b.Final) != 0 && len(b.Final) != beaconDigestBytes {
+		return fmt.Errorf("final must be empty or %d bytes", beaconDigestBytes)
+	}
+	return nil
+}
+
+// Validate checks a dealer hand: the encrypted deck and every share and
+// reveal recorded against it.
+func (h DealerHand) Validate() error {
+	if h.EpochId == 0 {
+		return fmt.Errorf("epoch_id must be > 0")
+	}
+	if err := validatePoint("pk_hand", h.PkHand); err != nil {
+		return err
+	}
+	if h.DeckSize < 2 || h.DeckSize > maxDeckSize {
+		return fmt.Errorf("invalid deck_size %d", h.DeckSize)
+	}
+	if len(h.Deck) != int(h.DeckSize) {
+		return fmt.Errorf("deck has %d ciphertexts, expected %d", len(h.Deck), h.DeckSize)
+	}
+	for i, ct := range h.Deck {
+		if err := validatePoint(fmt.Sprintf("deck[%d].c1", i), ct.C1); err != nil {
+			return err
+		}
+		if err := validatePoint(fmt.Sprintf("deck[%d].c2", i), ct.C2); err != nil {
+			return err
+		}
+	}
+	if h.ShuffleDeadline < 0 || h.HoleSharesDeadline < 0 || h.InitHeight < 0 {
+		return fmt.Errorf("deadlines and init_height must be >= 0")
+	}
+	if len(h.InitHashSalt) != 0 && len(h.InitHashSalt) != initHashSaltBytes {
+		return fmt.Errorf("init_hash_salt must be empty or %d bytes", initHashSaltBytes)
+	}
+	if len(h.Shufflers) != 0 && len(h.Shufflers) != int(h.ShuffleStep) {
+		return fmt.Errorf("shufflers has %d entries, expected shuffle_step %d", len(h.Shufflers), h.ShuffleStep)
+	}
+	for i, v := range h.Shufflers {
+		if err := validateValoper("shuffler", v); err != nil {
+			return err
+		}
+		for _, prev := range h.Shufflers[:i] {
+			if prev == v {
+				return fmt.Errorf("duplicate shuffler %s", v)
+			}
+		}
+	}
+	if !h.Finalized && (len(h.PubShares) != 0 || len(h.EncShares) != 0 || len(h.Reveals) != 0) {
+		return fmt.Errorf("shares and reveals require a finalized deck")
+	}
+
+	for i, ps := range h.PubShares {
+		if ps.Pos >= h.DeckSize {
+			return fmt.Errorf("pub share pos %d out of range", ps.Pos)
+		}
+		if err := validateValoper("pub share", ps.Validator); err != nil {
+			return err
+		}
+		if ps.Index == 0 {
+			return fmt.Errorf("pub share %d/%s: index must be > 0", ps.Pos, ps.Validator)
+		}
+		if err := validatePoint(fmt.Sprintf("pub share %d/%s", ps.Pos, ps.Validator), ps.Share); err != nil {
+			return err
+		}
+		if i > 0 {
+			p := h.PubShares[i-1]
+			if p.Pos > ps.Pos || (p.Pos == ps.Pos && p.Validator >= ps.Validator) {
+				return fmt.Errorf("pub shares must be sorted by (pos, validator) and unique")
+			}
+		}
+	}
+
+	for i, es := range h.EncShares {
+		if es.Pos >= h.DeckSize {
+			return fmt.Errorf("enc share pos %d out of range", es.Pos)
+		}
+		if err := validateValoper("enc share", es.Validator); err != nil {
+			return err
+		}
+		if es.Index == 0 {
+			return fmt.Errorf("enc share %d/%s: index must be > 0", es.Pos, es.Validator)
+		}
+		if err := validatePoint(fmt.Sprintf("enc share %d/%s pk_player", es.Pos, es.Validator), es.PkPlayer); err != nil {
+			return err
+		}
+		if len(es.EncShare) != encShareBytes {
+			return fmt.Errorf("enc share %d/%s: enc_share must be %d bytes", es.Pos, es.Validator, encShareBytes)
+		}
+		if i > 0 {
+			p := h.EncShares[i-1]
+			if p.Pos > es.Pos || (p.Pos == es.Pos && p.Validator >= es.Validator) {
+				return fmt.Errorf("enc shares must be sorted by (pos, validator) and unique")
+			}
+		}
+	}
+
+	seenCards := make(map[uint32]bool, len(h.Reveals))
+	for i, r := range h.Reveals {
+		if r.Pos >= h.DeckSize {
+			return fmt.Errorf("reveal pos %d out of range", r.Pos)
+		}
+		if r.CardId >= h.DeckSize {
+			return fmt.Errorf("reveal pos %d: card_id %d out of range", r.Pos, r.CardId)
+		}
+		if seenCards[r.CardId] {
+			return fmt.Errorf("card %d revealed twice", r.CardId)
+		}
+		seenCards[r.CardId] = true
+		if i > 0 && h.Reveals[i-1].Pos >= r.Pos {
+			return fmt.Errorf("reveals must be sorted by pos and unique")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+)
+
+func testPoint(n uint64) []byte {
+	return ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(n)).Bytes()
+}
+
+func testMembers(n int) []DealerMember {
+	out := make([]DealerMember, 0, n)
+	for i := 0; i < n; i++ {
+		out = append(out, DealerMember{
+			Validator:  sdk.ValAddress(bytes.Repeat([]byte{byte(0x10 + i)}, 20)).String(),
+			PubShare:   testPoint(uint64(100 + i)),
+			ConsPubkey: bytes.Repeat([]byte{byte(i + 1)}, 32),
+			Power:      1,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Validator < out[j].Validator })
+	for i := range out {
+		out[i].Index = uint32(i + 1)
+	}
+	return out
+}
+
+func testEpoch() *DealerEpoch {
+	return &DealerEpoch{
+		EpochId:        1,
+		Threshold:      2,
+		PkEpoch:        testPoint(7),
+		TranscriptRoot: make([]byte, 32),
+		Members:        testMembers(3),
+	}
+}
+
+func testHand() DealerHand {
+	deck := make([]DealerCiphertext, 4)
+	for i := range deck {
+		deck[i] = DealerCiphertext{C1: testPoint(uint64(10 + i)), C2: testPoint(uint64(20 + i))}
+	}
+	return DealerHand{
+		EpochId:      1,
+		PkHand:       testPoint(9),
+		DeckSize:     4,
+		Deck:         deck,
+		Finalized:    true,
+		InitHashSalt: make([]byte, 32),
+		Reveals:      []DealerReveal{{Pos: 0, CardId: 3}, {Pos: 2, CardId: 1}},
+	}
+}
+
+func testGenesis() *GenesisState {
+	gs := DefaultGenesisState()
+	gs.NextEpochId = 2
+	gs.Epoch = testEpoch()
+	gs.Hands = []GenesisDealerHand{{TableId: 1, HandId: 1, Hand: testHand()}}
+	return gs
+}
+
+func TestValidateGenesis_Valid(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+	require.NoError(t, ValidateGenesis(testGenesis()))
+}
+
+func TestValidateGenesis_RejectsMalformedState(t *testing.T) {
+	cases := map[string]func(gs *GenesisState){
+		"epoch not allocated": func(gs *GenesisState) { gs.NextEpochId = 1 },
+		"non-canonical pk_epoch": func(gs *GenesisState) {
+			gs.Epoch.PkEpoch = bytes.Repeat([]byte{0xff}, 32)
+		},
+		"unsorted members": func(gs *GenesisState) {
+			gs.Epoch.Members[0], gs.Epoch.Members[1] = gs.Epoch.Members[1], gs.Epoch.Members[0]
+		},
+		"duplicate member index": func(gs *GenesisState) { gs.Epoch.Members[1].Index = 1 },
+		"missing pub share":      func(gs *GenesisState) { gs.Epoch.Members[2].PubShare = nil },
+		"threshold above size":   func(gs *GenesisState) { gs.Epoch.Threshold = 4 },
+		"slashed non-member": func(gs *GenesisState) {
+			gs.Epoch.Slashed = []string{sdk.ValAddress(bytes.Repeat([]byte{0x77}, 20)).String()}
+		},
+		"stale dkg": func(gs *GenesisState) {
+			gs.Dkg = &DealerDKG{EpochId: 1, Threshold: 2, Members: testMembers(3)}
+		},
+		"beacon reveal without commit": func(gs *GenesisState) {
+			gs.Beacon = &BeaconState{
+				EpochId:   2,
+				Threshold: 2,
+				Reveals:   []BeaconRevealEntry{{Validator: gs.Epoch.Members[0].Validator, Salt: make([]byte, 32)}},
+			}
+		},
+		"short deck":          func(gs *GenesisState) { gs.Hands[0].Hand.Deck = gs.Hands[0].Hand.Deck[:3] },
+		"bad ciphertext":      func(gs *GenesisState) { gs.Hands[0].Hand.Deck[1].C2 = []byte{1} },
+		"future hand epoch":   func(gs *GenesisState) { gs.Hands[0].Hand.EpochId = 2 },
+		"init salt length":    func(gs *GenesisState) { gs.Hands[0].Hand.InitHashSalt = []byte{1} },
+		"unsorted reveals":    func(gs *GenesisState) { gs.Hands[0].Hand.Reveals[1].Pos = 0 },
+		"card out of deck":    func(gs *GenesisState) { gs.Hands[0].Hand.Reveals[0].CardId = 4 },
+		"shares before final": func(gs *GenesisState) { gs.Hands[0].Hand.Finalized = false },
+	}
+	for name, mutate := range cases {
+		t.Run(name, func(t *testing.T) {
+			gs := testGenesis()
+			mutate(gs)
+			require.Error(t, ValidateGenesis(gs))
+		})
+	}
+}
+
+func TestDealerDKGValidate(t *testing.T) {
+	members := testMembers(3)
+	for i := range members {
+		members[i].PubShare = nil
+	}
+	dkg := DealerDKG{
+		EpochId:           2,
+		Threshold:         2,
+		Members:           members,
+		StartHeight:       10,
+		CommitDeadline:    20,
+		ComplaintDeadline: 30,
+		RevealDeadline:    40,
+		FinalizeDeadline:  50,
+		Commits: []DealerDKGCommit{
+			{Dealer: members[0].Validator, Commitments: [][]byte{testPoint(1), testPoint(2)}},
+		},
+		Complaints: []DealerDKGComplaint{
+			{EpochId: 2, Dealer: members[0].Validator, Complainer: members[1].Validator, Kind: "missing"},
+		},
+	}
+	require.NoError(t, dkg.Validate())
+
+	dkg.Commits[0].Commitments = dkg.Commits[0].Commitments[:1]
+	require.Error(t, dkg.Validate())
+	dkg.Commits[0].Commitments = [][]byte{testPoint(1), testPoint(2)}
+
+	dkg.ComplaintDeadline = 15
+	require.Error(t, dkg.Validate())
+}
@@ -633,6 +633,344 @@ func (m *MsgDkgTimeoutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDkgTimeoutResponse proto.InternalMessageInfo
 
+type MsgReportDKGWithholding struct {
+	Reporter             string   `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	EpochId              uint64   `protobuf:"varint,2,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	Validator            string   `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgReportDKGWithholding) Reset()         { *m = MsgReportDKGWithholding{} }
+func (m *MsgReportDKGWithholding) String() string { return proto.CompactTextString(m) }
+func (*MsgReportDKGWithholding) ProtoMessage()    {}
+func (*MsgReportDKGWithholding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{18}
+}
+func (m *MsgReportDKGWithholding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgReportDKGWithholding.Unmarshal(m, b)
+}
+func (m *MsgReportDKGWithholding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgReportDKGWithholding.Marshal(b, m, deterministic)
+}
+func (m *MsgReportDKGWithholding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportDKGWithholding.Merge(m, src)
+}
+func (m *MsgReportDKGWithholding) XXX_Size() int {
+	return xxx_messageInfo_MsgReportDKGWithholding.Size(m)
+}
+func (m *MsgReportDKGWithholding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportDKGWithholding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportDKGWithholding proto.InternalMessageInfo
+
+type MsgReportDKGWithholdingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgReportDKGWithholdingResponse) Reset()         { *m = MsgReportDKGWithholdingResponse{} }
+func (m *MsgReportDKGWithholdingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportDKGWithholdingResponse) ProtoMessage()    {}
+func (*MsgReportDKGWithholdingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{19}
+}
+func (m *MsgReportDKGWithholdingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgReportDKGWithholdingResponse.Unmarshal(m, b)
+}
+func (m *MsgReportDKGWithholdingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgReportDKGWithholdingResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgReportDKGWithholdingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportDKGWithholdingResponse.Merge(m, src)
+}
+func (m *MsgReportDKGWithholdingResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgReportDKGWithholdingResponse.Size(m)
+}
+func (m *MsgReportDKGWithholdingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportDKGWithholdingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportDKGWithholdingResponse proto.InternalMessageInfo
+
+// MsgBeginReshare starts a share refresh of the active epoch. With no members
+// and threshold 0 it refreshes the current qualified committee in place; any
+// bonded validator may request that. Changing membership or threshold is
+// reserved to the module authority.
+type MsgBeginReshare struct {
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Must equal the active epoch id.
+	EpochId uint64 `protobuf:"varint,2,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// New committee (valoper addresses, must be bonded). Empty keeps the
+	// active epoch's qualified members.
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	// New threshold. 0 keeps the active epoch's threshold.
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Optional phase durations in blocks.
+	CommitBlocks         uint64   `protobuf:"varint,5,opt,name=commit_blocks,json=commitBlocks,proto3" json:"commit_blocks,omitempty"`
+	ShareBlocks          uint64   `protobuf:"varint,6,opt,name=share_blocks,json=shareBlocks,proto3" json:"share_blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgBeginReshare) Reset()         { *m = MsgBeginReshare{} }
+func (m *MsgBeginReshare) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginReshare) ProtoMessage()    {}
+func (*MsgBeginReshare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{20}
+}
+func (m *MsgBeginReshare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgBeginReshare.Unmarshal(m, b)
+}
+func (m *MsgBeginReshare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgBeginReshare.Marshal(b, m, deterministic)
+}
+func (m *MsgBeginReshare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginReshare.Merge(m, src)
+}
+func (m *MsgBeginReshare) XXX_Size() int {
+	return xxx_messageInfo_MsgBeginReshare.Size(m)
+}
+func (m *MsgBeginReshare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginReshare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBeginReshare proto.InternalMessageInfo
+
+type MsgBeginReshareResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgBeginReshareResponse) Reset()         { *m = MsgBeginReshareResponse{} }
+func (m *MsgBeginReshareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginReshareResponse) ProtoMessage()    {}
+func (*MsgBeginReshareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{21}
+}
+func (m *MsgBeginReshareResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgBeginReshareResponse.Unmarshal(m, b)
+}
+func (m *MsgBeginReshareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgBeginReshareResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgBeginReshareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginReshareResponse.Merge(m, src)
+}
+func (m *MsgBeginReshareResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgBeginReshareResponse.Size(m)
+}
+func (m *MsgBeginReshareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginReshareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBeginReshareResponse proto.InternalMessageInfo
+
+// MsgReshareCommit mirrors MsgDkgCommit for a reshare. Dealers (qualified
+// members of the active epoch) post threshold commitments; the constant term
+// must be the identity in refresh mode or the dealer's current pub share
+// otherwise. New members that are not dealers send only ephemeral_pubkey.
+type MsgReshareCommit struct {
+	Validator   string   `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	EpochId     uint64   `protobuf:"varint,2,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	Commitments [][]byte `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// Required for members of the new committee; 32 bytes canonical.
+	EphemeralPubkey      []byte   `protobuf:"bytes,4,opt,name=ephemeral_pubkey,json=ephemeralPubkey,proto3" json:"ephemeral_pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgReshareCommit) Reset()         { *m = MsgReshareCommit{} }
+func (m *MsgReshareCommit) String() string { return proto.CompactTextString(m) }
+func (*MsgReshareCommit) ProtoMessage()    {}
+func (*MsgReshareCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{22}
+}
+func (m *MsgReshareCommit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgReshareCommit.Unmarshal(m, b)
+}
+func (m *MsgReshareCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgReshareCommit.Marshal(b, m, deterministic)
+}
+func (m *MsgReshareCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReshareCommit.Merge(m, src)
+}
+func (m *MsgReshareCommit) XXX_Size() int {
+	return xxx_messageInfo_MsgReshareCommit.Size(m)
+}
+func (m *MsgReshareCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReshareCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReshareCommit proto.InternalMessageInfo
+
+type MsgReshareCommitResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgReshareCommitResponse) Reset()         { *m = MsgReshareCommitResponse{} }
+func (m *MsgReshareCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReshareCommitResponse) ProtoMessage()    {}
+func (*MsgReshareCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{23}
+}
+func (m *MsgReshareCommitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgReshareCommitResponse.Unmarshal(m, b)
+}
+func (m *MsgReshareCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgReshareCommitResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgReshareCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReshareCommitResponse.Merge(m, src)
+}
+func (m *MsgReshareCommitResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgReshareCommitResponse.Size(m)
+}
+func (m *MsgReshareCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReshareCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReshareCommitResponse proto.InternalMessageInfo
+
+// MsgReshareEncryptedShare mirrors MsgDkgEncryptedShare for a reshare: one
+// share from a dealer to one member of the new committee.
+type MsgReshareEncryptedShare struct {
+	Dealer               string   `protobuf:"bytes,1,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	EpochId              uint64   `protobuf:"varint,2,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	RecipientIndex       uint32   `protobuf:"varint,3,opt,name=recipient_index,json=recipientIndex,proto3" json:"recipient_index,omitempty"`
+	U                    []byte   `protobuf:"bytes,4,opt,name=u,proto3" json:"u,omitempty"`
+	V                    []byte   `protobuf:"bytes,5,opt,name=v,proto3" json:"v,omitempty"`
+	Proof                []byte   `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+	ScalarCt             []byte   `protobuf:"bytes,7,opt,name=scalar_ct,json=scalarCt,proto3" json:"scalar_ct,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgReshareEncryptedShare) Reset()         { *m = MsgReshareEncryptedShare{} }
+func (m *MsgReshareEncryptedShare) String() string { return proto.CompactTextString(m) }
+func (*MsgReshareEncryptedShare) ProtoMessage()    {}
+func (*MsgReshareEncryptedShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{24}
+}
+func (m *MsgReshareEncryptedShare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgReshareEncryptedShare.Unmarshal(m, b)
+}
+func (m *MsgReshareEncryptedShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgReshareEncryptedShare.Marshal(b, m, deterministic)
+}
+func (m *MsgReshareEncryptedShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReshareEncryptedShare.Merge(m, src)
+}
+func (m *MsgReshareEncryptedShare) XXX_Size() int {
+	return xxx_messageInfo_MsgReshareEncryptedShare.Size(m)
+}
+func (m *MsgReshareEncryptedShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReshareEncryptedShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReshareEncryptedShare proto.InternalMessageInfo
+
+type MsgReshareEncryptedShareResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgReshareEncryptedShareResponse) Reset()         { *m = MsgReshareEncryptedShareResponse{} }
+func (m *MsgReshareEncryptedShareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReshareEncryptedShareResponse) ProtoMessage()    {}
+func (*MsgReshareEncryptedShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{25}
+}
+func (m *MsgReshareEncryptedShareResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgReshareEncryptedShareResponse.Unmarshal(m, b)
+}
+func (m *MsgReshareEncryptedShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgReshareEncryptedShareResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgReshareEncryptedShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReshareEncryptedShareResponse.Merge(m, src)
+}
+func (m *MsgReshareEncryptedShareResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgReshareEncryptedShareResponse.Size(m)
+}
+func (m *MsgReshareEncryptedShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReshareEncryptedShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReshareEncryptedShareResponse proto.InternalMessageInfo
+
+type MsgFinalizeReshare struct {
+	Caller               string   `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	EpochId              uint64   `protobuf:"varint,2,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgFinalizeReshare) Reset()         { *m = MsgFinalizeReshare{} }
+func (m *MsgFinalizeReshare) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeReshare) ProtoMessage()    {}
+func (*MsgFinalizeReshare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{26}
+}
+func (m *MsgFinalizeReshare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgFinalizeReshare.Unmarshal(m, b)
+}
+func (m *MsgFinalizeReshare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgFinalizeReshare.Marshal(b, m, deterministic)
+}
+func (m *MsgFinalizeReshare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeReshare.Merge(m, src)
+}
+func (m *MsgFinalizeReshare) XXX_Size() int {
+	return xxx_messageInfo_MsgFinalizeReshare.Size(m)
+}
+func (m *MsgFinalizeReshare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeReshare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeReshare proto.InternalMessageInfo
+
+type MsgFinalizeReshareResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgFinalizeReshareResponse) Reset()         { *m = MsgFinalizeReshareResponse{} }
+func (m *MsgFinalizeReshareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeReshareResponse) ProtoMessage()    {}
+func (*MsgFinalizeReshareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{27}
+}
+func (m *MsgFinalizeReshareResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgFinalizeReshareResponse.Unmarshal(m, b)
+}
+func (m *MsgFinalizeReshareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgFinalizeReshareResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgFinalizeReshareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeReshareResponse.Merge(m, src)
+}
+func (m *MsgFinalizeReshareResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgFinalizeReshareResponse.Size(m)
+}
+func (m *MsgFinalizeReshareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeReshareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeReshareResponse proto.InternalMessageInfo
+
 // MsgOpenBeaconWindow is sent by any bonded validator (or a governance
 // account via typical Cosmos SDK patterns) to open a commit-reveal beacon
 // window for an upcoming epoch. The commit window begins at the block in
@@ -669,7 +1007,7 @@ func (m *MsgOpenBeaconWindow) Reset()         { *m = MsgOpenBeaconWindow{} }
 func (m *MsgOpenBeaconWindow) String() string { return proto.CompactTextString(m) }
 func (*MsgOpenBeaconWindow) ProtoMessage()    {}
 func (*MsgOpenBeaconWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{18}
+	return fileDescriptor_c5b1145576705eaf, []int{28}
 }
 func (m *MsgOpenBeaconWindow) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgOpenBeaconWindow.Unmarshal(m, b)
@@ -699,7 +1037,7 @@ func (m *MsgOpenBeaconWindowResponse) Reset()         { *m = MsgOpenBeaconWindow
 func (m *MsgOpenBeaconWindowResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOpenBeaconWindowResponse) ProtoMessage()    {}
 func (*MsgOpenBeaconWindowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{19}
+	return fileDescriptor_c5b1145576705eaf, []int{29}
 }
 func (m *MsgOpenBeaconWindowResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgOpenBeaconWindowResponse.Unmarshal(m, b)
@@ -736,7 +1074,7 @@ func (m *MsgBeaconCommit) Reset()         { *m = MsgBeaconCommit{} }
 func (m *MsgBeaconCommit) String() string { return proto.CompactTextString(m) }
 func (*MsgBeaconCommit) ProtoMessage()    {}
 func (*MsgBeaconCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{20}
+	return fileDescriptor_c5b1145576705eaf, []int{30}
 }
 func (m *MsgBeaconCommit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgBeaconCommit.Unmarshal(m, b)
@@ -766,7 +1104,7 @@ func (m *MsgBeaconCommitResponse) Reset()         { *m = MsgBeaconCommitResponse
 func (m *MsgBeaconCommitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBeaconCommitResponse) ProtoMessage()    {}
 func (*MsgBeaconCommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{21}
+	return fileDescriptor_c5b1145576705eaf, []int{31}
 }
 func (m *MsgBeaconCommitResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgBeaconCommitResponse.Unmarshal(m, b)
@@ -803,7 +1141,7 @@ func (m *MsgBeaconReveal) Reset()         { *m = MsgBeaconReveal{} }
 func (m *MsgBeaconReveal) String() string { return proto.CompactTextString(m) }
 func (*MsgBeaconReveal) ProtoMessage()    {}
 func (*MsgBeaconReveal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{22}
+	return fileDescriptor_c5b1145576705eaf, []int{32}
 }
 func (m *MsgBeaconReveal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgBeaconReveal.Unmarshal(m, b)
@@ -833,7 +1171,7 @@ func (m *MsgBeaconRevealResponse) Reset()         { *m = MsgBeaconRevealResponse
 func (m *MsgBeaconRevealResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBeaconRevealResponse) ProtoMessage()    {}
 func (*MsgBeaconRevealResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{23}
+	return fileDescriptor_c5b1145576705eaf, []int{33}
 }
 func (m *MsgBeaconRevealResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgBeaconRevealResponse.Unmarshal(m, b)
@@ -868,7 +1206,7 @@ func (m *MsgInitHand) Reset()         { *m = MsgInitHand{} }
 func (m *MsgInitHand) String() string { return proto.CompactTextString(m) }
 func (*MsgInitHand) ProtoMessage()    {}
 func (*MsgInitHand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{24}
+	return fileDescriptor_c5b1145576705eaf, []int{34}
 }
 func (m *MsgInitHand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgInitHand.Unmarshal(m, b)
@@ -898,7 +1236,7 @@ func (m *MsgInitHandResponse) Reset()         { *m = MsgInitHandResponse{} }
 func (m *MsgInitHandResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgInitHandResponse) ProtoMessage()    {}
 func (*MsgInitHandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{25}
+	return fileDescriptor_c5b1145576705eaf, []int{35}
 }
 func (m *MsgInitHandResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgInitHandResponse.Unmarshal(m, b)
@@ -933,7 +1271,7 @@ func (m *MsgSubmitShuffle) Reset()         { *m = MsgSubmitShuffle{} }
 func (m *MsgSubmitShuffle) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitShuffle) ProtoMessage()    {}
 func (*MsgSubmitShuffle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{26}
+	return fileDescriptor_c5b1145576705eaf, []int{36}
 }
 func (m *MsgSubmitShuffle) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgSubmitShuffle.Unmarshal(m, b)
@@ -963,7 +1301,7 @@ func (m *MsgSubmitShuffleResponse) Reset()         { *m = MsgSubmitShuffleRespon
 func (m *MsgSubmitShuffleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitShuffleResponse) ProtoMessage()    {}
 func (*MsgSubmitShuffleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{27}
+	return fileDescriptor_c5b1145576705eaf, []int{37}
 }
 func (m *MsgSubmitShuffleResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgSubmitShuffleResponse.Unmarshal(m, b)
@@ -996,7 +1334,7 @@ func (m *MsgFinalizeDeck) Reset()         { *m = MsgFinalizeDeck{} }
 func (m *MsgFinalizeDeck) String() string { return proto.CompactTextString(m) }
 func (*MsgFinalizeDeck) ProtoMessage()    {}
 func (*MsgFinalizeDeck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{28}
+	return fileDescriptor_c5b1145576705eaf, []int{38}
 }
 func (m *MsgFinalizeDeck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgFinalizeDeck.Unmarshal(m, b)
@@ -1026,7 +1364,7 @@ func (m *MsgFinalizeDeckResponse) Reset()         { *m = MsgFinalizeDeckResponse
 func (m *MsgFinalizeDeckResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFinalizeDeckResponse) ProtoMessage()    {}
 func (*MsgFinalizeDeckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{29}
+	return fileDescriptor_c5b1145576705eaf, []int{39}
 }
 func (m *MsgFinalizeDeckResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgFinalizeDeckResponse.Unmarshal(m, b)
@@ -1062,7 +1400,7 @@ func (m *MsgSubmitPubShare) Reset()         { *m = MsgSubmitPubShare{} }
 func (m *MsgSubmitPubShare) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitPubShare) ProtoMessage()    {}
 func (*MsgSubmitPubShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{30}
+	return fileDescriptor_c5b1145576705eaf, []int{40}
 }
 func (m *MsgSubmitPubShare) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgSubmitPubShare.Unmarshal(m, b)
@@ -1092,7 +1430,7 @@ func (m *MsgSubmitPubShareResponse) Reset()         { *m = MsgSubmitPubShareResp
 func (m *MsgSubmitPubShareResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitPubShareResponse) ProtoMessage()    {}
 func (*MsgSubmitPubShareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{31}
+	return fileDescriptor_c5b1145576705eaf, []int{41}
 }
 func (m *MsgSubmitPubShareResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgSubmitPubShareResponse.Unmarshal(m, b)
@@ -1129,7 +1467,7 @@ func (m *MsgSubmitEncShare) Reset()         { *m = MsgSubmitEncShare{} }
 func (m *MsgSubmitEncShare) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEncShare) ProtoMessage()    {}
 func (*MsgSubmitEncShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{32}
+	return fileDescriptor_c5b1145576705eaf, []int{42}
 }
 func (m *MsgSubmitEncShare) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgSubmitEncShare.Unmarshal(m, b)
@@ -1159,7 +1497,7 @@ func (m *MsgSubmitEncShareResponse) Reset()         { *m = MsgSubmitEncShareResp
 func (m *MsgSubmitEncShareResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEncShareResponse) ProtoMessage()    {}
 func (*MsgSubmitEncShareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{33}
+	return fileDescriptor_c5b1145576705eaf, []int{43}
 }
 func (m *MsgSubmitEncShareResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgSubmitEncShareResponse.Unmarshal(m, b)
@@ -1179,6 +1517,134 @@ func (m *MsgSubmitEncShareResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitEncShareResponse proto.InternalMessageInfo
 
+// EncShareEntry is one position of a MsgSubmitEncShares. Fields match the
+// corresponding MsgSubmitEncShare fields.
+type EncShareEntry struct {
+	Pos                  uint32   `protobuf:"varint,1,opt,name=pos,proto3" json:"pos,omitempty"`
+	PkPlayer             []byte   `protobuf:"bytes,2,opt,name=pk_player,json=pkPlayer,proto3" json:"pk_player,omitempty"`
+	EncShare             []byte   `protobuf:"bytes,3,opt,name=enc_share,json=encShare,proto3" json:"enc_share,omitempty"`
+	ProofEncShare        []byte   `protobuf:"bytes,4,opt,name=proof_enc_share,json=proofEncShare,proto3" json:"proof_enc_share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EncShareEntry) Reset()         { *m = EncShareEntry{} }
+func (m *EncShareEntry) String() string { return proto.CompactTextString(m) }
+func (*EncShareEntry) ProtoMessage()    {}
+func (*EncShareEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{44}
+}
+func (m *EncShareEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncShareEntry.Unmarshal(m, b)
+}
+func (m *EncShareEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncShareEntry.Marshal(b, m, deterministic)
+}
+func (m *EncShareEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncShareEntry.Merge(m, src)
+}
+func (m *EncShareEntry) XXX_Size() int {
+	return xxx_messageInfo_EncShareEntry.Size(m)
+}
+func (m *EncShareEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncShareEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncShareEntry proto.InternalMessageInfo
+
+func (m *EncShareEntry) GetPos() uint32 {
+	if m != nil {
+		return m.Pos
+	}
+	return 0
+}
+
+func (m *EncShareEntry) GetPkPlayer() []byte {
+	if m != nil {
+		return m.PkPlayer
+	}
+	return nil
+}
+
+func (m *EncShareEntry) GetEncShare() []byte {
+	if m != nil {
+		return m.EncShare
+	}
+	return nil
+}
+
+func (m *EncShareEntry) GetProofEncShare() []byte {
+	if m != nil {
+		return m.ProofEncShare
+	}
+	return nil
+}
+
+type MsgSubmitEncShares struct {
+	Validator            string          `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	TableId              uint64          `protobuf:"varint,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	HandId               uint64          `protobuf:"varint,3,opt,name=hand_id,json=handId,proto3" json:"hand_id,omitempty"`
+	Shares               []EncShareEntry `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MsgSubmitEncShares) Reset()         { *m = MsgSubmitEncShares{} }
+func (m *MsgSubmitEncShares) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEncShares) ProtoMessage()    {}
+func (*MsgSubmitEncShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{45}
+}
+func (m *MsgSubmitEncShares) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSubmitEncShares.Unmarshal(m, b)
+}
+func (m *MsgSubmitEncShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSubmitEncShares.Marshal(b, m, deterministic)
+}
+func (m *MsgSubmitEncShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEncShares.Merge(m, src)
+}
+func (m *MsgSubmitEncShares) XXX_Size() int {
+	return xxx_messageInfo_MsgSubmitEncShares.Size(m)
+}
+func (m *MsgSubmitEncShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEncShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitEncShares proto.InternalMessageInfo
+
+type MsgSubmitEncSharesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgSubmitEncSharesResponse) Reset()         { *m = MsgSubmitEncSharesResponse{} }
+func (m *MsgSubmitEncSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEncSharesResponse) ProtoMessage()    {}
+func (*MsgSubmitEncSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{46}
+}
+func (m *MsgSubmitEncSharesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSubmitEncSharesResponse.Unmarshal(m, b)
+}
+func (m *MsgSubmitEncSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSubmitEncSharesResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgSubmitEncSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEncSharesResponse.Merge(m, src)
+}
+func (m *MsgSubmitEncSharesResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgSubmitEncSharesResponse.Size(m)
+}
+func (m *MsgSubmitEncSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEncSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitEncSharesResponse proto.InternalMessageInfo
+
 type MsgFinalizeReveal struct {
 	Caller               string   `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
 	TableId              uint64   `protobuf:"varint,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
@@ -1193,7 +1659,7 @@ func (m *MsgFinalizeReveal) Reset()         { *m = MsgFinalizeReveal{} }
 func (m *MsgFinalizeReveal) String() string { return proto.CompactTextString(m) }
 func (*MsgFinalizeReveal) ProtoMessage()    {}
 func (*MsgFinalizeReveal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{34}
+	return fileDescriptor_c5b1145576705eaf, []int{47}
 }
 func (m *MsgFinalizeReveal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgFinalizeReveal.Unmarshal(m, b)
@@ -1223,7 +1689,7 @@ func (m *MsgFinalizeRevealResponse) Reset()         { *m = MsgFinalizeRevealResp
 func (m *MsgFinalizeRevealResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFinalizeRevealResponse) ProtoMessage()    {}
 func (*MsgFinalizeRevealResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{35}
+	return fileDescriptor_c5b1145576705eaf, []int{48}
 }
 func (m *MsgFinalizeRevealResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgFinalizeRevealResponse.Unmarshal(m, b)
@@ -1256,7 +1722,7 @@ func (m *MsgTimeout) Reset()         { *m = MsgTimeout{} }
 func (m *MsgTimeout) String() string { return proto.CompactTextString(m) }
 func (*MsgTimeout) ProtoMessage()    {}
 func (*MsgTimeout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{36}
+	return fileDescriptor_c5b1145576705eaf, []int{49}
 }
 func (m *MsgTimeout) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgTimeout.Unmarshal(m, b)
@@ -1286,7 +1752,7 @@ func (m *MsgTimeoutResponse) Reset()         { *m = MsgTimeoutResponse{} }
 func (m *MsgTimeoutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTimeoutResponse) ProtoMessage()    {}
 func (*MsgTimeoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5b1145576705eaf, []int{37}
+	return fileDescriptor_c5b1145576705eaf, []int{50}
 }
 func (m *MsgTimeoutResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgTimeoutResponse.Unmarshal(m, b)
@@ -1306,33 +1772,173 @@ func (m *MsgTimeoutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTimeoutResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgBeginEpoch)(nil), "onchainpoker.dealer.v1.MsgBeginEpoch")
-	proto.RegisterType((*MsgBeginEpochResponse)(nil), "onchainpoker.dealer.v1.MsgBeginEpochResponse")
-	proto.RegisterType((*MsgDkgCommit)(nil), "onchainpoker.dealer.v1.MsgDkgCommit")
-	proto.RegisterType((*MsgDkgCommitResponse)(nil), "onchainpoker.dealer.v1.MsgDkgCommitResponse")
-	proto.RegisterType((*MsgDkgComplaintMissing)(nil), "onchainpoker.dealer.v1.MsgDkgComplaintMissing")
-	proto.RegisterType((*MsgDkgComplaintMissingResponse)(nil), "onchainpoker.dealer.v1.MsgDkgComplaintMissingResponse")
-	proto.RegisterType((*MsgDkgComplaintInvalid)(nil), "onchainpoker.dealer.v1.MsgDkgComplaintInvalid")
-	proto.RegisterType((*MsgDkgComplaintInvalidResponse)(nil), "onchainpoker.dealer.v1.MsgDkgComplaintInvalidResponse")
-	proto.RegisterType((*MsgDkgComplaintAEADBad)(nil), "onchainpoker.dealer.v1.MsgDkgComplaintAEADBad")
-	proto.RegisterType((*MsgDkgComplaintAEADBadResponse)(nil), "onchainpoker.dealer.v1.MsgDkgComplaintAEADBadResponse")
-	proto.RegisterType((*MsgDkgShareReveal)(nil), "onchainpoker.dealer.v1.MsgDkgShareReveal")
-	proto.RegisterType((*MsgDkgShareRevealResponse)(nil), "onchainpoker.dealer.v1.MsgDkgShareRevealResponse")
-	proto.RegisterType((*MsgDkgEncryptedShare)(nil), "onchainpoker.dealer.v1.MsgDkgEncryptedShare")
-	proto.RegisterType((*MsgDkgEncryptedShareResponse)(nil), "onchainpoker.dealer.v1.MsgDkgEncryptedShareResponse")
-	proto.RegisterType((*MsgFinalizeEpoch)(nil), "onchainpoker.dealer.v1.MsgFinalizeEpoch")
-	proto.RegisterType((*MsgFinalizeEpochResponse)(nil), "onchainpoker.dealer.v1.MsgFinalizeEpochResponse")
-	proto.RegisterType((*MsgDkgTimeout)(nil), "onchainpoker.dealer.v1.MsgDkgTimeout")
-	proto.RegisterType((*MsgDkgTimeoutResponse)(nil), "onchainpoker.dealer.v1.MsgDkgTimeoutResponse")
-	proto.RegisterType((*MsgOpenBeaconWindow)(nil), "onchainpoker.dealer.v1.MsgOpenBeaconWindow")
-	proto.RegisterType((*MsgOpenBeaconWindowResponse)(nil), "onchainpoker.dealer.v1.MsgOpenBeaconWindowResponse")
-	proto.RegisterType((*MsgBeaconCommit)(nil), "onchainpoker.dealer.v1.MsgBeaconCommit")
-	proto.RegisterType((*MsgBeaconCommitResponse)(nil), "onchainpoker.dealer.v1.MsgBeaconCommitResponse")
-	proto.RegisterType((*MsgBeaconReveal)(nil), "onchainpoker.dealer.v1.MsgBeaconReveal")
-	proto.RegisterType((*MsgBeaconRevealResponse)(nil), "onchainpoker.dealer.v1.MsgBeaconRevealResponse")
-	proto.RegisterType((*MsgInitHand)(nil), "onchainpoker.dealer.v1.MsgInitHand")
-	proto.RegisterType((*MsgInitHandResponse)(nil), "onchainpoker.dealer.v1.MsgInitHandResponse")
+type MsgReportDealerWithholding struct {
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	TableId  uint64 `protobuf:"varint,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	HandId   uint64 `protobuf:"varint,3,opt,name=hand_id,json=handId,proto3" json:"hand_id,omitempty"`
+	// A dealt hole card position during the hole-share phase, or the position
+	// awaiting reveal.
+	Pos                  uint32   `protobuf:"varint,4,opt,name=pos,proto3" json:"pos,omitempty"`
+	Validator            string   `protobuf:"bytes,5,opt,name=validator,proto3" json:"validator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgReportDealerWithholding) Reset()         { *m = MsgReportDealerWithholding{} }
+func (m *MsgReportDealerWithholding) String() string { return proto.CompactTextString(m) }
+func (*MsgReportDealerWithholding) ProtoMessage()    {}
+func (*MsgReportDealerWithholding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{51}
+}
+func (m *MsgReportDealerWithholding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgReportDealerWithholding.Unmarshal(m, b)
+}
+func (m *MsgReportDealerWithholding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgReportDealerWithholding.Marshal(b, m, deterministic)
+}
+func (m *MsgReportDealerWithholding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportDealerWithholding.Merge(m, src)
+}
+func (m *MsgReportDealerWithholding) XXX_Size() int {
+	return xxx_messageInfo_MsgReportDealerWithholding.Size(m)
+}
+func (m *MsgReportDealerWithholding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportDealerWithholding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportDealerWithholding proto.InternalMessageInfo
+
+type MsgReportDealerWithholdingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgReportDealerWithholdingResponse) Reset()         { *m = MsgReportDealerWithholdingResponse{} }
+func (m *MsgReportDealerWithholdingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportDealerWithholdingResponse) ProtoMessage()    {}
+func (*MsgReportDealerWithholdingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{52}
+}
+func (m *MsgReportDealerWithholdingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgReportDealerWithholdingResponse.Unmarshal(m, b)
+}
+func (m *MsgReportDealerWithholdingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgReportDealerWithholdingResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgReportDealerWithholdingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportDealerWithholdingResponse.Merge(m, src)
+}
+func (m *MsgReportDealerWithholdingResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgReportDealerWithholdingResponse.Size(m)
+}
+func (m *MsgReportDealerWithholdingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportDealerWithholdingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportDealerWithholdingResponse proto.InternalMessageInfo
+
+type MsgUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params replaces all module params; every field must be set.
+	Params               Params   `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{53}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgUpdateParams.Unmarshal(m, b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return xxx_messageInfo_MsgUpdateParams.Size(m)
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+type MsgUpdateParamsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b1145576705eaf, []int{54}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgUpdateParamsResponse.Unmarshal(m, b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgUpdateParamsResponse.Size(m)
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgBeginEpoch)(nil), "onchainpoker.dealer.v1.MsgBeginEpoch")
+	proto.RegisterType((*MsgBeginEpochResponse)(nil), "onchainpoker.dealer.v1.MsgBeginEpochResponse")
+	proto.RegisterType((*MsgDkgCommit)(nil), "onchainpoker.dealer.v1.MsgDkgCommit")
+	proto.RegisterType((*MsgDkgCommitResponse)(nil), "onchainpoker.dealer.v1.MsgDkgCommitResponse")
+	proto.RegisterType((*MsgDkgComplaintMissing)(nil), "onchainpoker.dealer.v1.MsgDkgComplaintMissing")
+	proto.RegisterType((*MsgDkgComplaintMissingResponse)(nil), "onchainpoker.dealer.v1.MsgDkgComplaintMissingResponse")
+	proto.RegisterType((*MsgDkgComplaintInvalid)(nil), "onchainpoker.dealer.v1.MsgDkgComplaintInvalid")
+	proto.RegisterType((*MsgDkgComplaintInvalidResponse)(nil), "onchainpoker.dealer.v1.MsgDkgComplaintInvalidResponse")
+	proto.RegisterType((*MsgDkgComplaintAEADBad)(nil), "onchainpoker.dealer.v1.MsgDkgComplaintAEADBad")
+	proto.RegisterType((*MsgDkgComplaintAEADBadResponse)(nil), "onchainpoker.dealer.v1.MsgDkgComplaintAEADBadResponse")
+	proto.RegisterType((*MsgDkgShareReveal)(nil), "onchainpoker.dealer.v1.MsgDkgShareReveal")
+	proto.RegisterType((*MsgDkgShareRevealResponse)(nil), "onchainpoker.dealer.v1.MsgDkgShareRevealResponse")
+	proto.RegisterType((*MsgDkgEncryptedShare)(nil), "onchainpoker.dealer.v1.MsgDkgEncryptedShare")
+	proto.RegisterType((*MsgDkgEncryptedShareResponse)(nil), "onchainpoker.dealer.v1.MsgDkgEncryptedShareResponse")
+	proto.RegisterType((*MsgFinalizeEpoch)(nil), "onchainpoker.dealer.v1.MsgFinalizeEpoch")
+	proto.RegisterType((*MsgFinalizeEpochResponse)(nil), "onchainpoker.dealer.v1.MsgFinalizeEpochResponse")
+	proto.RegisterType((*MsgDkgTimeout)(nil), "onchainpoker.dealer.v1.MsgDkgTimeout")
+	proto.RegisterType((*MsgDkgTimeoutResponse)(nil), "onchainpoker.dealer.v1.MsgDkgTimeoutResponse")
+	proto.RegisterType((*MsgReportDKGWithholding)(nil), "onchainpoker.dealer.v1.MsgReportDKGWithholding")
+	proto.RegisterType((*MsgReportDKGWithholdingResponse)(nil), "onchainpoker.dealer.v1.MsgReportDKGWithholdingResponse")
+	proto.RegisterType((*MsgBeginReshare)(nil), "onchainpoker.dealer.v1.MsgBeginReshare")
+	proto.RegisterType((*MsgBeginReshareResponse)(nil), "onchainpoker.dealer.v1.MsgBeginReshareResponse")
+	proto.RegisterType((*MsgReshareCommit)(nil), "onchainpoker.dealer.v1.MsgReshareCommit")
+	proto.RegisterType((*MsgReshareCommitResponse)(nil), "onchainpoker.dealer.v1.MsgReshareCommitResponse")
+	proto.RegisterType((*MsgReshareEncryptedShare)(nil), "onchainpoker.dealer.v1.MsgReshareEncryptedShare")
+	proto.RegisterType((*MsgReshareEncryptedShareResponse)(nil), "onchainpoker.dealer.v1.MsgReshareEncryptedShareResponse")
+	proto.RegisterType((*MsgFinalizeReshare)(nil), "onchainpoker.dealer.v1.MsgFinalizeReshare")
+	proto.RegisterType((*MsgFinalizeReshareResponse)(nil), "onchainpoker.dealer.v1.MsgFinalizeReshareResponse")
+	proto.RegisterType((*MsgOpenBeaconWindow)(nil), "onchainpoker.dealer.v1.MsgOpenBeaconWindow")
+	proto.RegisterType((*MsgOpenBeaconWindowResponse)(nil), "onchainpoker.dealer.v1.MsgOpenBeaconWindowResponse")
+	proto.RegisterType((*MsgBeaconCommit)(nil), "onchainpoker.dealer.v1.MsgBeaconCommit")
+	proto.RegisterType((*MsgBeaconCommitResponse)(nil), "onchainpoker.dealer.v1.MsgBeaconCommitResponse")
+	proto.RegisterType((*MsgBeaconReveal)(nil), "onchainpoker.dealer.v1.MsgBeaconReveal")
+	proto.RegisterType((*MsgBeaconRevealResponse)(nil), "onchainpoker.dealer.v1.MsgBeaconRevealResponse")
+	proto.RegisterType((*MsgInitHand)(nil), "onchainpoker.dealer.v1.MsgInitHand")
+	proto.RegisterType((*MsgInitHandResponse)(nil), "onchainpoker.dealer.v1.MsgInitHandResponse")
 	proto.RegisterType((*MsgSubmitShuffle)(nil), "onchainpoker.dealer.v1.MsgSubmitShuffle")
 	proto.RegisterType((*MsgSubmitShuffleResponse)(nil), "onchainpoker.dealer.v1.MsgSubmitShuffleResponse")
 	proto.RegisterType((*MsgFinalizeDeck)(nil), "onchainpoker.dealer.v1.MsgFinalizeDeck")
@@ -1341,113 +1947,147 @@ func init() {
 	proto.RegisterType((*MsgSubmitPubShareResponse)(nil), "onchainpoker.dealer.v1.MsgSubmitPubShareResponse")
 	proto.RegisterType((*MsgSubmitEncShare)(nil), "onchainpoker.dealer.v1.MsgSubmitEncShare")
 	proto.RegisterType((*MsgSubmitEncShareResponse)(nil), "onchainpoker.dealer.v1.MsgSubmitEncShareResponse")
+	proto.RegisterType((*EncShareEntry)(nil), "onchainpoker.dealer.v1.EncShareEntry")
+	proto.RegisterType((*MsgSubmitEncShares)(nil), "onchainpoker.dealer.v1.MsgSubmitEncShares")
+	proto.RegisterType((*MsgSubmitEncSharesResponse)(nil), "onchainpoker.dealer.v1.MsgSubmitEncSharesResponse")
 	proto.RegisterType((*MsgFinalizeReveal)(nil), "onchainpoker.dealer.v1.MsgFinalizeReveal")
 	proto.RegisterType((*MsgFinalizeRevealResponse)(nil), "onchainpoker.dealer.v1.MsgFinalizeRevealResponse")
 	proto.RegisterType((*MsgTimeout)(nil), "onchainpoker.dealer.v1.MsgTimeout")
 	proto.RegisterType((*MsgTimeoutResponse)(nil), "onchainpoker.dealer.v1.MsgTimeoutResponse")
+	proto.RegisterType((*MsgReportDealerWithholding)(nil), "onchainpoker.dealer.v1.MsgReportDealerWithholding")
+	proto.RegisterType((*MsgReportDealerWithholdingResponse)(nil), "onchainpoker.dealer.v1.MsgReportDealerWithholdingResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "onchainpoker.dealer.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "onchainpoker.dealer.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("onchainpoker/dealer/v1/tx.proto", fileDescriptor_c5b1145576705eaf) }
 
 var fileDescriptor_c5b1145576705eaf = []byte{
-	// 1548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x1b, 0xd5,
-	0x16, 0x7f, 0x13, 0xe7, 0xc3, 0x3e, 0x75, 0x3e, 0x3a, 0x4d, 0x13, 0xc7, 0xe9, 0x87, 0x9f, 0xf3,
-	0xfa, 0x92, 0xb4, 0xaf, 0x71, 0xf3, 0x8a, 0x90, 0x88, 0x84, 0x50, 0xd2, 0x04, 0x91, 0x85, 0x45,
-	0xe4, 0x20, 0x10, 0x08, 0xc9, 0x1a, 0xcf, 0xdc, 0x8c, 0x47, 0x1e, 0xcf, 0x0c, 0x73, 0xc7, 0x6e,
-	0x53, 0x09, 0x09, 0xc1, 0xa6, 0x4b, 0x36, 0x2c, 0x90, 0x58, 0xb0, 0x84, 0x5d, 0x85, 0xba, 0x64,
-	0x05, 0x12, 0x7f, 0x40, 0x59, 0xb2, 0x83, 0x45, 0x37, 0x48, 0xac, 0xd8, 0xa3, 0xfb, 0xe9, 0x19,
-	0xcf, 0x74, 0x3c, 0x6e, 0x9b, 0xaa, 0x3b, 0xdf, 0x33, 0xbf, 0xb9, 0xe7, 0xfc, 0x7e, 0xe7, 0xdc,
-	0x33, 0xe7, 0x26, 0x70, 0xd5, 0x75, 0xf4, 0xb6, 0x66, 0x39, 0x9e, 0xdb, 0x41, 0x7e, 0xcd, 0x40,
-	0x9a, 0x8d, 0xfc, 0x5a, 0x7f, 0xbb, 0x16, 0xdc, 0xdb, 0xf2, 0x7c, 0x37, 0x70, 0xd5, 0xa5, 0x30,
-	0x60, 0x8b, 0x01, 0xb6, 0xfa, 0xdb, 0xe5, 0x45, 0xd3, 0x35, 0x5d, 0x0a, 0xa9, 0x91, 0x5f, 0x0c,
-	0x5d, 0x5e, 0xd6, 0x5d, 0xdc, 0x75, 0x71, 0xad, 0x8b, 0x4d, 0xb2, 0x4b, 0x17, 0x9b, 0xfc, 0xc1,
-	0x0a, 0x7b, 0xd0, 0x64, 0x6f, 0xb0, 0x05, 0x7f, 0xb4, 0xf6, 0x94, 0x10, 0xb8, 0x2f, 0x0a, 0xaa,
-	0xfe, 0x35, 0x01, 0xb3, 0x75, 0x6c, 0xee, 0x21, 0xd3, 0x72, 0x0e, 0x3c, 0x57, 0x6f, 0xab, 0xb7,
-	0x60, 0x5a, 0xd7, 0x6c, 0x1b, 0xf9, 0x25, 0xa5, 0xa2, 0x6c, 0x14, 0xf6, 0x4a, 0x8f, 0x1f, 0xdd,
-	0x5c, 0xe4, 0x1b, 0xef, 0x1a, 0x86, 0x8f, 0x30, 0x3e, 0x0e, 0x7c, 0xcb, 0x31, 0x1b, 0x1c, 0xa7,
-	0xae, 0x40, 0x1e, 0x91, 0x57, 0x9b, 0x96, 0x51, 0x9a, 0xa8, 0x28, 0x1b, 0x93, 0x8d, 0x19, 0xba,
-	0x3e, 0x34, 0xd4, 0x6b, 0x30, 0xa7, 0xbb, 0xdd, 0xae, 0x15, 0x04, 0x08, 0x35, 0xb1, 0x75, 0x1f,
-	0x95, 0x72, 0x15, 0x65, 0x63, 0xb6, 0x31, 0x2b, 0xad, 0xc7, 0xd6, 0x7d, 0xa4, 0x5e, 0x82, 0x42,
-	0xd0, 0xf6, 0x11, 0x6e, 0xbb, 0xb6, 0x51, 0x9a, 0xa4, 0x88, 0x81, 0x41, 0xbd, 0x0c, 0xe0, 0x6b,
-	0x8e, 0xd1, 0xa4, 0x9b, 0x96, 0xa6, 0x2a, 0xca, 0x46, 0xb1, 0x51, 0x20, 0x16, 0x16, 0xf0, 0x1a,
-	0xf0, 0xdd, 0x9a, 0x2d, 0xdb, 0xd5, 0x3b, 0xb8, 0x34, 0x4d, 0x63, 0x28, 0x32, 0xe3, 0x1e, 0xb5,
-	0xa9, 0x9b, 0xb0, 0xa0, 0xbb, 0x5d, 0xcf, 0xd6, 0x2c, 0x47, 0xe2, 0x66, 0x28, 0x6e, 0x5e, 0xda,
-	0x39, 0x74, 0x0d, 0x66, 0x7d, 0xd4, 0x47, 0x9a, 0x2d, 0x70, 0x79, 0xb6, 0x1f, 0x33, 0x72, 0xd0,
-	0x3a, 0xcc, 0x9f, 0x58, 0x8e, 0x66, 0x5b, 0xf7, 0x91, 0x80, 0x15, 0x28, 0x6c, 0x4e, 0x98, 0x19,
-	0x70, 0x67, 0xfe, 0xc1, 0xb7, 0x57, 0xff, 0xf5, 0xf9, 0x93, 0x87, 0xd7, 0xb9, 0x5a, 0xd5, 0x65,
-	0xb8, 0x18, 0x11, 0xbc, 0x81, 0xb0, 0xe7, 0x3a, 0x18, 0x55, 0x7f, 0x52, 0xa0, 0x58, 0xc7, 0xe6,
-	0x7e, 0xc7, 0xbc, 0x43, 0x23, 0x57, 0xdf, 0x80, 0x69, 0x96, 0x2b, 0x9e, 0x89, 0x7f, 0x3f, 0x7e,
-	0x74, 0xf3, 0x32, 0xcf, 0xc4, 0xfb, 0x9a, 0x6d, 0x19, 0x5a, 0xe0, 0xfa, 0x43, 0x29, 0x61, 0x2f,
-	0xa4, 0xa5, 0xa4, 0x02, 0xe7, 0x98, 0x32, 0x5d, 0xe4, 0x04, 0xb8, 0x94, 0xab, 0xe4, 0x36, 0x8a,
-	0x8d, 0xb0, 0x89, 0x68, 0x85, 0xbc, 0x36, 0xea, 0x22, 0x5f, 0xb3, 0x9b, 0x5e, 0xaf, 0xd5, 0x41,
-	0xa7, 0x34, 0x29, 0xc5, 0xc6, 0xbc, 0xb4, 0x1f, 0x51, 0x73, 0x88, 0x1d, 0x73, 0x5c, 0x5d, 0x82,
-	0xc5, 0x30, 0x07, 0x49, 0xee, 0x17, 0x05, 0x96, 0xe4, 0x03, 0x26, 0x77, 0xdd, 0xc2, 0xd8, 0x72,
-	0x4c, 0x75, 0x17, 0x40, 0xa4, 0x60, 0x1c, 0xaa, 0xa1, 0x97, 0xd2, 0xe8, 0x0e, 0x44, 0xcc, 0x8d,
-	0x29, 0xe2, 0xce, 0x05, 0x41, 0x2e, 0xe4, 0xaa, 0x5a, 0x81, 0x2b, 0xc9, 0x3c, 0x24, 0xd5, 0x3f,
-	0xe2, 0x54, 0x0f, 0x9d, 0x3e, 0x71, 0xf5, 0xca, 0x52, 0x55, 0x57, 0xa1, 0x80, 0xdb, 0x9a, 0x8f,
-	0x9a, 0x5d, 0x6c, 0xf2, 0x5c, 0xe7, 0xa9, 0xa1, 0x8e, 0xcd, 0xac, 0x3a, 0x70, 0x92, 0x52, 0x87,
-	0xef, 0x27, 0x62, 0x3a, 0xec, 0x1e, 0xec, 0xee, 0xef, 0x69, 0xaf, 0xb0, 0x0e, 0xeb, 0x30, 0xef,
-	0x23, 0xdd, 0xf2, 0x2c, 0xe4, 0x04, 0x4d, 0xcb, 0x31, 0xd0, 0x3d, 0xde, 0x8e, 0xe6, 0xa4, 0xf9,
-	0x90, 0x58, 0x89, 0x7b, 0xa3, 0xdd, 0xa4, 0x12, 0xf1, 0x8e, 0x34, 0x63, 0xb4, 0x8f, 0xc9, 0x92,
-	0xb4, 0x2b, 0xc3, 0x46, 0x9f, 0x90, 0x96, 0xec, 0x9e, 0xd0, 0x66, 0x54, 0x6c, 0x14, 0x88, 0xe5,
-	0x88, 0x18, 0xb2, 0xaa, 0xc9, 0xa5, 0x92, 0x6a, 0xfe, 0xac, 0xc0, 0x79, 0x06, 0xa1, 0x5e, 0x1a,
-	0xb4, 0x19, 0x9d, 0x51, 0x8b, 0xd8, 0x86, 0x89, 0xc0, 0xcd, 0x2e, 0xde, 0x44, 0xe0, 0xaa, 0x8b,
-	0x30, 0xc5, 0xc4, 0x60, 0xc5, 0xc3, 0x16, 0xf1, 0xf6, 0xb0, 0x0a, 0x2b, 0x31, 0x12, 0x92, 0xe2,
-	0xdf, 0x8a, 0x68, 0x1e, 0x07, 0x8e, 0xee, 0x9f, 0x7a, 0x01, 0x32, 0x98, 0xa2, 0x67, 0xc3, 0x32,
-	0x21, 0xd7, 0xb9, 0xc4, 0x5c, 0x17, 0x41, 0xe9, 0x71, 0x5e, 0x4a, 0x8f, 0xac, 0xfa, 0x3c, 0xe5,
-	0x4a, 0x9f, 0xf0, 0x0e, 0xe7, 0x99, 0x2d, 0xe8, 0x71, 0xd2, 0x35, 0x5b, 0xf3, 0x9b, 0x7a, 0x40,
-	0x3f, 0x33, 0xe4, 0x38, 0x51, 0xc3, 0x9d, 0x20, 0x2e, 0xca, 0x15, 0xb8, 0x94, 0x44, 0x5b, 0xea,
-	0xe2, 0xc1, 0x42, 0x1d, 0x9b, 0x6f, 0xf3, 0xef, 0xca, 0x8b, 0xff, 0x4a, 0xc7, 0xbf, 0x51, 0x65,
-	0x28, 0x0d, 0x7b, 0x94, 0xd1, 0x74, 0xe9, 0xc0, 0xb0, 0xdf, 0x31, 0xdf, 0xb3, 0xba, 0xc8, 0xed,
-	0x05, 0x67, 0x1c, 0x0a, 0xfb, 0x5c, 0x0e, 0xdc, 0xc9, 0x38, 0x7e, 0x53, 0xe0, 0x42, 0x1d, 0x9b,
-	0xef, 0x7a, 0xc8, 0xd9, 0x43, 0x9a, 0xee, 0x3a, 0x1f, 0x58, 0x8e, 0xe1, 0xde, 0x7d, 0xb1, 0xf3,
-	0x4b, 0x6c, 0xb6, 0xc8, 0x25, 0xcc, 0x16, 0xb1, 0x81, 0x61, 0x32, 0x61, 0x60, 0x88, 0x8c, 0x38,
-	0x53, 0x43, 0x23, 0x4e, 0x9c, 0xf6, 0x65, 0x58, 0x4d, 0x20, 0x27, 0xc9, 0x7f, 0xad, 0xc0, 0x3c,
-	0x9d, 0x22, 0xc8, 0x33, 0x3e, 0x2e, 0xbc, 0x05, 0x85, 0xbe, 0x38, 0x0c, 0xd9, 0x0f, 0xca, 0xe0,
-	0x9d, 0x34, 0x1d, 0x96, 0x60, 0x9a, 0x51, 0xa6, 0x02, 0x14, 0x1b, 0x7c, 0xb5, 0xa3, 0x8a, 0xb8,
-	0x07, 0xdb, 0x54, 0x57, 0x60, 0x79, 0x28, 0x34, 0x19, 0xf6, 0x57, 0xe1, 0xb0, 0x79, 0x0b, 0x3b,
-	0xcb, 0xb0, 0x55, 0x98, 0xc4, 0x9a, 0x2d, 0x82, 0xa6, 0xbf, 0x47, 0x86, 0x3c, 0xd4, 0x94, 0x7e,
-	0x54, 0xe0, 0x5c, 0x1d, 0x9b, 0x87, 0x8e, 0x15, 0xbc, 0xa3, 0x39, 0xc6, 0xb3, 0x95, 0x57, 0xa0,
-	0xb5, 0x6c, 0x14, 0x8a, 0x8f, 0xae, 0x0f, 0x0d, 0x75, 0x19, 0x66, 0xda, 0x64, 0xb2, 0xb5, 0x0c,
-	0x5e, 0x58, 0xd3, 0x64, 0x79, 0x68, 0x44, 0x38, 0x4d, 0x46, 0x39, 0xad, 0x42, 0xc1, 0x40, 0x7a,
-	0x87, 0x4d, 0xd3, 0xac, 0x90, 0xf2, 0xc4, 0x40, 0x06, 0xe9, 0x78, 0x1d, 0x5d, 0xa4, 0x87, 0x44,
-	0x44, 0x2f, 0x59, 0xfd, 0xaa, 0xd0, 0x9e, 0x72, 0xdc, 0x6b, 0x75, 0xad, 0xe0, 0xb8, 0xdd, 0x3b,
-	0x39, 0xb1, 0x91, 0xfa, 0x26, 0xe4, 0x31, 0xfb, 0x39, 0x46, 0x22, 0xe4, 0x2b, 0xcf, 0xc4, 0x73,
-	0x11, 0xa6, 0x7c, 0xb7, 0xe7, 0x88, 0xa1, 0x9f, 0x2d, 0xc8, 0x81, 0xa2, 0x7d, 0xb4, 0xc9, 0xf7,
-	0xe6, 0xed, 0xb6, 0x48, 0x8d, 0x3c, 0xda, 0x9d, 0xf3, 0x82, 0xaa, 0x8c, 0x80, 0xb7, 0xad, 0x08,
-	0x29, 0xc9, 0xf8, 0x01, 0x2b, 0x3d, 0xd1, 0xd3, 0xf6, 0x91, 0xde, 0x79, 0x39, 0xb9, 0x8c, 0xe7,
-	0x84, 0x55, 0x5b, 0x38, 0x12, 0x19, 0xe5, 0x9f, 0xec, 0x2b, 0xcf, 0x28, 0x1c, 0xf5, 0x5a, 0xec,
-	0xfb, 0xf7, 0x22, 0x8e, 0xc8, 0xd8, 0xa9, 0x59, 0x80, 0x9c, 0xe7, 0x62, 0x9e, 0x18, 0xf2, 0x93,
-	0x54, 0x9e, 0xd7, 0x6b, 0x45, 0x86, 0x9e, 0xbc, 0x27, 0x62, 0xbc, 0x0a, 0xe7, 0x44, 0xce, 0xc8,
-	0x63, 0xf6, 0x39, 0x04, 0x9e, 0x31, 0x32, 0x0b, 0x24, 0x9d, 0x3b, 0x36, 0x0e, 0x44, 0xd9, 0x4a,
-	0x2d, 0xbe, 0x9c, 0x08, 0x69, 0x71, 0xe0, 0xe8, 0xaf, 0x9c, 0x16, 0x9d, 0xa6, 0x67, 0x6b, 0xa7,
-	0xc8, 0x97, 0x5a, 0x74, 0x8e, 0xe8, 0x9a, 0x3c, 0x44, 0x8e, 0x1e, 0x51, 0x22, 0x8f, 0x04, 0x81,
-	0xff, 0xc2, 0x3c, 0x13, 0x6a, 0x00, 0x61, 0x13, 0x02, 0xab, 0x79, 0x41, 0x74, 0xa4, 0x5e, 0x02,
-	0x28, 0xf5, 0xfa, 0x86, 0xd5, 0x8e, 0xa8, 0x2b, 0xde, 0x5e, 0x5f, 0x4e, 0xbf, 0x8a, 0x09, 0x14,
-	0xaf, 0x7a, 0x16, 0x7b, 0x34, 0x3a, 0x19, 0xfb, 0x17, 0x0a, 0x40, 0x1d, 0x3f, 0xdf, 0x48, 0xf1,
-	0xfc, 0x07, 0x73, 0x11, 0xd4, 0x41, 0x10, 0x22, 0xb6, 0xff, 0xff, 0xb0, 0x00, 0xb9, 0x3a, 0x36,
-	0xd5, 0x16, 0x40, 0xe8, 0xcf, 0x24, 0xd7, 0xb6, 0x92, 0xff, 0x80, 0xb3, 0x15, 0xb9, 0xdc, 0x97,
-	0x6f, 0x66, 0x82, 0x09, 0x5f, 0x6a, 0x13, 0x0a, 0x83, 0xfb, 0xff, 0x7f, 0x52, 0xde, 0x95, 0xa8,
-	0xf2, 0xff, 0xb2, 0xa0, 0xa4, 0x83, 0x4f, 0xe1, 0x42, 0xd2, 0x1d, 0x7c, 0x6b, 0xe4, 0x26, 0x11,
-	0x7c, 0xf9, 0xf5, 0xf1, 0xf0, 0x4f, 0x73, 0x2f, 0xee, 0xc5, 0x59, 0xdd, 0x73, 0x7c, 0x66, 0xf7,
-	0x43, 0x57, 0xd2, 0x61, 0xf7, 0xe2, 0x3a, 0x9a, 0xd5, 0x3d, 0xc7, 0x67, 0x76, 0x3f, 0x74, 0x87,
-	0x53, 0x1d, 0x98, 0x1b, 0xba, 0xbf, 0x6d, 0xa6, 0xef, 0x14, 0x82, 0x96, 0xb7, 0x33, 0x43, 0xa5,
-	0xbf, 0xbb, 0x70, 0x3e, 0x7e, 0x99, 0x1a, 0x51, 0x2f, 0x51, 0x74, 0xf9, 0xb5, 0x71, 0xd0, 0xd2,
-	0x71, 0x07, 0x66, 0xa3, 0xd7, 0x95, 0x8d, 0x94, 0x6d, 0x22, 0xc8, 0xf2, 0xad, 0xac, 0x48, 0xe9,
-	0xac, 0x05, 0x10, 0xba, 0x8d, 0x5c, 0x4b, 0x0f, 0x98, 0xc3, 0x52, 0xcf, 0x65, 0xfc, 0xb2, 0xa1,
-	0x06, 0xb0, 0x10, 0xbb, 0x68, 0xdc, 0x48, 0xd9, 0x62, 0x18, 0x5c, 0xbe, 0x3d, 0x06, 0x58, 0x7a,
-	0x6d, 0x43, 0x31, 0x32, 0xe1, 0xaf, 0xa7, 0x36, 0x93, 0x01, 0xb0, 0x5c, 0xcb, 0x08, 0x8c, 0x7b,
-	0xe2, 0x75, 0x39, 0xda, 0x13, 0xaf, 0xca, 0x5a, 0x46, 0xa0, 0xf4, 0xf4, 0x31, 0xe4, 0xe5, 0x2c,
-	0xbd, 0x96, 0xf2, 0xb2, 0x00, 0x95, 0x6f, 0x64, 0x00, 0x85, 0x0b, 0x2f, 0x3a, 0xd3, 0xa6, 0x15,
-	0x5e, 0x04, 0x99, 0x5a, 0x78, 0x89, 0x23, 0x25, 0x11, 0x2d, 0x32, 0x4e, 0xae, 0x67, 0x28, 0x5d,
-	0x02, 0x4c, 0x15, 0x2d, 0x69, 0x2c, 0x24, 0x8d, 0x63, 0x68, 0x24, 0xdc, 0x1c, 0x19, 0xad, 0x80,
-	0xa6, 0x36, 0x8e, 0xe4, 0xd1, 0x6b, 0xe0, 0x4f, 0x8e, 0x5d, 0xa3, 0xfd, 0x09, 0x68, 0x06, 0x7f,
-	0xc3, 0xa3, 0x0b, 0xf1, 0x37, 0x34, 0xb6, 0x6c, 0x66, 0x90, 0x28, 0x43, 0x63, 0x4c, 0x1e, 0x37,
-	0xd4, 0x0f, 0x61, 0x46, 0xf4, 0x8b, 0x6a, 0xca, 0xdb, 0xa2, 0x59, 0x5c, 0x1f, 0x8d, 0x11, 0x5b,
-	0x97, 0xa7, 0x3e, 0x7b, 0xf2, 0xf0, 0xba, 0xb2, 0xb7, 0xf8, 0xdd, 0xef, 0x57, 0x94, 0x8f, 0xe6,
-	0xee, 0x89, 0xff, 0xbb, 0x04, 0xa7, 0x1e, 0xc2, 0xad, 0x69, 0xfa, 0x4f, 0x97, 0xdb, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xab, 0x89, 0x4e, 0x0d, 0x1e, 0x1a, 0x00, 0x00,
+	// 1975 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4f, 0x6c, 0x1b, 0x59,
+	0x19, 0xdf, 0x89, 0xf3, 0xc7, 0xfe, 0xe2, 0xc4, 0xed, 0xd4, 0x6d, 0xdc, 0xe9, 0x9f, 0xb8, 0xee,
+	0x96, 0xba, 0x59, 0x1a, 0x37, 0xd9, 0x55, 0x81, 0x0a, 0x84, 0x9a, 0x26, 0x40, 0x84, 0x2c, 0x22,
+	0x07, 0x58, 0x81, 0x90, 0xac, 0xf1, 0xcc, 0xeb, 0x78, 0xe4, 0xf1, 0xcc, 0xec, 0xbc, 0x71, 0xb6,
+	0xa9, 0x84, 0xb4, 0x62, 0x2f, 0xcb, 0x8d, 0x0b, 0x07, 0x04, 0x07, 0x8e, 0x70, 0xdb, 0xc3, 0x1e,
+	0x11, 0x07, 0x90, 0x10, 0x37, 0xa4, 0xe5, 0x88, 0xc4, 0x61, 0x39, 0xec, 0x05, 0xb1, 0x27, 0x0e,
+	0xdc, 0xd0, 0xbc, 0x7f, 0x7e, 0xe3, 0x19, 0x8f, 0xc7, 0xdb, 0xa4, 0x54, 0xe2, 0xe6, 0xf7, 0xcd,
+	0xef, 0xbd, 0xef, 0xff, 0xf7, 0xbd, 0xef, 0x25, 0xb0, 0xe9, 0xb9, 0x46, 0x5f, 0xb7, 0x5d, 0xdf,
+	0x1b, 0xa0, 0xa0, 0x65, 0x22, 0xdd, 0x41, 0x41, 0xeb, 0x64, 0xa7, 0x15, 0x3e, 0xdb, 0xf6, 0x03,
+	0x2f, 0xf4, 0xd4, 0x2b, 0x32, 0x60, 0x9b, 0x02, 0xb6, 0x4f, 0x76, 0xb4, 0xaa, 0xe5, 0x59, 0x1e,
+	0x81, 0xb4, 0xa2, 0x5f, 0x14, 0xad, 0x6d, 0x18, 0x1e, 0x1e, 0x7a, 0xb8, 0x35, 0xc4, 0x56, 0x74,
+	0xca, 0x10, 0x5b, 0xec, 0xc3, 0x55, 0xfa, 0xa1, 0x4b, 0x77, 0xd0, 0x05, 0xfb, 0x74, 0x7b, 0x8a,
+	0x08, 0x8c, 0x17, 0x01, 0x35, 0x3e, 0x5b, 0x80, 0xb5, 0x36, 0xb6, 0xf6, 0x90, 0x65, 0xbb, 0x07,
+	0xbe, 0x67, 0xf4, 0xd5, 0x07, 0xb0, 0x6c, 0xe8, 0x8e, 0x83, 0x82, 0x9a, 0x52, 0x57, 0x9a, 0xa5,
+	0xbd, 0xda, 0xc7, 0x1f, 0xdd, 0xaf, 0xb2, 0x83, 0x1f, 0x9b, 0x66, 0x80, 0x30, 0x3e, 0x0e, 0x03,
+	0xdb, 0xb5, 0x3a, 0x0c, 0xa7, 0x5e, 0x85, 0x22, 0x8a, 0xb6, 0x76, 0x6d, 0xb3, 0xb6, 0x50, 0x57,
+	0x9a, 0x8b, 0x9d, 0x15, 0xb2, 0x3e, 0x34, 0xd5, 0x3b, 0xb0, 0x6e, 0x78, 0xc3, 0xa1, 0x1d, 0x86,
+	0x08, 0x75, 0xb1, 0xfd, 0x1c, 0xd5, 0x0a, 0x75, 0xa5, 0xb9, 0xd6, 0x59, 0x13, 0xd4, 0x63, 0xfb,
+	0x39, 0x52, 0xaf, 0x43, 0x29, 0xec, 0x07, 0x08, 0xf7, 0x3d, 0xc7, 0xac, 0x2d, 0x12, 0xc4, 0x98,
+	0xa0, 0xde, 0x00, 0x08, 0x74, 0xd7, 0xec, 0x92, 0x43, 0x6b, 0x4b, 0x75, 0xa5, 0x59, 0xee, 0x94,
+	0x22, 0x0a, 0x15, 0xf8, 0x36, 0xb0, 0xd3, 0xba, 0x3d, 0xc7, 0x33, 0x06, 0xb8, 0xb6, 0x4c, 0x64,
+	0x28, 0x53, 0xe2, 0x1e, 0xa1, 0xa9, 0xf7, 0xe0, 0x82, 0xe1, 0x0d, 0x7d, 0x47, 0xb7, 0x5d, 0x81,
+	0x5b, 0x21, 0xb8, 0x8a, 0xa0, 0x33, 0xe8, 0x6d, 0x58, 0x0b, 0xd0, 0x09, 0xd2, 0x1d, 0x8e, 0x2b,
+	0xd2, 0xf3, 0x28, 0x91, 0x81, 0xee, 0x42, 0xe5, 0xa9, 0xed, 0xea, 0x8e, 0xfd, 0x1c, 0x71, 0x58,
+	0x89, 0xc0, 0xd6, 0x39, 0x99, 0x02, 0x1f, 0x55, 0x3e, 0xf8, 0xf5, 0xe6, 0x6b, 0x3f, 0xf9, 0xf4,
+	0xc3, 0x2d, 0x66, 0xad, 0xc6, 0x06, 0x5c, 0x8e, 0x19, 0xbc, 0x83, 0xb0, 0xef, 0xb9, 0x18, 0x35,
+	0xfe, 0xa0, 0x40, 0xb9, 0x8d, 0xad, 0xfd, 0x81, 0xf5, 0x84, 0x48, 0xae, 0x7e, 0x05, 0x96, 0xa9,
+	0xaf, 0x98, 0x27, 0x6e, 0x7d, 0xfc, 0xd1, 0xfd, 0x1b, 0xcc, 0x13, 0xdf, 0xd7, 0x1d, 0xdb, 0xd4,
+	0x43, 0x2f, 0x98, 0x70, 0x09, 0xdd, 0x90, 0xe5, 0x92, 0x3a, 0xac, 0x52, 0xcb, 0x0c, 0x91, 0x1b,
+	0xe2, 0x5a, 0xa1, 0x5e, 0x68, 0x96, 0x3b, 0x32, 0x29, 0xb2, 0x15, 0xf2, 0xfb, 0x68, 0x88, 0x02,
+	0xdd, 0xe9, 0xfa, 0xa3, 0xde, 0x00, 0x9d, 0x12, 0xa7, 0x94, 0x3b, 0x15, 0x41, 0x3f, 0x22, 0x64,
+	0x49, 0x3b, 0xca, 0xb8, 0x71, 0x05, 0xaa, 0xb2, 0x0e, 0x42, 0xb9, 0x3f, 0x29, 0x70, 0x45, 0x7c,
+	0xa0, 0xe6, 0x6e, 0xdb, 0x18, 0xdb, 0xae, 0xa5, 0x3e, 0x06, 0xe0, 0x2e, 0x98, 0x47, 0x55, 0x69,
+	0x53, 0x96, 0xba, 0x63, 0x23, 0x16, 0xe6, 0x34, 0xe2, 0xa3, 0x4b, 0x5c, 0x39, 0x89, 0x55, 0xa3,
+	0x0e, 0x37, 0xd3, 0xf5, 0x10, 0xaa, 0xfe, 0x23, 0xa9, 0xea, 0xa1, 0x7b, 0x12, 0xb1, 0x7a, 0x65,
+	0x55, 0x55, 0xaf, 0x41, 0x09, 0xf7, 0xf5, 0x00, 0x75, 0x87, 0xd8, 0x62, 0xbe, 0x2e, 0x12, 0x42,
+	0x1b, 0x5b, 0x79, 0xed, 0xc0, 0x94, 0x14, 0x76, 0xf8, 0xed, 0x42, 0xc2, 0x0e, 0x8f, 0x0f, 0x1e,
+	0xef, 0xef, 0xe9, 0xaf, 0xb0, 0x1d, 0xee, 0x42, 0x25, 0x40, 0x86, 0xed, 0xdb, 0xc8, 0x0d, 0xbb,
+	0xb6, 0x6b, 0xa2, 0x67, 0xac, 0x1c, 0xad, 0x0b, 0xf2, 0x61, 0x44, 0x8d, 0xd8, 0x9b, 0xfd, 0x2e,
+	0x31, 0x11, 0xab, 0x48, 0x2b, 0x66, 0xff, 0x38, 0x5a, 0x46, 0xe5, 0xca, 0x74, 0xd0, 0x3b, 0x51,
+	0x49, 0xf6, 0x9e, 0x92, 0x62, 0x54, 0xee, 0x94, 0x22, 0xca, 0x51, 0x44, 0xc8, 0x6b, 0x4d, 0x66,
+	0x2a, 0x61, 0xcd, 0x3f, 0x2a, 0x70, 0x91, 0x42, 0x08, 0x97, 0x0e, 0x29, 0x46, 0xe7, 0x54, 0x22,
+	0x76, 0x60, 0x21, 0xf4, 0xf2, 0x1b, 0x6f, 0x21, 0xf4, 0xd4, 0x2a, 0x2c, 0x51, 0x63, 0xd0, 0xe0,
+	0xa1, 0x8b, 0x64, 0x79, 0xb8, 0x06, 0x57, 0x13, 0x4a, 0x08, 0x15, 0xff, 0xad, 0xf0, 0xe2, 0x71,
+	0xe0, 0x1a, 0xc1, 0xa9, 0x1f, 0x22, 0x93, 0x5a, 0xf4, 0x7c, 0xb4, 0x4c, 0xf1, 0x75, 0x21, 0xd5,
+	0xd7, 0x65, 0x50, 0x46, 0x4c, 0x2f, 0x65, 0x14, 0xad, 0x4e, 0x98, 0xcb, 0x95, 0x93, 0x48, 0x6f,
+	0xd9, 0xcf, 0x74, 0x41, 0xd2, 0xc9, 0xd0, 0x1d, 0x3d, 0xe8, 0x1a, 0x61, 0x6d, 0x85, 0xa5, 0x13,
+	0x21, 0x3c, 0x09, 0x93, 0x46, 0xb9, 0x09, 0xd7, 0xd3, 0xd4, 0x16, 0x76, 0xf1, 0xe1, 0x42, 0x1b,
+	0x5b, 0xdf, 0x60, 0x7d, 0xe5, 0xec, 0xbb, 0x74, 0xb2, 0x47, 0x69, 0x50, 0x9b, 0xe4, 0x28, 0xa4,
+	0x19, 0x92, 0x0b, 0xc3, 0xfe, 0xc0, 0xfa, 0xae, 0x3d, 0x44, 0xde, 0x28, 0x3c, 0x67, 0x51, 0x68,
+	0xbb, 0x1c, 0xb3, 0x13, 0x72, 0xfc, 0x5e, 0x81, 0x8d, 0x36, 0xb6, 0x3a, 0xc8, 0xf7, 0x82, 0x70,
+	0xff, 0xdb, 0xdf, 0x7c, 0xdb, 0x0e, 0xfb, 0xd1, 0x75, 0x21, 0x6a, 0x29, 0x6f, 0x41, 0x31, 0x20,
+	0xf4, 0x1c, 0x42, 0x09, 0x64, 0x56, 0xac, 0x7c, 0x1d, 0x4a, 0x27, 0x3c, 0xd0, 0xf2, 0x27, 0xc6,
+	0x78, 0xcf, 0xa3, 0x8b, 0x5c, 0x2f, 0xc1, 0xae, 0x71, 0x0b, 0x36, 0xa7, 0xc8, 0x2f, 0x74, 0xfc,
+	0x4c, 0x81, 0x0a, 0xbf, 0x2c, 0x74, 0x10, 0xc9, 0xa9, 0xb3, 0xbd, 0x9f, 0xd5, 0x60, 0x65, 0x88,
+	0x86, 0x3d, 0x14, 0xd0, 0x8b, 0x40, 0xa9, 0xc3, 0x97, 0x33, 0xae, 0x64, 0x89, 0x3b, 0xd7, 0x52,
+	0xca, 0x9d, 0xeb, 0x16, 0x94, 0x69, 0x53, 0x89, 0xdd, 0xcb, 0x56, 0x09, 0x6d, 0xda, 0xed, 0xe8,
+	0x2a, 0x6c, 0x4c, 0x28, 0x2c, 0x8c, 0xf1, 0x17, 0x85, 0xe4, 0x01, 0x23, 0xb3, 0x3b, 0x52, 0xcc,
+	0x31, 0xca, 0xfc, 0x8e, 0x79, 0x69, 0x37, 0x25, 0x95, 0x6b, 0x3a, 0xe6, 0xcd, 0xd2, 0x2c, 0xa6,
+	0x90, 0xd0, 0xf6, 0x3f, 0x8a, 0xfc, 0xf1, 0xff, 0xab, 0x20, 0x36, 0xa0, 0x3e, 0x4d, 0x75, 0x61,
+	0x9f, 0x00, 0x54, 0xa9, 0x44, 0x9d, 0x47, 0x72, 0x24, 0x83, 0xf3, 0x3a, 0x68, 0x49, 0x9e, 0x42,
+	0xa2, 0xbf, 0x29, 0x70, 0xa9, 0x8d, 0xad, 0xef, 0xf8, 0xc8, 0xdd, 0x43, 0xba, 0xe1, 0xb9, 0x6f,
+	0xdb, 0xae, 0xe9, 0xbd, 0x7b, 0xb6, 0x09, 0x9b, 0x48, 0xbc, 0x42, 0x4a, 0xe2, 0x25, 0x26, 0x98,
+	0xc5, 0x94, 0x09, 0x26, 0x96, 0xe0, 0x4b, 0x13, 0x09, 0x9e, 0xd4, 0xfd, 0x06, 0x5c, 0x4b, 0x51,
+	0x4e, 0x28, 0xff, 0x0b, 0x5e, 0xa9, 0xa2, 0x6f, 0x2f, 0x21, 0x37, 0xaf, 0xc0, 0x32, 0x55, 0x99,
+	0x18, 0xa0, 0xdc, 0x61, 0xab, 0xd4, 0x34, 0xe3, 0x35, 0x65, 0x2c, 0x9a, 0x10, 0xfb, 0xe7, 0xb2,
+	0xd8, 0xec, 0x4e, 0x75, 0x9e, 0x62, 0xab, 0xb0, 0x88, 0x75, 0x87, 0x0b, 0x4d, 0x7e, 0xcf, 0x14,
+	0x79, 0xe2, 0x96, 0xf4, 0x3b, 0x05, 0x56, 0xdb, 0xd8, 0x3a, 0x74, 0xed, 0xf0, 0x5b, 0xba, 0x6b,
+	0x7e, 0xbe, 0xf0, 0x0a, 0xf5, 0x9e, 0x83, 0x24, 0xf9, 0xc8, 0xfa, 0xd0, 0x54, 0x37, 0x60, 0xa5,
+	0x1f, 0x8d, 0xda, 0xb6, 0xc9, 0x02, 0x6b, 0x39, 0x5a, 0x1e, 0x9a, 0x31, 0x9d, 0x16, 0xe3, 0x3a,
+	0x5d, 0x83, 0x92, 0x89, 0x8c, 0x01, 0x1d, 0xef, 0x69, 0x20, 0x15, 0x23, 0x42, 0x34, 0xd9, 0x27,
+	0xe3, 0xe8, 0x32, 0x5c, 0x92, 0xa4, 0x17, 0x5a, 0xfd, 0x95, 0x16, 0xf7, 0xe3, 0x51, 0x6f, 0x68,
+	0x87, 0xc7, 0xfd, 0xd1, 0xd3, 0xa7, 0x0e, 0x52, 0xbf, 0x06, 0x45, 0x4c, 0x7f, 0xce, 0xe1, 0x08,
+	0xb1, 0xe5, 0x73, 0xe9, 0x59, 0x85, 0xa5, 0xc0, 0x1b, 0xb9, 0xbc, 0xe5, 0xd1, 0x45, 0x94, 0x50,
+	0xa4, 0x8e, 0x75, 0xd9, 0xd9, 0xac, 0xdc, 0x95, 0x09, 0x91, 0x49, 0x2b, 0xb5, 0x78, 0x2e, 0x01,
+	0x2b, 0xf0, 0x31, 0xa5, 0x84, 0xc6, 0x1f, 0xd0, 0xd0, 0xe3, 0xd5, 0x64, 0x1f, 0x19, 0x83, 0x97,
+	0xe3, 0xcb, 0x69, 0x4d, 0x57, 0x96, 0x44, 0x48, 0xf9, 0x4f, 0x3a, 0x76, 0x50, 0x15, 0x8e, 0x46,
+	0x3d, 0xda, 0x7f, 0xce, 0x22, 0x45, 0xe6, 0x76, 0xcd, 0x05, 0x28, 0xf8, 0x1e, 0x66, 0x8e, 0x89,
+	0x7e, 0x46, 0x91, 0xe7, 0x8f, 0x7a, 0xb1, 0x29, 0xac, 0xe8, 0x73, 0x19, 0x37, 0x61, 0x95, 0xfb,
+	0x2c, 0xfa, 0x4c, 0xdb, 0x11, 0x30, 0x8f, 0x45, 0xc3, 0x49, 0x5a, 0xde, 0xd1, 0xf9, 0x24, 0xae,
+	0xad, 0xb0, 0xc5, 0xcf, 0x16, 0x24, 0x5b, 0x1c, 0xb8, 0xc6, 0x2b, 0x67, 0x8b, 0x41, 0xd7, 0x77,
+	0xf4, 0x53, 0x14, 0x08, 0x5b, 0x0c, 0x8e, 0xc8, 0x3a, 0xfa, 0x88, 0x5c, 0x23, 0x66, 0x89, 0x22,
+	0xe2, 0x0a, 0x7c, 0x01, 0x2a, 0xd4, 0x50, 0x63, 0x08, 0xed, 0xd0, 0x34, 0xe6, 0xb9, 0xa2, 0x33,
+	0xed, 0xc5, 0x81, 0xc2, 0x5e, 0xef, 0x2b, 0xb0, 0xc6, 0x89, 0x07, 0x6e, 0x18, 0x9c, 0x72, 0xb1,
+	0x95, 0x29, 0x62, 0x2f, 0x64, 0x89, 0x5d, 0x98, 0x2d, 0xf6, 0x62, 0x8a, 0xd8, 0x8d, 0x4f, 0x14,
+	0x50, 0x13, 0x32, 0xe2, 0xff, 0x8d, 0xdb, 0x9e, 0xc0, 0x32, 0x91, 0x34, 0xf2, 0x5c, 0xa1, 0xb9,
+	0xba, 0x7b, 0x67, 0x3b, 0xfd, 0x15, 0x78, 0x3b, 0x66, 0xb6, 0xbd, 0xc5, 0x3f, 0xff, 0x7d, 0xf3,
+	0xb5, 0x0e, 0xdb, 0x9a, 0xea, 0x07, 0x7a, 0x33, 0x99, 0xd0, 0x51, 0x38, 0xe2, 0x57, 0x34, 0x89,
+	0xc7, 0x17, 0x17, 0xd2, 0xe7, 0x5e, 0x4e, 0xe3, 0x48, 0x44, 0x6a, 0xb2, 0xfc, 0xd0, 0x20, 0x8a,
+	0x4b, 0x27, 0x07, 0x11, 0xb4, 0xf1, 0x8b, 0x0d, 0x9b, 0x2f, 0x5e, 0x21, 0xab, 0xa0, 0x8e, 0x85,
+	0x90, 0x8b, 0xa3, 0x36, 0x1e, 0xe1, 0x88, 0xf3, 0xce, 0x64, 0x0a, 0x3d, 0x83, 0x72, 0x10, 0x0b,
+	0xef, 0xa5, 0xb3, 0x19, 0x58, 0x5f, 0x87, 0xc6, 0x74, 0x6d, 0x85, 0x51, 0x7e, 0x49, 0xfb, 0xda,
+	0xf7, 0x7c, 0x53, 0x0f, 0xd1, 0x91, 0x1e, 0xe8, 0x43, 0xac, 0x3e, 0x84, 0x92, 0x3e, 0x0a, 0xfb,
+	0x5e, 0x60, 0x87, 0xa7, 0x33, 0x4d, 0x31, 0x86, 0xaa, 0x5f, 0x85, 0x65, 0x9f, 0x9c, 0x40, 0x2c,
+	0xb1, 0xba, 0x7b, 0x73, 0x5a, 0xbe, 0x50, 0x3e, 0x3c, 0x51, 0xe8, 0x1e, 0x29, 0x51, 0xc4, 0x89,
+	0xac, 0xd5, 0xc9, 0xc2, 0x71, 0xc1, 0x77, 0xff, 0xb5, 0x01, 0x85, 0x36, 0xb6, 0xd4, 0x1e, 0x80,
+	0xf4, 0xe7, 0x90, 0xa9, 0x29, 0x1a, 0x7b, 0xc4, 0xd7, 0xee, 0xe7, 0x82, 0x71, 0x5e, 0x6a, 0x17,
+	0x4a, 0xe3, 0x77, 0xfe, 0xd7, 0x33, 0xf6, 0x0a, 0x94, 0xf6, 0xc5, 0x3c, 0x28, 0xc1, 0xe0, 0xc7,
+	0x70, 0x29, 0xed, 0xad, 0x7d, 0x7b, 0xe6, 0x21, 0x31, 0xbc, 0xf6, 0x70, 0x3e, 0xfc, 0x34, 0xf6,
+	0xfc, 0xfd, 0x3b, 0x2f, 0x7b, 0x86, 0xd7, 0x1e, 0xce, 0x87, 0x9f, 0xc6, 0x9e, 0x3f, 0x3b, 0xe7,
+	0x65, 0xcf, 0xf0, 0xda, 0xc3, 0xf9, 0xf0, 0x82, 0xbd, 0x0b, 0xeb, 0x13, 0xef, 0xb4, 0xf7, 0xb2,
+	0x4f, 0x92, 0xa0, 0xda, 0x4e, 0x6e, 0xa8, 0xe0, 0xf7, 0x2e, 0x5c, 0x4c, 0x3e, 0x9a, 0xce, 0x88,
+	0x97, 0x38, 0x5a, 0x7b, 0x6b, 0x1e, 0xb4, 0x60, 0x3c, 0x80, 0xb5, 0xf8, 0xb3, 0x64, 0x33, 0xe3,
+	0x98, 0x18, 0x52, 0x7b, 0x90, 0x17, 0x29, 0x98, 0xf5, 0x00, 0xa4, 0x57, 0xc7, 0x3b, 0xd9, 0x02,
+	0x33, 0x98, 0x76, 0x3f, 0x17, 0x4c, 0xf0, 0x78, 0x4f, 0x81, 0x6a, 0xea, 0x8b, 0x62, 0x2b, 0xe3,
+	0x9c, 0xb4, 0x0d, 0xda, 0x97, 0xe6, 0xdc, 0x20, 0x44, 0xe8, 0x43, 0x39, 0xf6, 0xde, 0x77, 0x77,
+	0x56, 0x65, 0x61, 0x40, 0xad, 0x95, 0x13, 0x28, 0x7b, 0x2f, 0xfe, 0x98, 0xd6, 0xcc, 0x94, 0x59,
+	0x42, 0x6a, 0x0f, 0xf2, 0x22, 0x05, 0xb3, 0xf7, 0x15, 0xb8, 0x9c, 0xfe, 0x98, 0x95, 0xe3, 0xac,
+	0x89, 0x60, 0xfd, 0xf2, 0xbc, 0x3b, 0x84, 0x14, 0xef, 0x40, 0x65, 0xf2, 0xc9, 0x68, 0x2b, 0x47,
+	0x20, 0x72, 0x13, 0xef, 0xe6, 0xc7, 0x0a, 0x96, 0x21, 0x5c, 0x48, 0x3c, 0x09, 0xbd, 0x91, 0x71,
+	0xce, 0x24, 0x58, 0x7b, 0x73, 0x0e, 0x70, 0x3c, 0x8a, 0xa4, 0xb7, 0x98, 0xec, 0x28, 0x1a, 0x03,
+	0xb5, 0x56, 0x4e, 0x60, 0x92, 0x13, 0x2b, 0x75, 0xb3, 0x39, 0xb1, 0x42, 0xd7, 0xca, 0x09, 0x14,
+	0x9c, 0x7e, 0x04, 0x45, 0xf1, 0xea, 0x71, 0x3b, 0x63, 0x33, 0x07, 0x69, 0x6f, 0xe4, 0x00, 0xc9,
+	0xd9, 0x10, 0x7f, 0x7d, 0xc8, 0xca, 0x86, 0x18, 0x52, 0x7b, 0x90, 0x17, 0x29, 0x1b, 0x2d, 0x36,
+	0xf8, 0xdf, 0xcd, 0x11, 0x58, 0x11, 0x50, 0x6b, 0xe5, 0x04, 0xca, 0xbd, 0x68, 0x62, 0x78, 0xbf,
+	0x37, 0x53, 0x5a, 0x0e, 0xd5, 0x76, 0x72, 0x43, 0x93, 0xfc, 0xc4, 0x80, 0x3c, 0x9b, 0x1f, 0x87,
+	0x6a, 0x3b, 0xb9, 0xa1, 0x72, 0x46, 0x4f, 0x8e, 0x76, 0x5b, 0xb9, 0x4f, 0xc1, 0xda, 0x6e, 0x7e,
+	0xac, 0xac, 0xe2, 0xc4, 0x28, 0x75, 0x2f, 0x57, 0x5d, 0x98, 0xd9, 0xde, 0xd3, 0x47, 0x20, 0xf5,
+	0x07, 0xb0, 0xc2, 0xbb, 0x5e, 0x23, 0x63, 0x37, 0x6f, 0x79, 0x5b, 0xb3, 0x31, 0xe2, 0xe8, 0x9f,
+	0x2a, 0xb0, 0x31, 0x6d, 0x7c, 0xd9, 0x9d, 0xdd, 0xc1, 0x26, 0xf7, 0x68, 0x8f, 0xe6, 0xdf, 0x23,
+	0xe7, 0x44, 0x6c, 0x68, 0xc8, 0xca, 0x09, 0x19, 0xa8, 0xb5, 0x72, 0x02, 0x39, 0x27, 0x6d, 0xe9,
+	0xbd, 0x4f, 0x3f, 0xdc, 0x52, 0xf6, 0xaa, 0xbf, 0xf9, 0xe4, 0xa6, 0xf2, 0xc3, 0xf5, 0x67, 0xfc,
+	0x7f, 0xa3, 0xc2, 0x53, 0x1f, 0xe1, 0xde, 0x32, 0xf9, 0xc7, 0xa8, 0x37, 0xff, 0x3b, 0x00, 0xdf,
+	0x1e, 0x91, 0x7f, 0xc2, 0x25, 0x00, 0x00,
 }
 
 func (this *MsgBeginEpoch) Equal(that interface{}) bool {
@@ -2010,14 +2650,14 @@ func (this *MsgDkgTimeoutResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgOpenBeaconWindow) Equal(that interface{}) bool {
+func (this *MsgReportDKGWithholding) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgOpenBeaconWindow)
+	that1, ok := that.(*MsgReportDKGWithholding)
 	if !ok {
-		that2, ok := that.(MsgOpenBeaconWindow)
+		that2, ok := that.(MsgReportDKGWithholding)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2029,34 +2669,99 @@ func (this *MsgOpenBeaconWindow) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Caller != that1.Caller {
+	if this.Reporter != that1.Reporter {
 		return false
 	}
 	if this.EpochId != that1.EpochId {
 		return false
 	}
-	if this.CommitBlocks != that1.CommitBlocks {
+	if this.Validator != that1.Validator {
 		return false
 	}
-	if this.RevealBlocks != that1.RevealBlocks {
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgReportDKGWithholdingResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgReportDKGWithholdingResponse)
+	if !ok {
+		that2, ok := that.(MsgReportDKGWithholdingResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgBeginReshare) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgBeginReshare)
+	if !ok {
+		that2, ok := that.(MsgBeginReshare)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Caller != that1.Caller {
+		return false
+	}
+	if this.EpochId != that1.EpochId {
+		return false
+	}
+	if len(this.Members) != len(that1.Members) {
 		return false
 	}
+	for i := range this.Members {
+		if this.Members[i] != that1.Members[i] {
+			return false
+		}
+	}
 	if this.Threshold != that1.Threshold {
 		return false
 	}
+	if this.CommitBlocks != that1.CommitBlocks {
+		return false
+	}
+	if this.ShareBlocks != that1.ShareBlocks {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *MsgOpenBeaconWindowResponse) Equal(that interface{}) bool {
+func (this *MsgBeginReshareResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgOpenBeaconWindowResponse)
+	that1, ok := that.(*MsgBeginReshareResponse)
 	if !ok {
-		that2, ok := that.(MsgOpenBeaconWindowResponse)
+		that2, ok := that.(MsgBeginReshareResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2073,14 +2778,14 @@ func (this *MsgOpenBeaconWindowResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgBeaconCommit) Equal(that interface{}) bool {
+func (this *MsgReshareCommit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgBeaconCommit)
+	that1, ok := that.(*MsgReshareCommit)
 	if !ok {
-		that2, ok := that.(MsgBeaconCommit)
+		that2, ok := that.(MsgReshareCommit)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2098,7 +2803,15 @@ func (this *MsgBeaconCommit) Equal(that interface{}) bool {
 	if this.EpochId != that1.EpochId {
 		return false
 	}
-	if !bytes.Equal(this.Commit, that1.Commit) {
+	if len(this.Commitments) != len(that1.Commitments) {
+		return false
+	}
+	for i := range this.Commitments {
+		if !bytes.Equal(this.Commitments[i], that1.Commitments[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.EphemeralPubkey, that1.EphemeralPubkey) {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -2106,14 +2819,14 @@ func (this *MsgBeaconCommit) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgBeaconCommitResponse) Equal(that interface{}) bool {
+func (this *MsgReshareCommitResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgBeaconCommitResponse)
+	that1, ok := that.(*MsgReshareCommitResponse)
 	if !ok {
-		that2, ok := that.(MsgBeaconCommitResponse)
+		that2, ok := that.(MsgReshareCommitResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2130,14 +2843,14 @@ func (this *MsgBeaconCommitResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgBeaconReveal) Equal(that interface{}) bool {
+func (this *MsgReshareEncryptedShare) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgBeaconReveal)
+	that1, ok := that.(*MsgReshareEncryptedShare)
 	if !ok {
-		that2, ok := that.(MsgBeaconReveal)
+		that2, ok := that.(MsgReshareEncryptedShare)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2149,13 +2862,25 @@ func (this *MsgBeaconReveal) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Validator != that1.Validator {
+	if this.Dealer != that1.Dealer {
 		return false
 	}
 	if this.EpochId != that1.EpochId {
 		return false
 	}
-	if !bytes.Equal(this.Salt, that1.Salt) {
+	if this.RecipientIndex != that1.RecipientIndex {
+		return false
+	}
+	if !bytes.Equal(this.U, that1.U) {
+		return false
+	}
+	if !bytes.Equal(this.V, that1.V) {
+		return false
+	}
+	if !bytes.Equal(this.Proof, that1.Proof) {
+		return false
+	}
+	if !bytes.Equal(this.ScalarCt, that1.ScalarCt) {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -2163,14 +2888,14 @@ func (this *MsgBeaconReveal) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgBeaconRevealResponse) Equal(that interface{}) bool {
+func (this *MsgReshareEncryptedShareResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgBeaconRevealResponse)
+	that1, ok := that.(*MsgReshareEncryptedShareResponse)
 	if !ok {
-		that2, ok := that.(MsgBeaconRevealResponse)
+		that2, ok := that.(MsgReshareEncryptedShareResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2187,14 +2912,14 @@ func (this *MsgBeaconRevealResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgInitHand) Equal(that interface{}) bool {
+func (this *MsgFinalizeReshare) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgInitHand)
+	that1, ok := that.(*MsgFinalizeReshare)
 	if !ok {
-		that2, ok := that.(MsgInitHand)
+		that2, ok := that.(MsgFinalizeReshare)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2209,31 +2934,22 @@ func (this *MsgInitHand) Equal(that interface{}) bool {
 	if this.Caller != that1.Caller {
 		return false
 	}
-	if this.TableId != that1.TableId {
-		return false
-	}
-	if this.HandId != that1.HandId {
-		return false
-	}
 	if this.EpochId != that1.EpochId {
 		return false
 	}
-	if this.DeckSize != that1.DeckSize {
-		return false
-	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *MsgInitHandResponse) Equal(that interface{}) bool {
+func (this *MsgFinalizeReshareResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgInitHandResponse)
+	that1, ok := that.(*MsgFinalizeReshareResponse)
 	if !ok {
-		that2, ok := that.(MsgInitHandResponse)
+		that2, ok := that.(MsgFinalizeReshareResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2250,14 +2966,14 @@ func (this *MsgInitHandResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgSubmitShuffle) Equal(that interface{}) bool {
+func (this *MsgOpenBeaconWindow) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgSubmitShuffle)
+	that1, ok := that.(*MsgOpenBeaconWindow)
 	if !ok {
-		that2, ok := that.(MsgSubmitShuffle)
+		that2, ok := that.(MsgOpenBeaconWindow)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2269,19 +2985,19 @@ func (this *MsgSubmitShuffle) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Shuffler != that1.Shuffler {
+	if this.Caller != that1.Caller {
 		return false
 	}
-	if this.TableId != that1.TableId {
+	if this.EpochId != that1.EpochId {
 		return false
 	}
-	if this.HandId != that1.HandId {
+	if this.CommitBlocks != that1.CommitBlocks {
 		return false
 	}
-	if this.Round != that1.Round {
+	if this.RevealBlocks != that1.RevealBlocks {
 		return false
 	}
-	if !bytes.Equal(this.ProofShuffle, that1.ProofShuffle) {
+	if this.Threshold != that1.Threshold {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -2289,14 +3005,38 @@ func (this *MsgSubmitShuffle) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgSubmitShuffleResponse) Equal(that interface{}) bool {
+func (this *MsgOpenBeaconWindowResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgSubmitShuffleResponse)
+	that1, ok := that.(*MsgOpenBeaconWindowResponse)
 	if !ok {
-		that2, ok := that.(MsgSubmitShuffleResponse)
+		that2, ok := that.(MsgOpenBeaconWindowResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgBeaconCommit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgBeaconCommit)
+	if !ok {
+		that2, ok := that.(MsgBeaconCommit)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2308,19 +3048,463 @@ func (this *MsgSubmitShuffleResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if this.EpochId != that1.EpochId {
+		return false
+	}
+	if !bytes.Equal(this.Commit, that1.Commit) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgBeaconCommitResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgBeaconCommitResponse)
+	if !ok {
+		that2, ok := that.(MsgBeaconCommitResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgBeaconReveal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgBeaconReveal)
+	if !ok {
+		that2, ok := that.(MsgBeaconReveal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if this.EpochId != that1.EpochId {
+		return false
+	}
+	if !bytes.Equal(this.Salt, that1.Salt) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgBeaconRevealResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgBeaconRevealResponse)
+	if !ok {
+		that2, ok := that.(MsgBeaconRevealResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgInitHand) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgInitHand)
+	if !ok {
+		that2, ok := that.(MsgInitHand)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Caller != that1.Caller {
+		return false
+	}
+	if this.TableId != that1.TableId {
+		return false
+	}
+	if this.HandId != that1.HandId {
+		return false
+	}
+	if this.EpochId != that1.EpochId {
+		return false
+	}
+	if this.DeckSize != that1.DeckSize {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgInitHandResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgInitHandResponse)
+	if !ok {
+		that2, ok := that.(MsgInitHandResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgSubmitShuffle) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitShuffle)
+	if !ok {
+		that2, ok := that.(MsgSubmitShuffle)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Shuffler != that1.Shuffler {
+		return false
+	}
+	if this.TableId != that1.TableId {
+		return false
+	}
+	if this.HandId != that1.HandId {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if !bytes.Equal(this.ProofShuffle, that1.ProofShuffle) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgSubmitShuffleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitShuffleResponse)
+	if !ok {
+		that2, ok := that.(MsgSubmitShuffleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgFinalizeDeck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFinalizeDeck)
+	if !ok {
+		that2, ok := that.(MsgFinalizeDeck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Caller != that1.Caller {
+		return false
+	}
+	if this.TableId != that1.TableId {
+		return false
+	}
+	if this.HandId != that1.HandId {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgFinalizeDeckResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFinalizeDeckResponse)
+	if !ok {
+		that2, ok := that.(MsgFinalizeDeckResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgSubmitPubShare) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitPubShare)
+	if !ok {
+		that2, ok := that.(MsgSubmitPubShare)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if this.TableId != that1.TableId {
+		return false
+	}
+	if this.HandId != that1.HandId {
+		return false
+	}
+	if this.Pos != that1.Pos {
+		return false
+	}
+	if !bytes.Equal(this.PubShare, that1.PubShare) {
+		return false
+	}
+	if !bytes.Equal(this.ProofShare, that1.ProofShare) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgSubmitPubShareResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitPubShareResponse)
+	if !ok {
+		that2, ok := that.(MsgSubmitPubShareResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgSubmitEncShare) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitEncShare)
+	if !ok {
+		that2, ok := that.(MsgSubmitEncShare)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if this.TableId != that1.TableId {
+		return false
+	}
+	if this.HandId != that1.HandId {
+		return false
+	}
+	if this.Pos != that1.Pos {
+		return false
+	}
+	if !bytes.Equal(this.PkPlayer, that1.PkPlayer) {
+		return false
+	}
+	if !bytes.Equal(this.EncShare, that1.EncShare) {
+		return false
+	}
+	if !bytes.Equal(this.ProofEncShare, that1.ProofEncShare) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgSubmitEncShareResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitEncShareResponse)
+	if !ok {
+		that2, ok := that.(MsgSubmitEncShareResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *EncShareEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EncShareEntry)
+	if !ok {
+		that2, ok := that.(EncShareEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Pos != that1.Pos {
+		return false
+	}
+	if !bytes.Equal(this.PkPlayer, that1.PkPlayer) {
+		return false
+	}
+	if !bytes.Equal(this.EncShare, that1.EncShare) {
+		return false
+	}
+	if !bytes.Equal(this.ProofEncShare, that1.ProofEncShare) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *MsgFinalizeDeck) Equal(that interface{}) bool {
+func (this *MsgSubmitEncShares) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgFinalizeDeck)
+	that1, ok := that.(*MsgSubmitEncShares)
 	if !ok {
-		that2, ok := that.(MsgFinalizeDeck)
+		that2, ok := that.(MsgSubmitEncShares)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2332,7 +3516,7 @@ func (this *MsgFinalizeDeck) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Caller != that1.Caller {
+	if this.Validator != that1.Validator {
 		return false
 	}
 	if this.TableId != that1.TableId {
@@ -2341,19 +3525,27 @@ func (this *MsgFinalizeDeck) Equal(that interface{}) bool {
 	if this.HandId != that1.HandId {
 		return false
 	}
+	if len(this.Shares) != len(that1.Shares) {
+		return false
+	}
+	for i := range this.Shares {
+		if !this.Shares[i].Equal(&that1.Shares[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *MsgFinalizeDeckResponse) Equal(that interface{}) bool {
+func (this *MsgSubmitEncSharesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgFinalizeDeckResponse)
+	that1, ok := that.(*MsgSubmitEncSharesResponse)
 	if !ok {
-		that2, ok := that.(MsgFinalizeDeckResponse)
+		that2, ok := that.(MsgSubmitEncSharesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2370,14 +3562,14 @@ func (this *MsgFinalizeDeckResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgSubmitPubShare) Equal(that interface{}) bool {
+func (this *MsgFinalizeReveal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgSubmitPubShare)
+	that1, ok := that.(*MsgFinalizeReveal)
 	if !ok {
-		that2, ok := that.(MsgSubmitPubShare)
+		that2, ok := that.(MsgFinalizeReveal)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2389,7 +3581,7 @@ func (this *MsgSubmitPubShare) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Validator != that1.Validator {
+	if this.Caller != that1.Caller {
 		return false
 	}
 	if this.TableId != that1.TableId {
@@ -2401,25 +3593,19 @@ func (this *MsgSubmitPubShare) Equal(that interface{}) bool {
 	if this.Pos != that1.Pos {
 		return false
 	}
-	if !bytes.Equal(this.PubShare, that1.PubShare) {
-		return false
-	}
-	if !bytes.Equal(this.ProofShare, that1.ProofShare) {
-		return false
-	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *MsgSubmitPubShareResponse) Equal(that interface{}) bool {
+func (this *MsgFinalizeRevealResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgSubmitPubShareResponse)
+	that1, ok := that.(*MsgFinalizeRevealResponse)
 	if !ok {
-		that2, ok := that.(MsgSubmitPubShareResponse)
+		that2, ok := that.(MsgFinalizeRevealResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2436,14 +3622,14 @@ func (this *MsgSubmitPubShareResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgSubmitEncShare) Equal(that interface{}) bool {
+func (this *MsgTimeout) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgSubmitEncShare)
+	that1, ok := that.(*MsgTimeout)
 	if !ok {
-		that2, ok := that.(MsgSubmitEncShare)
+		that2, ok := that.(MsgTimeout)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2455,7 +3641,7 @@ func (this *MsgSubmitEncShare) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Validator != that1.Validator {
+	if this.Caller != that1.Caller {
 		return false
 	}
 	if this.TableId != that1.TableId {
@@ -2464,31 +3650,19 @@ func (this *MsgSubmitEncShare) Equal(that interface{}) bool {
 	if this.HandId != that1.HandId {
 		return false
 	}
-	if this.Pos != that1.Pos {
-		return false
-	}
-	if !bytes.Equal(this.PkPlayer, that1.PkPlayer) {
-		return false
-	}
-	if !bytes.Equal(this.EncShare, that1.EncShare) {
-		return false
-	}
-	if !bytes.Equal(this.ProofEncShare, that1.ProofEncShare) {
-		return false
-	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *MsgSubmitEncShareResponse) Equal(that interface{}) bool {
+func (this *MsgTimeoutResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgSubmitEncShareResponse)
+	that1, ok := that.(*MsgTimeoutResponse)
 	if !ok {
-		that2, ok := that.(MsgSubmitEncShareResponse)
+		that2, ok := that.(MsgTimeoutResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2505,14 +3679,14 @@ func (this *MsgSubmitEncShareResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgFinalizeReveal) Equal(that interface{}) bool {
+func (this *MsgReportDealerWithholding) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgFinalizeReveal)
+	that1, ok := that.(*MsgReportDealerWithholding)
 	if !ok {
-		that2, ok := that.(MsgFinalizeReveal)
+		that2, ok := that.(MsgReportDealerWithholding)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2524,7 +3698,7 @@ func (this *MsgFinalizeReveal) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Caller != that1.Caller {
+	if this.Reporter != that1.Reporter {
 		return false
 	}
 	if this.TableId != that1.TableId {
@@ -2536,19 +3710,22 @@ func (this *MsgFinalizeReveal) Equal(that interface{}) bool {
 	if this.Pos != that1.Pos {
 		return false
 	}
+	if this.Validator != that1.Validator {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *MsgFinalizeRevealResponse) Equal(that interface{}) bool {
+func (this *MsgReportDealerWithholdingResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgFinalizeRevealResponse)
+	that1, ok := that.(*MsgReportDealerWithholdingResponse)
 	if !ok {
-		that2, ok := that.(MsgFinalizeRevealResponse)
+		that2, ok := that.(MsgReportDealerWithholdingResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2565,14 +3742,14 @@ func (this *MsgFinalizeRevealResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgTimeout) Equal(that interface{}) bool {
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgTimeout)
+	that1, ok := that.(*MsgUpdateParams)
 	if !ok {
-		that2, ok := that.(MsgTimeout)
+		that2, ok := that.(MsgUpdateParams)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2584,13 +3761,10 @@ func (this *MsgTimeout) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Caller != that1.Caller {
-		return false
-	}
-	if this.TableId != that1.TableId {
+	if this.Authority != that1.Authority {
 		return false
 	}
-	if this.HandId != that1.HandId {
+	if !this.Params.Equal(&that1.Params) {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -2598,14 +3772,14 @@ func (this *MsgTimeout) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgTimeoutResponse) Equal(that interface{}) bool {
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgTimeoutResponse)
+	that1, ok := that.(*MsgUpdateParamsResponse)
 	if !ok {
-		that2, ok := that.(MsgTimeoutResponse)
+		that2, ok := that.(MsgUpdateParamsResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2658,6 +3832,17 @@ type MsgClient interface {
 	DkgEncryptedShare(ctx context.Context, in *MsgDkgEncryptedShare, opts ...grpc.CallOption) (*MsgDkgEncryptedShareResponse, error)
 	FinalizeEpoch(ctx context.Context, in *MsgFinalizeEpoch, opts ...grpc.CallOption) (*MsgFinalizeEpochResponse, error)
 	DkgTimeout(ctx context.Context, in *MsgDkgTimeout, opts ...grpc.CallOption) (*MsgDkgTimeoutResponse, error)
+	// ReportDKGWithholding slashes one DKG member that missed the commit
+	// deadline, without sweeping the rest of the committee as DkgTimeout does.
+	ReportDKGWithholding(ctx context.Context, in *MsgReportDKGWithholding, opts ...grpc.CallOption) (*MsgReportDKGWithholdingResponse, error)
+	// Proactive share refresh. Re-deals the active epoch's key to a (possibly
+	// different) committee without changing pk_epoch. Dealers post Feldman
+	// commitments and DKG v2 encrypted shares exactly as in the DKG; the chain
+	// verifies them with the same DkgEncShareVerify NIZK.
+	BeginReshare(ctx context.Context, in *MsgBeginReshare, opts ...grpc.CallOption) (*MsgBeginReshareResponse, error)
+	ReshareCommit(ctx context.Context, in *MsgReshareCommit, opts ...grpc.CallOption) (*MsgReshareCommitResponse, error)
+	ReshareEncryptedShare(ctx context.Context, in *MsgReshareEncryptedShare, opts ...grpc.CallOption) (*MsgReshareEncryptedShareResponse, error)
+	FinalizeReshare(ctx context.Context, in *MsgFinalizeReshare, opts ...grpc.CallOption) (*MsgFinalizeReshareResponse, error)
 	// Randomness-beacon commit-reveal (replaces devnet RandEpoch on prod chains).
 	OpenBeaconWindow(ctx context.Context, in *MsgOpenBeaconWindow, opts ...grpc.CallOption) (*MsgOpenBeaconWindowResponse, error)
 	BeaconCommit(ctx context.Context, in *MsgBeaconCommit, opts ...grpc.CallOption) (*MsgBeaconCommitResponse, error)
@@ -2667,8 +3852,19 @@ type MsgClient interface {
 	FinalizeDeck(ctx context.Context, in *MsgFinalizeDeck, opts ...grpc.CallOption) (*MsgFinalizeDeckResponse, error)
 	SubmitPubShare(ctx context.Context, in *MsgSubmitPubShare, opts ...grpc.CallOption) (*MsgSubmitPubShareResponse, error)
 	SubmitEncShare(ctx context.Context, in *MsgSubmitEncShare, opts ...grpc.CallOption) (*MsgSubmitEncShareResponse, error)
+	// SubmitEncShares delivers one validator's encrypted shares for several
+	// hole positions of a hand in a single tx. The proofs are checked with one
+	// batched verification; the batch is accepted or rejected as a whole.
+	SubmitEncShares(ctx context.Context, in *MsgSubmitEncShares, opts ...grpc.CallOption) (*MsgSubmitEncSharesResponse, error)
 	FinalizeReveal(ctx context.Context, in *MsgFinalizeReveal, opts ...grpc.CallOption) (*MsgFinalizeRevealResponse, error)
 	Timeout(ctx context.Context, in *MsgTimeout, opts ...grpc.CallOption) (*MsgTimeoutResponse, error)
+	// ReportDealerWithholding slashes one committee member that missed the
+	// deadline for its encrypted share or public share of a hand position. The
+	// hand goes on while the committee still meets its threshold.
+	ReportDealerWithholding(ctx context.Context, in *MsgReportDealerWithholding, opts ...grpc.CallOption) (*MsgReportDealerWithholdingResponse, error)
+	// UpdateParams replaces the module params. Only the module authority
+	// (x/gov by default) may call it.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -2760,6 +3956,51 @@ func (c *msgClient) DkgTimeout(ctx context.Context, in *MsgDkgTimeout, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ReportDKGWithholding(ctx context.Context, in *MsgReportDKGWithholding, opts ...grpc.CallOption) (*MsgReportDKGWithholdingResponse, error) {
+	out := new(MsgReportDKGWithholdingResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.dealer.v1.Msg/ReportDKGWithholding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BeginReshare(ctx context.Context, in *MsgBeginReshare, opts ...grpc.CallOption) (*MsgBeginReshareResponse, error) {
+	out := new(MsgBeginReshareResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.dealer.v1.Msg/BeginReshare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReshareCommit(ctx context.Context, in *MsgReshareCommit, opts ...grpc.CallOption) (*MsgReshareCommitResponse, error) {
+	out := new(MsgReshareCommitResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.dealer.v1.Msg/ReshareCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReshareEncryptedShare(ctx context.Context, in *MsgReshareEncryptedShare, opts ...grpc.CallOption) (*MsgReshareEncryptedShareResponse, error) {
+	out := new(MsgReshareEncryptedShareResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.dealer.v1.Msg/ReshareEncryptedShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeReshare(ctx context.Context, in *MsgFinalizeReshare, opts ...grpc.CallOption) (*MsgFinalizeReshareResponse, error) {
+	out := new(MsgFinalizeReshareResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.dealer.v1.Msg/FinalizeReshare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) OpenBeaconWindow(ctx context.Context, in *MsgOpenBeaconWindow, opts ...grpc.CallOption) (*MsgOpenBeaconWindowResponse, error) {
 	out := new(MsgOpenBeaconWindowResponse)
 	err := c.cc.Invoke(ctx, "/onchainpoker.dealer.v1.Msg/OpenBeaconWindow", in, out, opts...)
@@ -2832,6 +4073,15 @@ func (c *msgClient) SubmitEncShare(ctx context.Context, in *MsgSubmitEncShare, o
 	return out, nil
 }
 
+func (c *msgClient) SubmitEncShares(ctx context.Context, in *MsgSubmitEncShares, opts ...grpc.CallOption) (*MsgSubmitEncSharesResponse, error) {
+	out := new(MsgSubmitEncSharesResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.dealer.v1.Msg/SubmitEncShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) FinalizeReveal(ctx context.Context, in *MsgFinalizeReveal, opts ...grpc.CallOption) (*MsgFinalizeRevealResponse, error) {
 	out := new(MsgFinalizeRevealResponse)
 	err := c.cc.Invoke(ctx, "/onchainpoker.dealer.v1.Msg/FinalizeReveal", in, out, opts...)
@@ -2850,6 +4100,24 @@ func (c *msgClient) Timeout(ctx context.Context, in *MsgTimeout, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) ReportDealerWithholding(ctx context.Context, in *MsgReportDealerWithholding, opts ...grpc.CallOption) (*MsgReportDealerWithholdingResponse, error) {
+	out := new(MsgReportDealerWithholdingResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.dealer.v1.Msg/ReportDealerWithholding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.dealer.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	BeginEpoch(context.Context, *MsgBeginEpoch) (*MsgBeginEpochResponse, error)
@@ -2875,6 +4143,17 @@ type MsgServer interface {
 	DkgEncryptedShare(context.Context, *MsgDkgEncryptedShare) (*MsgDkgEncryptedShareResponse, error)
 	FinalizeEpoch(context.Context, *MsgFinalizeEpoch) (*MsgFinalizeEpochResponse, error)
 	DkgTimeout(context.Context, *MsgDkgTimeout) (*MsgDkgTimeoutResponse, error)
+	// ReportDKGWithholding slashes one DKG member that missed the commit
+	// deadline, without sweeping the rest of the committee as DkgTimeout does.
+	ReportDKGWithholding(context.Context, *MsgReportDKGWithholding) (*MsgReportDKGWithholdingResponse, error)
+	// Proactive share refresh. Re-deals the active epoch's key to a (possibly
+	// different) committee without changing pk_epoch. Dealers post Feldman
+	// commitments and DKG v2 encrypted shares exactly as in the DKG; the chain
+	// verifies them with the same DkgEncShareVerify NIZK.
+	BeginReshare(context.Context, *MsgBeginReshare) (*MsgBeginReshareResponse, error)
+	ReshareCommit(context.Context, *MsgReshareCommit) (*MsgReshareCommitResponse, error)
+	ReshareEncryptedShare(context.Context, *MsgReshareEncryptedShare) (*MsgReshareEncryptedShareResponse, error)
+	FinalizeReshare(context.Context, *MsgFinalizeReshare) (*MsgFinalizeReshareResponse, error)
 	// Randomness-beacon commit-reveal (replaces devnet RandEpoch on prod chains).
 	OpenBeaconWindow(context.Context, *MsgOpenBeaconWindow) (*MsgOpenBeaconWindowResponse, error)
 	BeaconCommit(context.Context, *MsgBeaconCommit) (*MsgBeaconCommitResponse, error)
@@ -2884,8 +4163,19 @@ type MsgServer interface {
 	FinalizeDeck(context.Context, *MsgFinalizeDeck) (*MsgFinalizeDeckResponse, error)
 	SubmitPubShare(context.Context, *MsgSubmitPubShare) (*MsgSubmitPubShareResponse, error)
 	SubmitEncShare(context.Context, *MsgSubmitEncShare) (*MsgSubmitEncShareResponse, error)
+	// SubmitEncShares delivers one validator's encrypted shares for several
+	// hole positions of a hand in a single tx. The proofs are checked with one
+	// batched verification; the batch is accepted or rejected as a whole.
+	SubmitEncShares(context.Context, *MsgSubmitEncShares) (*MsgSubmitEncSharesResponse, error)
 	FinalizeReveal(context.Context, *MsgFinalizeReveal) (*MsgFinalizeRevealResponse, error)
 	Timeout(context.Context, *MsgTimeout) (*MsgTimeoutResponse, error)
+	// ReportDealerWithholding slashes one committee member that missed the
+	// deadline for its encrypted share or public share of a hand position. The
+	// hand goes on while the committee still meets its threshold.
+	ReportDealerWithholding(context.Context, *MsgReportDealerWithholding) (*MsgReportDealerWithholdingResponse, error)
+	// UpdateParams replaces the module params. Only the module authority
+	// (x/gov by default) may call it.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2919,6 +4209,21 @@ func (*UnimplementedMsgServer) FinalizeEpoch(ctx context.Context, req *MsgFinali
 func (*UnimplementedMsgServer) DkgTimeout(ctx context.Context, req *MsgDkgTimeout) (*MsgDkgTimeoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DkgTimeout not implemented")
 }
+func (*UnimplementedMsgServer) ReportDKGWithholding(ctx context.Context, req *MsgReportDKGWithholding) (*MsgReportDKGWithholdingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDKGWithholding not implemented")
+}
+func (*UnimplementedMsgServer) BeginReshare(ctx context.Context, req *MsgBeginReshare) (*MsgBeginReshareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginReshare not implemented")
+}
+func (*UnimplementedMsgServer) ReshareCommit(ctx context.Context, req *MsgReshareCommit) (*MsgReshareCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReshareCommit not implemented")
+}
+func (*UnimplementedMsgServer) ReshareEncryptedShare(ctx context.Context, req *MsgReshareEncryptedShare) (*MsgReshareEncryptedShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReshareEncryptedShare not implemented")
+}
+func (*UnimplementedMsgServer) FinalizeReshare(ctx context.Context, req *MsgFinalizeReshare) (*MsgFinalizeReshareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeReshare not implemented")
+}
 func (*UnimplementedMsgServer) OpenBeaconWindow(ctx context.Context, req *MsgOpenBeaconWindow) (*MsgOpenBeaconWindowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenBeaconWindow not implemented")
 }
@@ -2943,12 +4248,21 @@ func (*UnimplementedMsgServer) SubmitPubShare(ctx context.Context, req *MsgSubmi
 func (*UnimplementedMsgServer) SubmitEncShare(ctx context.Context, req *MsgSubmitEncShare) (*MsgSubmitEncShareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEncShare not implemented")
 }
+func (*UnimplementedMsgServer) SubmitEncShares(ctx context.Context, req *MsgSubmitEncShares) (*MsgSubmitEncSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEncShares not implemented")
+}
 func (*UnimplementedMsgServer) FinalizeReveal(ctx context.Context, req *MsgFinalizeReveal) (*MsgFinalizeRevealResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizeReveal not implemented")
 }
 func (*UnimplementedMsgServer) Timeout(ctx context.Context, req *MsgTimeout) (*MsgTimeoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Timeout not implemented")
 }
+func (*UnimplementedMsgServer) ReportDealerWithholding(ctx context.Context, req *MsgReportDealerWithholding) (*MsgReportDealerWithholdingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDealerWithholding not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -3116,6 +4430,96 @@ func _Msg_DkgTimeout_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReportDKGWithholding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportDKGWithholding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportDKGWithholding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.dealer.v1.Msg/ReportDKGWithholding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportDKGWithholding(ctx, req.(*MsgReportDKGWithholding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BeginReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginReshare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginReshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.dealer.v1.Msg/BeginReshare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginReshare(ctx, req.(*MsgBeginReshare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReshareCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReshareCommit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReshareCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.dealer.v1.Msg/ReshareCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReshareCommit(ctx, req.(*MsgReshareCommit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReshareEncryptedShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReshareEncryptedShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReshareEncryptedShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.dealer.v1.Msg/ReshareEncryptedShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReshareEncryptedShare(ctx, req.(*MsgReshareEncryptedShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeReshare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeReshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.dealer.v1.Msg/FinalizeReshare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeReshare(ctx, req.(*MsgFinalizeReshare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_OpenBeaconWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgOpenBeaconWindow)
 	if err := dec(in); err != nil {
@@ -3260,6 +4664,24 @@ func _Msg_SubmitEncShare_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitEncShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEncShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEncShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.dealer.v1.Msg/SubmitEncShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEncShares(ctx, req.(*MsgSubmitEncShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_FinalizeReveal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgFinalizeReveal)
 	if err := dec(in); err != nil {
@@ -3296,6 +4718,42 @@ func _Msg_Timeout_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReportDealerWithholding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportDealerWithholding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportDealerWithholding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.dealer.v1.Msg/ReportDealerWithholding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportDealerWithholding(ctx, req.(*MsgReportDealerWithholding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.dealer.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "onchainpoker.dealer.v1.Msg",
@@ -3337,6 +4795,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DkgTimeout",
 			Handler:    _Msg_DkgTimeout_Handler,
 		},
+		{
+			MethodName: "ReportDKGWithholding",
+			Handler:    _Msg_ReportDKGWithholding_Handler,
+		},
+		{
+			MethodName: "BeginReshare",
+			Handler:    _Msg_BeginReshare_Handler,
+		},
+		{
+			MethodName: "ReshareCommit",
+			Handler:    _Msg_ReshareCommit_Handler,
+		},
+		{
+			MethodName: "ReshareEncryptedShare",
+			Handler:    _Msg_ReshareEncryptedShare_Handler,
+		},
+		{
+			MethodName: "FinalizeReshare",
+			Handler:    _Msg_FinalizeReshare_Handler,
+		},
 		{
 			MethodName: "OpenBeaconWindow",
 			Handler:    _Msg_OpenBeaconWindow_Handler,
@@ -3369,6 +4847,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEncShare",
 			Handler:    _Msg_SubmitEncShare_Handler,
 		},
+		{
+			MethodName: "SubmitEncShares",
+			Handler:    _Msg_SubmitEncShares_Handler,
+		},
 		{
 			MethodName: "FinalizeReveal",
 			Handler:    _Msg_FinalizeReveal_Handler,
@@ -3377,6 +4859,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Timeout",
 			Handler:    _Msg_Timeout_Handler,
 		},
+		{
+			MethodName: "ReportDealerWithholding",
+			Handler:    _Msg_ReportDealerWithholding_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "onchainpoker/dealer/v1/tx.proto",
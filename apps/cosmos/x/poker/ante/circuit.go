@@ -0,0 +1,99 @@
+package ante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// PauseKeeper is the subset of the x/poker keeper backing the circuit breaker.
+type PauseKeeper interface {
+	CheckNotPaused(ctx context.Context, msgTypeURL string, tableID uint64) error
+}
+
+// CircuitBreakerDecorator rejects transactions containing a message paused
+// by the x/poker circuit breaker (see pokertypes.PauseState), including
+// messages nested in authz MsgExec. The msg servers repeat the check, so
+// this only stops paused txs before they pay for execution.
+type CircuitBreakerDecorator struct {
+	pauseKeeper PauseKeeper
+}
+
+func NewCircuitBreakerDecorator(pauseKeeper PauseKeeper) CircuitBreakerDecorator {
+	if pauseKeeper == nil {
+		panic("circuit breaker decorator: pause keeper is nil")
+	}
+	return CircuitBreakerDecorator{pauseKeeper: pauseKeeper}
+}
+
+func (d CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (d CircuitBreakerDecorator) checkMsgs(ctx context.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			inner, err := exec.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(ctx, inner); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := d.pauseKeeper.CheckNotPaused(ctx, sdk.MsgTypeURL(msg), msgTableID(msg)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// msgTableID returns the table a poker or dealer message targets, or 0.
+func msgTableID(msg sdk.Msg) uint64 {
+	switch m := msg.(type) {
+	case *pokertypes.MsgSit:
+		return m.TableId
+	case *pokertypes.MsgStartHand:
+		return m.TableId
+	case *pokertypes.MsgAct:
+		return m.TableId
+	case *pokertypes.MsgTick:
+		return m.TableId
+	case *pokertypes.MsgLeave:
+		return m.TableId
+	case *pokertypes.MsgRebuy:
+		return m.TableId
+	case *pokertypes.MsgSetAutoTopUp:
+		return m.TableId
+	case *pokertypes.MsgChangeTable:
+		// Moving to a paused table is blocked like sitting at it.
+		return m.ToTableId
+	case *dealertypes.MsgInitHand:
+		return m.TableId
+	case *dealertypes.MsgSubmitShuffle:
+		return m.TableId
+	case *dealertypes.MsgFinalizeDeck:
+		return m.TableId
+	case *dealertypes.MsgSubmitPubShare:
+		return m.TableId
+	case *dealertypes.MsgSubmitEncShare:
+		return m.TableId
+	case *dealertypes.MsgSubmitEncShares:
+		return m.TableId
+	case *dealertypes.MsgFinalizeReveal:
+		return m.TableId
+	case *dealertypes.MsgTimeout:
+		return m.TableId
+	case *dealertypes.MsgReportDealerWithholding:
+		return m.TableId
+	}
+	return 0
+}
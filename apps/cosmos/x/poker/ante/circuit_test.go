@@ -0,0 +1,55 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+	"onchainpoker/apps/cosmos/x/poker/ante"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+type fakePauseKeeper struct{ state pokertypes.PauseState }
+
+func (k fakePauseKeeper) CheckNotPaused(_ context.Context, msgTypeURL string, tableID uint64) error {
+	return k.state.Check(msgTypeURL, tableID)
+}
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).Ctx
+	handler := sdk.ChainAnteDecorators(ante.NewCircuitBreakerDecorator(fakePauseKeeper{state: pokertypes.PauseState{
+		MsgTypeUrls: []string{sdk.MsgTypeURL(&pokertypes.MsgStartHand{})},
+		TableIds:    []uint64{3},
+	}}))
+	player := acc(0x01).String()
+	run := func(msgs ...sdk.Msg) error {
+		_, err := handler(ctx, fakeTx{msgs: msgs}, false)
+		return err
+	}
+
+	// Paused message type, on any table and nested in authz.
+	require.ErrorIs(t, run(&pokertypes.MsgStartHand{Caller: player, TableId: 1}), pokertypes.ErrPaused)
+	exec := authz.NewMsgExec(acc(0x02), []sdk.Msg{&pokertypes.MsgStartHand{Caller: player, TableId: 1}})
+	require.ErrorIs(t, run(&exec), pokertypes.ErrPaused)
+
+	// Paused table: no new seats or hands, but the running hand can finish
+	// and players can leave.
+	require.ErrorIs(t, run(&pokertypes.MsgSit{Player: player, TableId: 3}), pokertypes.ErrPaused)
+	require.ErrorIs(t, run(&dealertypes.MsgInitHand{Caller: player, TableId: 3}), pokertypes.ErrPaused)
+	require.ErrorIs(t, run(&pokertypes.MsgChangeTable{Player: player, FromTableId: 4, ToTableId: 3}), pokertypes.ErrPaused)
+	require.NoError(t, run(&pokertypes.MsgChangeTable{Player: player, FromTableId: 3, ToTableId: 4}))
+	require.NoError(t, run(&pokertypes.MsgAct{Player: player, TableId: 3, Action: "fold"}))
+	require.NoError(t, run(&pokertypes.MsgLeave{Player: player, TableId: 3}))
+	require.NoError(t, run(&dealertypes.MsgTimeout{Caller: player, TableId: 3}))
+
+	// Other tables are unaffected.
+	require.NoError(t, run(&pokertypes.MsgSit{Player: player, TableId: 4}))
+}
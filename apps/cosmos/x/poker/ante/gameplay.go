@@ -0,0 +1,305 @@
+// Package ante holds the fee policy for gameplay transactions and the
+// circuit breaker decorator (circuit.go).
+//
+// Gameplay messages (poker actions and ticks from seated players, and dealer
+// share submissions from members of the hand's committee) are fee-exempt so
+// players and validators are not charged per action. To keep that from
+// becoming free spam, every exempt transaction is counted per signer and per
+// table in a transient store and rejected once a block's budget, set by the
+// x/poker params, is used up. An authz MsgExec is exempt only when its
+// grantee holds a poker session authorization from the seated player of
+// every inner action or tick. Every other transaction, including a MsgTick
+// from an account not seated at the table, pays standard fees through the
+// wrapped fee decorator.
+package ante
+
+import (
+	"context"
+	"encoding/binary"
+	"slices"
+	"time"
+
+	corestore "cosmossdk.io/core/store"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// TransientStoreKey names the transient store holding per-block counters.
+const TransientStoreKey = "transient_poker_gameplay"
+
+var (
+	signerCountPrefix = []byte{0x01}
+	tableCountPrefix  = []byte{0x02}
+)
+
+// DealerKeeper is the subset of the x/dealer keeper used to recognise
+// committee members of the epoch a hand is dealt by.
+type DealerKeeper interface {
+	GetHand(ctx context.Context, tableID, handID uint64) (*dealertypes.DealerHand, error)
+	EpochByID(ctx context.Context, epochID uint64) (*dealertypes.DealerEpoch, error)
+}
+
+// PokerKeeper is the subset of the x/poker keeper used to read the rate
+// limits and to recognise seated players.
+type PokerKeeper interface {
+	GetParams(ctx context.Context) (pokertypes.Params, error)
+	GetTable(ctx context.Context, tableID uint64) (*pokertypes.Table, error)
+}
+
+// AuthzKeeper is the subset of the x/authz keeper used to check that the
+// grantee of a MsgExec holds a session authorization from the player.
+type AuthzKeeper interface {
+	GetAuthorization(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
+}
+
+// GameplayFeeDecorator exempts gameplay transactions from fees and rate
+// limits them; any other transaction is passed to feeDecorator.
+type GameplayFeeDecorator struct {
+	feeDecorator sdk.AnteDecorator
+	dealerKeeper DealerKeeper
+	pokerKeeper  PokerKeeper
+	authzKeeper  AuthzKeeper
+	storeService corestore.TransientStoreService
+}
+
+func NewGameplayFeeDecorator(
+	feeDecorator sdk.AnteDecorator,
+	dealerKeeper DealerKeeper,
+	pokerKeeper PokerKeeper,
+	authzKeeper AuthzKeeper,
+	storeService corestore.TransientStoreService,
+) GameplayFeeDecorator {
+	if feeDecorator == nil {
+		panic("gameplay fee decorator: fee decorator is nil")
+	}
+	if dealerKeeper == nil {
+		panic("gameplay fee decorator: dealer keeper is nil")
+	}
+	if pokerKeeper == nil {
+		panic("gameplay fee decorator: poker keeper is nil")
+	}
+	if authzKeeper == nil {
+		panic("gameplay fee decorator: authz keeper is nil")
+	}
+	if storeService == nil {
+		panic("gameplay fee decorator: store service is nil")
+	}
+	return GameplayFeeDecorator{
+		feeDecorator: feeDecorator,
+		dealerKeeper: dealerKeeper,
+		pokerKeeper:  pokerKeeper,
+		authzKeeper:  authzKeeper,
+		storeService: storeService,
+	}
+}
+
+func (d GameplayFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	tables, ok, err := d.gameplayTables(ctx, tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	if !ok {
+		return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, pokertypes.ErrInvalidRequest.Wrap("invalid transaction type")
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	params, err := d.pokerKeeper.GetParams(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	store := d.storeService.OpenTransientStore(ctx)
+	for _, signer := range signers {
+		key := append(append([]byte{}, signerCountPrefix...), signer...)
+		ok, err := bumpCounter(store, key, params.GameplayTxsPerSigner)
+		if err != nil {
+			return ctx, err
+		}
+		if !ok {
+			return ctx, pokertypes.ErrRateLimited.Wrapf("signer %s reached %d txs this block", sdk.AccAddress(signer), params.GameplayTxsPerSigner)
+		}
+	}
+	for _, tableID := range tables {
+		key := binary.BigEndian.AppendUint64(append([]byte{}, tableCountPrefix...), tableID)
+		ok, err := bumpCounter(store, key, params.GameplayTxsPerTable)
+		if err != nil {
+			return ctx, err
+		}
+		if !ok {
+			return ctx, pokertypes.ErrRateLimited.Wrapf("table %d reached %d txs this block", tableID, params.GameplayTxsPerTable)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// gameplayTables reports whether every message in msgs is a gameplay
+// message, and if so which tables they touch (deduplicated, in order).
+// authz MsgExec counts when all of its inner messages do and its grantee
+// holds a session authorization for each of them.
+func (d GameplayFeeDecorator) gameplayTables(ctx context.Context, msgs []sdk.Msg) ([]uint64, bool, error) {
+	if len(msgs) == 0 {
+		return nil, false, nil
+	}
+	epochs := map[uint64]*dealertypes.DealerEpoch{}
+	isMember := func(validator string, tableID, handID uint64) (bool, error) {
+		dh, err := d.dealerKeeper.GetHand(ctx, tableID, handID)
+		if err != nil || dh == nil {
+			return false, err
+		}
+		epoch, ok := epochs[dh.EpochId]
+		if !ok {
+			if epoch, err = d.dealerKeeper.EpochByID(ctx, dh.EpochId); err != nil {
+				return false, err
+			}
+			epochs[dh.EpochId] = epoch
+		}
+		if epoch == nil {
+			return false, nil
+		}
+		for _, m := range epoch.Members {
+			if m.Validator == validator {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	isSeated := func(player string, tableID uint64) (bool, error) {
+		t, err := d.pokerKeeper.GetTable(ctx, tableID)
+		if err != nil || t == nil {
+			return false, err
+		}
+		for _, seat := range t.Seats {
+			if seat != nil && seat.Player == player {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	tables := []uint64{}
+	add := func(id uint64) {
+		for _, t := range tables {
+			if t == id {
+				return
+			}
+		}
+		tables = append(tables, id)
+	}
+	for _, msg := range msgs {
+		var (
+			validator       string
+			tableID, handID uint64
+		)
+		switch m := msg.(type) {
+		case *pokertypes.MsgAct:
+			seated, err := isSeated(m.Player, m.TableId)
+			if err != nil || !seated {
+				return nil, false, err
+			}
+			add(m.TableId)
+			continue
+		case *pokertypes.MsgTick:
+			seated, err := isSeated(m.Caller, m.TableId)
+			if err != nil || !seated {
+				return nil, false, err
+			}
+			add(m.TableId)
+			continue
+		case *authz.MsgExec:
+			inner, err := m.GetMessages()
+			if err != nil {
+				return nil, false, err
+			}
+			for _, msg := range inner {
+				if ok, err := d.holdsSession(ctx, m.Grantee, msg); err != nil || !ok {
+					return nil, false, err
+				}
+			}
+			innerTables, ok, err := d.gameplayTables(ctx, inner)
+			if err != nil || !ok {
+				return nil, false, err
+			}
+			for _, id := range innerTables {
+				add(id)
+			}
+			continue
+		case *dealertypes.MsgSubmitShuffle:
+			validator, tableID, handID = m.Shuffler, m.TableId, m.HandId
+		case *dealertypes.MsgSubmitPubShare:
+			validator, tableID, handID = m.Validator, m.TableId, m.HandId
+		case *dealertypes.MsgSubmitEncShare:
+			validator, tableID, handID = m.Validator, m.TableId, m.HandId
+		case *dealertypes.MsgSubmitEncShares:
+			validator, tableID, handID = m.Validator, m.TableId, m.HandId
+		default:
+			return nil, false, nil
+		}
+		member, err := isMember(validator, tableID, handID)
+		if err != nil || !member {
+			return nil, false, err
+		}
+		add(tableID)
+	}
+	return tables, true, nil
+}
+
+// holdsSession reports whether grantee may send msg, a MsgAct or MsgTick, on
+// behalf of its signer under a poker session authorization covering the
+// message's table. A signer executing its own message needs no grant.
+func (d GameplayFeeDecorator) holdsSession(ctx context.Context, grantee string, msg sdk.Msg) (bool, error) {
+	var (
+		granter string
+		tableID uint64
+	)
+	switch m := msg.(type) {
+	case *pokertypes.MsgAct:
+		granter, tableID = m.Player, m.TableId
+	case *pokertypes.MsgTick:
+		granter, tableID = m.Caller, m.TableId
+	default:
+		return false, nil
+	}
+	if granter == grantee {
+		return true, nil
+	}
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return false, nil
+	}
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return false, nil
+	}
+	auth, _ := d.authzKeeper.GetAuthorization(ctx, granteeAddr, granterAddr, sdk.MsgTypeURL(msg))
+	session, ok := auth.(*pokertypes.SessionAuthorization)
+	return ok && slices.Contains(session.TableIds, tableID), nil
+}
+
+// bumpCounter increments the counter at key, reporting false instead if that
+// would take it past limit.
+func bumpCounter(store corestore.KVStore, key []byte, limit uint64) (bool, error) {
+	bz, err := store.Get(key)
+	if err != nil {
+		return false, err
+	}
+	var n uint64
+	if len(bz) == 8 {
+		n = binary.BigEndian.Uint64(bz)
+	}
+	if limit != 0 && n >= limit {
+		return false, nil
+	}
+	return true, store.Set(key, binary.BigEndian.AppendUint64(nil, n+1))
+}
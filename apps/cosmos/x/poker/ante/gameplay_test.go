@@ -0,0 +1,241 @@
+package ante_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	storetypes "cosmossdk.io/store/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	dealertypes "onchainpoker/apps/cosmos/x/dealer/types"
+	"onchainpoker/apps/cosmos/x/poker/ante"
+	pokertypes "onchainpoker/apps/cosmos/x/poker/types"
+)
+
+type fakeTx struct {
+	msgs    []sdk.Msg
+	signers [][]byte
+}
+
+func (tx fakeTx) GetMsgs() []sdk.Msg                              { return tx.msgs }
+func (tx fakeTx) GetMsgsV2() ([]protov2.Message, error)           { return nil, nil }
+func (tx fakeTx) GetSigners() ([][]byte, error)                   { return tx.signers, nil }
+func (tx fakeTx) GetPubKeys() ([]cryptotypes.PubKey, error)       { return nil, nil }
+func (tx fakeTx) GetSignaturesV2() ([]signing.SignatureV2, error) { return nil, nil }
+
+// fakeDealerKeeper deals every hand of table 1 with epoch 1 and hand 2 with
+// epoch 2.
+type fakeDealerKeeper struct {
+	epochs map[uint64]*dealertypes.DealerEpoch
+}
+
+func (k fakeDealerKeeper) GetHand(_ context.Context, tableID, handID uint64) (*dealertypes.DealerHand, error) {
+	if tableID != 1 {
+		return nil, nil
+	}
+	if handID == 2 {
+		return &dealertypes.DealerHand{EpochId: 2}, nil
+	}
+	return &dealertypes.DealerHand{EpochId: 1}, nil
+}
+
+func (k fakeDealerKeeper) EpochByID(_ context.Context, epochID uint64) (*dealertypes.DealerEpoch, error) {
+	return k.epochs[epochID], nil
+}
+
+type fakePokerKeeper struct {
+	params pokertypes.Params
+	tables map[uint64]*pokertypes.Table
+}
+
+func (k fakePokerKeeper) GetParams(context.Context) (pokertypes.Params, error) { return k.params, nil }
+
+func (k fakePokerKeeper) GetTable(_ context.Context, id uint64) (*pokertypes.Table, error) {
+	return k.tables[id], nil
+}
+
+// fakeAuthzKeeper holds grants keyed by grantee, granter and msg type URL.
+type fakeAuthzKeeper struct {
+	grants map[string]authz.Authorization
+}
+
+func (k fakeAuthzKeeper) GetAuthorization(_ context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time) {
+	return k.grants[grantee.String()+"/"+granter.String()+msgType], nil
+}
+
+// feeRecorder stands in for the SDK fee decorator and records whether it ran.
+type feeRecorder struct{ calls *int }
+
+func (f feeRecorder) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*f.calls++
+	return next(ctx, tx, simulate)
+}
+
+func acc(b byte) sdk.AccAddress { return sdk.AccAddress(bytes.Repeat([]byte{b}, 20)) }
+
+// setup seats acc(1) to acc(4) at tables 1 to 3. acc(0x50) is in
+// the committee of epoch 1 and acc(0x52) in that of epoch 2 only. acc(9)
+// holds a session authorization from acc(1) for actions at table 1.
+func setup(t *testing.T, params pokertypes.Params) (sdk.Context, sdk.AnteHandler, *int, string) {
+	t.Helper()
+	tkey := storetypes.NewTransientStoreKey(ante.TransientStoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey("test"), tkey)
+
+	member := sdk.ValAddress(acc(0x50)).String()
+	dk := fakeDealerKeeper{epochs: map[uint64]*dealertypes.DealerEpoch{
+		1: {EpochId: 1, Members: []dealertypes.DealerMember{{Validator: member, Index: 1}}},
+		2: {EpochId: 2, Members: []dealertypes.DealerMember{{Validator: sdk.ValAddress(acc(0x52)).String(), Index: 1}}},
+	}}
+	pk := fakePokerKeeper{params: params, tables: map[uint64]*pokertypes.Table{}}
+	for _, id := range []uint64{1, 2, 3} {
+		seats := make([]*pokertypes.Seat, 9)
+		for i, b := range []byte{1, 2, 3, 4} {
+			seats[i] = &pokertypes.Seat{Player: acc(b).String()}
+		}
+		pk.tables[id] = &pokertypes.Table{Id: id, Seats: seats}
+	}
+	act := &pokertypes.MsgAct{}
+	ak := fakeAuthzKeeper{grants: map[string]authz.Authorization{
+		acc(9).String() + "/" + acc(1).String() + sdk.MsgTypeURL(act): pokertypes.NewSessionAuthorization(act, []uint64{1}, 0),
+	}}
+	calls := 0
+	dec := ante.NewGameplayFeeDecorator(feeRecorder{calls: &calls}, dk, pk, ak, runtime.NewTransientStoreService(tkey))
+	return testCtx.Ctx, sdk.ChainAnteDecorators(dec), &calls, member
+}
+
+func TestGameplayFeeDecorator_ExemptsGameplay(t *testing.T) {
+	ctx, handler, calls, member := setup(t, pokertypes.DefaultParams())
+	p := acc(1)
+
+	cases := []fakeTx{
+		{msgs: []sdk.Msg{&pokertypes.MsgAct{Player: p.String(), TableId: 1, Action: "check"}}, signers: [][]byte{p}},
+		{msgs: []sdk.Msg{&dealertypes.MsgSubmitPubShare{Validator: member, TableId: 1, HandId: 1}}, signers: [][]byte{acc(0x50)}},
+		{msgs: []sdk.Msg{&dealertypes.MsgSubmitEncShares{Validator: member, TableId: 1, HandId: 1}}, signers: [][]byte{acc(0x50)}},
+		// A member of the epoch dealing hand 2, which is not the newest one.
+		{msgs: []sdk.Msg{&dealertypes.MsgSubmitPubShare{Validator: sdk.ValAddress(acc(0x52)).String(), TableId: 1, HandId: 2}}, signers: [][]byte{acc(0x52)}},
+	}
+	for _, tx := range cases {
+		_, err := handler(ctx, tx, false)
+		require.NoError(t, err)
+	}
+	require.Zero(t, *calls)
+
+	// Session keys: an authz MsgExec of gameplay messages is exempt too when
+	// the grantee holds a session authorization from the player.
+	exec := authz.NewMsgExec(acc(9), []sdk.Msg{&pokertypes.MsgAct{Player: p.String(), TableId: 1, Action: "fold"}})
+	_, err := handler(ctx, fakeTx{msgs: []sdk.Msg{&exec}, signers: [][]byte{acc(9)}}, false)
+	require.NoError(t, err)
+	require.Zero(t, *calls)
+}
+
+func TestGameplayFeeDecorator_ChargesOtherTxs(t *testing.T) {
+	ctx, handler, calls, member := setup(t, pokertypes.DefaultParams())
+	p := acc(1)
+
+	txs := []fakeTx{
+		// Not gameplay.
+		{msgs: []sdk.Msg{&pokertypes.MsgSit{Player: p.String(), TableId: 1}}, signers: [][]byte{p}},
+		// Mixed with a gameplay message.
+		{msgs: []sdk.Msg{
+			&pokertypes.MsgAct{Player: p.String(), TableId: 1, Action: "check"},
+			&pokertypes.MsgLeave{Player: p.String(), TableId: 1},
+		}, signers: [][]byte{p}},
+		// Dealer share from a validator outside the committee.
+		{msgs: []sdk.Msg{&dealertypes.MsgSubmitEncShare{Validator: sdk.ValAddress(acc(0x51)).String(), TableId: 1, HandId: 1}}, signers: [][]byte{acc(0x51)}},
+		// Dealer share from a member of another epoch than the hand's.
+		{msgs: []sdk.Msg{&dealertypes.MsgSubmitEncShare{Validator: member, TableId: 1, HandId: 2}}, signers: [][]byte{acc(0x50)}},
+		// An action from an account not seated at the table.
+		{msgs: []sdk.Msg{&pokertypes.MsgAct{Player: acc(7).String(), TableId: 1, Action: "check"}}, signers: [][]byte{acc(7)}},
+	}
+	for i, tx := range txs {
+		_, err := handler(ctx, tx, false)
+		require.NoError(t, err)
+		require.Equal(t, i+1, *calls)
+	}
+}
+
+func TestGameplayFeeDecorator_ChargesExecWithoutSession(t *testing.T) {
+	ctx, handler, calls, member := setup(t, pokertypes.Params{GameplayTxsPerTable: 1})
+	p := acc(1).String()
+
+	cases := []struct {
+		grantee sdk.AccAddress
+		msg     sdk.Msg
+	}{
+		// acc(8) holds no grant from the player.
+		{acc(8), &pokertypes.MsgAct{Player: p, TableId: 1, Action: "fold"}},
+		// acc(9)'s grant covers actions, not ticks.
+		{acc(9), &pokertypes.MsgTick{Caller: p, TableId: 1}},
+		// acc(9)'s grant covers table 1 only.
+		{acc(9), &pokertypes.MsgAct{Player: p, TableId: 2, Action: "fold"}},
+		// Dealer shares are only exempt when the member sends them itself.
+		{acc(9), &dealertypes.MsgSubmitPubShare{Validator: member, TableId: 1, HandId: 1}},
+	}
+	for i, tc := range cases {
+		exec := authz.NewMsgExec(tc.grantee, []sdk.Msg{tc.msg})
+		_, err := handler(ctx, fakeTx{msgs: []sdk.Msg{&exec}, signers: [][]byte{tc.grantee}}, false)
+		require.NoError(t, err)
+		require.Equal(t, i+1, *calls)
+	}
+
+	// None of them used up table 1's budget.
+	_, err := handler(ctx, fakeTx{msgs: []sdk.Msg{&pokertypes.MsgAct{Player: p, TableId: 1, Action: "check"}}, signers: [][]byte{acc(1)}}, false)
+	require.NoError(t, err)
+	require.Equal(t, len(cases), *calls)
+}
+
+func TestGameplayFeeDecorator_TicksExemptOnlyWhenSeated(t *testing.T) {
+	ctx, handler, calls, _ := setup(t, pokertypes.Params{GameplayTxsPerSigner: 1})
+
+	// A tick from a player seated at the table is exempt and counted like an
+	// action.
+	seated := fakeTx{msgs: []sdk.Msg{&pokertypes.MsgTick{Caller: acc(1).String(), TableId: 1}}, signers: [][]byte{acc(1)}}
+	_, err := handler(ctx, seated, false)
+	require.NoError(t, err)
+	require.Zero(t, *calls)
+	_, err = handler(ctx, seated, false)
+	require.ErrorIs(t, err, pokertypes.ErrRateLimited)
+
+	// A tick from anyone else pays fees and is not rate limited.
+	unseated := fakeTx{msgs: []sdk.Msg{&pokertypes.MsgTick{Caller: acc(7).String(), TableId: 1}}, signers: [][]byte{acc(7)}}
+	for i := 1; i <= 2; i++ {
+		_, err = handler(ctx, unseated, false)
+		require.NoError(t, err)
+		require.Equal(t, i, *calls)
+	}
+}
+
+func TestGameplayFeeDecorator_RateLimits(t *testing.T) {
+	ctx, handler, _, _ := setup(t, pokertypes.Params{GameplayTxsPerSigner: 2, GameplayTxsPerTable: 3})
+	act := func(b byte, table uint64) error {
+		p := acc(b)
+		_, err := handler(ctx, fakeTx{
+			msgs:    []sdk.Msg{&pokertypes.MsgAct{Player: p.String(), TableId: table, Action: "check"}},
+			signers: [][]byte{p},
+		}, false)
+		return err
+	}
+
+	require.NoError(t, act(1, 1))
+	require.NoError(t, act(1, 2))
+	err := act(1, 3)
+	require.ErrorIs(t, err, pokertypes.ErrRateLimited)
+	require.ErrorContains(t, err, "reached 2 txs this block")
+
+	require.NoError(t, act(2, 1))
+	require.NoError(t, act(3, 1))
+	err = act(4, 1)
+	require.ErrorIs(t, err, pokertypes.ErrRateLimited)
+	require.ErrorContains(t, err, "table 1 reached 3 txs this block")
+}
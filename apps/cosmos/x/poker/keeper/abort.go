@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+func tableAbortRefundPolicy(t *types.Table) types.AbortRefundPolicy {
+	if t.Params.AbortRefundPolicy == types.AbortRefundPolicy_ABORT_REFUND_POLICY_UNSPECIFIED {
+		return types.AbortRefundPolicy_ABORT_REFUND_POLICY_REFUND_ALL
+	}
+	return t.Params.AbortRefundPolicy
+}
+
+func abortRefundPolicyLabel(p types.AbortRefundPolicy) string {
+	switch p {
+	case types.AbortRefundPolicy_ABORT_REFUND_POLICY_REFUND_ALL_BUT_BLINDS:
+		return "refund-all-but-blinds"
+	case types.AbortRefundPolicy_ABORT_REFUND_POLICY_LOCK_POTS:
+		return "lock-pots"
+	default:
+		return "refund-all"
+	}
+}
+
+func seatOccupied(t *types.Table, i int) bool {
+	return t.Seats[i] != nil && t.Seats[i].Player != ""
+}
+
+func handU64(xs []uint64, i int) uint64 {
+	if i < len(xs) {
+		return xs[i]
+	}
+	return 0
+}
+
+// abortPayouts returns what each seat is owed from its own commits under the
+// table's abort refund policy, plus a pool of chips that belong to the live
+// seats collectively (forfeited blinds).
+func abortPayouts(t *types.Table, policy types.AbortRefundPolicy) ([]uint64, uint64, error) {
+	h := t.Hand
+	payout := make([]uint64, 9)
+	for i := 0; i < 9; i++ {
+		payout[i] = handU64(h.TotalCommit, i)
+	}
+
+	live := make([]bool, 9)
+	anyLive := false
+	for i := 0; i < 9; i++ {
+		live[i] = h.InHand[i] && !h.Folded[i] && seatOccupied(t, i)
+		anyLive = anyLive || live[i]
+	}
+	if !anyLive {
+		// Nobody is left to lock pots or blinds for.
+		return payout, 0, nil
+	}
+
+	switch policy {
+	case types.AbortRefundPolicy_ABORT_REFUND_POLICY_REFUND_ALL_BUT_BLINDS:
+		var pool uint64
+		forfeit := func(seat int32, blind uint64) error {
+			if seat < 0 || seat >= 9 {
+				return nil
+			}
+			amt := min(blind, payout[seat])
+			payout[seat] -= amt
+			next, err := addUint64Checked(pool, amt, "forfeited blinds")
+			if err != nil {
+				return err
+			}
+			pool = next
+			return nil
+		}
+		if err := forfeit(h.SmallBlindSeat, t.Params.SmallBlind); err != nil {
+			return nil, 0, err
+		}
+		if err := forfeit(h.BigBlindSeat, t.Params.BigBlind); err != nil {
+			return nil, 0, err
+		}
+		return payout, pool, nil
+
+	case types.AbortRefundPolicy_ABORT_REFUND_POLICY_LOCK_POTS:
+		// Commits above the largest live commit were never matched; return them
+		// and split each remaining pot evenly among the seats eligible for it.
+		var maxLive uint64
+		for i := 0; i < 9; i++ {
+			if live[i] && payout[i] > maxLive {
+				maxLive = payout[i]
+			}
+		}
+		capped := make([]uint64, 9)
+		for i := 0; i < 9; i++ {
+			capped[i] = min(payout[i], maxLive)
+			payout[i] -= capped[i]
+		}
+		pots, err := computeSidePots(capped, live)
+		if err != nil {
+			return nil, 0, err
+		}
+		var pool uint64
+		for _, p := range pots {
+			if len(p.EligibleSeats) == 0 {
+				next, err := addUint64Checked(pool, p.Amount, "locked pot")
+				if err != nil {
+					return nil, 0, err
+				}
+				pool = next
+				continue
+			}
+			for j, amt := range splitEvenly(p.Amount, len(p.EligibleSeats)) {
+				seat := p.EligibleSeats[j]
+				next, err := addUint64Checked(payout[seat], amt, "locked pot share")
+				if err != nil {
+					return nil, 0, err
+				}
+				payout[seat] = next
+			}
+		}
+		return payout, pool, nil
+	}
+	return payout, 0, nil
+}
+
+// abortHand clears the active hand, resolving its escrow under the table's
+// abort refund policy. It returns the chips nobody seated can receive, which
+// the caller sends to the fee collector. The table is left untouched on error.
+func abortHand(t *types.Table, reason string) ([]sdk.Event, uint64, error) {
+	if t == nil || t.Hand == nil {
+		return nil, 0, nil
+	}
+	h := t.Hand
+	handID := h.HandId
+	policy := tableAbortRefundPolicy(t)
+
+	payout, pool, err := abortPayouts(t, policy)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Chips owed to seats whose player has left, dead money and slash credit
+	// all go to the pool shared by the remaining players.
+	orphan := h.DeadMoney
+	for i := 0; i < 9; i++ {
+		credit := handU64(h.SlashCredit, i)
+		if seatOccupied(t, i) {
+			next, err := addUint64Checked(payout[i], credit, "slash credit")
+			if err != nil {
+				return nil, 0, err
+			}
+			payout[i] = next
+			continue
+		}
+		next, err := addUint64Checked(orphan, payout[i], "orphaned refund")
+		if err != nil {
+			return nil, 0, err
+		}
+		if next, err = addUint64Checked(next, credit, "orphaned refund"); err != nil {
+			return nil, 0, err
+		}
+		orphan = next
+		payout[i] = 0
+	}
+	if pool, err = addUint64Checked(pool, orphan, "abort pool"); err != nil {
+		return nil, 0, err
+	}
+
+	var unclaimed uint64
+	if pool != 0 {
+		seats := liveSeatsFromButton(t)
+		if len(seats) == 0 {
+			for i := 0; i < 9; i++ {
+				if h.InHand[i] && seatOccupied(t, i) {
+					seats = append(seats, i)
+				}
+			}
+		}
+		if len(seats) == 0 {
+			unclaimed = pool
+		} else {
+			for j, amt := range splitEvenly(pool, len(seats)) {
+				next, err := addUint64Checked(payout[seats[j]], amt, "abort pool share")
+				if err != nil {
+					return nil, 0, err
+				}
+				payout[seats[j]] = next
+			}
+		}
+	}
+
+	stacks := make([]uint64, 9)
+	for i := 0; i < 9; i++ {
+		if !seatOccupied(t, i) {
+			continue
+		}
+		next, err := addUint64Checked(t.Seats[i].Stack, payout[i], "seat stack refund")
+		if err != nil {
+			return nil, 0, err
+		}
+		stacks[i] = next
+	}
+
+	// Everything is computed; apply it and clear any public hole cards.
+	refundSeats := make([]int, 0, 9)
+	refundAmounts := make([]uint64, 0, 9)
+	for i := 0; i < 9; i++ {
+		if t.Seats[i] == nil {
+			continue
+		}
+		if seatOccupied(t, i) {
+			t.Seats[i].Stack = stacks[i]
+			if payout[i] != 0 {
+				refundSeats = append(refundSeats, i)
+				refundAmounts = append(refundAmounts, payout[i])
+			}
+		}
+		t.Seats[i].Hole = []uint32{255, 255}
+	}
+	t.Hand = nil
+
+	return []sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeHandAborted,
+			sdk.NewAttribute("tableId", fmt.Sprintf("%d", t.Id)),
+			sdk.NewAttribute("handId", fmt.Sprintf("%d", handID)),
+			sdk.NewAttribute("reason", reason),
+			sdk.NewAttribute("refundPolicy", abortRefundPolicyLabel(policy)),
+			sdk.NewAttribute("refundSeats", joinSeats(refundSeats)),
+			sdk.NewAttribute("refundAmounts", joinAmounts(refundAmounts)),
+			sdk.NewAttribute("unclaimed", fmt.Sprintf("%d", unclaimed)),
+		),
+	}, unclaimed, nil
+}
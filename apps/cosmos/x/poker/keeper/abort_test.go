@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// abortTable returns a three-handed hand: button 0, blinds 1/2 posted by
+// seats 1 and 2, seats 0 and 1 have put in 20 and seat 2 folded for 5.
+func abortTable(policy types.AbortRefundPolicy) *types.Table {
+	tbl := &types.Table{
+		Id: 1,
+		Params: types.TableParams{
+			MaxPlayers:        9,
+			SmallBlind:        1,
+			BigBlind:          2,
+			PlayerBond:        10,
+			AbortRefundPolicy: policy,
+		},
+		Seats:      make([]*types.Seat, 9),
+		NextHandId: 2,
+		Hand: &types.Hand{
+			HandId:         1,
+			Phase:          types.HandPhase_HAND_PHASE_AWAIT_FLOP,
+			Street:         types.Street_STREET_PREFLOP,
+			ButtonSeat:     0,
+			SmallBlindSeat: 1,
+			BigBlindSeat:   2,
+			ActionOn:       -1,
+			InHand:         []bool{true, true, true, false, false, false, false, false, false},
+			Folded:         []bool{false, false, true, false, false, false, false, false, false},
+			AllIn:          make([]bool, 9),
+			StreetCommit:   make([]uint64, 9),
+			TotalCommit:    []uint64{20, 20, 5, 0, 0, 0, 0, 0, 0},
+			SlashCredit:    make([]uint64, 9),
+		},
+	}
+	for i := range tbl.Seats {
+		tbl.Seats[i] = &types.Seat{Hole: []uint32{255, 255}}
+	}
+	for i := 0; i < 3; i++ {
+		tbl.Seats[i] = &types.Seat{Player: abortAddr(byte(0x30 + i)), Stack: 100, Bond: 10, Hole: []uint32{7, 8}}
+	}
+	return tbl
+}
+
+func abortAddr(b byte) string {
+	return sdk.AccAddress(bytes.Repeat([]byte{b}, 20)).String()
+}
+
+func abortStacks(tbl *types.Table) []uint64 {
+	return []uint64{tbl.Seats[0].Stack, tbl.Seats[1].Stack, tbl.Seats[2].Stack}
+}
+
+func eventAttr(ev sdk.Event, key string) string {
+	for _, a := range ev.Attributes {
+		if a.Key == key {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func TestAbortHand_RefundPolicies(t *testing.T) {
+	cases := []struct {
+		policy types.AbortRefundPolicy
+		label  string
+		stacks []uint64
+	}{
+		{types.AbortRefundPolicy_ABORT_REFUND_POLICY_UNSPECIFIED, "refund-all", []uint64{120, 120, 105}},
+		{types.AbortRefundPolicy_ABORT_REFUND_POLICY_REFUND_ALL, "refund-all", []uint64{120, 120, 105}},
+		// Blinds (1 + 2) are split among the live seats starting left of the button.
+		{types.AbortRefundPolicy_ABORT_REFUND_POLICY_REFUND_ALL_BUT_BLINDS, "refund-all-but-blinds", []uint64{121, 121, 103}},
+		// The 45 chip pot is split between the two live seats.
+		{types.AbortRefundPolicy_ABORT_REFUND_POLICY_LOCK_POTS, "lock-pots", []uint64{123, 122, 100}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			tbl := abortTable(tc.policy)
+			events, unclaimed, err := abortHand(tbl, "dealer: test")
+			require.NoError(t, err)
+			require.Zero(t, unclaimed)
+			require.Nil(t, tbl.Hand)
+			require.Equal(t, tc.stacks, abortStacks(tbl))
+			require.Equal(t, []uint32{255, 255}, tbl.Seats[0].Hole)
+
+			require.Len(t, events, 1)
+			require.Equal(t, types.EventTypeHandAborted, events[0].Type)
+			require.Equal(t, tc.label, eventAttr(events[0], "refundPolicy"))
+			require.Equal(t, "dealer: test", eventAttr(events[0], "reason"))
+		})
+	}
+}
+
+func TestAbortHand_LockPotsReturnsUnmatchedCommit(t *testing.T) {
+	tbl := abortTable(types.AbortRefundPolicy_ABORT_REFUND_POLICY_LOCK_POTS)
+	// Seat 1 is all-in for 10; seat 0's extra 10 was never matched.
+	tbl.Hand.TotalCommit[1] = 10
+	tbl.Hand.AllIn[1] = true
+
+	_, _, err := abortHand(tbl, "abort")
+	require.NoError(t, err)
+	// Main pot 25 -> 13/12; seat 0 gets back its unmatched 10.
+	require.Equal(t, []uint64{123, 112, 100}, abortStacks(tbl))
+}
+
+func TestAbortHand_OrphanedChipsGoToRemainingPlayers(t *testing.T) {
+	tbl := abortTable(types.AbortRefundPolicy_ABORT_REFUND_POLICY_REFUND_ALL)
+	// Seat 2 folded and left; its commit and the dead money have no owner.
+	tbl.Seats[2] = &types.Seat{Hole: []uint32{255, 255}}
+	tbl.Hand.DeadMoney = 4
+	tbl.Hand.SlashCredit[1] = 3
+
+	events, unclaimed, err := abortHand(tbl, "abort")
+	require.NoError(t, err)
+	require.Zero(t, unclaimed)
+	// Pool of 9 splits 5/4 starting left of the button.
+	require.Equal(t, []uint64{124, 128, 0}, abortStacks(tbl))
+	require.Equal(t, "0,1", eventAttr(events[0], "refundSeats"))
+	require.Equal(t, "24,28", eventAttr(events[0], "refundAmounts"))
+}
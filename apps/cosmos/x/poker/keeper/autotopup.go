@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+func (m msgServer) SetAutoTopUp(ctx context.Context, req *types.MsgSetAutoTopUp) (*types.MsgSetAutoTopUpResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest.Wrap("nil request")
+	}
+	if err := m.CheckNotPaused(ctx, sdk.MsgTypeURL(req), req.TableId); err != nil {
+		return nil, err
+	}
+	if req.Player == "" {
+		return nil, types.ErrInvalidRequest.Wrap("missing player")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Player); err != nil {
+		return nil, types.ErrInvalidRequest.Wrap("invalid player address")
+	}
+
+	t, err := m.GetTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, types.ErrTableNotFound.Wrapf("table %d not found", req.TableId)
+	}
+
+	seat := seatOfPlayer(t, req.Player)
+	if seat < 0 || seat >= 9 || t.Seats[seat] == nil || t.Seats[seat].Player == "" {
+		return nil, types.ErrNotSeated.Wrap("player not seated at table")
+	}
+	s := t.Seats[seat]
+
+	if req.TargetStack == 0 {
+		s.AutoTopUp = nil
+	} else {
+		if s.LeavePending {
+			return nil, types.ErrInvalidRequest.Wrap("leave pending")
+		}
+		if req.TargetStack > t.Params.MaxBuyIn {
+			return nil, types.ErrInvalidRequest.Wrapf("target stack exceeds max buy-in: %d > %d", req.TargetStack, t.Params.MaxBuyIn)
+		}
+		if req.Allowance == 0 {
+			return nil, types.ErrInvalidRequest.Wrap("allowance must be > 0")
+		}
+		s.AutoTopUp = &types.AutoTopUp{TargetStack: req.TargetStack, Allowance: req.Allowance}
+	}
+	if err := m.SetTable(ctx, t); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAutoTopUpSet,
+		sdk.NewAttribute("tableId", fmt.Sprintf("%d", req.TableId)),
+		sdk.NewAttribute("seat", fmt.Sprintf("%d", seat)),
+		sdk.NewAttribute("player", req.Player),
+		sdk.NewAttribute("targetStack", fmt.Sprintf("%d", req.TargetStack)),
+		sdk.NewAttribute("allowance", fmt.Sprintf("%d", req.Allowance)),
+	))
+
+	return &types.MsgSetAutoTopUpResponse{}, nil
+}
+
+// settleSeatsAfterHand runs the seat bookkeeping owed once a hand has ended:
+// queued leaves are cashed out, then auto top-ups are applied. It is a no-op
+// while a hand is in progress.
+func (k Keeper) settleSeatsAfterHand(ctx context.Context, t *types.Table) ([]sdk.Event, error) {
+	if t == nil || t.Hand != nil {
+		return nil, nil
+	}
+	events, err := k.settlePendingLeaves(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	topUpEvents, err := k.applyAutoTopUps(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	return append(events, topUpEvents...), nil
+}
+
+// applyAutoTopUps refills every seat with an AutoTopUp setting to its target
+// stack, drawing at most the remaining allowance from the player's cashier
+// balance and then their spendable bank balance. A setting whose allowance is
+// spent, or whose player can no longer cover the top-up, is cleared. Top-ups
+// are skipped while rebuys are paused for the table.
+func (k Keeper) applyAutoTopUps(ctx context.Context, t *types.Table) ([]sdk.Event, error) {
+	if t == nil || t.Hand != nil {
+		return nil, nil
+	}
+	if err := k.CheckNotPaused(ctx, sdk.MsgTypeURL(&types.MsgRebuy{}), t.Id); err != nil {
+		if errors.Is(err, types.ErrPaused) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var events []sdk.Event
+	for i := 0; i < 9 && i < len(t.Seats); i++ {
+		s := t.Seats[i]
+		if s == nil || s.Player == "" || s.AutoTopUp == nil || s.LeavePending {
+			continue
+		}
+		// Bondless seats are about to be ejected; don't pull chips in first.
+		if t.Params.PlayerBond != 0 && s.Bond == 0 {
+			continue
+		}
+		cfg := s.AutoTopUp
+		if s.Stack >= cfg.TargetStack {
+			continue
+		}
+		amount := min(cfg.TargetStack-s.Stack, cfg.Allowance)
+
+		addr, err := sdk.AccAddressFromBech32(s.Player)
+		if err != nil {
+			return nil, err
+		}
+		available, err := k.availableChips(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		if available.LT(sdkmath.NewIntFromUint64(amount)) {
+			s.AutoTopUp = nil
+			events = append(events, autoTopUpDisabledEvent(t.Id, i, s.Player, "insufficient balance"))
+			continue
+		}
+		if _, err := k.collectChips(ctx, addr, amount); err != nil {
+			return nil, err
+		}
+		if err := k.addTableEscrow(ctx, t.Id, amount); err != nil {
+			return nil, err
+		}
+
+		// target_stack <= max_buy_in, so the new stack cannot overflow.
+		s.Stack += amount
+		cfg.Allowance -= amount
+		events = append(events, sdk.NewEvent(
+			types.EventTypePlayerRebuyed,
+			sdk.NewAttribute("tableId", fmt.Sprintf("%d", t.Id)),
+			sdk.NewAttribute("seat", fmt.Sprintf("%d", i)),
+			sdk.NewAttribute("player", s.Player),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", amount)),
+			sdk.NewAttribute("newStack", fmt.Sprintf("%d", s.Stack)),
+			sdk.NewAttribute("auto", "true"),
+		))
+		if cfg.Allowance == 0 {
+			s.AutoTopUp = nil
+			events = append(events, autoTopUpDisabledEvent(t.Id, i, s.Player, "allowance spent"))
+		}
+	}
+	return events, nil
+}
+
+func autoTopUpDisabledEvent(tableID uint64, seat int, player, reason string) sdk.Event {
+	return sdk.NewEvent(
+		types.EventTypeAutoTopUpDisabled,
+		sdk.NewAttribute("tableId", fmt.Sprintf("%d", tableID)),
+		sdk.NewAttribute("seat", fmt.Sprintf("%d", seat)),
+		sdk.NewAttribute("player", player),
+		sdk.NewAttribute("reason", reason),
+	)
+}
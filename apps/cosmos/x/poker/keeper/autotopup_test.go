@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+func TestSetAutoTopUp_Validation(t *testing.T) {
+	sdkCtx, k, ms, _, p0, _ := setupHeadsUpBetting(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	_, err := ms.SetAutoTopUp(ctx, &types.MsgSetAutoTopUp{Player: addr(0xEE).String(), TableId: 1, TargetStack: 100, Allowance: 10})
+	require.ErrorIs(t, err, types.ErrNotSeated)
+	_, err = ms.SetAutoTopUp(ctx, &types.MsgSetAutoTopUp{Player: p0.String(), TableId: 1, TargetStack: 1001, Allowance: 10})
+	require.ErrorContains(t, err, "exceeds max buy-in")
+	_, err = ms.SetAutoTopUp(ctx, &types.MsgSetAutoTopUp{Player: p0.String(), TableId: 1, TargetStack: 100})
+	require.ErrorContains(t, err, "allowance must be > 0")
+
+	_, err = ms.SetAutoTopUp(ctx, &types.MsgSetAutoTopUp{Player: p0.String(), TableId: 1, TargetStack: 100, Allowance: 10})
+	require.NoError(t, err)
+	tbl, err := k.GetTable(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, &types.AutoTopUp{TargetStack: 100, Allowance: 10}, tbl.Seats[0].AutoTopUp)
+
+	// Target 0 switches it off.
+	_, err = ms.SetAutoTopUp(ctx, &types.MsgSetAutoTopUp{Player: p0.String(), TableId: 1})
+	require.NoError(t, err)
+	tbl, err = k.GetTable(ctx, 1)
+	require.NoError(t, err)
+	require.Nil(t, tbl.Seats[0].AutoTopUp)
+}
+
+func TestAutoTopUp_AppliedWhenHandEnds(t *testing.T) {
+	sdkCtx, k, ms, bk, p0, p1 := setupHeadsUpBetting(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	bk.spendable = map[string]sdk.Coins{
+		p0.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+	}
+
+	// P0 may pull one chip in total; P1 has no spendable balance.
+	_, err := ms.SetAutoTopUp(ctx, &types.MsgSetAutoTopUp{Player: p0.String(), TableId: 1, TargetStack: 100, Allowance: 1})
+	require.NoError(t, err)
+	_, err = ms.SetAutoTopUp(ctx, &types.MsgSetAutoTopUp{Player: p1.String(), TableId: 1, TargetStack: 200, Allowance: 50})
+	require.NoError(t, err)
+
+	// P0 folds the small blind: stack 99 -> topped up to 100, P1 wins 3 (101).
+	_, err = ms.Act(ctx, &types.MsgAct{Player: p0.String(), TableId: 1, Action: "fold"})
+	require.NoError(t, err)
+
+	last := bk.calls[len(bk.calls)-1]
+	require.Equal(t, "a2m", last.kind)
+	require.Equal(t, p0, last.fromAcc)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))), last.coins)
+
+	tbl, err := k.GetTable(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), tbl.Seats[0].Stack)
+	require.Nil(t, tbl.Seats[0].AutoTopUp, "allowance spent")
+	require.Equal(t, uint64(101), tbl.Seats[1].Stack)
+	require.Nil(t, tbl.Seats[1].AutoTopUp, "insufficient balance")
+
+	var rebuys, disabled int
+	for _, ev := range sdkCtx.EventManager().Events() {
+		switch ev.Type {
+		case types.EventTypePlayerRebuyed:
+			rebuys++
+		case types.EventTypeAutoTopUpDisabled:
+			disabled++
+		}
+	}
+	require.Equal(t, 1, rebuys)
+	require.Equal(t, 2, disabled)
+}
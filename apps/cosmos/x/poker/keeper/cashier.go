@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// GetChipBalance returns addr's cashier balance (0 if none).
+func (k Keeper) GetChipBalance(ctx context.Context, addr sdk.AccAddress) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ChipBalanceKey(addr))
+	if err != nil {
+		return 0, err
+	}
+	if bz == nil {
+		return 0, nil
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid chip balance encoding")
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// SetChipBalance stores addr's cashier balance; a zero balance is deleted.
+func (k Keeper) SetChipBalance(ctx context.Context, addr sdk.AccAddress, amount uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if amount == 0 {
+		return store.Delete(types.ChipBalanceKey(addr))
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, amount)
+	return store.Set(types.ChipBalanceKey(addr), bz)
+}
+
+// IterateChipBalances calls cb for every non-zero cashier balance in key order.
+func (k Keeper) IterateChipBalances(ctx context.Context, cb func(addr sdk.AccAddress, amount uint64) (stop bool)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	it, err := store.Iterator(types.ChipBalanceKeyPrefix, storetypes.PrefixEndBytes(types.ChipBalanceKeyPrefix))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) < 2 || int(key[1]) != len(key)-2 {
+			continue
+		}
+		if len(it.Value()) != 8 {
+			return fmt.Errorf("invalid chip balance encoding")
+		}
+		if cb(sdk.AccAddress(key[2:]), binary.BigEndian.Uint64(it.Value())) {
+			break
+		}
+	}
+	return nil
+}
+
+func (k Keeper) creditChipBalance(ctx context.Context, addr sdk.AccAddress, amount uint64) (uint64, error) {
+	bal, err := k.GetChipBalance(ctx, addr)
+	if err != nil {
+		return 0, err
+	}
+	next, err := addUint64Checked(bal, amount, "chip balance")
+	if err != nil {
+		return 0, types.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return next, k.SetChipBalance(ctx, addr, next)
+}
+
+// availableChips is what collectChips could draw for addr: the cashier
+// balance plus the spendable bank balance.
+func (k Keeper) availableChips(ctx context.Context, addr sdk.AccAddress) (sdkmath.Int, error) {
+	bal, err := k.GetChipBalance(ctx, addr)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	spendable := k.bankKeeper.SpendableCoins(ctx, addr).AmountOf(sdk.DefaultBondDenom)
+	return spendable.Add(sdkmath.NewIntFromUint64(bal)), nil
+}
+
+// collectChips moves amount chips from addr into table escrow, drawing on the
+// cashier balance first and pulling any shortfall from the bank account. It
+// returns how much came from the cashier balance.
+func (k Keeper) collectChips(ctx context.Context, addr sdk.AccAddress, amount uint64) (uint64, error) {
+	bal, err := k.GetChipBalance(ctx, addr)
+	if err != nil {
+		return 0, err
+	}
+	fromBalance := min(bal, amount)
+	if fromBalance != 0 {
+		if err := k.SetChipBalance(ctx, addr, bal-fromBalance); err != nil {
+			return 0, err
+		}
+	}
+	if rest := amount - fromBalance; rest != 0 {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(rest)))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, coins); err != nil {
+			return 0, err
+		}
+	}
+	return fromBalance, nil
+}
+
+func (m msgServer) Deposit(ctx context.Context, req *types.MsgDeposit) (*types.MsgDepositResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest.Wrap("nil request")
+	}
+	if err := m.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
+	playerAddr, err := sdk.AccAddressFromBech32(req.Player)
+	if err != nil {
+		return nil, types.ErrInvalidRequest.Wrap("invalid player address")
+	}
+	if req.Amount == 0 {
+		return nil, types.ErrInvalidRequest.Wrap("amount must be > 0")
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(req.Amount)))
+	if err := m.bankKeeper.SendCoinsFromAccountToModule(ctx, playerAddr, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+	bal, err := m.creditChipBalance(ctx, playerAddr, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeChipsDeposited,
+		sdk.NewAttribute("player", req.Player),
+		sdk.NewAttribute("amount", fmt.Sprintf("%d", req.Amount)),
+		sdk.NewAttribute("balance", fmt.Sprintf("%d", bal)),
+	))
+
+	return &types.MsgDepositResponse{Balance: bal}, nil
+}
+
+func (m msgServer) Withdraw(ctx context.Context, req *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest.Wrap("nil request")
+	}
+	playerAddr, err := sdk.AccAddressFromBech32(req.Player)
+	if err != nil {
+		return nil, types.ErrInvalidRequest.Wrap("invalid player address")
+	}
+	if req.Amount == 0 {
+		return nil, types.ErrInvalidRequest.Wrap("amount must be > 0")
+	}
+
+	bal, err := m.GetChipBalance(ctx, playerAddr)
+	if err != nil {
+		return nil, err
+	}
+	if bal < req.Amount {
+		return nil, types.ErrInsufficientChips.Wrapf("balance %d < %d", bal, req.Amount)
+	}
+	bal -= req.Amount
+	if err := m.SetChipBalance(ctx, playerAddr, bal); err != nil {
+		return nil, err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(req.Amount)))
+	if err := m.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, playerAddr, coins); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeChipsWithdrawn,
+		sdk.NewAttribute("player", req.Player),
+		sdk.NewAttribute("amount", fmt.Sprintf("%d", req.Amount)),
+		sdk.NewAttribute("balance", fmt.Sprintf("%d", bal)),
+	))
+
+	return &types.MsgWithdrawResponse{Balance: bal}, nil
+}
+
+// ChangeTable cashes the player's seat at from_table_id out to their cashier
+// balance and sits them at to_table_id funded from it. Both legs run in the
+// same message, so either both apply or neither does.
+func (m msgServer) ChangeTable(ctx context.Context, req *types.MsgChangeTable) (*types.MsgChangeTableResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest.Wrap("nil request")
+	}
+	if err := m.CheckNotPaused(ctx, sdk.MsgTypeURL(req), req.ToTableId); err != nil {
+		return nil, err
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Player); err != nil {
+		return nil, types.ErrInvalidRequest.Wrap("invalid player address")
+	}
+	if req.FromTableId == req.ToTableId {
+		return nil, types.ErrInvalidRequest.Wrap("from and to table must differ")
+	}
+
+	from, err := m.GetTable(ctx, req.FromTableId)
+	if err != nil {
+		return nil, err
+	}
+	if from == nil {
+		return nil, types.ErrTableNotFound.Wrapf("table %d not found", req.FromTableId)
+	}
+	seat := seatOfPlayer(from, req.Player)
+	if seat < 0 {
+		return nil, types.ErrNotSeated.Wrap("player not seated at from table")
+	}
+	if seatLiveInHand(from, seat) {
+		return nil, types.ErrHandInProgress.Wrap("cannot change tables while live in a hand")
+	}
+
+	leftEv, err := m.cashOutSeat(ctx, from, seat, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.SetTable(ctx, from); err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(leftEv)
+
+	sitResp, err := m.Sit(ctx, &types.MsgSit{
+		Player:        req.Player,
+		TableId:       req.ToTableId,
+		BuyIn:         req.BuyIn,
+		PkPlayer:      req.PkPlayer,
+		PasswordProof: req.PasswordProof,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTableChanged,
+		sdk.NewAttribute("player", req.Player),
+		sdk.NewAttribute("fromTableId", fmt.Sprintf("%d", req.FromTableId)),
+		sdk.NewAttribute("toTableId", fmt.Sprintf("%d", req.ToTableId)),
+		sdk.NewAttribute("seat", fmt.Sprintf("%d", sitResp.Seat)),
+	))
+
+	return &types.MsgChangeTableResponse{Seat: sitResp.Seat}, nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	"onchainpoker/apps/cosmos/x/poker/keeper"
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+func TestCashier_DepositWithdraw(t *testing.T) {
+	sdkCtx, k, ms, bk := newKeeper(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	player := addr(0x41)
+
+	resp, err := ms.Deposit(ctx, &types.MsgDeposit{Player: player.String(), Amount: 500})
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), resp.Balance)
+	require.Equal(t, "a2m", bk.calls[len(bk.calls)-1].kind)
+
+	_, err = ms.Withdraw(ctx, &types.MsgWithdraw{Player: player.String(), Amount: 501})
+	require.ErrorIs(t, err, types.ErrInsufficientChips)
+
+	wresp, err := ms.Withdraw(ctx, &types.MsgWithdraw{Player: player.String(), Amount: 200})
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), wresp.Balance)
+	last := bk.calls[len(bk.calls)-1]
+	require.Equal(t, "m2a", last.kind)
+	require.Equal(t, player, last.toAcc)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(200))), last.coins)
+
+	q, err := keeper.NewQueryServerImpl(k).ChipBalance(ctx, &types.QueryChipBalanceRequest{Address: player.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), q.Amount)
+}
+
+func TestCashier_SitLeaveAndChangeTable(t *testing.T) {
+	sdkCtx, k, ms, bk := newKeeper(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	player := addr(0x42)
+	pkBytes := ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(1)).Bytes()
+
+	for i := 0; i < 2; i++ {
+		_, err := ms.CreateTable(ctx, &types.MsgCreateTable{
+			Creator:    player.String(),
+			SmallBlind: 1, BigBlind: 2,
+			MinBuyIn: 100, MaxBuyIn: 1000,
+			PlayerBond: 10,
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := ms.Deposit(ctx, &types.MsgDeposit{Player: player.String(), Amount: 150})
+	require.NoError(t, err)
+
+	// Buy-in 200 + bond 10: 150 from the cashier, the 60 shortfall from the bank.
+	n := len(bk.calls)
+	_, err = ms.Sit(ctx, &types.MsgSit{Player: player.String(), TableId: 1, BuyIn: 200, PkPlayer: pkBytes})
+	require.NoError(t, err)
+	require.Len(t, bk.calls, n+1)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60))), bk.calls[n].coins)
+	bal, err := k.GetChipBalance(ctx, player)
+	require.NoError(t, err)
+	require.Zero(t, bal)
+
+	// Moving tables never touches the bank: stack + bond go to the cashier
+	// and the new buy-in comes out of it.
+	n = len(bk.calls)
+	resp, err := ms.ChangeTable(ctx, &types.MsgChangeTable{
+		Player: player.String(), FromTableId: 1, ToTableId: 2,
+		BuyIn: 150, PkPlayer: pkBytes,
+	})
+	require.NoError(t, err)
+	require.Len(t, bk.calls, n)
+	bal, err = k.GetChipBalance(ctx, player)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), bal, "210 credited, 160 re-seated")
+
+	from, err := k.GetTable(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, -1, seatIndex(from, player.String()))
+	to, err := k.GetTable(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, player.String(), to.Seats[resp.Seat].Player)
+	require.Equal(t, uint64(150), to.Seats[resp.Seat].Stack)
+
+	// Staying at the same table is not a table change.
+	_, err = ms.ChangeTable(ctx, &types.MsgChangeTable{
+		Player: player.String(), FromTableId: 2, ToTableId: 2, BuyIn: 100, PkPlayer: pkBytes,
+	})
+	require.ErrorContains(t, err, "must differ")
+
+	_, err = ms.Leave(ctx, &types.MsgLeave{Player: player.String(), TableId: 2, ToBalance: true})
+	require.NoError(t, err)
+	require.Len(t, bk.calls, n)
+	bal, err = k.GetChipBalance(ctx, player)
+	require.NoError(t, err)
+	require.Equal(t, uint64(210), bal)
+}
+
+func seatIndex(t *types.Table, player string) int {
+	for i, s := range t.Seats {
+		if s != nil && s.Player == player {
+			return i
+		}
+	}
+	return -1
+}
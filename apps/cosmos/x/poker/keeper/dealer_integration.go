@@ -2,17 +2,20 @@ package keeper
 
 import (
 	"context"
-	"fmt"
+
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"onchainpoker/apps/cosmos/x/poker/types"
 )
 
-// AbortHandRefundAllCommits clears the active hand and refunds any committed chips back to stacks.
+// AbortHand clears the active hand and resolves its escrow under the table's
+// abort refund policy.
 //
 // This is used by x/dealer to abort a hand when dealer duties fail and liveness cannot be recovered.
-func (k Keeper) AbortHandRefundAllCommits(ctx context.Context, tableID, handID uint64, reason string) ([]sdk.Event, error) {
+func (k Keeper) AbortHand(ctx context.Context, tableID, handID uint64, reason string) ([]sdk.Event, error) {
 	t, err := k.GetTable(ctx, tableID)
 	if err != nil {
 		return nil, err
@@ -30,48 +33,31 @@ func (k Keeper) AbortHandRefundAllCommits(ctx context.Context, tableID, handID u
 		reason = "abort"
 	}
 
-	events, err := abortHandRefundAllCommits(t, reason)
+	events, unclaimed, err := abortHand(t, reason)
 	if err != nil {
 		return nil, err
 	}
-	if err := k.SetTable(ctx, t); err != nil {
+	if unclaimed != 0 {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(unclaimed)))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins); err != nil {
+			return nil, err
+		}
+		if err := k.subTableEscrow(ctx, tableID, unclaimed); err != nil {
+			return nil, err
+		}
+	}
+	seatEvents, err := k.settleSeatsAfterHand(ctx, t)
+	if err != nil {
 		return nil, err
 	}
-	return events, nil
-}
-
-func abortHandRefundAllCommits(t *types.Table, reason string) ([]sdk.Event, error) {
-	if t == nil || t.Hand == nil {
-		return nil, nil
+	events = append(events, seatEvents...)
+	if err := k.SetTable(ctx, t); err != nil {
+		return nil, err
 	}
-	h := t.Hand
-	handID := h.HandId
-
-	// Refund all committed chips and clear any public hole cards.
-	for i := 0; i < 9; i++ {
-		if t.Seats[i] == nil {
-			continue
-		}
-		if i < len(h.TotalCommit) {
-			nextStack, err := addUint64Checked(t.Seats[i].Stack, h.TotalCommit[i], "seat stack refund")
-			if err != nil {
-				return nil, err
-			}
-			t.Seats[i].Stack = nextStack
-		}
-		t.Seats[i].Hole = []uint32{255, 255}
+	if err := k.afterHandEnded(ctx, tableID, handID); err != nil {
+		return nil, err
 	}
-
-	t.Hand = nil
-
-	return []sdk.Event{
-		sdk.NewEvent(
-			types.EventTypeHandAborted,
-			sdk.NewAttribute("tableId", fmt.Sprintf("%d", t.Id)),
-			sdk.NewAttribute("handId", fmt.Sprintf("%d", handID)),
-			sdk.NewAttribute("reason", reason),
-		),
-	}, nil
+	return events, nil
 }
 
 // ApplyDealerReveal applies a dealer reveal (board card or showdown hole card) to the poker state machine.
@@ -100,7 +86,8 @@ func (k Keeper) ApplyDealerReveal(ctx context.Context, tableID, handID uint64, p
 		return nil, err
 	}
 
-	// Update deadlines if the hand is still active.
+	// Update deadlines if the hand is still active; otherwise settle queued
+	// leaves and auto top-ups.
 	if t.Hand != nil {
 		if err := setRevealDeadlineIfAwaiting(t, nowUnix); err != nil {
 			return nil, err
@@ -108,11 +95,22 @@ func (k Keeper) ApplyDealerReveal(ctx context.Context, tableID, handID uint64, p
 		if err := setActionDeadlineIfBetting(t, nowUnix); err != nil {
 			return nil, err
 		}
+	} else {
+		seatEvents, err := k.settleSeatsAfterHand(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, seatEvents...)
 	}
 
 	if err := k.SetTable(ctx, t); err != nil {
 		return nil, err
 	}
+	if t.Hand == nil {
+		if err := k.afterHandEnded(ctx, tableID, handID); err != nil {
+			return nil, err
+		}
+	}
 	return events, nil
 }
 
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// Every coin movement into or out of a table (buy-ins, rebuys, top-ups,
+// cash-outs, ejections and fee-collector routing) is recorded against the
+// table's escrow, so the share of the module account owed to each table can
+// be audited without trusting the table state alone. The recorded escrow
+// always equals Table.EscrowTotal.
+
+// GetTableEscrow returns the recorded escrow of tableID (0 if none).
+func (k Keeper) GetTableEscrow(ctx context.Context, tableID uint64) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.TableEscrowKey(tableID))
+	if err != nil {
+		return 0, err
+	}
+	if bz == nil {
+		return 0, nil
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid table escrow encoding")
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// SetTableEscrow stores the recorded escrow of tableID; zero is deleted.
+func (k Keeper) SetTableEscrow(ctx context.Context, tableID uint64, amount uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if amount == 0 {
+		return store.Delete(types.TableEscrowKey(tableID))
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, amount)
+	return store.Set(types.TableEscrowKey(tableID), bz)
+}
+
+func (k Keeper) addTableEscrow(ctx context.Context, tableID uint64, amount uint64) error {
+	cur, err := k.GetTableEscrow(ctx, tableID)
+	if err != nil {
+		return err
+	}
+	next, err := addUint64Checked(cur, amount, "table escrow")
+	if err != nil {
+		return types.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return k.SetTableEscrow(ctx, tableID, next)
+}
+
+func (k Keeper) subTableEscrow(ctx context.Context, tableID uint64, amount uint64) error {
+	cur, err := k.GetTableEscrow(ctx, tableID)
+	if err != nil {
+		return err
+	}
+	if amount > cur {
+		return fmt.Errorf("table %d escrow underflow: %d > %d", tableID, amount, cur)
+	}
+	return k.SetTableEscrow(ctx, tableID, cur-amount)
+}
+
+// Reserves sums the recorded escrow of every table and all cashier balances
+// and reads the poker module account balance.
+func (k Keeper) Reserves(ctx context.Context) (*types.QueryReservesResponse, error) {
+	var tablesEscrow uint64
+	var iterErr error
+	if err := k.IterateTables(ctx, func(id uint64) bool {
+		e, err := k.GetTableEscrow(ctx, id)
+		if err == nil {
+			tablesEscrow, err = addUint64Checked(tablesEscrow, e, "tables escrow")
+		}
+		iterErr = err
+		return err != nil
+	}); err != nil {
+		return nil, err
+	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
+
+	var chipBalances uint64
+	if err := k.IterateChipBalances(ctx, func(_ sdk.AccAddress, amount uint64) bool {
+		chipBalances, iterErr = addUint64Checked(chipBalances, amount, "chip balances")
+		return iterErr != nil
+	}); err != nil {
+		return nil, err
+	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
+
+	bal := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), sdk.DefaultBondDenom)
+	liabilities := sdkmath.NewIntFromUint64(tablesEscrow).Add(sdkmath.NewIntFromUint64(chipBalances))
+	return &types.QueryReservesResponse{
+		TablesEscrow:  tablesEscrow,
+		ChipBalances:  chipBalances,
+		ModuleBalance: bal.Amount.String(),
+		Balanced:      bal.Amount.Equal(liabilities),
+	}, nil
+}
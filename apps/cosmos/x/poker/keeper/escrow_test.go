@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	"onchainpoker/apps/cosmos/x/poker/keeper"
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+func requireEscrowMatchesTable(t *testing.T, ctx sdk.Context, k keeper.Keeper, tableID uint64) uint64 {
+	t.Helper()
+	tbl, err := k.GetTable(ctx, tableID)
+	require.NoError(t, err)
+	want, err := tbl.EscrowTotal()
+	require.NoError(t, err)
+	got, err := k.GetTableEscrow(ctx, tableID)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+	return got
+}
+
+func TestTableEscrow_TracksSitRebuyAndLeave(t *testing.T) {
+	sdkCtx, k, ms, bk := newKeeper(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	p0, p1 := addr(0x61), addr(0x62)
+	pkBytes := ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(1)).Bytes()
+
+	_, err := ms.CreateTable(ctx, &types.MsgCreateTable{
+		Creator:    p0.String(),
+		SmallBlind: 1, BigBlind: 2,
+		MinBuyIn: 100, MaxBuyIn: 1000,
+		PlayerBond: 10,
+	})
+	require.NoError(t, err)
+	deposit := types.DefaultParams().TableCreationDeposit
+
+	_, err = ms.Sit(ctx, &types.MsgSit{Player: p0.String(), TableId: 1, BuyIn: 200, PkPlayer: pkBytes})
+	require.NoError(t, err)
+	_, err = ms.Sit(ctx, &types.MsgSit{Player: p1.String(), TableId: 1, BuyIn: 300, PkPlayer: pkBytes})
+	require.NoError(t, err)
+	require.Equal(t, 520+deposit, requireEscrowMatchesTable(t, sdkCtx, k, 1))
+
+	_, err = ms.Leave(ctx, &types.MsgLeave{Player: p0.String(), TableId: 1})
+	require.NoError(t, err)
+	require.Equal(t, 310+deposit, requireEscrowMatchesTable(t, sdkCtx, k, 1))
+
+	_, err = ms.Deposit(ctx, &types.MsgDeposit{Player: p0.String(), Amount: 40})
+	require.NoError(t, err)
+
+	qs := keeper.NewQueryServerImpl(k)
+	esc, err := qs.TableEscrow(ctx, &types.QueryTableEscrowRequest{TableId: 1})
+	require.NoError(t, err)
+	require.Equal(t, 310+deposit, esc.Escrow)
+	require.Equal(t, uint64(300), esc.Stacks)
+	require.Equal(t, uint64(10), esc.Bonds)
+	require.Zero(t, esc.Pot)
+	require.Equal(t, deposit, esc.Deposit)
+
+	// The module account holds table escrow plus cashier balances.
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	bk.balances = map[string]sdk.Coins{
+		moduleAddr: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(350+deposit))),
+	}
+	res, err := qs.Reserves(ctx, &types.QueryReservesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 310+deposit, res.TablesEscrow)
+	require.Equal(t, uint64(40), res.ChipBalances)
+	require.Equal(t, sdkmath.NewIntFromUint64(350+deposit).String(), res.ModuleBalance)
+	require.True(t, res.Balanced)
+
+	bk.balances[moduleAddr] = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(349+deposit)))
+	res, err = qs.Reserves(ctx, &types.QueryReservesRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Balanced)
+}
+
+func TestTableEscrow_HandEndKeepsRecordInSync(t *testing.T) {
+	sdkCtx, k, ms, _, p0, p1 := setupHeadsUpBetting(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	require.Equal(t, uint64(200), requireEscrowMatchesTable(t, sdkCtx, k, 1))
+
+	// p1 leaves mid-hand; the queued cash-out (98 + the 3-chip pot) runs
+	// once p0 folds.
+	resp, err := ms.Leave(ctx, &types.MsgLeave{Player: p1.String(), TableId: 1})
+	require.NoError(t, err)
+	require.True(t, resp.LeavePending)
+	_, err = ms.Act(ctx, &types.MsgAct{Player: p0.String(), TableId: 1, Action: "fold"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(99), requireEscrowMatchesTable(t, sdkCtx, k, 1))
+}
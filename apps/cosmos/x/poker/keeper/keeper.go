@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"onchainpoker/apps/cosmos/x/poker/types"
 )
@@ -19,9 +20,16 @@ type Keeper struct {
 	storeService corestore.KVStoreService
 	cdc          codec.BinaryCodec
 	bankKeeper   types.BankKeeper
+
+	// hooks is shared by every copy of the keeper, so hooks set after other
+	// modules took their copy still reach them.
+	hooks *types.MultiPokerHooks
+
+	// authority may update params via MsgUpdateParams (x/gov by default).
+	authority string
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeService corestore.KVStoreService, bankKeeper types.BankKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeService corestore.KVStoreService, bankKeeper types.BankKeeper, authority string) Keeper {
 	if cdc == nil {
 		panic("poker keeper: cdc is nil")
 	}
@@ -31,11 +39,38 @@ func NewKeeper(cdc codec.BinaryCodec, storeService corestore.KVStoreService, ban
 	if bankKeeper == nil {
 		panic("poker keeper: bank keeper is nil")
 	}
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("poker keeper: invalid authority %q: %s", authority, err))
+	}
 	return Keeper{
 		storeService: storeService,
 		cdc:          cdc,
 		bankKeeper:   bankKeeper,
+		hooks:        new(types.MultiPokerHooks),
+		authority:    authority,
+	}
+}
+
+// SetHooks installs the poker hooks. It may only be called once.
+func (k Keeper) SetHooks(hooks ...types.PokerHooks) {
+	if len(*k.hooks) != 0 {
+		panic("poker keeper: cannot set hooks twice")
+	}
+	*k.hooks = types.NewMultiPokerHooks(hooks...)
+}
+
+// afterHandEnded runs the AfterHandEnded hooks for a hand that has just left
+// its table.
+func (k Keeper) afterHandEnded(ctx context.Context, tableID, handID uint64) error {
+	if k.hooks == nil {
+		return nil
 	}
+	return k.hooks.AfterHandEnded(ctx, tableID, handID)
+}
+
+// GetAuthority returns the address allowed to update module params.
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
 func (k Keeper) Logger(ctx context.Context) log.Logger {
@@ -58,6 +93,18 @@ func (k Keeper) GetNextTableID(ctx context.Context) (uint64, error) {
 	return binary.BigEndian.Uint64(bz), nil
 }
 
+// ValidateEscrowBalance checks that the poker module account holds exactly
+// the chips escrowed by tables (stacks, bonds and in-flight pots) plus the
+// cashier chip balances.
+func (k Keeper) ValidateEscrowBalance(ctx context.Context, escrow uint64) error {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	bal := k.bankKeeper.GetBalance(ctx, moduleAddr, sdk.DefaultBondDenom)
+	if !bal.Amount.IsUint64() || bal.Amount.Uint64() != escrow {
+		return fmt.Errorf("poker module balance %s does not match escrowed chips %d%s", bal.Amount, escrow, sdk.DefaultBondDenom)
+	}
+	return nil
+}
+
 func (k Keeper) SetNextTableID(ctx context.Context, next uint64) error {
 	store := k.storeService.OpenKVStore(ctx)
 	bz := make([]byte, 8)
@@ -87,6 +134,9 @@ func (k Keeper) SetTable(ctx context.Context, t *types.Table) error {
 		return fmt.Errorf("table is nil")
 	}
 	normalizeTable(t)
+	if err := k.trackIdle(ctx, t); err != nil {
+		return err
+	}
 	store := k.storeService.OpenKVStore(ctx)
 	bz, err := k.cdc.Marshal(t)
 	if err != nil {
@@ -95,14 +145,16 @@ func (k Keeper) SetTable(ctx context.Context, t *types.Table) error {
 	return store.Set(types.TableKey(t.Id), bz)
 }
 
-// lastHandEndedHeightKeyPrefix is a keeper-private kv prefix; kept here (rather
+// LastHandEndedHeightKeyPrefix is a keeper-private kv prefix; kept here (rather
 // than types/keys.go) because it backs an internal anti-griefing cooldown and
-// is not part of the externally-visible state schema.
-var lastHandEndedHeightKeyPrefix = []byte{0x03}
+// is not part of the externally-visible state schema. It is exported only so
+// the simulation store decoder and import/export sims can recognize it; it is
+// deliberately not carried through genesis.
+var LastHandEndedHeightKeyPrefix = []byte{0x03}
 
 func lastHandEndedHeightKey(tableID uint64) []byte {
 	bz := make([]byte, 1+8)
-	bz[0] = lastHandEndedHeightKeyPrefix[0]
+	bz[0] = LastHandEndedHeightKeyPrefix[0]
 	binary.BigEndian.PutUint64(bz[1:], tableID)
 	return bz
 }
@@ -228,6 +280,7 @@ func normalizeHand(h *types.Hand) {
 	fixU64Len(&h.StreetCommit, 9)
 	fixU64Len(&h.TotalCommit, 9)
 	fixI32Len(&h.LastIntervalActed, 9, -1)
+	fixU64Len(&h.SlashCredit, 9)
 
 	if h.ActionOn < -1 || h.ActionOn > 8 {
 		h.ActionOn = -1
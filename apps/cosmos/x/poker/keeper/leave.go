@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// seatLiveInHand reports whether seat is dealt into the active hand and has
+// not folded.
+func seatLiveInHand(t *types.Table, seat int) bool {
+	h := t.Hand
+	if h == nil || seat >= len(h.InHand) || !h.InHand[seat] {
+		return false
+	}
+	return !(seat < len(h.Folded) && h.Folded[seat])
+}
+
+// cashOutSeat pays a seat's stack, bond and any slash credit owed from the
+// active hand back to the player (to their cashier balance if toBalance),
+// clears the seat and returns the PlayerLeft event. The caller persists the
+// table.
+func (k Keeper) cashOutSeat(ctx context.Context, t *types.Table, seat int, toBalance bool) (sdk.Event, error) {
+	s := t.Seats[seat]
+	playerAddr, err := sdk.AccAddressFromBech32(s.Player)
+	if err != nil {
+		return sdk.Event{}, types.ErrInvalidRequest.Wrap("invalid player address")
+	}
+
+	amount := s.Stack
+	if s.Bond != 0 {
+		if amount > ^uint64(0)-s.Bond {
+			return sdk.Event{}, types.ErrInvalidRequest.Wrap("stack + bond overflows uint64")
+		}
+		amount += s.Bond
+	}
+	// A folded player leaving mid-hand takes any slash credit owed to them.
+	credit := uint64(0)
+	if t.Hand != nil && seat < len(t.Hand.SlashCredit) {
+		credit = t.Hand.SlashCredit[seat]
+		if amount > ^uint64(0)-credit {
+			return sdk.Event{}, types.ErrInvalidRequest.Wrap("stack + bond + slash credit overflows uint64")
+		}
+		amount += credit
+		t.Hand.SlashCredit[seat] = 0
+	}
+
+	switch {
+	case amount == 0:
+	case toBalance:
+		if _, err := k.creditChipBalance(ctx, playerAddr, amount); err != nil {
+			return sdk.Event{}, err
+		}
+	default:
+		denom := sdk.DefaultBondDenom
+		coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromUint64(amount)))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, playerAddr, coins); err != nil {
+			return sdk.Event{}, err
+		}
+	}
+
+	if err := k.subTableEscrow(ctx, t.Id, amount); err != nil {
+		return sdk.Event{}, err
+	}
+	t.Seats[seat] = &types.Seat{}
+
+	return sdk.NewEvent(
+		types.EventTypePlayerLeft,
+		sdk.NewAttribute("tableId", fmt.Sprintf("%d", t.Id)),
+		sdk.NewAttribute("seat", fmt.Sprintf("%d", seat)),
+		sdk.NewAttribute("player", s.Player),
+		sdk.NewAttribute("stack", fmt.Sprintf("%d", s.Stack)),
+		sdk.NewAttribute("bond", fmt.Sprintf("%d", s.Bond)),
+		sdk.NewAttribute("slashCredit", fmt.Sprintf("%d", credit)),
+		sdk.NewAttribute("amount", fmt.Sprintf("%d", amount)),
+		sdk.NewAttribute("toBalance", fmt.Sprintf("%t", toBalance)),
+	), nil
+}
+
+// settlePendingLeaves cashes out every seat that queued a leave during the hand
+// that just ended. It is a no-op while a hand is still in progress.
+func (k Keeper) settlePendingLeaves(ctx context.Context, t *types.Table) ([]sdk.Event, error) {
+	if t == nil || t.Hand != nil {
+		return nil, nil
+	}
+	var events []sdk.Event
+	for i := 0; i < 9 && i < len(t.Seats); i++ {
+		s := t.Seats[i]
+		if s == nil || s.Player == "" || !s.LeavePending {
+			continue
+		}
+		ev, err := k.cashOutSeat(ctx, t, i, s.LeaveToBalance)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
@@ -60,13 +60,16 @@ func autoAssignSeat(t *types.Table) (int, error) {
 	return -1, fmt.Errorf("table full")
 }
 
+// seatDealtIn reports whether a seat takes part in the next hand: it must be
+// funded and not waiting to be cashed out.
+func seatDealtIn(s *types.Seat) bool {
+	return s != nil && s.Stack > 0 && !s.LeavePending
+}
+
 func occupiedSeatsWithStack(t *types.Table) []int {
 	out := make([]int, 0, 9)
 	for i := 0; i < 9; i++ {
-		if i >= len(t.Seats) || t.Seats[i] == nil {
-			continue
-		}
-		if t.Seats[i].Stack == 0 {
+		if i >= len(t.Seats) || !seatDealtIn(t.Seats[i]) {
 			continue
 		}
 		out = append(out, i)
@@ -93,14 +96,15 @@ func seatOfPlayer(t *types.Table, player string) int {
 	return -1
 }
 
-// nextOccupiedSeat returns the next *funded* seat (clockwise).
+// nextOccupiedSeat returns the next *funded* seat (clockwise), skipping seats
+// with a pending leave.
 func nextOccupiedSeat(t *types.Table, from int) int {
 	for step := 1; step <= 9; step++ {
 		i := (from + step) % 9
 		if i >= len(t.Seats) {
 			continue
 		}
-		if t.Seats[i] != nil && t.Seats[i].Stack > 0 {
+		if seatDealtIn(t.Seats[i]) {
 			return i
 		}
 	}
@@ -459,6 +463,12 @@ func completeByFolds(t *types.Table, events *[]sdk.Event) error {
 	}
 	if winnerSeat == -1 {
 		// Should not happen; clear hand to avoid stuck state.
+		if err := returnDeadMoney(t); err != nil {
+			return err
+		}
+		if err := payoutSlashCredits(t); err != nil {
+			return err
+		}
 		t.Hand = nil
 		return nil
 	}
@@ -468,7 +478,7 @@ func completeByFolds(t *types.Table, events *[]sdk.Event) error {
 		return err
 	}
 
-	var potTotal uint64
+	potTotal := h.DeadMoney
 	for i := 0; i < 9; i++ {
 		nextPot, err := addUint64Checked(potTotal, h.TotalCommit[i], "pot total")
 		if err != nil {
@@ -483,6 +493,9 @@ func completeByFolds(t *types.Table, events *[]sdk.Event) error {
 		}
 		t.Seats[winnerSeat].Stack = nextStack
 	}
+	if err := payoutSlashCredits(t); err != nil {
+		return err
+	}
 
 	handId := h.HandId
 
@@ -917,6 +930,12 @@ func settleKnownShowdown(t *types.Table) ([]sdk.Event, error) {
 
 	if len(h.Board) < 5 {
 		handId := h.HandId
+		if err := returnDeadMoney(t); err != nil {
+			return nil, err
+		}
+		if err := payoutSlashCredits(t); err != nil {
+			return nil, err
+		}
 		t.Hand = nil
 		events = append(events, sdk.NewEvent(
 			types.EventTypeHandAborted,
@@ -936,6 +955,24 @@ func settleKnownShowdown(t *types.Table) ([]sdk.Event, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Dead money joins the main pot (the first one anybody can still win).
+	if h.DeadMoney != 0 {
+		for i := range pots {
+			if len(pots[i].EligibleSeats) == 0 {
+				continue
+			}
+			nextAmt, err := addUint64Checked(pots[i].Amount, h.DeadMoney, "pot amount with dead money")
+			if err != nil {
+				return nil, err
+			}
+			pots[i].Amount = nextAmt
+			h.DeadMoney = 0
+			break
+		}
+		if err := returnDeadMoney(t); err != nil {
+			return nil, err
+		}
+	}
 
 	events = append(events, sdk.NewEvent(
 		types.EventTypeShowdownReached,
@@ -1030,6 +1067,12 @@ func settleKnownShowdown(t *types.Table) ([]sdk.Event, error) {
 					}
 					t.Seats[i].Stack = nextStack
 				}
+				if err := returnDeadMoney(t); err != nil {
+					return nil, err
+				}
+				if err := payoutSlashCredits(t); err != nil {
+					return nil, err
+				}
 				handId := h.HandId
 				for i := 0; i < 9; i++ {
 					if t.Seats[i] == nil {
@@ -1087,6 +1130,10 @@ func settleKnownShowdown(t *types.Table) ([]sdk.Event, error) {
 		))
 	}
 
+	if err := payoutSlashCredits(t); err != nil {
+		return nil, err
+	}
+
 	handId := h.HandId
 	// Clear public hole cards (showdown reveal).
 	for i := 0; i < 9; i++ {
@@ -1205,6 +1252,10 @@ func applyAction(t *types.Table, action string, amount uint64, nowUnix int64) ([
 			return nil, err
 		}
 	case "call":
+		// Optional guard: a non-zero amount is the highest bet the caller agrees to match.
+		if amount != 0 && h.BetTo > amount {
+			return nil, fmt.Errorf("call would match %d, above amount %d", h.BetTo, amount)
+		}
 		if err := applyCall(t, actorIdx); err != nil {
 			return nil, err
 		}
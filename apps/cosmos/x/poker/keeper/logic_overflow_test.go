@@ -114,14 +114,15 @@ func TestSettleKnownShowdown_SeatAwardOverflow(t *testing.T) {
 	require.Equal(t, ^uint64(0), tbl.Seats[0].Stack)
 }
 
-func TestAbortHandRefundAllCommits_RefundOverflow(t *testing.T) {
+func TestAbortHand_RefundOverflow(t *testing.T) {
 	tbl := newOverflowTestTable()
 	tbl.Seats[0] = &types.Seat{Player: "p0", Stack: ^uint64(0), Hole: []uint32{255, 255}}
 	tbl.Hand.TotalCommit[0] = 1
 
-	events, err := abortHandRefundAllCommits(tbl, "abort")
+	events, unclaimed, err := abortHand(tbl, "abort")
 	require.ErrorContains(t, err, "seat stack refund overflows uint64")
 	require.Nil(t, events)
+	require.Zero(t, unclaimed)
 	require.NotNil(t, tbl.Hand)
 	require.Equal(t, ^uint64(0), tbl.Seats[0].Stack)
 }
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
 )
 
 // Migrator provides the upgrade handlers for the x/poker module. New
@@ -53,3 +55,78 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	)
 	return nil
 }
+
+// Migrate2to3 lifts x/poker from ConsensusVersion 2 to 3. Tables written
+// before v3 have no per-table escrow record and are missing from the
+// idle-table index, so this handler backfills both from table state:
+// each table's recorded escrow is set to Table.EscrowTotal and the table is
+// rewritten, which stamps idle_since_height and indexes it if no one is
+// seated.
+//
+// Params are left as stored: table_creation_deposit and idle_table_blocks
+// read as 0 on an upgraded chain, which keeps both the deposit and the
+// sweeper off until governance enables them.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	gctx := sdk.WrapSDKContext(ctx)
+	var ids []uint64
+	if err := m.keeper.IterateTables(gctx, func(id uint64) bool {
+		ids = append(ids, id)
+		return false
+	}); err != nil {
+		return fmt.Errorf("poker migrate v2->v3: iterate tables: %w", err)
+	}
+
+	var idle uint64
+	for _, id := range ids {
+		t, err := m.keeper.GetTable(gctx, id)
+		if err != nil {
+			return fmt.Errorf("poker migrate v2->v3: table %d: %w", id, err)
+		}
+		if t == nil {
+			continue
+		}
+		escrow, err := t.EscrowTotal()
+		if err != nil {
+			return fmt.Errorf("poker migrate v2->v3: %w", err)
+		}
+		if err := m.keeper.SetTableEscrow(gctx, id, escrow); err != nil {
+			return fmt.Errorf("poker migrate v2->v3: table %d escrow: %w", id, err)
+		}
+		if err := m.keeper.SetTable(gctx, t); err != nil {
+			return fmt.Errorf("poker migrate v2->v3: table %d: %w", id, err)
+		}
+		if t.IdleSinceHeight != 0 {
+			idle++
+		}
+	}
+	ctx.Logger().Info(
+		"x/poker migrated to v3 (table escrow records + idle-table index)",
+		"tables", len(ids),
+		"idle", idle,
+	)
+	return nil
+}
+
+// Migrate3to4 lifts x/poker from ConsensusVersion 3 to 4. The gameplay fee
+// rate limits moved from a hard-coded app default into Params; stored params
+// read them as 0, which would lift both limits, so this handler writes the
+// defaults the chain was already enforcing.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	gctx := sdk.WrapSDKContext(ctx)
+	params, err := m.keeper.GetParams(gctx)
+	if err != nil {
+		return fmt.Errorf("poker migrate v3->v4: %w", err)
+	}
+	defaults := types.DefaultParams()
+	params.GameplayTxsPerSigner = defaults.GameplayTxsPerSigner
+	params.GameplayTxsPerTable = defaults.GameplayTxsPerTable
+	if err := m.keeper.SetParams(gctx, params); err != nil {
+		return fmt.Errorf("poker migrate v3->v4: %w", err)
+	}
+	ctx.Logger().Info(
+		"x/poker migrated to v4 (gameplay rate limits in params)",
+		"per_signer", params.GameplayTxsPerSigner,
+		"per_table", params.GameplayTxsPerTable,
+	)
+	return nil
+}
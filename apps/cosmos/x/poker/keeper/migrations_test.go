@@ -59,3 +59,66 @@ func TestMigrate1to2_PreservesPasswordHash(t *testing.T) {
 	require.Equal(t, legacyHash[:], got.Params.PasswordHash, "password_hash must be preserved")
 	require.Empty(t, got.Params.PasswordSalt, "legacy salt stays empty")
 }
+
+// TestMigrate2to3_BackfillsEscrowAndIdleIndex plants tables without escrow
+// records, as a pre-v3 store has them, and checks the migration leaves them
+// both accountable and sweepable.
+func TestMigrate2to3_BackfillsEscrowAndIdleIndex(t *testing.T) {
+	sdkCtx, k, _, _ := newKeeper(t, time.Unix(100, 0).UTC())
+	sdkCtx = sdkCtx.WithBlockHeight(5)
+
+	seated := &types.Table{
+		Id:      1,
+		Creator: addr(0xC2).String(),
+		Params:  types.TableParams{MaxPlayers: 9, SmallBlind: 1, BigBlind: 2, MinBuyIn: 100, MaxBuyIn: 1000},
+		Seats:   make([]*types.Seat, 9),
+
+		NextHandId: 1,
+		ButtonSeat: -1,
+	}
+	seated.Seats[3] = &types.Seat{Player: addr(0xC3).String(), Stack: 150, Bond: 5}
+	require.NoError(t, k.SetTable(sdkCtx, seated))
+	empty := *seated
+	empty.Id = 2
+	empty.Seats = make([]*types.Seat, 9)
+	require.NoError(t, k.SetTable(sdkCtx, &empty))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(sdkCtx))
+
+	escrow, err := k.GetTableEscrow(sdkCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(155), escrow)
+
+	params := types.DefaultParams()
+	params.IdleTableBlocks = 100
+	params.TableCreationDeposit = 0
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	require.NoError(t, k.SweepIdleTables(sdkCtx.WithBlockHeight(105)))
+
+	got, err := k.GetTable(sdkCtx, 2)
+	require.NoError(t, err)
+	require.Nil(t, got, "empty legacy table is sweepable")
+	got, err = k.GetTable(sdkCtx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+}
+
+// TestMigrate3to4_WritesGameplayRateLimits stores v3 params (no rate limits)
+// and confirms the migration restores the limits the app used to hard-code
+// while keeping the other params.
+func TestMigrate3to4_WritesGameplayRateLimits(t *testing.T) {
+	sdkCtx, k, _, _ := newKeeper(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	v3 := types.DefaultParams()
+	v3.GameplayTxsPerSigner, v3.GameplayTxsPerTable = 0, 0
+	v3.IdleTableBlocks = 0
+	require.NoError(t, k.SetParams(ctx, v3))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(sdkCtx))
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), params.GameplayTxsPerSigner)
+	require.Equal(t, uint64(64), params.GameplayTxsPerTable)
+	require.Zero(t, params.IdleTableBlocks)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -23,14 +24,9 @@ type msgServer struct {
 
 var _ types.MsgServer = msgServer{}
 
+// Table size, timeout, buy-in and cooldown limits are module Params (see
+// types.DefaultParams) so each network can tune them via MsgUpdateParams.
 const (
-	MaxTableLabelLen     = 64
-	MaxActionTimeoutSecs = 600  // 10 minutes
-	MaxDealerTimeoutSecs = 1800 // 30 minutes
-	MaxBuyInUchips       = 1_000_000_000_000 // 1M CHIPS
-	// InterHandCooldownBlocks defeats single-block griefing of StartHand while
-	// staying short enough to be invisible during normal table cadence (~30s at 6s blocks).
-	InterHandCooldownBlocks = 5
 	// PasswordCommitmentBytes is the byte length of password_commitment and
 	// password_proof: 32 bytes (SHA-256 output).
 	PasswordCommitmentBytes = 32
@@ -50,6 +46,9 @@ func (m msgServer) CreateTable(ctx context.Context, req *types.MsgCreateTable) (
 	if req == nil {
 		return nil, types.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.CheckNotPaused(ctx, sdk.MsgTypeURL(req), 0); err != nil {
+		return nil, err
+	}
 	if req.Creator == "" {
 		return nil, types.ErrInvalidRequest.Wrap("missing creator")
 	}
@@ -76,8 +75,18 @@ func (m msgServer) CreateTable(ctx context.Context, req *types.MsgCreateTable) (
 	if req.RakeBps != 0 {
 		return nil, types.ErrInvalidTableCfg.Wrap("rake_bps must be 0")
 	}
-	if len(req.Label) > MaxTableLabelLen {
-		return nil, types.ErrInvalidTableCfg.Wrapf("label exceeds %d bytes", MaxTableLabelLen)
+	if _, ok := types.SlashDestination_name[int32(req.SlashDestination)]; !ok {
+		return nil, types.ErrInvalidTableCfg.Wrapf("unknown slash_destination %d", req.SlashDestination)
+	}
+	if _, ok := types.AbortRefundPolicy_name[int32(req.AbortRefundPolicy)]; !ok {
+		return nil, types.ErrInvalidTableCfg.Wrapf("unknown abort_refund_policy %d", req.AbortRefundPolicy)
+	}
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Label) > int(params.MaxTableLabelLen) {
+		return nil, types.ErrInvalidTableCfg.Wrapf("label exceeds %d bytes", params.MaxTableLabelLen)
 	}
 	// Password is now a client-computed commitment: SHA256(password_salt || password).
 	// Either both commitment+salt are empty (no password) or both must be set
@@ -95,26 +104,26 @@ func (m msgServer) CreateTable(ctx context.Context, req *types.MsgCreateTable) (
 			return nil, types.ErrInvalidTableCfg.Wrapf("password_salt must be %d-%d bytes", PasswordSaltMinBytes, PasswordSaltMaxBytes)
 		}
 	}
-	if req.ActionTimeoutSecs > MaxActionTimeoutSecs {
-		return nil, types.ErrInvalidTableCfg.Wrapf("action_timeout_secs exceeds %d", MaxActionTimeoutSecs)
+	if req.ActionTimeoutSecs > params.MaxActionTimeoutSecs {
+		return nil, types.ErrInvalidTableCfg.Wrapf("action_timeout_secs exceeds %d", params.MaxActionTimeoutSecs)
 	}
-	if req.DealerTimeoutSecs > MaxDealerTimeoutSecs {
-		return nil, types.ErrInvalidTableCfg.Wrapf("dealer_timeout_secs exceeds %d", MaxDealerTimeoutSecs)
+	if req.DealerTimeoutSecs > params.MaxDealerTimeoutSecs {
+		return nil, types.ErrInvalidTableCfg.Wrapf("dealer_timeout_secs exceeds %d", params.MaxDealerTimeoutSecs)
 	}
-	if req.PlayerBond > MaxBuyInUchips {
-		return nil, types.ErrInvalidTableCfg.Wrapf("player_bond exceeds %d", MaxBuyInUchips)
+	if req.PlayerBond > params.MaxBuyInUchips {
+		return nil, types.ErrInvalidTableCfg.Wrapf("player_bond exceeds %d", params.MaxBuyInUchips)
 	}
-	if req.MinBuyIn > MaxBuyInUchips {
-		return nil, types.ErrInvalidTableCfg.Wrapf("min_buy_in exceeds %d", MaxBuyInUchips)
+	if req.MinBuyIn > params.MaxBuyInUchips {
+		return nil, types.ErrInvalidTableCfg.Wrapf("min_buy_in exceeds %d", params.MaxBuyInUchips)
 	}
-	if req.MaxBuyIn > MaxBuyInUchips {
-		return nil, types.ErrInvalidTableCfg.Wrapf("max_buy_in exceeds %d", MaxBuyInUchips)
+	if req.MaxBuyIn > params.MaxBuyInUchips {
+		return nil, types.ErrInvalidTableCfg.Wrapf("max_buy_in exceeds %d", params.MaxBuyInUchips)
 	}
-	if req.SmallBlind > MaxBuyInUchips {
-		return nil, types.ErrInvalidTableCfg.Wrapf("small_blind exceeds %d", MaxBuyInUchips)
+	if req.SmallBlind > params.MaxBuyInUchips {
+		return nil, types.ErrInvalidTableCfg.Wrapf("small_blind exceeds %d", params.MaxBuyInUchips)
 	}
-	if req.BigBlind > MaxBuyInUchips {
-		return nil, types.ErrInvalidTableCfg.Wrapf("big_blind exceeds %d", MaxBuyInUchips)
+	if req.BigBlind > params.MaxBuyInUchips {
+		return nil, types.ErrInvalidTableCfg.Wrapf("big_blind exceeds %d", params.MaxBuyInUchips)
 	}
 
 	id, err := m.GetNextTableID(ctx)
@@ -151,13 +160,30 @@ func (m msgServer) CreateTable(ctx context.Context, req *types.MsgCreateTable) (
 			RakeBps:           req.RakeBps,
 			PasswordHash:      passwordHash,
 			PasswordSalt:      passwordSalt,
+			SlashDestination:  req.SlashDestination,
+			AbortRefundPolicy: req.AbortRefundPolicy,
 		},
 		Seats:      make([]*types.Seat, 9),
 		NextHandId: 1,
 		ButtonSeat: -1,
 		Hand:       nil,
+
+		CreationDeposit: params.TableCreationDeposit,
 	}
 
+	// The deposit is refunded when the idle-table sweeper deletes the table.
+	if t.CreationDeposit != 0 {
+		creatorAddr, err := sdk.AccAddressFromBech32(req.Creator)
+		if err != nil {
+			return nil, types.ErrInvalidRequest.Wrap("invalid creator address")
+		}
+		if _, err := m.collectChips(ctx, creatorAddr, t.CreationDeposit); err != nil {
+			return nil, err
+		}
+		if err := m.addTableEscrow(ctx, id, t.CreationDeposit); err != nil {
+			return nil, err
+		}
+	}
 	if err := m.SetTable(ctx, t); err != nil {
 		return nil, err
 	}
@@ -166,6 +192,7 @@ func (m msgServer) CreateTable(ctx context.Context, req *types.MsgCreateTable) (
 	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeTableCreated,
 		sdk.NewAttribute("tableId", fmt.Sprintf("%d", id)),
+		sdk.NewAttribute("deposit", fmt.Sprintf("%d", t.CreationDeposit)),
 	))
 
 	return &types.MsgCreateTableResponse{TableId: id}, nil
@@ -175,6 +202,9 @@ func (m msgServer) Sit(ctx context.Context, req *types.MsgSit) (*types.MsgSitRes
 	if req == nil {
 		return nil, types.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.CheckNotPaused(ctx, sdk.MsgTypeURL(req), req.TableId); err != nil {
+		return nil, err
+	}
 	if req.Player == "" {
 		return nil, types.ErrInvalidRequest.Wrap("missing player")
 	}
@@ -238,9 +268,10 @@ func (m msgServer) Sit(ctx context.Context, req *types.MsgSit) (*types.MsgSitRes
 		total += bond
 	}
 
-	denom := sdk.DefaultBondDenom
-	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromUint64(total)))
-	if err := m.bankKeeper.SendCoinsFromAccountToModule(ctx, playerAddr, types.ModuleName, coins); err != nil {
+	if _, err := m.collectChips(ctx, playerAddr, total); err != nil {
+		return nil, err
+	}
+	if err := m.addTableEscrow(ctx, t.Id, total); err != nil {
 		return nil, err
 	}
 
@@ -273,6 +304,9 @@ func (m msgServer) StartHand(ctx context.Context, req *types.MsgStartHand) (*typ
 	if req == nil {
 		return nil, types.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.CheckNotPaused(ctx, sdk.MsgTypeURL(req), req.TableId); err != nil {
+		return nil, err
+	}
 	if req.Caller == "" {
 		return nil, types.ErrInvalidRequest.Wrap("missing caller")
 	}
@@ -296,8 +330,14 @@ func (m msgServer) StartHand(ctx context.Context, req *types.MsgStartHand) (*typ
 	if err != nil {
 		return nil, err
 	}
-	if lastEnded != 0 && sdkCtxCooldown.BlockHeight() < lastEnded+InterHandCooldownBlocks {
-		return nil, types.ErrInvalidRequest.Wrapf("inter-hand cooldown: must wait until block %d", lastEnded+InterHandCooldownBlocks)
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Params.Validate bounds the cooldown well below int64 overflow.
+	cooldown := int64(params.InterHandCooldownBlocks)
+	if lastEnded != 0 && sdkCtxCooldown.BlockHeight() < lastEnded+cooldown {
+		return nil, types.ErrInvalidRequest.Wrapf("inter-hand cooldown: must wait until block %d", lastEnded+cooldown)
 	}
 
 	activeSeats := occupiedSeatsWithStack(t)
@@ -335,7 +375,7 @@ func (m msgServer) StartHand(ctx context.Context, req *types.MsgStartHand) (*typ
 
 	inHand := make([]bool, 9)
 	for i := 0; i < 9; i++ {
-		if t.Seats[i] != nil && t.Seats[i].Stack > 0 {
+		if seatDealtIn(t.Seats[i]) {
 			inHand[i] = true
 		}
 	}
@@ -417,6 +457,9 @@ func (m msgServer) Act(ctx context.Context, req *types.MsgAct) (*types.MsgActRes
 	if req == nil {
 		return nil, types.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.CheckNotPaused(ctx, sdk.MsgTypeURL(req), req.TableId); err != nil {
+		return nil, err
+	}
 	if req.Player == "" {
 		return nil, types.ErrInvalidRequest.Wrap("missing player")
 	}
@@ -474,8 +517,13 @@ func (m msgServer) Act(ctx context.Context, req *types.MsgAct) (*types.MsgActRes
 		sdkCtx.EventManager().EmitEvent(ev)
 	}
 
-	// Eject bondless seats between hands.
+	// Settle queued leaves and auto top-ups, then eject bondless seats.
 	if t.Hand == nil {
+		seatEvents, err := m.settleSeatsAfterHand(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		sdkCtx.EventManager().EmitEvents(seatEvents)
 		if err := m.ejectBondlessSeats(ctx, t); err != nil {
 			return nil, err
 		}
@@ -485,6 +533,9 @@ func (m msgServer) Act(ctx context.Context, req *types.MsgAct) (*types.MsgActRes
 		if err := m.setLastHandEndedHeight(ctx, req.TableId, sdkCtx.BlockHeight()); err != nil {
 			return nil, err
 		}
+		if err := m.afterHandEnded(ctx, req.TableId, h.HandId); err != nil {
+			return nil, err
+		}
 	}
 
 	return &types.MsgActResponse{}, nil
@@ -541,6 +592,25 @@ func (m msgServer) Tick(ctx context.Context, req *types.MsgTick) (*types.MsgTick
 		return nil, types.ErrInvalidRequest.Wrap("action not timed out")
 	}
 
+	// While actions are paused the actor could not have acted in time, so the
+	// hand is aborted under the refund policy instead of folding the actor
+	// and slashing its bond.
+	actURL := sdk.MsgTypeURL(&types.MsgAct{})
+	if err := m.CheckNotPaused(ctx, actURL, req.TableId); err != nil {
+		if !errors.Is(err, types.ErrPaused) {
+			return nil, err
+		}
+		abortEvents, err := m.AbortHand(ctx, req.TableId, h.HandId, fmt.Sprintf("poker: %s is paused", actURL))
+		if err != nil {
+			return nil, err
+		}
+		sdkCtx.EventManager().EmitEvents(abortEvents)
+		if err := m.setLastHandEndedHeight(ctx, req.TableId, sdkCtx.BlockHeight()); err != nil {
+			return nil, err
+		}
+		return &types.MsgTickResponse{}, nil
+	}
+
 	handID := h.HandId
 	actorSeat := int(h.ActionOn)
 	player := t.Seats[actorSeat].Player
@@ -552,6 +622,7 @@ func (m msgServer) Tick(ctx context.Context, req *types.MsgTick) (*types.MsgTick
 
 	// Slash a per-player bond on timeouts (if configured on the table).
 	slashAmt := uint64(0)
+	var slash slashSplit
 	seatState := t.Seats[actorSeat]
 	if seatState != nil && seatState.Bond != 0 {
 		slashUnit := t.Params.BigBlind
@@ -564,13 +635,23 @@ func (m msgServer) Tick(ctx context.Context, req *types.MsgTick) (*types.MsgTick
 		}
 		seatState.Bond -= slashAmt
 
-		// Move slashed bond out of escrow to fee collector.
 		if slashAmt != 0 {
-			denom := sdk.DefaultBondDenom
-			coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromUint64(slashAmt)))
-			if err := m.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins); err != nil {
+			slash, err = routeSlashedBond(t, actorSeat, slashAmt)
+			if err != nil {
 				return nil, err
 			}
+			// Only the fee-collector route moves coins out of escrow; the
+			// other routes stay in the poker module account.
+			if slash.destination == types.SlashDestination_SLASH_DESTINATION_FEE_COLLECTOR {
+				denom := sdk.DefaultBondDenom
+				coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromUint64(slashAmt)))
+				if err := m.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins); err != nil {
+					return nil, err
+				}
+				if err := m.subTableEscrow(ctx, t.Id, slashAmt); err != nil {
+					return nil, err
+				}
+			}
 		}
 	}
 
@@ -606,14 +687,22 @@ func (m msgServer) Tick(ctx context.Context, req *types.MsgTick) (*types.MsgTick
 			sdk.NewAttribute("reason", "action-timeout"),
 			sdk.NewAttribute("amount", fmt.Sprintf("%d", slashAmt)),
 			sdk.NewAttribute("bondRemaining", fmt.Sprintf("%d", remaining)),
+			sdk.NewAttribute("destination", slashDestinationLabel(slash.destination)),
+			sdk.NewAttribute("recipientSeats", joinSeats(slash.seats)),
+			sdk.NewAttribute("recipientAmounts", joinAmounts(slash.amounts)),
 		))
 	}
 	for _, ev := range extraEvents {
 		sdkCtx.EventManager().EmitEvent(ev)
 	}
 
-	// Eject bondless seats between hands.
+	// Settle queued leaves and auto top-ups, then eject bondless seats.
 	if t.Hand == nil {
+		seatEvents, err := m.settleSeatsAfterHand(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		sdkCtx.EventManager().EmitEvents(seatEvents)
 		if err := m.ejectBondlessSeats(ctx, t); err != nil {
 			return nil, err
 		}
@@ -623,6 +712,9 @@ func (m msgServer) Tick(ctx context.Context, req *types.MsgTick) (*types.MsgTick
 		if err := m.setLastHandEndedHeight(ctx, req.TableId, sdkCtx.BlockHeight()); err != nil {
 			return nil, err
 		}
+		if err := m.afterHandEnded(ctx, req.TableId, handID); err != nil {
+			return nil, err
+		}
 	}
 
 	return &types.MsgTickResponse{}, nil
@@ -635,8 +727,7 @@ func (m msgServer) Leave(ctx context.Context, req *types.MsgLeave) (*types.MsgLe
 	if req.Player == "" {
 		return nil, types.ErrInvalidRequest.Wrap("missing player")
 	}
-	playerAddr, err := sdk.AccAddressFromBech32(req.Player)
-	if err != nil {
+	if _, err := sdk.AccAddressFromBech32(req.Player); err != nil {
 		return nil, types.ErrInvalidRequest.Wrap("invalid player address")
 	}
 
@@ -652,42 +743,37 @@ func (m msgServer) Leave(ctx context.Context, req *types.MsgLeave) (*types.MsgLe
 	if seat < 0 || seat >= 9 || t.Seats[seat] == nil || t.Seats[seat].Player == "" {
 		return nil, types.ErrNotSeated.Wrap("player not seated at table")
 	}
-	if t.Hand != nil && seat < len(t.Hand.InHand) && t.Hand.InHand[seat] && !(seat < len(t.Hand.Folded) && t.Hand.Folded[seat]) {
-		return nil, types.ErrInvalidRequest.Wrap("cannot leave during active hand")
-	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	s := t.Seats[seat]
-	amount := s.Stack
-	if s.Bond != 0 {
-		if amount > ^uint64(0)-s.Bond {
-			return nil, types.ErrInvalidRequest.Wrap("stack + bond overflows uint64")
+	// A player still live in the hand cannot take their chips off the table
+	// yet: queue the leave and cash the seat out when the hand ends.
+	if seatLiveInHand(t, seat) {
+		if t.Seats[seat].LeavePending {
+			return nil, types.ErrInvalidRequest.Wrap("leave already pending")
 		}
-		amount += s.Bond
-	}
-
-	if amount != 0 {
-		denom := sdk.DefaultBondDenom
-		coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromUint64(amount)))
-		if err := m.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, playerAddr, coins); err != nil {
+		t.Seats[seat].LeavePending = true
+		t.Seats[seat].LeaveToBalance = req.ToBalance
+		if err := m.SetTable(ctx, t); err != nil {
 			return nil, err
 		}
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePlayerLeavePending,
+			sdk.NewAttribute("tableId", fmt.Sprintf("%d", req.TableId)),
+			sdk.NewAttribute("handId", fmt.Sprintf("%d", t.Hand.HandId)),
+			sdk.NewAttribute("seat", fmt.Sprintf("%d", seat)),
+			sdk.NewAttribute("player", req.Player),
+		))
+		return &types.MsgLeaveResponse{LeavePending: true}, nil
 	}
 
-	t.Seats[seat] = &types.Seat{}
+	ev, err := m.cashOutSeat(ctx, t, seat, req.ToBalance)
+	if err != nil {
+		return nil, err
+	}
 	if err := m.SetTable(ctx, t); err != nil {
 		return nil, err
 	}
-
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypePlayerLeft,
-		sdk.NewAttribute("tableId", fmt.Sprintf("%d", req.TableId)),
-		sdk.NewAttribute("seat", fmt.Sprintf("%d", seat)),
-		sdk.NewAttribute("player", req.Player),
-		sdk.NewAttribute("stack", fmt.Sprintf("%d", s.Stack)),
-		sdk.NewAttribute("bond", fmt.Sprintf("%d", s.Bond)),
-		sdk.NewAttribute("amount", fmt.Sprintf("%d", amount)),
-	))
+	sdkCtx.EventManager().EmitEvent(ev)
 
 	return &types.MsgLeaveResponse{}, nil
 }
@@ -696,6 +782,9 @@ func (m msgServer) Rebuy(ctx context.Context, req *types.MsgRebuy) (*types.MsgRe
 	if req == nil {
 		return nil, types.ErrInvalidRequest.Wrap("nil request")
 	}
+	if err := m.CheckNotPaused(ctx, sdk.MsgTypeURL(req), req.TableId); err != nil {
+		return nil, err
+	}
 	if req.Player == "" {
 		return nil, types.ErrInvalidRequest.Wrap("missing player")
 	}
@@ -732,9 +821,10 @@ func (m msgServer) Rebuy(ctx context.Context, req *types.MsgRebuy) (*types.MsgRe
 		return nil, types.ErrInvalidRequest.Wrapf("rebuy would exceed max buy-in: %d > %d", newStack, t.Params.MaxBuyIn)
 	}
 
-	denom := sdk.DefaultBondDenom
-	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromUint64(req.Amount)))
-	if err := m.bankKeeper.SendCoinsFromAccountToModule(ctx, playerAddr, types.ModuleName, coins); err != nil {
+	if _, err := m.collectChips(ctx, playerAddr, req.Amount); err != nil {
+		return nil, err
+	}
+	if err := m.addTableEscrow(ctx, t.Id, req.Amount); err != nil {
 		return nil, err
 	}
 
@@ -784,6 +874,9 @@ func (m msgServer) ejectBondlessSeats(ctx context.Context, t *types.Table) error
 			if err := m.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
 				return err
 			}
+			if err := m.subTableEscrow(ctx, t.Id, s.Stack); err != nil {
+				return err
+			}
 		}
 
 		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
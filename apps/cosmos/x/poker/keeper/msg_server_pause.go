@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// SetPauseState replaces the circuit breaker state. Only the module authority
+// (x/gov by default) may call it.
+func (m msgServer) SetPauseState(ctx context.Context, req *types.MsgSetPauseState) (*types.MsgSetPauseStateResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest.Wrap("nil request")
+	}
+	if req.Authority != m.authority {
+		return nil, types.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", m.authority, req.Authority)
+	}
+	if err := req.PauseState.Validate(); err != nil {
+		return nil, types.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err := m.Keeper.SetPauseState(ctx, req.PauseState); err != nil {
+		return nil, err
+	}
+
+	tableIDs := make([]string, 0, len(req.PauseState.TableIds))
+	for _, id := range req.PauseState.TableIds {
+		tableIDs = append(tableIDs, fmt.Sprintf("%d", id))
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePauseStateUpdated,
+		sdk.NewAttribute("authority", req.Authority),
+		sdk.NewAttribute("msgTypeUrls", strings.Join(req.PauseState.MsgTypeUrls, ",")),
+		sdk.NewAttribute("tableIds", strings.Join(tableIDs, ",")),
+	))
+	return &types.MsgSetPauseStateResponse{}, nil
+}
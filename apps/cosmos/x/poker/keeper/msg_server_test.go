@@ -37,7 +37,9 @@ type bankCall struct {
 }
 
 type fakeBankKeeper struct {
-	calls []bankCall
+	calls     []bankCall
+	spendable map[string]sdk.Coins
+	balances  map[string]sdk.Coins
 }
 
 func (b *fakeBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
@@ -73,10 +75,23 @@ func (b *fakeBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, sender
 	return nil
 }
 
+func (b *fakeBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	if c, ok := b.spendable[addr.String()]; ok {
+		return c
+	}
+	return sdk.NewCoins()
+}
+
+func (b *fakeBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.balances[addr.String()].AmountOf(denom))
+}
+
 func addr(b byte) sdk.AccAddress {
 	return sdk.AccAddress(bytes.Repeat([]byte{b}, 20))
 }
 
+var testAuthority = authtypes.NewModuleAddress("gov").String()
+
 func newKeeper(t *testing.T, blockTime time.Time) (sdk.Context, keeper.Keeper, types.MsgServer, *fakeBankKeeper) {
 	t.Helper()
 
@@ -90,7 +105,7 @@ func newKeeper(t *testing.T, blockTime time.Time) (sdk.Context, keeper.Keeper, t
 	cdc := codec.NewProtoCodec(ir)
 
 	bk := &fakeBankKeeper{}
-	k := keeper.NewKeeper(cdc, storeService, bk)
+	k := keeper.NewKeeper(cdc, storeService, bk, testAuthority)
 	ms := keeper.NewMsgServerImpl(k, cdc)
 
 	return sdkCtx, k, ms, bk
@@ -180,6 +195,7 @@ func TestSitLeave_EscrowAndReturnCoins(t *testing.T) {
 		Label:             "escrow",
 	})
 	require.NoError(t, err)
+	bk.calls = nil // drop the creation deposit transfer
 
 	_, err = ms.Sit(ctx, &types.MsgSit{
 		Player:   player,
@@ -279,7 +295,7 @@ func TestTick_SlashesBondAndMovesCoinsToFeeCollector(t *testing.T) {
 	tbl.Seats[0] = &types.Seat{Player: p0.String(), Stack: 100, Bond: 10, Hole: []uint32{255, 255}}
 	tbl.Seats[1] = &types.Seat{Player: p1.String(), Stack: 100, Bond: 10, Hole: []uint32{255, 255}}
 
-	require.NoError(t, k.SetTable(ctx, tbl))
+	storeTable(t, ctx, k, tbl)
 
 	_, err := ms.Tick(ctx, &types.MsgTick{Caller: p0.String(), TableId: 1})
 	require.NoError(t, err)
@@ -296,6 +312,158 @@ func TestTick_SlashesBondAndMovesCoinsToFeeCollector(t *testing.T) {
 	require.Equal(t, uint64(5), tbl2.Seats[0].Bond)
 }
 
+// timeoutTable returns a preflop table where seat 0 faces a bet of 5 from
+// every other seated player and its action deadline has passed.
+func timeoutTable(now time.Time, players int, dest types.SlashDestination) *types.Table {
+	tbl := &types.Table{
+		Id:      1,
+		Creator: addr(0x10).String(),
+		Label:   "tick",
+		Params: types.TableParams{
+			MaxPlayers:       9,
+			SmallBlind:       1,
+			BigBlind:         5,
+			MinBuyIn:         1,
+			MaxBuyIn:         1000,
+			PlayerBond:       10,
+			SlashDestination: dest,
+		},
+		Seats:      make([]*types.Seat, 9),
+		NextHandId: 2,
+		ButtonSeat: -1,
+		Hand: &types.Hand{
+			HandId:            1,
+			Phase:             types.HandPhase_HAND_PHASE_BETTING,
+			Street:            types.Street_STREET_PREFLOP,
+			ActionOn:          0,
+			BetTo:             5,
+			InHand:            make([]bool, 9),
+			Folded:            make([]bool, 9),
+			AllIn:             make([]bool, 9),
+			StreetCommit:      make([]uint64, 9),
+			TotalCommit:       make([]uint64, 9),
+			LastIntervalActed: make([]int32, 9),
+			ActionDeadline:    now.Unix() - 1,
+		},
+	}
+	for i := 0; i < 9; i++ {
+		tbl.Hand.LastIntervalActed[i] = -1
+	}
+	for i := 0; i < players; i++ {
+		tbl.Seats[i] = &types.Seat{Player: addr(byte(0x10 + i)).String(), Stack: 100, Bond: 10, Hole: []uint32{255, 255}}
+		tbl.Hand.InHand[i] = true
+		if i > 0 {
+			tbl.Hand.StreetCommit[i] = 5
+			tbl.Hand.TotalCommit[i] = 5
+			tbl.Hand.LastIntervalActed[i] = 0
+		}
+	}
+	return tbl
+}
+
+func TestTick_SlashSplitAmongOtherLivePlayers(t *testing.T) {
+	now := time.Unix(100, 0).UTC()
+	sdkCtx, k, ms, bk := newKeeper(t, now)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	tbl := timeoutTable(now, 3, types.SlashDestination_SLASH_DESTINATION_PLAYERS)
+	escrowBefore, err := tbl.EscrowTotal()
+	require.NoError(t, err)
+	storeTable(t, ctx, k, tbl)
+
+	_, err = ms.Tick(ctx, &types.MsgTick{Caller: addr(0x10).String(), TableId: 1})
+	require.NoError(t, err)
+	require.Empty(t, bk.calls, "slashed bond must stay in escrow")
+
+	tbl2, err := k.GetTable(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), tbl2.Seats[0].Bond)
+	require.NotNil(t, tbl2.Hand)
+	require.True(t, tbl2.Hand.Folded[0])
+	// 5 chips over seats 1 and 2: the odd chip goes to the first seat after the offender.
+	require.Equal(t, []uint64{0, 3, 2, 0, 0, 0, 0, 0, 0}, tbl2.Hand.SlashCredit)
+	// Credit is not spendable mid-hand.
+	require.Equal(t, uint64(100), tbl2.Seats[1].Stack)
+
+	escrowAfter, err := tbl2.EscrowTotal()
+	require.NoError(t, err)
+	require.Equal(t, escrowBefore, escrowAfter)
+
+	var slashEv *sdk.Event
+	for _, ev := range sdkCtx.EventManager().Events() {
+		if ev.Type == types.EventTypePlayerSlashed {
+			ev := ev
+			slashEv = &ev
+		}
+	}
+	require.NotNil(t, slashEv)
+	attrs := map[string]string{}
+	for _, a := range slashEv.Attributes {
+		attrs[a.Key] = a.Value
+	}
+	require.Equal(t, "players", attrs["destination"])
+	require.Equal(t, "1,2", attrs["recipientSeats"])
+	require.Equal(t, "3,2", attrs["recipientAmounts"])
+}
+
+func TestTick_SlashCreditPaidWhenHandEnds(t *testing.T) {
+	for _, dest := range []types.SlashDestination{
+		types.SlashDestination_SLASH_DESTINATION_PLAYERS,
+		types.SlashDestination_SLASH_DESTINATION_POT,
+	} {
+		t.Run(dest.String(), func(t *testing.T) {
+			now := time.Unix(100, 0).UTC()
+			sdkCtx, k, ms, bk := newKeeper(t, now)
+			ctx := sdk.WrapSDKContext(sdkCtx)
+
+			storeTable(t, ctx, k, timeoutTable(now, 2, dest))
+			_, err := ms.Tick(ctx, &types.MsgTick{Caller: addr(0x10).String(), TableId: 1})
+			require.NoError(t, err)
+			require.Empty(t, bk.calls)
+
+			// Seat 0 folds heads-up: seat 1 gets its uncalled bet back plus the slashed 5.
+			tbl, err := k.GetTable(ctx, 1)
+			require.NoError(t, err)
+			require.Nil(t, tbl.Hand)
+			require.Equal(t, uint64(110), tbl.Seats[1].Stack)
+			require.Equal(t, uint64(100), tbl.Seats[0].Stack)
+			require.Equal(t, uint64(5), tbl.Seats[0].Bond)
+		})
+	}
+}
+
+func TestCreateTable_RejectsUnknownSlashDestination(t *testing.T) {
+	sdkCtx, _, ms, _ := newKeeper(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	_, err := ms.CreateTable(ctx, &types.MsgCreateTable{
+		Creator:          addr(0x20).String(),
+		SmallBlind:       1,
+		BigBlind:         2,
+		MinBuyIn:         100,
+		MaxBuyIn:         1000,
+		MaxPlayers:       9,
+		SlashDestination: types.SlashDestination(42),
+	})
+	require.ErrorContains(t, err, "unknown slash_destination")
+}
+
+func TestCreateTable_RejectsBadAbortPolicy(t *testing.T) {
+	sdkCtx, _, ms, _ := newKeeper(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	_, err := ms.CreateTable(ctx, &types.MsgCreateTable{
+		Creator:           addr(0x20).String(),
+		SmallBlind:        1,
+		BigBlind:          2,
+		MinBuyIn:          100,
+		MaxBuyIn:          1000,
+		MaxPlayers:        9,
+		AbortRefundPolicy: types.AbortRefundPolicy(42),
+	})
+	require.ErrorContains(t, err, "unknown abort_refund_policy")
+}
+
 func TestCreateTable_RejectsHugeTimeoutInputs(t *testing.T) {
 	sdkCtx, k, ms, _ := newKeeper(t, time.Unix(100, 0).UTC())
 	ctx := sdk.WrapSDKContext(sdkCtx)
@@ -396,7 +564,7 @@ func TestStartHand_NextHandIDOverflow(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, tbl)
 	tbl.NextHandId = ^uint64(0)
-	require.NoError(t, k.SetTable(ctx, tbl))
+	storeTable(t, ctx, k, tbl)
 
 	_, err = ms.StartHand(ctx, &types.MsgStartHand{Caller: p0, TableId: 1})
 	require.ErrorContains(t, err, "next hand id overflows uint64")
@@ -456,7 +624,7 @@ func TestTick_HugeActionTimeoutOverflowDoesNotMutate(t *testing.T) {
 	tbl.Hand.InHand[1] = true
 	tbl.Seats[0] = &types.Seat{Player: p0.String(), Stack: 100, Bond: 0, Hole: []uint32{255, 255}}
 	tbl.Seats[1] = &types.Seat{Player: p1.String(), Stack: 100, Bond: 0, Hole: []uint32{255, 255}}
-	require.NoError(t, k.SetTable(ctx, tbl))
+	storeTable(t, ctx, k, tbl)
 
 	_, err := ms.Tick(ctx, &types.MsgTick{Caller: p0.String(), TableId: 1})
 	require.ErrorContains(t, err, "action deadline overflows int64")
@@ -477,6 +645,16 @@ func TestTick_HugeActionTimeoutOverflowDoesNotMutate(t *testing.T) {
 // shuffle protocol) so that betting/fold/check/call logic can be exercised.
 // Blinds: SB=1, BB=2. P0 at seat 0 (SB/Button), P1 at seat 1 (BB).
 // ActionOn starts at seat 0 (UTG in heads-up = button/SB, first to act preflop).
+// storeTable persists a hand-built table along with the escrow the keeper
+// would have recorded for it had its chips arrived through Sit.
+func storeTable(t *testing.T, ctx context.Context, k keeper.Keeper, tbl *types.Table) {
+	t.Helper()
+	require.NoError(t, k.SetTable(ctx, tbl))
+	escrow, err := tbl.EscrowTotal()
+	require.NoError(t, err)
+	require.NoError(t, k.SetTableEscrow(ctx, tbl.Id, escrow))
+}
+
 func setupHeadsUpBetting(t *testing.T, now time.Time) (sdk.Context, keeper.Keeper, types.MsgServer, *fakeBankKeeper, sdk.AccAddress, sdk.AccAddress) {
 	t.Helper()
 
@@ -543,14 +721,32 @@ func setupHeadsUpBetting(t *testing.T, now time.Time) (sdk.Context, keeper.Keepe
 	tbl.Hand.StreetCommit[1] = 2
 	tbl.Hand.TotalCommit[1] = 2
 
-	require.NoError(t, k.SetTable(ctx, tbl))
+	storeTable(t, ctx, k, tbl)
 
 	return sdkCtx, k, ms, bk, p0, p1
 }
 
+// recordingHooks records the poker hooks it is called with.
+type recordingHooks struct {
+	handsEnded   [][2]uint64
+	tablesClosed []uint64
+}
+
+func (h *recordingHooks) AfterHandEnded(_ context.Context, tableID, handID uint64) error {
+	h.handsEnded = append(h.handsEnded, [2]uint64{tableID, handID})
+	return nil
+}
+
+func (h *recordingHooks) AfterTableClosed(_ context.Context, tableID uint64) error {
+	h.tablesClosed = append(h.tablesClosed, tableID)
+	return nil
+}
+
 func TestHeadsUpAllFold(t *testing.T) {
 	sdkCtx, k, ms, _, p0, _ := setupHeadsUpBetting(t, time.Unix(100, 0).UTC())
 	ctx := sdk.WrapSDKContext(sdkCtx)
+	hooks := &recordingHooks{}
+	k.SetHooks(hooks)
 
 	// P0 (seat 0, actionOn) folds preflop → P1 wins.
 	_, err := ms.Act(ctx, &types.MsgAct{
@@ -571,6 +767,9 @@ func TestHeadsUpAllFold(t *testing.T) {
 	require.Equal(t, uint64(101), tbl.Seats[1].Stack, "P1 should win the pot")
 	// P0 folded, stack unchanged from post-blind state (99).
 	require.Equal(t, uint64(99), tbl.Seats[0].Stack, "P0 stack unchanged after fold")
+
+	// The dealer learns the hand is over without being part of its end.
+	require.Equal(t, [][2]uint64{{1, 1}}, hooks.handsEnded)
 }
 
 func TestHeadsUpCallCheck(t *testing.T) {
@@ -628,17 +827,91 @@ func TestActRejectsWrongPlayer(t *testing.T) {
 	require.ErrorContains(t, err, "not your turn")
 }
 
-func TestLeaveRejectsDuringHand(t *testing.T) {
-	sdkCtx, _, ms, _, p0, _ := setupHeadsUpBetting(t, time.Unix(100, 0).UTC())
+func TestActCallRespectsAmountGuard(t *testing.T) {
+	sdkCtx, k, ms, _, p0, _ := setupHeadsUpBetting(t, time.Unix(100, 0).UTC())
 	ctx := sdk.WrapSDKContext(sdkCtx)
 
-	// P0 is in an active hand (InHand[0] = true). Leave should be rejected.
-	_, err := ms.Leave(ctx, &types.MsgLeave{
-		Player:  p0.String(),
-		TableId: 1,
-	})
-	require.Error(t, err)
-	require.ErrorContains(t, err, "cannot leave during active hand")
+	// BetTo is the big blind (2); a call capped at 1 must not go through.
+	_, err := ms.Act(ctx, &types.MsgAct{Player: p0.String(), TableId: 1, Action: "call", Amount: 1})
+	require.ErrorContains(t, err, "call would match 2, above amount 1")
+
+	_, err = ms.Act(ctx, &types.MsgAct{Player: p0.String(), TableId: 1, Action: "call", Amount: 2})
+	require.NoError(t, err)
+
+	tbl, err := k.GetTable(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), tbl.Hand.StreetCommit[0])
+}
+
+func TestLeaveDuringHandIsQueued(t *testing.T) {
+	sdkCtx, k, ms, bk, p0, p1 := setupHeadsUpBetting(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	// P1 is live in the hand: the leave is queued and nothing is paid out yet.
+	resp, err := ms.Leave(ctx, &types.MsgLeave{Player: p1.String(), TableId: 1})
+	require.NoError(t, err)
+	require.True(t, resp.LeavePending)
+	require.Empty(t, bk.calls)
+
+	_, err = ms.Leave(ctx, &types.MsgLeave{Player: p1.String(), TableId: 1})
+	require.ErrorContains(t, err, "leave already pending")
+
+	tbl, err := k.GetTable(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, tbl.Seats[1].LeavePending)
+	require.Equal(t, p1.String(), tbl.Seats[1].Player)
+
+	// P0 folds: P1 wins the pot (3) and is cashed out when the hand settles.
+	_, err = ms.Act(ctx, &types.MsgAct{Player: p0.String(), TableId: 1, Action: "fold"})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, bk.calls)
+	last := bk.calls[len(bk.calls)-1]
+	require.Equal(t, "m2a", last.kind)
+	require.Equal(t, p1, last.toAcc)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(101))), last.coins, "stack 98 + pot 3")
+
+	tbl, err = k.GetTable(ctx, 1)
+	require.NoError(t, err)
+	require.Nil(t, tbl.Hand)
+	require.Empty(t, tbl.Seats[1].Player)
+	require.False(t, tbl.Seats[1].LeavePending)
+
+	var left bool
+	for _, ev := range sdkCtx.EventManager().Events() {
+		if ev.Type == types.EventTypePlayerLeft {
+			left = true
+		}
+	}
+	require.True(t, left, "expected PlayerLeft event at hand end")
+
+	_, err = ms.StartHand(sdk.WrapSDKContext(sdkCtx.WithBlockHeight(1000)), &types.MsgStartHand{Caller: p0.String(), TableId: 1})
+	require.ErrorContains(t, err, "need at least 2 players")
+}
+
+func TestStartHandSkipsLeavePendingSeat(t *testing.T) {
+	sdkCtx, k, ms, _, p0, _ := setupHeadsUpBetting(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	p2 := addr(0xA2)
+	tbl, err := k.GetTable(ctx, 1)
+	require.NoError(t, err)
+	tbl.Hand = nil
+	tbl.Seats[1].LeavePending = true
+	tbl.Seats[2] = &types.Seat{Player: p2.String(), Stack: 100, Hole: []uint32{255, 255}}
+	storeTable(t, ctx, k, tbl)
+
+	_, err = ms.StartHand(ctx, &types.MsgStartHand{Caller: p0.String(), TableId: 1})
+	require.NoError(t, err)
+
+	tbl, err = k.GetTable(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, tbl.Hand)
+	require.False(t, tbl.Hand.InHand[1], "leaving seat must not be dealt in")
+	require.True(t, tbl.Hand.InHand[0])
+	require.True(t, tbl.Hand.InHand[2])
+	require.NotEqual(t, int32(1), tbl.Hand.SmallBlindSeat)
+	require.NotEqual(t, int32(1), tbl.Hand.BigBlindSeat)
 }
 
 func TestHeadsUpFoldAfterRaise(t *testing.T) {
@@ -754,7 +1027,7 @@ func TestAllInCall(t *testing.T) {
 	tbl.Hand.StreetCommit[1] = 2
 	tbl.Hand.TotalCommit[1] = 2
 
-	require.NoError(t, k.SetTable(ctx, tbl))
+	storeTable(t, ctx, k, tbl)
 
 	// P1 checks (BetTo=2, P1 already committed 2).
 	_, err := ms.Act(ctx, &types.MsgAct{
@@ -1065,7 +1338,7 @@ func TestAutoAssignSeatPlacement(t *testing.T) {
 	tbl.Seats[3] = &types.Seat{Player: p3, Stack: 100, Hole: []uint32{255, 255}}
 	tbl.Seats[7] = &types.Seat{Player: p7, Stack: 100, Hole: []uint32{255, 255}}
 	require.NoError(t, k.SetNextTableID(ctx, 2))
-	require.NoError(t, k.SetTable(ctx, tbl))
+	storeTable(t, ctx, k, tbl)
 
 	// Sit new player — should be placed after BB (seat 7) → seat 1 (first empty after 7).
 	resp, err := ms.Sit(ctx, &types.MsgSit{Player: pNew, TableId: 1, BuyIn: 100, PkPlayer: pkBytes})
@@ -1114,7 +1387,7 @@ func TestSitPasswordLegacyTableBitCompat(t *testing.T) {
 		NextHandId: 1,
 		ButtonSeat: -1,
 	}
-	require.NoError(t, k.SetTable(ctx, legacyTable))
+	storeTable(t, ctx, k, legacyTable)
 	require.NoError(t, k.SetNextTableID(ctx, 2))
 
 	// v2 client computes SHA256(empty_salt || password) = SHA256("legacy").
@@ -1215,6 +1488,7 @@ func TestRebuy_HappyPath(t *testing.T) {
 		MaxPlayers: 9, Label: "rebuy-happy",
 	})
 	require.NoError(t, err)
+	bk.calls = nil // drop the creation deposit transfer
 
 	_, err = ms.Sit(ctx, &types.MsgSit{
 		Player: player, TableId: 1, BuyIn: 100, PkPlayer: pkBytes,
@@ -1361,7 +1635,7 @@ func TestRebuy_AllowsBetweenHands(t *testing.T) {
 	tbl, err := k.GetTable(ctx, 1)
 	require.NoError(t, err)
 	tbl.Seats[0].Stack = 0
-	require.NoError(t, k.SetTable(ctx, tbl))
+	storeTable(t, ctx, k, tbl)
 
 	resp, err := ms.Rebuy(ctx, &types.MsgRebuy{
 		Player: player, TableId: 1, Amount: 500,
@@ -1396,7 +1670,7 @@ func TestRebuy_OverflowProtection(t *testing.T) {
 	}
 	tbl.Seats[0] = &types.Seat{Player: player, Stack: math.MaxUint64 - 1, Hole: []uint32{255, 255}}
 	require.NoError(t, k.SetNextTableID(ctx, 2))
-	require.NoError(t, k.SetTable(ctx, tbl))
+	storeTable(t, ctx, k, tbl)
 
 	_, err := ms.Rebuy(ctx, &types.MsgRebuy{
 		Player: player, TableId: 1, Amount: 2,
@@ -1467,7 +1741,7 @@ func buildTickTable(t *testing.T, k keeper.Keeper, ctx context.Context, players
 		tbl.Hand.InHand[i] = true
 		tbl.Seats[i] = &types.Seat{Player: p.String(), Stack: 100, Bond: 0, Hole: []uint32{255, 255}}
 	}
-	require.NoError(t, k.SetTable(ctx, tbl))
+	storeTable(t, ctx, k, tbl)
 }
 
 func TestTick_RejectsEmptyCaller(t *testing.T) {
@@ -1589,7 +1863,7 @@ func TestStartHand_AcceptsAfterInterHandCooldown(t *testing.T) {
 	require.NoError(t, err)
 
 	// Advance past the 5-block cooldown.
-	sdkCtx = sdkCtx.WithBlockHeight(startHeight + int64(keeper.InterHandCooldownBlocks))
+	sdkCtx = sdkCtx.WithBlockHeight(startHeight + int64(types.DefaultParams().InterHandCooldownBlocks))
 	ctx = sdk.WrapSDKContext(sdkCtx)
 
 	_, err = ms.StartHand(ctx, &types.MsgStartHand{Caller: p0.String(), TableId: 1})
@@ -1671,7 +1945,7 @@ func TestLeave_FoldedPlayerCanLeaveMidHand(t *testing.T) {
 	tbl.Hand.StreetCommit[2] = 2
 	tbl.Hand.TotalCommit[2] = 2
 
-	require.NoError(t, k.SetTable(ctx, tbl))
+	storeTable(t, ctx, k, tbl)
 
 	_, err := ms.Leave(ctx, &types.MsgLeave{
 		Player:  p0.String(),
@@ -1704,7 +1978,7 @@ func TestCreateTable_RejectsOversizeLabel(t *testing.T) {
 	ctx := sdk.WrapSDKContext(sdkCtx)
 	creator := addr(0x22).String()
 
-	huge := make([]byte, keeper.MaxTableLabelLen+1)
+	huge := make([]byte, types.DefaultParams().MaxTableLabelLen+1)
 	for i := range huge {
 		huge[i] = 'a'
 	}
@@ -1777,7 +2051,7 @@ func TestCreateTable_RejectsOversizeActionTimeout(t *testing.T) {
 		Creator:    creator,
 		SmallBlind: 1, BigBlind: 2,
 		MinBuyIn: 100, MaxBuyIn: 1000,
-		ActionTimeoutSecs: keeper.MaxActionTimeoutSecs + 1,
+		ActionTimeoutSecs: types.DefaultParams().MaxActionTimeoutSecs + 1,
 		MaxPlayers:        9, Label: "huge-action",
 	})
 	require.Error(t, err)
@@ -1793,7 +2067,7 @@ func TestCreateTable_RejectsOversizeDealerTimeout(t *testing.T) {
 		Creator:    creator,
 		SmallBlind: 1, BigBlind: 2,
 		MinBuyIn: 100, MaxBuyIn: 1000,
-		DealerTimeoutSecs: keeper.MaxDealerTimeoutSecs + 1,
+		DealerTimeoutSecs: types.DefaultParams().MaxDealerTimeoutSecs + 1,
 		MaxPlayers:        9, Label: "huge-dealer",
 	})
 	require.Error(t, err)
@@ -1808,7 +2082,7 @@ func TestCreateTable_RejectsOversizeBuyIn(t *testing.T) {
 	_, err := ms.CreateTable(ctx, &types.MsgCreateTable{
 		Creator:    creator,
 		SmallBlind: 1, BigBlind: 2,
-		MinBuyIn: 100, MaxBuyIn: keeper.MaxBuyInUchips + 1,
+		MinBuyIn: 100, MaxBuyIn: types.DefaultParams().MaxBuyInUchips + 1,
 		MaxPlayers: 9, Label: "huge-max-buyin",
 	})
 	require.Error(t, err)
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"onchainpoker/apps/cosmos/x/poker/keeper"
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+func TestParams_DefaultsWhenUnset(t *testing.T) {
+	sdkCtx, k, _, _ := newKeeper(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	resp, err := keeper.NewQueryServerImpl(k).Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), resp.Params)
+}
+
+func TestUpdateParams_Authority(t *testing.T) {
+	sdkCtx, k, ms, _ := newKeeper(t, time.Unix(100, 0).UTC())
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	want := types.DefaultParams()
+	want.MaxTableLabelLen = 8
+
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: addr(0x01).String(), Params: want})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	bad := want
+	bad.MaxBuyInUchips = 0
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: testAuthority, Params: bad})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	got, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), got)
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: testAuthority, Params: want})
+	require.NoError(t, err)
+
+	got, err = k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	// The new label cap applies to subsequent CreateTable calls.
+	_, err = ms.CreateTable(ctx, &types.MsgCreateTable{
+		Creator:    addr(0x02).String(),
+		SmallBlind: 1, BigBlind: 2,
+		MinBuyIn: 100, MaxBuyIn: 1000,
+		MaxPlayers: 9, Label: "123456789",
+	})
+	require.ErrorContains(t, err, "label exceeds 8 bytes")
+}
+
+func TestStartHand_CooldownFollowsParams(t *testing.T) {
+	startHeight := int64(100)
+	sdkCtx, k, ms, _, p0, _ := setupHeadsUpBetting(t, time.Unix(100, 0).UTC())
+	sdkCtx = sdkCtx.WithBlockHeight(startHeight)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	p := types.DefaultParams()
+	p.InterHandCooldownBlocks = 0
+	require.NoError(t, k.SetParams(ctx, p))
+
+	_, err := ms.Act(ctx, &types.MsgAct{Player: p0.String(), TableId: 1, Action: "fold"})
+	require.NoError(t, err)
+
+	// With no cooldown the next hand can start in the same block.
+	_, err = ms.StartHand(ctx, &types.MsgStartHand{Caller: p0.String(), TableId: 1})
+	require.NoError(t, err)
+}
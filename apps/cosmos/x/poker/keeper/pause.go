@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+func (k Keeper) GetPauseState(ctx context.Context) (types.PauseState, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PauseStateKey)
+	if err != nil {
+		return types.PauseState{}, err
+	}
+	if bz == nil {
+		return types.PauseState{}, nil
+	}
+	var p types.PauseState
+	if err := k.cdc.Unmarshal(bz, &p); err != nil {
+		return types.PauseState{}, err
+	}
+	return p, nil
+}
+
+func (k Keeper) SetPauseState(ctx context.Context, p types.PauseState) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if len(p.MsgTypeUrls) == 0 && len(p.TableIds) == 0 {
+		return store.Delete(types.PauseStateKey)
+	}
+	bz, err := k.cdc.Marshal(&p)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.PauseStateKey, bz)
+}
+
+// CheckNotPaused returns types.ErrPaused if the circuit breaker blocks a
+// message of type msgTypeURL touching tableID (0 for none). It is called by
+// the ante handler and again by the poker and dealer msg servers, which also
+// covers messages nested in authz MsgExec.
+func (k Keeper) CheckNotPaused(ctx context.Context, msgTypeURL string, tableID uint64) error {
+	p, err := k.GetPauseState(ctx)
+	if err != nil {
+		return err
+	}
+	return p.Check(msgTypeURL, tableID)
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"onchainpoker/apps/cosmos/x/poker/keeper"
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+func TestSetPauseState_BlocksNewHandsButAllowsLeave(t *testing.T) {
+	sdkCtx, k, ms, _, p0, p1 := setupHeadsUpBetting(t, time.Unix(100, 0).UTC())
+	sdkCtx = sdkCtx.WithBlockHeight(100)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	pause := types.PauseState{
+		MsgTypeUrls: []string{sdk.MsgTypeURL(&types.MsgCreateTable{})},
+		TableIds:    []uint64{1},
+	}
+	_, err := ms.SetPauseState(ctx, &types.MsgSetPauseState{Authority: p0.String(), PauseState: pause})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.SetPauseState(ctx, &types.MsgSetPauseState{Authority: testAuthority, PauseState: types.PauseState{
+		MsgTypeUrls: []string{sdk.MsgTypeURL(&types.MsgLeave{})},
+	}})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	_, err = ms.SetPauseState(ctx, &types.MsgSetPauseState{Authority: testAuthority, PauseState: pause})
+	require.NoError(t, err)
+
+	resp, err := keeper.NewQueryServerImpl(k).PauseState(ctx, &types.QueryPauseStateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, pause, resp.PauseState)
+
+	_, err = ms.CreateTable(ctx, &types.MsgCreateTable{
+		Creator:    p0.String(),
+		SmallBlind: 1, BigBlind: 2,
+		MinBuyIn: 100, MaxBuyIn: 1000,
+	})
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// The running hand finishes, but no new hand starts on the paused table.
+	_, err = ms.Act(ctx, &types.MsgAct{Player: p0.String(), TableId: 1, Action: "fold"})
+	require.NoError(t, err)
+	sdkCtx = sdkCtx.WithBlockHeight(200)
+	ctx = sdk.WrapSDKContext(sdkCtx)
+	_, err = ms.StartHand(ctx, &types.MsgStartHand{Caller: p0.String(), TableId: 1})
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	_, err = ms.Leave(ctx, &types.MsgLeave{Player: p1.String(), TableId: 1})
+	require.NoError(t, err)
+
+	// An empty state lifts the pause.
+	_, err = ms.SetPauseState(ctx, &types.MsgSetPauseState{Authority: testAuthority})
+	require.NoError(t, err)
+	got, err := k.GetPauseState(ctx)
+	require.NoError(t, err)
+	require.Empty(t, got.MsgTypeUrls)
+	require.Empty(t, got.TableIds)
+}
+
+func TestTick_PausedActionsAbortWithoutSlashing(t *testing.T) {
+	now := time.Unix(100, 0).UTC()
+	sdkCtx, k, ms, _, _, p1 := setupHeadsUpBetting(t, now)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	tbl, err := k.GetTable(ctx, 1)
+	require.NoError(t, err)
+	tbl.Params.PlayerBond = 10
+	tbl.Seats[0].Bond = 10
+	tbl.Seats[1].Bond = 10
+	tbl.Hand.ActionDeadline = now.Unix() - 1
+	storeTable(t, ctx, k, tbl)
+	require.NoError(t, k.SetPauseState(ctx, types.PauseState{
+		MsgTypeUrls: []string{sdk.MsgTypeURL(&types.MsgAct{})},
+	}))
+
+	// p0 could not act, so the timeout refunds the hand instead of folding
+	// p0 and slashing its bond.
+	_, err = ms.Tick(ctx, &types.MsgTick{Caller: p1.String(), TableId: 1})
+	require.NoError(t, err)
+
+	got, err := k.GetTable(ctx, 1)
+	require.NoError(t, err)
+	require.Nil(t, got.Hand)
+	require.Equal(t, []uint64{100, 100}, []uint64{got.Seats[0].Stack, got.Seats[1].Stack})
+	require.Equal(t, []uint64{10, 10}, []uint64{got.Seats[0].Bond, got.Seats[1].Bond})
+	var aborted, slashed int
+	for _, ev := range sdkCtx.EventManager().Events() {
+		switch ev.Type {
+		case types.EventTypeHandAborted:
+			aborted++
+		case types.EventTypePlayerSlashed:
+			slashed++
+		}
+	}
+	require.Equal(t, 1, aborted)
+	require.Zero(t, slashed)
+}
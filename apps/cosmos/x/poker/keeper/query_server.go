@@ -19,6 +19,22 @@ func NewQueryServerImpl(k Keeper) types.QueryServer {
 	return &queryServer{Keeper: k}
 }
 
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	p, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: p}, nil
+}
+
+func (q queryServer) PauseState(ctx context.Context, _ *types.QueryPauseStateRequest) (*types.QueryPauseStateResponse, error) {
+	p, err := q.GetPauseState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPauseStateResponse{PauseState: p}, nil
+}
+
 func (q queryServer) Table(ctx context.Context, req *types.QueryTableRequest) (*types.QueryTableResponse, error) {
 	if req == nil {
 		return nil, types.ErrInvalidRequest.Wrap("nil request")
@@ -44,6 +60,47 @@ func (q queryServer) Tables(ctx context.Context, _ *types.QueryTablesRequest) (*
 	return &types.QueryTablesResponse{TableIds: ids}, nil
 }
 
+func (q queryServer) ChipBalance(ctx context.Context, req *types.QueryChipBalanceRequest) (*types.QueryChipBalanceResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest.Wrap("nil request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, types.ErrInvalidRequest.Wrap("invalid address")
+	}
+	amount, err := q.GetChipBalance(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryChipBalanceResponse{Amount: amount}, nil
+}
+
+func (q queryServer) TableEscrow(ctx context.Context, req *types.QueryTableEscrowRequest) (*types.QueryTableEscrowResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest.Wrap("nil request")
+	}
+	t, err := q.GetTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, types.ErrTableNotFound.Wrapf("table %d not found", req.TableId)
+	}
+	escrow, err := q.GetTableEscrow(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+	stacks, bonds, pot, err := t.EscrowBreakdown()
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTableEscrowResponse{Escrow: escrow, Stacks: stacks, Bonds: bonds, Pot: pot, Deposit: t.CreationDeposit}, nil
+}
+
+func (q queryServer) Reserves(ctx context.Context, _ *types.QueryReservesRequest) (*types.QueryReservesResponse, error) {
+	return q.Keeper.Reserves(ctx)
+}
+
 // Query helpers (used by other modules / tests).
 func (k Keeper) MustGetTable(ctx context.Context, tableID uint64) *types.Table {
 	t, err := k.GetTable(ctx, tableID)
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// slashSplit records where a slashed bond went, for events.
+type slashSplit struct {
+	destination types.SlashDestination
+	seats       []int
+	amounts     []uint64
+}
+
+func tableSlashDestination(t *types.Table) types.SlashDestination {
+	if t.Params.SlashDestination == types.SlashDestination_SLASH_DESTINATION_UNSPECIFIED {
+		return types.SlashDestination_SLASH_DESTINATION_FEE_COLLECTOR
+	}
+	return t.Params.SlashDestination
+}
+
+func slashDestinationLabel(d types.SlashDestination) string {
+	switch d {
+	case types.SlashDestination_SLASH_DESTINATION_PLAYERS:
+		return "players"
+	case types.SlashDestination_SLASH_DESTINATION_POT:
+		return "pot"
+	default:
+		return "fee-collector"
+	}
+}
+
+// liveSeatsFrom returns occupied seats still live in the hand, clockwise
+// starting after fromSeat and excluding it.
+func liveSeatsFrom(t *types.Table, fromSeat int) []int {
+	h := t.Hand
+	out := make([]int, 0, 9)
+	for step := 1; step < 9; step++ {
+		i := (fromSeat + step) % 9
+		if !h.InHand[i] || h.Folded[i] {
+			continue
+		}
+		if t.Seats[i] == nil || t.Seats[i].Player == "" {
+			continue
+		}
+		out = append(out, i)
+	}
+	return out
+}
+
+// liveSeatsFromButton returns the live seats clockwise starting left of the
+// button, with the button itself last.
+func liveSeatsFromButton(t *types.Table) []int {
+	button := int(t.Hand.ButtonSeat)
+	if button < 0 || button >= 9 {
+		button = 8
+	}
+	seats := liveSeatsFrom(t, button)
+	h := t.Hand
+	if h.InHand[button] && !h.Folded[button] && t.Seats[button] != nil && t.Seats[button].Player != "" {
+		seats = append(seats, button)
+	}
+	return seats
+}
+
+// splitEvenly divides amount across n recipients; the remainder goes one chip
+// at a time to the first recipients.
+func splitEvenly(amount uint64, n int) []uint64 {
+	out := make([]uint64, n)
+	if n == 0 {
+		return out
+	}
+	share := amount / uint64(n)
+	rem := amount % uint64(n)
+	for i := range out {
+		out[i] = share
+		if uint64(i) < rem {
+			out[i]++
+		}
+	}
+	return out
+}
+
+// routeSlashedBond books amount (already removed from the offender's bond)
+// according to the table's slash destination. The fee-collector route only
+// returns the decision; the caller moves the coins. If no other player is
+// live to receive a players split, the bond goes to the fee collector.
+func routeSlashedBond(t *types.Table, offenderSeat int, amount uint64) (slashSplit, error) {
+	h := t.Hand
+	dest := tableSlashDestination(t)
+	switch dest {
+	case types.SlashDestination_SLASH_DESTINATION_PLAYERS:
+		seats := liveSeatsFrom(t, offenderSeat)
+		if len(seats) == 0 {
+			break
+		}
+		amounts := splitEvenly(amount, len(seats))
+		for i, seat := range seats {
+			next, err := addUint64Checked(h.SlashCredit[seat], amounts[i], "slash credit")
+			if err != nil {
+				return slashSplit{}, err
+			}
+			h.SlashCredit[seat] = next
+		}
+		return slashSplit{destination: dest, seats: seats, amounts: amounts}, nil
+	case types.SlashDestination_SLASH_DESTINATION_POT:
+		next, err := addUint64Checked(h.DeadMoney, amount, "dead money")
+		if err != nil {
+			return slashSplit{}, err
+		}
+		h.DeadMoney = next
+		return slashSplit{destination: dest}, nil
+	}
+	return slashSplit{destination: types.SlashDestination_SLASH_DESTINATION_FEE_COLLECTOR}, nil
+}
+
+// returnDeadMoney converts dead money into slash credit for the live seats
+// when a hand ends without awarding the main pot (aborts).
+func returnDeadMoney(t *types.Table) error {
+	h := t.Hand
+	if h == nil || h.DeadMoney == 0 {
+		return nil
+	}
+	seats := liveSeatsFromButton(t)
+	if len(seats) == 0 {
+		// Nobody live is left to receive it; fall back to anyone still seated
+		// in the hand so the chips stay accounted for.
+		for i := 0; i < 9; i++ {
+			if h.InHand[i] && t.Seats[i] != nil && t.Seats[i].Player != "" {
+				seats = append(seats, i)
+			}
+		}
+	}
+	if len(seats) == 0 {
+		return fmt.Errorf("no seat to return %d dead money to", h.DeadMoney)
+	}
+	for i, amt := range splitEvenly(h.DeadMoney, len(seats)) {
+		next, err := addUint64Checked(h.SlashCredit[seats[i]], amt, "slash credit")
+		if err != nil {
+			return err
+		}
+		h.SlashCredit[seats[i]] = next
+	}
+	h.DeadMoney = 0
+	return nil
+}
+
+// payoutSlashCredits moves per-seat slash credit into stacks. It must run
+// right before a hand is cleared.
+func payoutSlashCredits(t *types.Table) error {
+	h := t.Hand
+	if h == nil {
+		return nil
+	}
+	for i, credit := range h.SlashCredit {
+		if credit == 0 {
+			continue
+		}
+		if t.Seats[i] == nil || t.Seats[i].Player == "" {
+			// Leave pays out credit, so an empty seat cannot hold any.
+			return fmt.Errorf("seat %d has slash credit but no player", i)
+		}
+		next, err := addUint64Checked(t.Seats[i].Stack, credit, "seat stack slash credit")
+		if err != nil {
+			return err
+		}
+		t.Seats[i].Stack = next
+		h.SlashCredit[i] = 0
+	}
+	return nil
+}
+
+func joinAmounts(amounts []uint64) string {
+	parts := make([]string, 0, len(amounts))
+	for _, a := range amounts {
+		parts = append(parts, fmt.Sprintf("%d", a))
+	}
+	return strings.Join(parts, ",")
+}
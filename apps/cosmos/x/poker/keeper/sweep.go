@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// maxTablesSweptPerBlock bounds the EndBlock work of SweepIdleTables; any
+// remaining idle tables are picked up in later blocks.
+const maxTablesSweptPerBlock = 50
+
+func tableIsIdle(t *types.Table) bool {
+	if t.Hand != nil {
+		return false
+	}
+	for _, s := range t.Seats {
+		if s != nil && s.Player != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// trackIdle keeps t.IdleSinceHeight and the idle-table index in step with the
+// table's seats. It runs on every SetTable, so every path that seats or
+// clears a player is covered.
+func (k Keeper) trackIdle(ctx context.Context, t *types.Table) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if !tableIsIdle(t) {
+		if t.IdleSinceHeight == 0 {
+			return nil
+		}
+		if err := store.Delete(types.IdleTableKey(t.IdleSinceHeight, t.Id)); err != nil {
+			return err
+		}
+		t.IdleSinceHeight = 0
+		return nil
+	}
+	if t.IdleSinceHeight == 0 {
+		// Height 0 is the "occupied" sentinel, so an idle table is stamped no
+		// lower than 1.
+		t.IdleSinceHeight = max(sdk.UnwrapSDKContext(ctx).BlockHeight(), 1)
+	}
+	// Set unconditionally so InitGenesis rebuilds the index from table state.
+	return store.Set(types.IdleTableKey(t.IdleSinceHeight, t.Id), []byte{})
+}
+
+// SweepIdleTables deletes tables that have had no seated players for at
+// least params.IdleTableBlocks and refunds their creation deposits. It runs
+// in EndBlock and is a no-op while sweeping is disabled.
+func (k Keeper) SweepIdleTables(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.IdleTableBlocks == 0 {
+		return nil
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cutoff := sdkCtx.BlockHeight() - int64(params.IdleTableBlocks)
+	if cutoff < 1 {
+		return nil
+	}
+
+	// Collect first; the index must not be written while it is iterated.
+	store := k.storeService.OpenKVStore(ctx)
+	it, err := store.Iterator(types.IdleTableKeyPrefix, types.IdleTableKey(cutoff+1, 0))
+	if err != nil {
+		return err
+	}
+	var ids []uint64
+	for ; it.Valid() && len(ids) < maxTablesSweptPerBlock; it.Next() {
+		key := it.Key()
+		if len(key) != 1+8+8 {
+			continue
+		}
+		ids = append(ids, binary.BigEndian.Uint64(key[9:]))
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.closeIdleTable(cacheCtx, id); err != nil {
+			// A table that cannot be closed (e.g. the refund is rejected)
+			// must not halt the chain or starve the rest of the queue; it
+			// is stamped idle from this block, so it is retried only after
+			// another idle_table_blocks.
+			k.Logger(ctx).Error("failed to sweep idle table", "tableId", id, "err", err)
+			if t, gerr := k.GetTable(ctx, id); gerr == nil && t != nil {
+				if err := store.Delete(types.IdleTableKey(t.IdleSinceHeight, id)); err != nil {
+					return err
+				}
+				t.IdleSinceHeight = 0
+				if err := k.SetTable(ctx, t); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
+// closeIdleTable refunds the creation deposit of an idle table and removes
+// every store entry it owns.
+func (k Keeper) closeIdleTable(ctx context.Context, tableID uint64) error {
+	t, err := k.GetTable(ctx, tableID)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		return fmt.Errorf("table %d not found", tableID)
+	}
+	if !tableIsIdle(t) {
+		return fmt.Errorf("table %d is not idle", tableID)
+	}
+
+	if t.CreationDeposit != 0 {
+		creator, err := sdk.AccAddressFromBech32(t.Creator)
+		if err != nil {
+			return err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(t.CreationDeposit)))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, coins); err != nil {
+			return err
+		}
+		if err := k.subTableEscrow(ctx, tableID, t.CreationDeposit); err != nil {
+			return err
+		}
+	}
+	if escrow, err := k.GetTableEscrow(ctx, tableID); err != nil {
+		return err
+	} else if escrow != 0 {
+		return fmt.Errorf("table %d still escrows %d after refund", tableID, escrow)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	for _, key := range [][]byte{
+		types.TableKey(tableID),
+		types.IdleTableKey(t.IdleSinceHeight, tableID),
+		lastHandEndedHeightKey(tableID),
+	} {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+	if k.hooks != nil {
+		if err := k.hooks.AfterTableClosed(ctx, tableID); err != nil {
+			return err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTableClosed,
+		sdk.NewAttribute("tableId", fmt.Sprintf("%d", tableID)),
+		sdk.NewAttribute("creator", t.Creator),
+		sdk.NewAttribute("refund", fmt.Sprintf("%d", t.CreationDeposit)),
+		sdk.NewAttribute("idleSinceHeight", fmt.Sprintf("%d", t.IdleSinceHeight)),
+	))
+	return nil
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+func TestCreateTable_CollectsDepositAndSweepRefundsIt(t *testing.T) {
+	sdkCtx, k, ms, bk := newKeeper(t, time.Unix(100, 0).UTC())
+	sdkCtx = sdkCtx.WithBlockHeight(10)
+	creator := addr(0x71)
+	player := addr(0x72)
+	pkBytes := ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(1)).Bytes()
+
+	params := types.DefaultParams()
+	params.TableCreationDeposit = 500
+	params.IdleTableBlocks = 100
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	hooks := &recordingHooks{}
+	k.SetHooks(hooks)
+
+	_, err := ms.CreateTable(sdkCtx, &types.MsgCreateTable{
+		Creator:    creator.String(),
+		SmallBlind: 1, BigBlind: 2,
+		MinBuyIn: 100, MaxBuyIn: 1000,
+	})
+	require.NoError(t, err)
+	require.Len(t, bk.calls, 1)
+	require.Equal(t, "a2m", bk.calls[0].kind)
+	require.Equal(t, creator, bk.calls[0].fromAcc)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(500))), bk.calls[0].coins)
+
+	tbl, err := k.GetTable(sdkCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), tbl.CreationDeposit)
+	require.Equal(t, int64(10), tbl.IdleSinceHeight)
+	require.Equal(t, uint64(500), requireEscrowMatchesTable(t, sdkCtx, k, 1))
+
+	// A player sitting and leaving restarts the idle window.
+	_, err = ms.Sit(sdkCtx, &types.MsgSit{Player: player.String(), TableId: 1, BuyIn: 100, PkPlayer: pkBytes})
+	require.NoError(t, err)
+	tbl, err = k.GetTable(sdkCtx, 1)
+	require.NoError(t, err)
+	require.Zero(t, tbl.IdleSinceHeight)
+
+	sdkCtx = sdkCtx.WithBlockHeight(50)
+	_, err = ms.Leave(sdkCtx, &types.MsgLeave{Player: player.String(), TableId: 1})
+	require.NoError(t, err)
+
+	sdkCtx = sdkCtx.WithBlockHeight(149)
+	require.NoError(t, k.SweepIdleTables(sdkCtx))
+	tbl, err = k.GetTable(sdkCtx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, tbl, "idle for 99 blocks only")
+
+	n := len(bk.calls)
+	sdkCtx = sdkCtx.WithBlockHeight(150).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.SweepIdleTables(sdkCtx))
+	tbl, err = k.GetTable(sdkCtx, 1)
+	require.NoError(t, err)
+	require.Nil(t, tbl)
+	escrow, err := k.GetTableEscrow(sdkCtx, 1)
+	require.NoError(t, err)
+	require.Zero(t, escrow)
+
+	require.Len(t, bk.calls, n+1)
+	require.Equal(t, "m2a", bk.calls[n].kind)
+	require.Equal(t, creator, bk.calls[n].toAcc)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(500))), bk.calls[n].coins)
+
+	var closed bool
+	for _, ev := range sdkCtx.EventManager().Events() {
+		closed = closed || ev.Type == types.EventTypeTableClosed
+	}
+	require.True(t, closed)
+	require.Equal(t, []uint64{1}, hooks.tablesClosed)
+
+	// Sweeping again is a no-op.
+	require.NoError(t, k.SweepIdleTables(sdkCtx))
+	require.Len(t, bk.calls, n+1)
+}
+
+func TestSweepIdleTables_DisabledAndOccupied(t *testing.T) {
+	sdkCtx, k, ms, bk := newKeeper(t, time.Unix(100, 0).UTC())
+	sdkCtx = sdkCtx.WithBlockHeight(1)
+	pkBytes := ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(1)).Bytes()
+
+	params := types.DefaultParams()
+	params.IdleTableBlocks = 0
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	for i := 0; i < 2; i++ {
+		_, err := ms.CreateTable(sdkCtx, &types.MsgCreateTable{
+			Creator:    addr(0x73).String(),
+			SmallBlind: 1, BigBlind: 2,
+			MinBuyIn: 100, MaxBuyIn: 1000,
+		})
+		require.NoError(t, err)
+	}
+	_, err := ms.Sit(sdkCtx, &types.MsgSit{Player: addr(0x74).String(), TableId: 2, BuyIn: 100, PkPlayer: pkBytes})
+	require.NoError(t, err)
+
+	sdkCtx = sdkCtx.WithBlockHeight(1_000_000)
+	n := len(bk.calls)
+	require.NoError(t, k.SweepIdleTables(sdkCtx))
+	require.Len(t, bk.calls, n, "sweeping disabled")
+
+	params.IdleTableBlocks = 100
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	require.NoError(t, k.SweepIdleTables(sdkCtx))
+
+	tbl, err := k.GetTable(sdkCtx, 1)
+	require.NoError(t, err)
+	require.Nil(t, tbl, "empty table swept")
+	tbl, err = k.GetTable(sdkCtx, 2)
+	require.NoError(t, err)
+	require.NotNil(t, tbl, "occupied table kept")
+}
+
+func TestSweepIdleTables_FailedCloseRetriedAfterAnotherIdleWindow(t *testing.T) {
+	sdkCtx, k, _, bk := newKeeper(t, time.Unix(100, 0).UTC())
+	sdkCtx = sdkCtx.WithBlockHeight(10)
+
+	params := types.DefaultParams()
+	params.IdleTableBlocks = 100
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	// The deposit refund cannot be paid to an invalid creator, so closing
+	// the table fails.
+	require.NoError(t, k.SetTable(sdkCtx, &types.Table{Id: 1, Creator: "invalid", CreationDeposit: 500, Seats: make([]*types.Seat, 9)}))
+	tbl, err := k.GetTable(sdkCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), tbl.IdleSinceHeight)
+
+	sdkCtx = sdkCtx.WithBlockHeight(110)
+	require.NoError(t, k.SweepIdleTables(sdkCtx))
+	tbl, err = k.GetTable(sdkCtx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, tbl)
+	require.Equal(t, int64(110), tbl.IdleSinceHeight, "restamped idle from the failed sweep")
+
+	// A later write keeps the new stamp rather than re-queueing the table
+	// at its old height.
+	sdkCtx = sdkCtx.WithBlockHeight(111)
+	require.NoError(t, k.SetTable(sdkCtx, tbl))
+	tbl, err = k.GetTable(sdkCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(110), tbl.IdleSinceHeight)
+	require.NoError(t, k.SweepIdleTables(sdkCtx))
+	tbl, err = k.GetTable(sdkCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(110), tbl.IdleSinceHeight, "not retried within the new idle window")
+
+	sdkCtx = sdkCtx.WithBlockHeight(210)
+	require.NoError(t, k.SweepIdleTables(sdkCtx))
+	tbl, err = k.GetTable(sdkCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(210), tbl.IdleSinceHeight, "retried once idle for another window")
+	require.Empty(t, bk.calls)
+}
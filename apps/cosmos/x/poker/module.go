@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 
@@ -18,8 +19,12 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"onchainpoker/apps/cosmos/x/poker/keeper"
+	"onchainpoker/apps/cosmos/x/poker/simulation"
 	"onchainpoker/apps/cosmos/x/poker/types"
 )
 
@@ -28,14 +33,23 @@ import (
 // v2 introduces TableParams.password_salt and replaces plaintext password
 // fields on MsgCreateTable/MsgSit with client-computed commitments+proofs.
 // See keeper.Migrator.Migrate1to2.
-const ConsensusVersion = 2
+//
+// v3 adds per-table escrow records and the idle-table index, backfilled by
+// keeper.Migrator.Migrate2to3.
+//
+// v4 moves the gameplay fee rate limits into Params, written by
+// keeper.Migrator.Migrate3to4.
+const ConsensusVersion = 4
 
 var (
 	_ module.AppModuleBasic = AppModule{}
 	_ module.HasServices    = AppModule{}
 	_ module.HasGenesis     = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
+
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by x/poker.
@@ -76,10 +90,14 @@ type AppModule struct {
 
 	cdc    codec.Codec
 	keeper keeper.Keeper
+
+	// Only used by the module simulation.
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 }
 
-func NewAppModule(cdc codec.Codec, k keeper.Keeper) AppModule {
-	return AppModule{AppModuleBasic: AppModuleBasic{}, cdc: cdc, keeper: k}
+func NewAppModule(cdc codec.Codec, k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) AppModule {
+	return AppModule{AppModuleBasic: AppModuleBasic{}, cdc: cdc, keeper: k, accountKeeper: ak, bankKeeper: bk}
 }
 
 func (AppModule) IsOnePerModuleType() {}
@@ -93,6 +111,12 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Errorf("x/poker: failed to register Migrate1to2: %w", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Errorf("x/poker: failed to register Migrate2to3: %w", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Errorf("x/poker: failed to register Migrate3to4: %w", err))
+	}
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
@@ -109,6 +133,17 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 		panic(fmt.Errorf("x/poker invalid genesis: %w", err))
 	}
 
+	if err := am.keeper.SetParams(gctx, gs.Params); err != nil {
+		panic(err)
+	}
+	if err := am.keeper.SetPauseState(gctx, gs.PauseState); err != nil {
+		panic(err)
+	}
+	for _, b := range gs.ChipBalances {
+		if err := am.keeper.SetChipBalance(gctx, sdk.MustAccAddressFromBech32(b.Address), b.Amount); err != nil {
+			panic(err)
+		}
+	}
 	if err := am.keeper.SetNextTableID(gctx, gs.NextTableId); err != nil {
 		panic(err)
 	}
@@ -117,12 +152,43 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 		if err := am.keeper.SetTable(gctx, &tt); err != nil {
 			panic(err)
 		}
+		// Recorded escrow is derived from the table state at genesis.
+		escrow, err := tt.EscrowTotal()
+		if err != nil {
+			panic(err)
+		}
+		if err := am.keeper.SetTableEscrow(gctx, tt.Id, escrow); err != nil {
+			panic(err)
+		}
+	}
+
+	escrow, err := gs.EscrowTotal()
+	if err != nil {
+		panic(err)
+	}
+	if err := am.keeper.ValidateEscrowBalance(gctx, escrow); err != nil {
+		panic(fmt.Errorf("x/poker invalid genesis: %w", err))
 	}
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	gctx := sdk.WrapSDKContext(ctx)
 
+	params, err := am.keeper.GetParams(gctx)
+	if err != nil {
+		panic(err)
+	}
+	pauseState, err := am.keeper.GetPauseState(gctx)
+	if err != nil {
+		panic(err)
+	}
+	var chipBalances []types.ChipBalance
+	if err := am.keeper.IterateChipBalances(gctx, func(addr sdk.AccAddress, amount uint64) bool {
+		chipBalances = append(chipBalances, types.ChipBalance{Address: addr.String(), Amount: amount})
+		return false
+	}); err != nil {
+		panic(err)
+	}
 	next, err := am.keeper.GetNextTableID(gctx)
 	if err != nil {
 		panic(err)
@@ -143,20 +209,47 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	}
 
 	gs := types.GenesisState{
-		NextTableId: next,
-		Tables:      tables,
+		NextTableId:  next,
+		Tables:       tables,
+		Params:       params,
+		PauseState:   pauseState,
+		ChipBalances: chipBalances,
 	}
 	return cdc.MustMarshalJSON(&gs)
 }
 
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// EndBlock deletes tables that have sat empty for params.IdleTableBlocks and
+// refunds their creation deposits; see x/poker/keeper/sweep.go.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.SweepIdleTables(ctx)
+}
+
+// ---- Simulation ----
+
+// GenerateGenesisState creates a randomized GenState of the poker module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for poker module's types.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the poker module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper)
+}
+
 // ---- App Wiring Setup ----
 
 func init() {
 	appmodule.Register(
 		&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetPokerHooks),
 	)
 }
 
@@ -167,7 +260,8 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	StoreService corestore.KVStoreService
 
-	BankKeeper types.BankKeeper
+	BankKeeper    types.BankKeeper
+	AccountKeeper types.AccountKeeper
 }
 
 type ModuleOutputs struct {
@@ -178,7 +272,30 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Cdc, in.StoreService, in.BankKeeper)
-	m := NewAppModule(in.Cdc, k)
+	// Default to x/gov as the params authority unless the app config overrides it.
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	k := keeper.NewKeeper(in.Cdc, in.StoreService, in.BankKeeper, authority.String())
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper)
 	return ModuleOutputs{PokerKeeper: k, Module: m}
 }
+
+// InvokeSetPokerHooks installs the poker hooks other modules provide, in
+// module name order so every node runs them in the same order.
+func InvokeSetPokerHooks(k keeper.Keeper, pokerHooks map[string]types.PokerHooksWrapper) error {
+	names := make([]string, 0, len(pokerHooks))
+	for name := range pokerHooks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var hooks []types.PokerHooks
+	for _, name := range names {
+		hooks = append(hooks, pokerHooks[name])
+	}
+	k.SetHooks(hooks...)
+	return nil
+}
@@ -93,6 +93,17 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 		panic(fmt.Errorf("x/poker invalid genesis: %w", err))
 	}
 
+	if err := am.keeper.SetParams(gctx, gs.Params); err != nil {
+		panic(err)
+	}
+	if err := am.keeper.SetPauseState(gctx, gs.PauseState); err != nil {
+		panic(err)
+	}
+	for _, b := range gs.ChipBalances {
+		if err := am.keeper.SetChipBalance(gctx, sdk.MustAccAddressFromBech32(b.Address), b.Amount); err != nil {
+			panic(err)
+		}
+	}
 	if err := am.keeper.SetNextTableID(gctx, gs.NextTableId); err != nil {
 		panic(err)
 	}
@@ -101,12 +112,43 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 		if err := am.keeper.SetTable(gctx, &tt); err != nil {
 			panic(err)
 		}
+		// Recorded escrow is derived from the table state at genesis.
+		escrow, err := tt.EscrowTotal()
+		if err != nil {
+			panic(err)
+		}
+		if err := am.keeper.SetTableEscrow(gctx, tt.Id, escrow); err != nil {
+			panic(err)
+		}
+	}
+
+	escrow, err := gs.EscrowTotal()
+	if err != nil {
+		panic(err)
+	}
+	if err := am.keeper.ValidateEscrowBalance(gctx, escrow); err != nil {
+		panic(fmt.Errorf("x/poker invalid genesis: %w", err))
 	}
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	gctx := sdk.WrapSDKContext(ctx)
 
+	params, err := am.keeper.GetParams(gctx)
+	if err != nil {
+		panic(err)
+	}
+	pauseState, err := am.keeper.GetPauseState(gctx)
+	if err != nil {
+		panic(err)
+	}
+	var chipBalances []types.ChipBalance
+	if err := am.keeper.IterateChipBalances(gctx, func(addr sdk.AccAddress, amount uint64) bool {
+		chipBalances = append(chipBalances, types.ChipBalance{Address: addr.String(), Amount: amount})
+		return false
+	}); err != nil {
+		panic(err)
+	}
 	next, err := am.keeper.GetNextTableID(gctx)
 	if err != nil {
 		panic(err)
@@ -127,8 +169,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	}
 
 	gs := types.GenesisState{
-		NextTableId: next,
-		Tables:      tables,
+		NextTableId:  next,
+		Tables:       tables,
+		Params:       params,
+		PauseState:   pauseState,
+		ChipBalances: chipBalances,
 	}
 	return cdc.MustMarshalJSON(&gs)
 }
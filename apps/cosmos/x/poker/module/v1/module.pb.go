@@ -24,9 +24,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Module is the appmodule config object for x/poker.
-//
-// It is intentionally empty for now; add fields as module-level params/wiring needs grow.
 type Module struct {
+	// authority may update module params. Defaults to the x/gov module account.
+	Authority            string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -56,6 +56,13 @@ func (m *Module) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Module proto.InternalMessageInfo
 
+func (m *Module) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Module)(nil), "onchainpoker.poker.module.v1.Module")
 }
@@ -65,17 +72,18 @@ func init() {
 }
 
 var fileDescriptor_44c8bcb219a77a31 = []byte{
-	// 154 bytes of a gzipped FileDescriptorProto
+	// 173 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0xcf, 0x4b, 0xce,
 	0x48, 0xcc, 0xcc, 0x2b, 0xc8, 0xcf, 0x4e, 0x2d, 0xd2, 0x87, 0x90, 0xb9, 0xf9, 0x29, 0xa5, 0x39,
 	0xa9, 0xfa, 0x65, 0x86, 0x50, 0x96, 0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x90, 0x0c, 0xb2, 0x52,
 	0x3d, 0x08, 0x09, 0x55, 0x50, 0x66, 0x28, 0xa5, 0x90, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0xac, 0x9f,
 	0x58, 0x50, 0xa0, 0x5f, 0x66, 0x98, 0x98, 0x53, 0x90, 0x91, 0x88, 0xaa, 0x5f, 0x4a, 0x24, 0x3d,
-	0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a, 0x46, 0x5c, 0x6c, 0xbe, 0x60, 0x55,
-	0x56, 0x1a, 0xbb, 0x0e, 0x4c, 0xbb, 0xc5, 0xa8, 0xc4, 0xa5, 0x80, 0xe2, 0xa4, 0xc4, 0x82, 0x82,
-	0x62, 0x7d, 0xa8, 0xd9, 0x15, 0x10, 0x07, 0x3a, 0x29, 0xac, 0x78, 0x24, 0xc7, 0x18, 0x25, 0x55,
-	0x81, 0xee, 0x5e, 0x6b, 0x08, 0xab, 0xcc, 0x30, 0x89, 0x0d, 0x6c, 0xb8, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0x21, 0xe7, 0xff, 0x38, 0xdf, 0x00, 0x00, 0x00,
+	0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a, 0x01, 0x5c, 0x6c, 0xbe, 0x60, 0x55,
+	0x42, 0x32, 0x5c, 0x9c, 0x89, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x12, 0x8c, 0x0a,
+	0x8c, 0x1a, 0x9c, 0x41, 0x08, 0x01, 0x2b, 0x8d, 0x5d, 0x07, 0xa6, 0xdd, 0x62, 0x54, 0xe2, 0x52,
+	0x40, 0x71, 0x70, 0x62, 0x41, 0x41, 0xb1, 0x3e, 0xd4, 0xe6, 0x0a, 0x88, 0xf3, 0x9d, 0x14, 0x56,
+	0x3c, 0x92, 0x63, 0x8c, 0x92, 0xaa, 0x40, 0xf7, 0x8d, 0x35, 0x84, 0x55, 0x66, 0x98, 0xc4, 0x06,
+	0xb6, 0xda, 0x18, 0x30, 0x00, 0x78, 0xe1, 0x66, 0xac, 0xfd, 0x00, 0x00, 0x00,
 }
 
 func (this *Module) Equal(that interface{}) bool {
@@ -97,6 +105,9 @@ func (this *Module) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Authority != that1.Authority {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -0,0 +1,57 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"onchainpoker/apps/cosmos/x/poker/keeper"
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// value to the corresponding poker type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.NextTableIDKey):
+			return fmt.Sprintf("NextTableID A: %d\nNextTableID B: %d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.TableKeyPrefix):
+			var tableA, tableB types.Table
+			cdc.MustUnmarshal(kvA.Value, &tableA)
+			cdc.MustUnmarshal(kvB.Value, &tableB)
+			return fmt.Sprintf("%v\n%v", tableA, tableB)
+
+		case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], types.PauseStateKey):
+			var pauseA, pauseB types.PauseState
+			cdc.MustUnmarshal(kvA.Value, &pauseA)
+			cdc.MustUnmarshal(kvB.Value, &pauseB)
+			return fmt.Sprintf("%v\n%v", pauseA, pauseB)
+
+		case bytes.Equal(kvA.Key[:1], types.TableEscrowKeyPrefix):
+			return fmt.Sprintf("TableEscrow A: %d\nTableEscrow B: %d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.IdleTableKeyPrefix):
+			return fmt.Sprintf("IdleTable A: %X\nIdleTable B: %X", kvA.Key[1:], kvB.Key[1:])
+
+		case bytes.Equal(kvA.Key[:1], types.ChipBalanceKeyPrefix):
+			return fmt.Sprintf("ChipBalance A: %d\nChipBalance B: %d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], keeper.LastHandEndedHeightKeyPrefix):
+			return fmt.Sprintf("LastHandEndedHeight A: %d\nLastHandEndedHeight B: %d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid poker key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// Simulation parameter constants.
+const (
+	GenesisTableCount = "genesis_table_count"
+)
+
+// RandomTableParams returns table parameters that pass MsgCreateTable
+// validation and whose buy-ins stay far below simulated account balances.
+func RandomTableParams(r *rand.Rand) types.TableParams {
+	smallBlind := uint64(1 + r.Intn(500))
+	bigBlind := smallBlind * 2
+	var bond uint64
+	if r.Intn(2) == 0 {
+		bond = bigBlind * uint64(1+r.Intn(10))
+	}
+	return types.TableParams{
+		MaxPlayers:        9,
+		SmallBlind:        smallBlind,
+		BigBlind:          bigBlind,
+		MinBuyIn:          bigBlind * 20,
+		MaxBuyIn:          bigBlind * uint64(100+r.Intn(101)),
+		ActionTimeoutSecs: uint64(15 + r.Intn(106)),
+		DealerTimeoutSecs: uint64(60 + r.Intn(241)),
+		PlayerBond:        bond,
+		RakeBps:           0,
+		SlashDestination:  types.SlashDestination(r.Intn(len(types.SlashDestination_name))),
+		AbortRefundPolicy: types.AbortRefundPolicy(r.Intn(len(types.AbortRefundPolicy_name))),
+	}
+}
+
+// emptySeats returns the normalized representation of nine empty seats.
+func emptySeats() []*types.Seat {
+	seats := make([]*types.Seat, 9)
+	for i := range seats {
+		seats[i] = &types.Seat{Hole: []uint32{255, 255}}
+	}
+	return seats
+}
+
+// RandomizedGenState generates a random GenesisState for x/poker.
+//
+// Genesis tables are always empty: seated players would need matching escrow
+// in the poker module account, which the bank genesis does not provide.
+func RandomizedGenState(simState *module.SimulationState) {
+	var tableCount int
+	simState.AppParams.GetOrGenerate(GenesisTableCount, &tableCount, simState.Rand, func(r *rand.Rand) {
+		tableCount = r.Intn(4)
+	})
+
+	tables := make([]types.Table, 0, tableCount)
+	for i := 0; i < tableCount; i++ {
+		creator := simState.Accounts[simState.Rand.Intn(len(simState.Accounts))]
+		tables = append(tables, types.Table{
+			Id:         uint64(i + 1),
+			Creator:    creator.Address.String(),
+			Label:      "sim",
+			Params:     RandomTableParams(simState.Rand),
+			Seats:      emptySeats(),
+			NextHandId: 1,
+			ButtonSeat: -1,
+		})
+	}
+
+	gs := types.GenesisState{
+		NextTableId: uint64(tableCount + 1),
+		Tables:      tables,
+		Params:      types.DefaultParams(),
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&gs)
+}
@@ -0,0 +1,500 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+	"onchainpoker/apps/cosmos/x/poker/keeper"
+	"onchainpoker/apps/cosmos/x/poker/types"
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightMsgCreateTable  = "op_weight_msg_create_table"
+	OpWeightMsgSit          = "op_weight_msg_sit"
+	OpWeightMsgStartHand    = "op_weight_msg_start_hand"
+	OpWeightMsgAct          = "op_weight_msg_act"
+	OpWeightMsgTick         = "op_weight_msg_tick"
+	OpWeightMsgLeave        = "op_weight_msg_leave"
+	OpWeightMsgRebuy        = "op_weight_msg_rebuy"
+	OpWeightMsgSetAutoTopUp = "op_weight_msg_set_auto_top_up"
+	OpWeightMsgDeposit      = "op_weight_msg_deposit"
+	OpWeightMsgWithdraw     = "op_weight_msg_withdraw"
+
+	DefaultWeightMsgCreateTable  = 5
+	DefaultWeightMsgSit          = 40
+	DefaultWeightMsgStartHand    = 30
+	DefaultWeightMsgAct          = 100
+	DefaultWeightMsgTick         = 20
+	DefaultWeightMsgLeave        = 10
+	DefaultWeightMsgRebuy        = 10
+	DefaultWeightMsgSetAutoTopUp = 5
+	DefaultWeightMsgDeposit      = 10
+	DefaultWeightMsgWithdraw     = 5
+)
+
+// playerKeyDomain derives a deterministic per-account player secret so that a
+// simulated account always sits with the same pk_player.
+const playerKeyDomain = "ocp/sim/poker/player-sk"
+
+// PlayerPubKey returns the pk_player a simulated account sits with.
+func PlayerPubKey(addr sdk.AccAddress) []byte {
+	sk, err := ocpcrypto.HashToScalar(playerKeyDomain, addr.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return ocpcrypto.MulBase(sk).Bytes()
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateTable  int
+		weightMsgSit          int
+		weightMsgStartHand    int
+		weightMsgAct          int
+		weightMsgTick         int
+		weightMsgLeave        int
+		weightMsgRebuy        int
+		weightMsgSetAutoTopUp int
+		weightMsgDeposit      int
+		weightMsgWithdraw     int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgCreateTable, &weightMsgCreateTable, nil, func(_ *rand.Rand) {
+		weightMsgCreateTable = DefaultWeightMsgCreateTable
+	})
+	appParams.GetOrGenerate(OpWeightMsgSit, &weightMsgSit, nil, func(_ *rand.Rand) {
+		weightMsgSit = DefaultWeightMsgSit
+	})
+	appParams.GetOrGenerate(OpWeightMsgStartHand, &weightMsgStartHand, nil, func(_ *rand.Rand) {
+		weightMsgStartHand = DefaultWeightMsgStartHand
+	})
+	appParams.GetOrGenerate(OpWeightMsgAct, &weightMsgAct, nil, func(_ *rand.Rand) {
+		weightMsgAct = DefaultWeightMsgAct
+	})
+	appParams.GetOrGenerate(OpWeightMsgTick, &weightMsgTick, nil, func(_ *rand.Rand) {
+		weightMsgTick = DefaultWeightMsgTick
+	})
+	appParams.GetOrGenerate(OpWeightMsgLeave, &weightMsgLeave, nil, func(_ *rand.Rand) {
+		weightMsgLeave = DefaultWeightMsgLeave
+	})
+	appParams.GetOrGenerate(OpWeightMsgRebuy, &weightMsgRebuy, nil, func(_ *rand.Rand) {
+		weightMsgRebuy = DefaultWeightMsgRebuy
+	})
+	appParams.GetOrGenerate(OpWeightMsgSetAutoTopUp, &weightMsgSetAutoTopUp, nil, func(_ *rand.Rand) {
+		weightMsgSetAutoTopUp = DefaultWeightMsgSetAutoTopUp
+	})
+	appParams.GetOrGenerate(OpWeightMsgDeposit, &weightMsgDeposit, nil, func(_ *rand.Rand) {
+		weightMsgDeposit = DefaultWeightMsgDeposit
+	})
+	appParams.GetOrGenerate(OpWeightMsgWithdraw, &weightMsgWithdraw, nil, func(_ *rand.Rand) {
+		weightMsgWithdraw = DefaultWeightMsgWithdraw
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreateTable, SimulateMsgCreateTable(txGen, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgSit, SimulateMsgSit(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgStartHand, SimulateMsgStartHand(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgAct, SimulateMsgAct(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgTick, SimulateMsgTick(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgLeave, SimulateMsgLeave(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgRebuy, SimulateMsgRebuy(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSetAutoTopUp, SimulateMsgSetAutoTopUp(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgDeposit, SimulateMsgDeposit(txGen, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgWithdraw, SimulateMsgWithdraw(txGen, ak, bk, k)),
+	}
+}
+
+// SimulateMsgCreateTable generates a MsgCreateTable with random blinds and buy-ins.
+func SimulateMsgCreateTable(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		creator, _ := simtypes.RandomAcc(r, accs)
+		p := RandomTableParams(r)
+		msg := &types.MsgCreateTable{
+			Creator:           creator.Address.String(),
+			SmallBlind:        p.SmallBlind,
+			BigBlind:          p.BigBlind,
+			MinBuyIn:          p.MinBuyIn,
+			MaxBuyIn:          p.MaxBuyIn,
+			ActionTimeoutSecs: p.ActionTimeoutSecs,
+			DealerTimeoutSecs: p.DealerTimeoutSecs,
+			PlayerBond:        p.PlayerBond,
+			RakeBps:           p.RakeBps,
+			MaxPlayers:        p.MaxPlayers,
+			Label:             "sim",
+			SlashDestination:  p.SlashDestination,
+			AbortRefundPolicy: p.AbortRefundPolicy,
+		}
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, creator, msg, nil)
+	}
+}
+
+// SimulateMsgSit seats a random unseated account at a random table with a free seat.
+func SimulateMsgSit(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSit{})
+		t, err := randomTable(r, ctx, k, func(t *types.Table) bool {
+			return len(t.Params.PasswordHash) == 0 && seatedCount(t) < 9
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read tables"), nil, err
+		}
+		if t == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no table with a free seat"), nil, nil
+		}
+
+		player, _ := simtypes.RandomAcc(r, accs)
+		buyIn := t.Params.MinBuyIn
+		if t.Params.MaxBuyIn > t.Params.MinBuyIn {
+			buyIn += uint64(r.Int63n(int64(t.Params.MaxBuyIn - t.Params.MinBuyIn + 1)))
+		}
+		msg := &types.MsgSit{
+			Player:   player.Address.String(),
+			TableId:  t.Id,
+			BuyIn:    buyIn,
+			PkPlayer: PlayerPubKey(player.Address),
+		}
+		spent := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(buyIn+t.Params.PlayerBond)))
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, player, msg, spent)
+	}
+}
+
+// SimulateMsgStartHand starts a hand at a random idle table on behalf of a seated player.
+func SimulateMsgStartHand(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgStartHand{})
+		t, err := randomTable(r, ctx, k, func(t *types.Table) bool {
+			return t.Hand == nil && seatedCount(t) >= 2
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read tables"), nil, err
+		}
+		if t == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no idle table with two players"), nil, nil
+		}
+		caller, ok := randomSeatedAccount(r, t, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no simulated account seated"), nil, nil
+		}
+		msg := &types.MsgStartHand{Caller: caller.Address.String(), TableId: t.Id}
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, caller, msg, nil)
+	}
+}
+
+// SimulateMsgAct plays a random legal-looking action for the player to act.
+// Bets and raises are sized between the minimum raise and all-in.
+func SimulateMsgAct(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgAct{})
+		t, err := randomTable(r, ctx, k, func(t *types.Table) bool {
+			return t.Hand != nil && t.Hand.Phase == types.HandPhase_HAND_PHASE_BETTING &&
+				t.Hand.ActionOn >= 0 && t.Hand.ActionOn < 9
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read tables"), nil, err
+		}
+		if t == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no hand in betting"), nil, nil
+		}
+
+		h := t.Hand
+		seat := t.Seats[h.ActionOn]
+		player, ok := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(seat.Player))
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "actor is not a simulated account"), nil, nil
+		}
+
+		commit := h.StreetCommit[h.ActionOn]
+		action, amount := "check", uint64(0)
+		if h.BetTo > commit {
+			action = "call"
+		}
+		switch n := r.Intn(10); {
+		case n == 0:
+			action = "fold"
+		case n >= 7:
+			action = "raise"
+			if h.BetTo == 0 {
+				action = "bet"
+			}
+			minTo := h.BetTo + h.MinRaiseSize
+			if minTo == 0 {
+				minTo = t.Params.BigBlind
+			}
+			maxTo := commit + seat.Stack
+			amount = maxTo
+			if minTo < maxTo {
+				amount = minTo + uint64(r.Int63n(int64(maxTo-minTo+1)))
+			}
+		}
+
+		msg := &types.MsgAct{
+			Player:  player.Address.String(),
+			TableId: t.Id,
+			Action:  action,
+			Amount:  amount,
+		}
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, player, msg, nil)
+	}
+}
+
+// SimulateMsgTick advances a random betting hand whose action deadline has passed.
+func SimulateMsgTick(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgTick{})
+		nowUnix := ctx.BlockTime().Unix()
+		t, err := randomTable(r, ctx, k, func(t *types.Table) bool {
+			return t.Hand != nil && t.Hand.Phase == types.HandPhase_HAND_PHASE_BETTING &&
+				nowUnix >= t.Hand.ActionDeadline
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read tables"), nil, err
+		}
+		if t == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no timed-out hand"), nil, nil
+		}
+		caller, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgTick{Caller: caller.Address.String(), TableId: t.Id}
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, caller, msg, nil)
+	}
+}
+
+// SimulateMsgLeave removes a random seated player from a random table.
+func SimulateMsgLeave(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgLeave{})
+		t, err := randomTable(r, ctx, k, func(t *types.Table) bool { return seatedCount(t) > 0 })
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read tables"), nil, err
+		}
+		if t == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no seated players"), nil, nil
+		}
+		player, ok := randomSeatedAccount(r, t, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no simulated account seated"), nil, nil
+		}
+		msg := &types.MsgLeave{Player: player.Address.String(), TableId: t.Id}
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, player, msg, nil)
+	}
+}
+
+// SimulateMsgRebuy tops up a random seated player by a random amount up to the table max.
+func SimulateMsgRebuy(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRebuy{})
+		t, err := randomTable(r, ctx, k, func(t *types.Table) bool { return t.Hand == nil && seatedCount(t) > 0 })
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read tables"), nil, err
+		}
+		if t == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no idle table with players"), nil, nil
+		}
+		player, ok := randomSeatedAccount(r, t, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no simulated account seated"), nil, nil
+		}
+		var stack uint64
+		for _, s := range t.Seats {
+			if s != nil && s.Player == player.Address.String() {
+				stack = s.Stack
+			}
+		}
+		if stack >= t.Params.MaxBuyIn {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "stack already at max buy-in"), nil, nil
+		}
+		amount := 1 + uint64(r.Int63n(int64(t.Params.MaxBuyIn-stack)))
+		msg := &types.MsgRebuy{Player: player.Address.String(), TableId: t.Id, Amount: amount}
+		spent := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(amount)))
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, player, msg, spent)
+	}
+}
+
+// SimulateMsgSetAutoTopUp sets (or, one time in four, clears) a random seated
+// player's auto top-up with a random target and allowance.
+func SimulateMsgSetAutoTopUp(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetAutoTopUp{})
+		t, err := randomTable(r, ctx, k, func(t *types.Table) bool { return seatedCount(t) > 0 })
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read tables"), nil, err
+		}
+		if t == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no table with players"), nil, nil
+		}
+		player, ok := randomSeatedAccount(r, t, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no simulated account seated"), nil, nil
+		}
+		msg := &types.MsgSetAutoTopUp{Player: player.Address.String(), TableId: t.Id}
+		if r.Intn(4) != 0 {
+			msg.TargetStack = 1 + uint64(r.Int63n(int64(t.Params.MaxBuyIn)))
+			msg.Allowance = 1 + uint64(r.Int63n(int64(t.Params.MaxBuyIn)))
+		}
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, player, msg, nil)
+	}
+}
+
+// SimulateMsgDeposit moves a random amount (up to 1000 chips) from a random
+// account into its cashier balance.
+func SimulateMsgDeposit(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		player, _ := simtypes.RandomAcc(r, accs)
+		amount := 1 + uint64(r.Int63n(1000))
+		msg := &types.MsgDeposit{Player: player.Address.String(), Amount: amount}
+		spent := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(amount)))
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, player, msg, spent)
+	}
+}
+
+// SimulateMsgWithdraw withdraws part or all of a random simulated account's
+// cashier balance.
+func SimulateMsgWithdraw(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgWithdraw{})
+		player, _ := simtypes.RandomAcc(r, accs)
+		bal, err := k.GetChipBalance(ctx, player.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read chip balance"), nil, err
+		}
+		if bal == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no chip balance"), nil, nil
+		}
+		msg := &types.MsgWithdraw{Player: player.Address.String(), Amount: 1 + uint64(r.Int63n(int64(bal)))}
+		return deliverIfValid(r, app, ctx, txGen, ak, bk, player, msg, nil)
+	}
+}
+
+// deliverIfValid dry-runs msg through the app's msg router on a cached
+// context and, only if that succeeds, signs and delivers it as a real
+// transaction. Randomly generated gameplay msgs are frequently invalid for
+// the current table state (wrong turn, cooldown, insufficient stack, ...);
+// those are reported as no-ops instead of failing the simulation.
+func deliverIfValid(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(msg)
+	handler := app.MsgServiceRouter().Handler(msg)
+	if handler == nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no msg handler"), nil, nil
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	if _, err := handler(cacheCtx, msg); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Msg:             msg,
+		CoinsSpentInMsg: spent,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	})
+}
+
+// randomTable returns a uniformly random table matching filter, or nil.
+func randomTable(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, filter func(t *types.Table) bool) (*types.Table, error) {
+	var (
+		matches []*types.Table
+		iterErr error
+	)
+	if err := k.IterateTables(ctx, func(id uint64) bool {
+		t, err := k.GetTable(ctx, id)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		if t != nil && filter(t) {
+			matches = append(matches, t)
+		}
+		return false
+	}); err != nil {
+		return nil, err
+	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	return matches[r.Intn(len(matches))], nil
+}
+
+func seatedCount(t *types.Table) int {
+	n := 0
+	for _, s := range t.Seats {
+		if s != nil && s.Player != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// randomSeatedAccount returns a random simulated account seated at t.
+func randomSeatedAccount(r *rand.Rand, t *types.Table, accs []simtypes.Account) (simtypes.Account, bool) {
+	var seated []simtypes.Account
+	for _, s := range t.Seats {
+		if s == nil || s.Player == "" {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(s.Player)
+		if err != nil {
+			continue
+		}
+		if acc, ok := simtypes.FindAccount(accs, addr); ok {
+			seated = append(seated, acc)
+		}
+	}
+	if len(seated) == 0 {
+		return simtypes.Account{}, false
+	}
+	return seated[r.Intn(len(seated))], true
+}
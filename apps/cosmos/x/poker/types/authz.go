@@ -0,0 +1,104 @@
+package types
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &SessionAuthorization{}
+
+// NewSessionAuthorization returns a SessionAuthorization for msg (one of
+// MsgAct, MsgTick, MsgLeave) on the given tables.
+func NewSessionAuthorization(msg sdk.Msg, tableIDs []uint64, spendLimit uint64) *SessionAuthorization {
+	return &SessionAuthorization{
+		Msg:        sdk.MsgTypeURL(msg),
+		TableIds:   tableIDs,
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL implements authz.Authorization.
+func (a SessionAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements authz.Authorization.
+func (a SessionAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var tableID uint64
+	switch m := msg.(type) {
+	case *MsgAct:
+		tableID = m.TableId
+	case *MsgTick:
+		tableID = m.TableId
+	case *MsgLeave:
+		tableID = m.TableId
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("session authorization does not cover %T", msg)
+	}
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if !slices.Contains(a.TableIds, tableID) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("table %d not in session authorization", tableID)
+	}
+
+	act, ok := msg.(*MsgAct)
+	if !ok || a.SpendLimit == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+	switch act.Action {
+	case "fold", "check":
+		return authz.AcceptResponse{Accept: true}, nil
+	case "call", "bet", "raise":
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("unknown action %q", act.Action)
+	}
+	// A capped session must bound every chip-moving action; for calls the
+	// amount is the highest bet the caller agrees to match. The budget is
+	// charged the full street total, not the chips the action adds.
+	if act.Amount == 0 {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("%s amount required under a spend-capped session", act.Action)
+	}
+	if act.Amount > a.SpendLimit {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("amount %d exceeds session spend limit %d", act.Amount, a.SpendLimit)
+	}
+	remaining := a.SpendLimit - act.Amount
+	if remaining == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	return authz.AcceptResponse{Accept: true, Updated: &SessionAuthorization{
+		Msg:        a.Msg,
+		TableIds:   a.TableIds,
+		SpendLimit: remaining,
+	}}, nil
+}
+
+// ValidateBasic implements authz.Authorization.
+func (a SessionAuthorization) ValidateBasic() error {
+	switch a.Msg {
+	case sdk.MsgTypeURL(&MsgAct{}):
+	case sdk.MsgTypeURL(&MsgTick{}), sdk.MsgTypeURL(&MsgLeave{}):
+		if a.SpendLimit != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "spend_limit only applies to %s", sdk.MsgTypeURL(&MsgAct{}))
+		}
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "session authorization cannot cover %q", a.Msg)
+	}
+	if len(a.TableIds) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "table_ids must not be empty")
+	}
+	seen := make(map[uint64]bool, len(a.TableIds))
+	for _, id := range a.TableIds {
+		if seen[id] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate table id %d", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionAuthorization_ValidateBasic(t *testing.T) {
+	require.NoError(t, NewSessionAuthorization(&MsgAct{}, []uint64{1, 2}, 100).ValidateBasic())
+	require.NoError(t, NewSessionAuthorization(&MsgLeave{}, []uint64{1}, 0).ValidateBasic())
+
+	require.Error(t, NewSessionAuthorization(&MsgSit{}, []uint64{1}, 0).ValidateBasic())
+	require.Error(t, NewSessionAuthorization(&MsgAct{}, nil, 0).ValidateBasic())
+	require.Error(t, NewSessionAuthorization(&MsgAct{}, []uint64{1, 1}, 0).ValidateBasic())
+	require.Error(t, NewSessionAuthorization(&MsgTick{}, []uint64{1}, 5).ValidateBasic())
+}
+
+func TestSessionAuthorization_AcceptTables(t *testing.T) {
+	ctx := context.Background()
+	auth := NewSessionAuthorization(&MsgLeave{}, []uint64{3}, 0)
+
+	resp, err := auth.Accept(ctx, &MsgLeave{TableId: 3})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.Nil(t, resp.Updated)
+
+	_, err = auth.Accept(ctx, &MsgLeave{TableId: 4})
+	require.ErrorContains(t, err, "table 4 not in session authorization")
+
+	_, err = auth.Accept(ctx, &MsgTick{TableId: 3})
+	require.ErrorContains(t, err, "type mismatch")
+
+	_, err = auth.Accept(ctx, &MsgSit{TableId: 3})
+	require.Error(t, err)
+}
+
+func TestSessionAuthorization_SpendLimit(t *testing.T) {
+	ctx := context.Background()
+	auth := NewSessionAuthorization(&MsgAct{}, []uint64{1}, 50)
+
+	resp, err := auth.Accept(ctx, &MsgAct{TableId: 1, Action: "fold"})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.Nil(t, resp.Updated)
+
+	_, err = auth.Accept(ctx, &MsgAct{TableId: 1, Action: "call"})
+	require.ErrorContains(t, err, "amount required")
+
+	resp, err = auth.Accept(ctx, &MsgAct{TableId: 1, Action: "raise", Amount: 30})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.Equal(t, uint64(20), resp.Updated.(*SessionAuthorization).SpendLimit)
+
+	_, err = resp.Updated.Accept(ctx, &MsgAct{TableId: 1, Action: "bet", Amount: 21})
+	require.ErrorContains(t, err, "exceeds session spend limit")
+
+	resp, err = resp.Updated.Accept(ctx, &MsgAct{TableId: 1, Action: "call", Amount: 20})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+}
+
+func TestSessionAuthorization_SpendLimitChargesStreetTotals(t *testing.T) {
+	ctx := context.Background()
+	auth := NewSessionAuthorization(&MsgAct{}, []uint64{1}, 500)
+
+	// Raise to 100, then call a re-raise to 300: 300 chips committed, 400
+	// charged.
+	resp, err := auth.Accept(ctx, &MsgAct{TableId: 1, Action: "raise", Amount: 100})
+	require.NoError(t, err)
+	resp, err = resp.Updated.Accept(ctx, &MsgAct{TableId: 1, Action: "call", Amount: 300})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), resp.Updated.(*SessionAuthorization).SpendLimit)
+}
@@ -6,6 +6,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // RegisterLegacyAminoCodec registers the x/poker messages for legacy Amino JSON.
@@ -21,6 +22,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgTick{}, "ocp/poker/Tick")
 	legacy.RegisterAminoMsg(cdc, &MsgLeave{}, "ocp/poker/Leave")
 	legacy.RegisterAminoMsg(cdc, &MsgRebuy{}, "ocp/poker/Rebuy")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoTopUp{}, "ocp/poker/SetAutoTopUp")
+	legacy.RegisterAminoMsg(cdc, &MsgDeposit{}, "ocp/poker/Deposit")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdraw{}, "ocp/poker/Withdraw")
+	legacy.RegisterAminoMsg(cdc, &MsgChangeTable{}, "ocp/poker/ChangeTable")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "ocp/poker/UpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPauseState{}, "ocp/poker/SetPauseState")
+	cdc.RegisterConcrete(&SessionAuthorization{}, "ocp/poker/SessionAuthorization", nil)
 }
 
 // RegisterInterfaces registers the x/poker module's interface implementations.
@@ -33,6 +41,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgTick{},
 		&MsgLeave{},
 		&MsgRebuy{},
+		&MsgSetAutoTopUp{},
+		&MsgDeposit{},
+		&MsgWithdraw{},
+		&MsgChangeTable{},
+		&MsgUpdateParams{},
+		&MsgSetPauseState{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&SessionAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
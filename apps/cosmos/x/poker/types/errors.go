@@ -13,11 +13,15 @@ var (
 	// Queried frequently by clients; map to HTTP 404 instead of a generic 500.
 	ErrTableNotFound = errorsmod.RegisterWithGRPCCode(ModuleName, 2, grpccodes.NotFound, "table not found")
 
-	ErrSeatOccupied    = errorsmod.Register(ModuleName, 3, "seat occupied")
-	ErrNotSeated       = errorsmod.Register(ModuleName, 4, "not seated at table")
-	ErrHandInProgress  = errorsmod.Register(ModuleName, 5, "hand already in progress")
-	ErrNoActiveHand    = errorsmod.Register(ModuleName, 6, "no active hand")
-	ErrNotYourTurn     = errorsmod.Register(ModuleName, 7, "not your turn")
-	ErrInvalidAction   = errorsmod.Register(ModuleName, 8, "invalid action")
-	ErrInvalidTableCfg = errorsmod.Register(ModuleName, 9, "invalid table configuration")
+	ErrSeatOccupied      = errorsmod.Register(ModuleName, 3, "seat occupied")
+	ErrNotSeated         = errorsmod.Register(ModuleName, 4, "not seated at table")
+	ErrHandInProgress    = errorsmod.Register(ModuleName, 5, "hand already in progress")
+	ErrNoActiveHand      = errorsmod.Register(ModuleName, 6, "no active hand")
+	ErrNotYourTurn       = errorsmod.Register(ModuleName, 7, "not your turn")
+	ErrInvalidAction     = errorsmod.Register(ModuleName, 8, "invalid action")
+	ErrInvalidTableCfg   = errorsmod.Register(ModuleName, 9, "invalid table configuration")
+	ErrRateLimited       = errorsmod.Register(ModuleName, 10, "gameplay rate limit exceeded")
+	ErrUnauthorized      = errorsmod.RegisterWithGRPCCode(ModuleName, 11, grpccodes.PermissionDenied, "unauthorized")
+	ErrPaused            = errorsmod.RegisterWithGRPCCode(ModuleName, 12, grpccodes.Unavailable, "paused by circuit breaker")
+	ErrInsufficientChips = errorsmod.Register(ModuleName, 13, "insufficient chip balance")
 )
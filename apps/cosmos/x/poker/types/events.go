@@ -3,12 +3,14 @@ package types
 // Event types are kept close to the legacy v0 names to ease client migration.
 const (
 	EventTypeTableCreated   = "TableCreated"
+	EventTypeTableClosed    = "TableClosed"
 	EventTypePlayerSat      = "PlayerSat"
 	EventTypeHandStarted    = "HandStarted"
 	EventTypeActionApplied  = "ActionApplied"
 	EventTypeTimeoutApplied = "TimeoutApplied"
 	EventTypePlayerSlashed  = "PlayerSlashed"
 	EventTypePlayerLeft     = "PlayerLeft"
+	EventTypePlayerLeavePending = "PlayerLeavePending"
 	EventTypePlayerEjected  = "PlayerEjected"
 
 	EventTypeStreetRevealed   = "StreetRevealed"
@@ -19,5 +21,13 @@ const (
 	EventTypeHandAborted      = "HandAborted"
 	EventTypeHoleCardRevealed = "HoleCardRevealed"
 	EventTypePlayerRebuyed    = "PlayerRebuyed"
+	EventTypeAutoTopUpSet      = "AutoTopUpSet"
+	EventTypeAutoTopUpDisabled = "AutoTopUpDisabled"
+	EventTypeChipsDeposited    = "ChipsDeposited"
+	EventTypeChipsWithdrawn    = "ChipsWithdrawn"
+	EventTypeTableChanged      = "TableChanged"
+
+	EventTypeParamsUpdated     = "PokerParamsUpdated"
+	EventTypePauseStateUpdated = "PauseStateUpdated"
 )
 
@@ -11,5 +11,16 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule string, recipientModule string, amt sdk.Coins) error
+
+	// GetBalance is used by InitGenesis to check escrow against the module account.
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+
+	// SpendableCoins sizes auto top-ups and simulation fees.
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// AccountKeeper defines the expected account keeper; only used by the module simulation.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 }
 
@@ -1,11 +1,16 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		NextTableId: 1,
 		Tables:      nil,
+		Params:      DefaultParams(),
 	}
 }
 
@@ -16,6 +21,12 @@ func ValidateGenesis(gs *GenesisState) error {
 	if gs.NextTableId == 0 {
 		return fmt.Errorf("next_table_id must be > 0")
 	}
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("params: %w", err)
+	}
+	if err := gs.PauseState.Validate(); err != nil {
+		return fmt.Errorf("pause_state: %w", err)
+	}
 	seen := make(map[uint64]bool, len(gs.Tables))
 	for _, t := range gs.Tables {
 		if t.Id == 0 {
@@ -28,7 +39,49 @@ func ValidateGenesis(gs *GenesisState) error {
 		if t.Id >= gs.NextTableId {
 			return fmt.Errorf("table id %d >= next_table_id %d", t.Id, gs.NextTableId)
 		}
+		if err := t.Validate(); err != nil {
+			return fmt.Errorf("table %d: %w", t.Id, err)
+		}
+	}
+	seenAddr := make(map[string]bool, len(gs.ChipBalances))
+	for _, b := range gs.ChipBalances {
+		if _, err := sdk.AccAddressFromBech32(b.Address); err != nil {
+			return fmt.Errorf("chip balance: invalid address %q: %w", b.Address, err)
+		}
+		if seenAddr[b.Address] {
+			return fmt.Errorf("duplicate chip balance for %s", b.Address)
+		}
+		seenAddr[b.Address] = true
+		if b.Amount == 0 {
+			return fmt.Errorf("chip balance for %s must be > 0", b.Address)
+		}
+	}
+	if _, err := gs.EscrowTotal(); err != nil {
+		return err
 	}
 	return nil
 }
 
+// EscrowTotal sums Table.EscrowTotal over all genesis tables plus the
+// cashier chip balances. The poker module account balance must equal this
+// amount (checked in InitGenesis, where the bank state is available).
+func (gs GenesisState) EscrowTotal() (uint64, error) {
+	var total uint64
+	for _, t := range gs.Tables {
+		e, err := t.EscrowTotal()
+		if err != nil {
+			return 0, err
+		}
+		if total > ^uint64(0)-e {
+			return 0, fmt.Errorf("genesis escrow overflows uint64")
+		}
+		total += e
+	}
+	for _, b := range gs.ChipBalances {
+		if total > ^uint64(0)-b.Amount {
+			return 0, fmt.Errorf("genesis escrow overflows uint64")
+		}
+		total += b.Amount
+	}
+	return total, nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import "context"
+
+// PokerHooks lets other modules follow a table's hands without polling the
+// poker store. x/dealer uses them to drop the dealer state of a hand once
+// poker is done with it.
+type PokerHooks interface {
+	// AfterHandEnded is called once a hand has left its table, whether it was
+	// settled, folded out or aborted.
+	AfterHandEnded(ctx context.Context, tableID, handID uint64) error
+	// AfterTableClosed is called once an idle table has been deleted.
+	AfterTableClosed(ctx context.Context, tableID uint64) error
+}
+
+var _ PokerHooks = MultiPokerHooks{}
+
+// MultiPokerHooks runs each hook in order and stops at the first error.
+type MultiPokerHooks []PokerHooks
+
+func NewMultiPokerHooks(hooks ...PokerHooks) MultiPokerHooks {
+	return hooks
+}
+
+func (h MultiPokerHooks) AfterHandEnded(ctx context.Context, tableID, handID uint64) error {
+	for _, hook := range h {
+		if err := hook.AfterHandEnded(ctx, tableID, handID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPokerHooks) AfterTableClosed(ctx context.Context, tableID uint64) error {
+	for _, hook := range h {
+		if err := hook.AfterTableClosed(ctx, tableID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PokerHooksWrapper lets modules provide PokerHooks through depinject.
+type PokerHooksWrapper struct{ PokerHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (PokerHooksWrapper) IsOnePerModuleType() {}
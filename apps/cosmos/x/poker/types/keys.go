@@ -1,6 +1,11 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName defines the module name.
@@ -16,6 +21,27 @@ var (
 
 	// TableKeyPrefix stores Table by id: TableKeyPrefix || u64be(tableID).
 	TableKeyPrefix = []byte{0x02}
+
+	// 0x03 is used by keeper.LastHandEndedHeightKeyPrefix.
+
+	// ParamsKey stores the module Params.
+	ParamsKey = []byte{0x04}
+
+	// PauseStateKey stores the circuit breaker PauseState.
+	PauseStateKey = []byte{0x05}
+
+	// ChipBalanceKeyPrefix stores cashier balances as big-endian u64:
+	// ChipBalanceKeyPrefix || len-prefixed address.
+	ChipBalanceKeyPrefix = []byte{0x06}
+
+	// TableEscrowKeyPrefix stores each table's recorded escrow as
+	// big-endian u64: TableEscrowKeyPrefix || u64be(tableID).
+	TableEscrowKeyPrefix = []byte{0x07}
+
+	// IdleTableKeyPrefix indexes tables with no seated players by the height
+	// they became idle: IdleTableKeyPrefix || u64be(idleSince) || u64be(tableID).
+	// Values are empty.
+	IdleTableKeyPrefix = []byte{0x08}
 )
 
 func TableKey(tableID uint64) []byte {
@@ -25,3 +51,21 @@ func TableKey(tableID uint64) []byte {
 	return bz
 }
 
+func TableEscrowKey(tableID uint64) []byte {
+	bz := make([]byte, 1+8)
+	bz[0] = TableEscrowKeyPrefix[0]
+	binary.BigEndian.PutUint64(bz[1:], tableID)
+	return bz
+}
+
+func IdleTableKey(idleSince int64, tableID uint64) []byte {
+	bz := make([]byte, 1+8+8)
+	bz[0] = IdleTableKeyPrefix[0]
+	binary.BigEndian.PutUint64(bz[1:], uint64(idleSince))
+	binary.BigEndian.PutUint64(bz[9:], tableID)
+	return bz
+}
+
+func ChipBalanceKey(addr sdk.AccAddress) []byte {
+	return append([]byte{ChipBalanceKeyPrefix[0]}, address.MustLengthPrefix(addr)...)
+}
@@ -0,0 +1,61 @@
+package types
+
+import "fmt"
+
+const (
+	// Sanity bounds so a bad governance proposal cannot make tables
+	// effectively unbounded.
+	maxParamsLabelLen     uint32 = 1024
+	maxParamsTimeoutSecs  uint64 = 24 * 60 * 60 // 24h
+	maxParamsCooldownBlks uint64 = 10_000
+
+	// A shorter idle window could sweep a freshly created table before
+	// anyone has had a chance to sit.
+	minParamsIdleTableBlks uint64 = 100
+)
+
+func DefaultParams() Params {
+	return Params{
+		MaxTableLabelLen:     64,
+		MaxActionTimeoutSecs: 600,               // 10 minutes
+		MaxDealerTimeoutSecs: 1800,              // 30 minutes
+		MaxBuyInUchips:       1_000_000_000_000, // 1M CHIPS
+
+		// Short enough to be invisible during normal table cadence (~30s at
+		// 6s blocks).
+		InterHandCooldownBlocks: 5,
+
+		TableCreationDeposit: 10_000_000, // 10 CHIPS
+		IdleTableBlocks:      14_400,     // ~1 day at 6s blocks
+
+		// A few actions per player per block, and enough per table for a
+		// full ring of players plus the dealer committee.
+		GameplayTxsPerSigner: 8,
+		GameplayTxsPerTable:  64,
+	}
+}
+
+func (p Params) Validate() error {
+	if p.MaxTableLabelLen == 0 || p.MaxTableLabelLen > maxParamsLabelLen {
+		return fmt.Errorf("max_table_label_len must be in [1, %d]", maxParamsLabelLen)
+	}
+	if p.MaxActionTimeoutSecs == 0 || p.MaxActionTimeoutSecs > maxParamsTimeoutSecs {
+		return fmt.Errorf("max_action_timeout_secs must be in [1, %d]", maxParamsTimeoutSecs)
+	}
+	if p.MaxDealerTimeoutSecs == 0 || p.MaxDealerTimeoutSecs > maxParamsTimeoutSecs {
+		return fmt.Errorf("max_dealer_timeout_secs must be in [1, %d]", maxParamsTimeoutSecs)
+	}
+	if p.MaxBuyInUchips == 0 {
+		return fmt.Errorf("max_buy_in_uchips must be > 0")
+	}
+	if p.InterHandCooldownBlocks > maxParamsCooldownBlks {
+		return fmt.Errorf("inter_hand_cooldown_blocks too large: %d > %d", p.InterHandCooldownBlocks, maxParamsCooldownBlks)
+	}
+	if p.TableCreationDeposit > p.MaxBuyInUchips {
+		return fmt.Errorf("table_creation_deposit exceeds max_buy_in_uchips: %d > %d", p.TableCreationDeposit, p.MaxBuyInUchips)
+	}
+	if p.IdleTableBlocks != 0 && p.IdleTableBlocks < minParamsIdleTableBlks {
+		return fmt.Errorf("idle_table_blocks must be 0 or >= %d", minParamsIdleTableBlks)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Message type URL prefixes the circuit breaker may pause.
+const (
+	pokerMsgTypeURLPrefix  = "/onchainpoker.poker.v1.Msg"
+	dealerMsgTypeURLPrefix = "/onchainpoker.dealer.v1.Msg"
+
+	// DealerMsgInitHandTypeURL is spelled out here because x/poker cannot
+	// import the x/dealer types.
+	DealerMsgInitHandTypeURL = "/onchainpoker.dealer.v1.MsgInitHand"
+)
+
+// unpausableMsgTypeURLs can never be paused: they are how players leave and
+// get refunded, and how the authority lifts a pause. A MsgTick or dealer
+// MsgTimeout whose step waits on a paused message aborts the hand with
+// refunds and penalizes nobody.
+var unpausableMsgTypeURLs = map[string]bool{
+	"/onchainpoker.poker.v1.MsgLeave":         true,
+	"/onchainpoker.poker.v1.MsgWithdraw":      true,
+	"/onchainpoker.poker.v1.MsgTick":          true,
+	"/onchainpoker.poker.v1.MsgUpdateParams":  true,
+	"/onchainpoker.poker.v1.MsgSetPauseState": true,
+	"/onchainpoker.dealer.v1.MsgTimeout":      true,
+	"/onchainpoker.dealer.v1.MsgUpdateParams": true,
+}
+
+// tablePausableMsgTypeURLs are rejected for paused tables. Everything else
+// (acting, ticking, dealer steps for a running hand) may continue so the
+// current hand can finish.
+var tablePausableMsgTypeURLs = map[string]bool{
+	"/onchainpoker.poker.v1.MsgSit":          true,
+	"/onchainpoker.poker.v1.MsgChangeTable":  true,
+	"/onchainpoker.poker.v1.MsgRebuy":        true,
+	"/onchainpoker.poker.v1.MsgSetAutoTopUp": true,
+	"/onchainpoker.poker.v1.MsgStartHand":    true,
+	DealerMsgInitHandTypeURL:                 true,
+}
+
+// IsMsgTypePaused reports whether msgTypeURL is paused.
+func (p PauseState) IsMsgTypePaused(msgTypeURL string) bool {
+	for _, u := range p.MsgTypeUrls {
+		if u == msgTypeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTablePaused reports whether tableID is paused.
+func (p PauseState) IsTablePaused(tableID uint64) bool {
+	for _, id := range p.TableIds {
+		if id == tableID {
+			return true
+		}
+	}
+	return false
+}
+
+// Check returns ErrPaused if a message of type msgTypeURL touching tableID
+// (0 for none) is blocked by the circuit breaker.
+func (p PauseState) Check(msgTypeURL string, tableID uint64) error {
+	if unpausableMsgTypeURLs[msgTypeURL] {
+		return nil
+	}
+	if p.IsMsgTypePaused(msgTypeURL) {
+		return ErrPaused.Wrapf("%s is paused", msgTypeURL)
+	}
+	if tableID != 0 && tablePausableMsgTypeURLs[msgTypeURL] && p.IsTablePaused(tableID) {
+		return ErrPaused.Wrapf("table %d is paused", tableID)
+	}
+	return nil
+}
+
+func (p PauseState) Validate() error {
+	seen := make(map[string]bool, len(p.MsgTypeUrls))
+	for _, u := range p.MsgTypeUrls {
+		if !strings.HasPrefix(u, pokerMsgTypeURLPrefix) && !strings.HasPrefix(u, dealerMsgTypeURLPrefix) {
+			return fmt.Errorf("msg type %q is not a poker or dealer message", u)
+		}
+		if unpausableMsgTypeURLs[u] {
+			return fmt.Errorf("msg type %q cannot be paused", u)
+		}
+		if seen[u] {
+			return fmt.Errorf("duplicate msg type %q", u)
+		}
+		seen[u] = true
+	}
+	seenTables := make(map[uint64]bool, len(p.TableIds))
+	for _, id := range p.TableIds {
+		if id == 0 {
+			return fmt.Errorf("table id must be > 0")
+		}
+		if seenTables[id] {
+			return fmt.Errorf("duplicate table id %d", id)
+		}
+		seenTables[id] = true
+	}
+	return nil
+}
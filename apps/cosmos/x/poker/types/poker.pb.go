@@ -22,6 +22,81 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SlashDestination selects who receives a player's slashed bond.
+type SlashDestination int32
+
+const (
+	// Legacy tables: same as SLASH_DESTINATION_FEE_COLLECTOR.
+	SlashDestination_SLASH_DESTINATION_UNSPECIFIED SlashDestination = 0
+	// Sent to the fee collector (i.e. to validators).
+	SlashDestination_SLASH_DESTINATION_FEE_COLLECTOR SlashDestination = 1
+	// Split evenly among the other players still live in the hand; credited
+	// when the hand ends.
+	SlashDestination_SLASH_DESTINATION_PLAYERS SlashDestination = 2
+	// Added to the current pot as dead money and awarded with the main pot.
+	SlashDestination_SLASH_DESTINATION_POT SlashDestination = 3
+)
+
+var SlashDestination_name = map[int32]string{
+	0: "SLASH_DESTINATION_UNSPECIFIED",
+	1: "SLASH_DESTINATION_FEE_COLLECTOR",
+	2: "SLASH_DESTINATION_PLAYERS",
+	3: "SLASH_DESTINATION_POT",
+}
+
+var SlashDestination_value = map[string]int32{
+	"SLASH_DESTINATION_UNSPECIFIED":   0,
+	"SLASH_DESTINATION_FEE_COLLECTOR": 1,
+	"SLASH_DESTINATION_PLAYERS":       2,
+	"SLASH_DESTINATION_POT":           3,
+}
+
+func (x SlashDestination) String() string {
+	return proto.EnumName(SlashDestination_name, int32(x))
+}
+
+func (SlashDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b562bf5e5877c9a5, []int{0}
+}
+
+// AbortRefundPolicy selects how an aborted hand's committed chips are resolved.
+type AbortRefundPolicy int32
+
+const (
+	// Legacy tables: same as ABORT_REFUND_POLICY_REFUND_ALL.
+	AbortRefundPolicy_ABORT_REFUND_POLICY_UNSPECIFIED AbortRefundPolicy = 0
+	// Option A: every seat gets its total commit back.
+	AbortRefundPolicy_ABORT_REFUND_POLICY_REFUND_ALL AbortRefundPolicy = 1
+	// Commits above the posted blinds are refunded; the blinds are split
+	// evenly among the players still live in the hand.
+	AbortRefundPolicy_ABORT_REFUND_POLICY_REFUND_ALL_BUT_BLINDS AbortRefundPolicy = 2
+	// Option B: committed pots stay locked to the live players and are split
+	// evenly among each pot's eligible seats. Only uncalled excess is refunded.
+	AbortRefundPolicy_ABORT_REFUND_POLICY_LOCK_POTS AbortRefundPolicy = 3
+)
+
+var AbortRefundPolicy_name = map[int32]string{
+	0: "ABORT_REFUND_POLICY_UNSPECIFIED",
+	1: "ABORT_REFUND_POLICY_REFUND_ALL",
+	2: "ABORT_REFUND_POLICY_REFUND_ALL_BUT_BLINDS",
+	3: "ABORT_REFUND_POLICY_LOCK_POTS",
+}
+
+var AbortRefundPolicy_value = map[string]int32{
+	"ABORT_REFUND_POLICY_UNSPECIFIED":           0,
+	"ABORT_REFUND_POLICY_REFUND_ALL":            1,
+	"ABORT_REFUND_POLICY_REFUND_ALL_BUT_BLINDS": 2,
+	"ABORT_REFUND_POLICY_LOCK_POTS":             3,
+}
+
+func (x AbortRefundPolicy) String() string {
+	return proto.EnumName(AbortRefundPolicy_name, int32(x))
+}
+
+func (AbortRefundPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b562bf5e5877c9a5, []int{1}
+}
+
 type HandPhase int32
 
 const (
@@ -62,7 +137,7 @@ func (x HandPhase) String() string {
 }
 
 func (HandPhase) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b562bf5e5877c9a5, []int{0}
+	return fileDescriptor_b562bf5e5877c9a5, []int{2}
 }
 
 type Street int32
@@ -96,16 +171,19 @@ func (x Street) String() string {
 }
 
 func (Street) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b562bf5e5877c9a5, []int{1}
+	return fileDescriptor_b562bf5e5877c9a5, []int{3}
 }
 
 // GenesisState defines the x/poker module genesis state.
 type GenesisState struct {
-	NextTableId          uint64   `protobuf:"varint,1,opt,name=next_table_id,json=nextTableId,proto3" json:"next_table_id,omitempty"`
-	Tables               []Table  `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	NextTableId          uint64        `protobuf:"varint,1,opt,name=next_table_id,json=nextTableId,proto3" json:"next_table_id,omitempty"`
+	Tables               []Table       `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables"`
+	Params               Params        `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	PauseState           PauseState    `protobuf:"bytes,4,opt,name=pause_state,json=pauseState,proto3" json:"pause_state"`
+	ChipBalances         []ChipBalance `protobuf:"bytes,5,rep,name=chip_balances,json=chipBalances,proto3" json:"chip_balances"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -146,6 +224,245 @@ func (m *GenesisState) GetTables() []Table {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPauseState() PauseState {
+	if m != nil {
+		return m.PauseState
+	}
+	return PauseState{}
+}
+
+func (m *GenesisState) GetChipBalances() []ChipBalance {
+	if m != nil {
+		return m.ChipBalances
+	}
+	return nil
+}
+
+// ChipBalance is a player's cashier balance: chips held by the poker module
+// account outside any table. Sit, Rebuy and auto top-ups draw on it before the
+// player's bank balance, and MsgLeave can credit it instead of paying out.
+type ChipBalance struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount               uint64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChipBalance) Reset()         { *m = ChipBalance{} }
+func (m *ChipBalance) String() string { return proto.CompactTextString(m) }
+func (*ChipBalance) ProtoMessage()    {}
+func (*ChipBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b562bf5e5877c9a5, []int{1}
+}
+func (m *ChipBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChipBalance.Unmarshal(m, b)
+}
+func (m *ChipBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChipBalance.Marshal(b, m, deterministic)
+}
+func (m *ChipBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChipBalance.Merge(m, src)
+}
+func (m *ChipBalance) XXX_Size() int {
+	return xxx_messageInfo_ChipBalance.Size(m)
+}
+func (m *ChipBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChipBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChipBalance proto.InternalMessageInfo
+
+func (m *ChipBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ChipBalance) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// PauseState is the emergency circuit breaker for x/poker and x/dealer.
+//
+// Paused message types are rejected by the ante handler and the msg servers.
+// Paused tables accept no new seats, rebuys or hands; a hand already in
+// progress may finish. MsgLeave, MsgTick, dealer MsgTimeout and the authority
+// messages can never be paused, so players can always leave and be refunded.
+type PauseState struct {
+	// Fully-qualified Msg type URLs, e.g. "/onchainpoker.poker.v1.MsgStartHand".
+	MsgTypeUrls          []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	TableIds             []uint64 `protobuf:"varint,2,rep,packed,name=table_ids,json=tableIds,proto3" json:"table_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseState) Reset()         { *m = PauseState{} }
+func (m *PauseState) String() string { return proto.CompactTextString(m) }
+func (*PauseState) ProtoMessage()    {}
+func (*PauseState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b562bf5e5877c9a5, []int{2}
+}
+func (m *PauseState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseState.Unmarshal(m, b)
+}
+func (m *PauseState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseState.Marshal(b, m, deterministic)
+}
+func (m *PauseState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseState.Merge(m, src)
+}
+func (m *PauseState) XXX_Size() int {
+	return xxx_messageInfo_PauseState.Size(m)
+}
+func (m *PauseState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseState proto.InternalMessageInfo
+
+func (m *PauseState) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *PauseState) GetTableIds() []uint64 {
+	if m != nil {
+		return m.TableIds
+	}
+	return nil
+}
+
+// Params defines the x/poker module parameters. They bound what
+// MsgCreateTable and MsgStartHand accept; existing tables are not revalidated
+// when they change.
+type Params struct {
+	// Maximum table label length in bytes.
+	MaxTableLabelLen uint32 `protobuf:"varint,1,opt,name=max_table_label_len,json=maxTableLabelLen,proto3" json:"max_table_label_len,omitempty"`
+	// Upper bounds for TableParams.action_timeout_secs and dealer_timeout_secs.
+	MaxActionTimeoutSecs uint64 `protobuf:"varint,2,opt,name=max_action_timeout_secs,json=maxActionTimeoutSecs,proto3" json:"max_action_timeout_secs,omitempty"`
+	MaxDealerTimeoutSecs uint64 `protobuf:"varint,3,opt,name=max_dealer_timeout_secs,json=maxDealerTimeoutSecs,proto3" json:"max_dealer_timeout_secs,omitempty"`
+	// Upper bound (in uchips) for blinds, buy-ins and the player bond.
+	MaxBuyInUchips uint64 `protobuf:"varint,4,opt,name=max_buy_in_uchips,json=maxBuyInUchips,proto3" json:"max_buy_in_uchips,omitempty"`
+	// Blocks a table must wait after a hand ends before MsgStartHand is
+	// accepted. Defeats single-block griefing of StartHand.
+	InterHandCooldownBlocks uint64 `protobuf:"varint,5,opt,name=inter_hand_cooldown_blocks,json=interHandCooldownBlocks,proto3" json:"inter_hand_cooldown_blocks,omitempty"`
+	// Chips (uchips) escrowed from the creator by MsgCreateTable and refunded
+	// when the table is swept. 0 disables the deposit.
+	TableCreationDeposit uint64 `protobuf:"varint,6,opt,name=table_creation_deposit,json=tableCreationDeposit,proto3" json:"table_creation_deposit,omitempty"`
+	// Blocks a table may sit with no seated players before EndBlock deletes it
+	// and refunds its creation deposit. 0 disables sweeping.
+	IdleTableBlocks uint64 `protobuf:"varint,7,opt,name=idle_table_blocks,json=idleTableBlocks,proto3" json:"idle_table_blocks,omitempty"`
+	// Fee-exempt gameplay txs (see x/poker/ante) allowed per block for one
+	// signer and for one table. 0 disables a limit.
+	GameplayTxsPerSigner uint64   `protobuf:"varint,8,opt,name=gameplay_txs_per_signer,json=gameplayTxsPerSigner,proto3" json:"gameplay_txs_per_signer,omitempty"`
+	GameplayTxsPerTable  uint64   `protobuf:"varint,9,opt,name=gameplay_txs_per_table,json=gameplayTxsPerTable,proto3" json:"gameplay_txs_per_table,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b562bf5e5877c9a5, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Params.Unmarshal(m, b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return xxx_messageInfo_Params.Size(m)
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxTableLabelLen() uint32 {
+	if m != nil {
+		return m.MaxTableLabelLen
+	}
+	return 0
+}
+
+func (m *Params) GetMaxActionTimeoutSecs() uint64 {
+	if m != nil {
+		return m.MaxActionTimeoutSecs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDealerTimeoutSecs() uint64 {
+	if m != nil {
+		return m.MaxDealerTimeoutSecs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBuyInUchips() uint64 {
+	if m != nil {
+		return m.MaxBuyInUchips
+	}
+	return 0
+}
+
+func (m *Params) GetInterHandCooldownBlocks() uint64 {
+	if m != nil {
+		return m.InterHandCooldownBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetTableCreationDeposit() uint64 {
+	if m != nil {
+		return m.TableCreationDeposit
+	}
+	return 0
+}
+
+func (m *Params) GetIdleTableBlocks() uint64 {
+	if m != nil {
+		return m.IdleTableBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetGameplayTxsPerSigner() uint64 {
+	if m != nil {
+		return m.GameplayTxsPerSigner
+	}
+	return 0
+}
+
+func (m *Params) GetGameplayTxsPerTable() uint64 {
+	if m != nil {
+		return m.GameplayTxsPerTable
+	}
+	return 0
+}
+
 type TableParams struct {
 	MaxPlayers        uint32 `protobuf:"varint,1,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
 	SmallBlind        uint64 `protobuf:"varint,2,opt,name=small_blind,json=smallBlind,proto3" json:"small_blind,omitempty"`
@@ -162,17 +479,21 @@ type TableParams struct {
 	PasswordHash []byte `protobuf:"bytes,10,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
 	// 32 random bytes for tables created after the v2 password rollout; empty
 	// for legacy tables. Public — clients read it to compute password_proof.
-	PasswordSalt         []byte   `protobuf:"bytes,11,opt,name=password_salt,json=passwordSalt,proto3" json:"password_salt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PasswordSalt []byte `protobuf:"bytes,11,opt,name=password_salt,json=passwordSalt,proto3" json:"password_salt,omitempty"`
+	// Where bond slashed from a timed-out player goes (SPEC 7.1).
+	SlashDestination SlashDestination `protobuf:"varint,12,opt,name=slash_destination,json=slashDestination,proto3,enum=onchainpoker.poker.v1.SlashDestination" json:"slash_destination,omitempty"`
+	// How committed chips are resolved when a hand aborts (SPEC 8.2).
+	AbortRefundPolicy    AbortRefundPolicy `protobuf:"varint,13,opt,name=abort_refund_policy,json=abortRefundPolicy,proto3,enum=onchainpoker.poker.v1.AbortRefundPolicy" json:"abort_refund_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *TableParams) Reset()         { *m = TableParams{} }
 func (m *TableParams) String() string { return proto.CompactTextString(m) }
 func (*TableParams) ProtoMessage()    {}
 func (*TableParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b562bf5e5877c9a5, []int{1}
+	return fileDescriptor_b562bf5e5877c9a5, []int{4}
 }
 func (m *TableParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TableParams.Unmarshal(m, b)
@@ -269,13 +590,35 @@ func (m *TableParams) GetPasswordSalt() []byte {
 	return nil
 }
 
+func (m *TableParams) GetSlashDestination() SlashDestination {
+	if m != nil {
+		return m.SlashDestination
+	}
+	return SlashDestination_SLASH_DESTINATION_UNSPECIFIED
+}
+
+func (m *TableParams) GetAbortRefundPolicy() AbortRefundPolicy {
+	if m != nil {
+		return m.AbortRefundPolicy
+	}
+	return AbortRefundPolicy_ABORT_REFUND_POLICY_UNSPECIFIED
+}
+
 type Seat struct {
 	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
 	Pk     []byte `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
 	Stack  uint64 `protobuf:"varint,3,opt,name=stack,proto3" json:"stack,omitempty"`
 	Bond   uint64 `protobuf:"varint,4,opt,name=bond,proto3" json:"bond,omitempty"`
 	// Public hole cards (set during showdown reveal). Unknown cards are stored as 0.
-	Hole                 []uint32 `protobuf:"varint,5,rep,packed,name=hole,proto3" json:"hole,omitempty"`
+	Hole []uint32 `protobuf:"varint,5,rep,packed,name=hole,proto3" json:"hole,omitempty"`
+	// Set by MsgLeave while the player is still live in a hand. The seat is
+	// cashed out when the hand ends and is never dealt into another hand.
+	LeavePending bool `protobuf:"varint,6,opt,name=leave_pending,json=leavePending,proto3" json:"leave_pending,omitempty"`
+	// Optional standing order to refill the stack between hands (nil = off).
+	AutoTopUp *AutoTopUp `protobuf:"bytes,7,opt,name=auto_top_up,json=autoTopUp,proto3" json:"auto_top_up,omitempty"`
+	// With leave_pending: credit the cashier balance instead of the bank
+	// account when the seat is cashed out.
+	LeaveToBalance       bool     `protobuf:"varint,8,opt,name=leave_to_balance,json=leaveToBalance,proto3" json:"leave_to_balance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -285,7 +628,7 @@ func (m *Seat) Reset()         { *m = Seat{} }
 func (m *Seat) String() string { return proto.CompactTextString(m) }
 func (*Seat) ProtoMessage()    {}
 func (*Seat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b562bf5e5877c9a5, []int{2}
+	return fileDescriptor_b562bf5e5877c9a5, []int{5}
 }
 func (m *Seat) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Seat.Unmarshal(m, b)
@@ -340,6 +683,79 @@ func (m *Seat) GetHole() []uint32 {
 	return nil
 }
 
+func (m *Seat) GetLeavePending() bool {
+	if m != nil {
+		return m.LeavePending
+	}
+	return false
+}
+
+func (m *Seat) GetAutoTopUp() *AutoTopUp {
+	if m != nil {
+		return m.AutoTopUp
+	}
+	return nil
+}
+
+func (m *Seat) GetLeaveToBalance() bool {
+	if m != nil {
+		return m.LeaveToBalance
+	}
+	return false
+}
+
+// AutoTopUp tops a seat back up to target_stack between hands by pulling
+// chips from the player's bank balance, without a signature, until the
+// pre-authorized allowance is spent. It switches itself off when the
+// allowance or the player's balance runs out.
+type AutoTopUp struct {
+	// Stack to refill to; at most the table's max_buy_in.
+	TargetStack uint64 `protobuf:"varint,1,opt,name=target_stack,json=targetStack,proto3" json:"target_stack,omitempty"`
+	// Chips the module may still pull from the player's account.
+	Allowance            uint64   `protobuf:"varint,2,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AutoTopUp) Reset()         { *m = AutoTopUp{} }
+func (m *AutoTopUp) String() string { return proto.CompactTextString(m) }
+func (*AutoTopUp) ProtoMessage()    {}
+func (*AutoTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b562bf5e5877c9a5, []int{6}
+}
+func (m *AutoTopUp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutoTopUp.Unmarshal(m, b)
+}
+func (m *AutoTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutoTopUp.Marshal(b, m, deterministic)
+}
+func (m *AutoTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoTopUp.Merge(m, src)
+}
+func (m *AutoTopUp) XXX_Size() int {
+	return xxx_messageInfo_AutoTopUp.Size(m)
+}
+func (m *AutoTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoTopUp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoTopUp proto.InternalMessageInfo
+
+func (m *AutoTopUp) GetTargetStack() uint64 {
+	if m != nil {
+		return m.TargetStack
+	}
+	return 0
+}
+
+func (m *AutoTopUp) GetAllowance() uint64 {
+	if m != nil {
+		return m.Allowance
+	}
+	return 0
+}
+
 // DealerMeta is the minimal dealer state needed by the poker state machine.
 // Encrypted deck/shares are stored in x/dealer.
 type DealerMeta struct {
@@ -363,7 +779,7 @@ func (m *DealerMeta) Reset()         { *m = DealerMeta{} }
 func (m *DealerMeta) String() string { return proto.CompactTextString(m) }
 func (*DealerMeta) ProtoMessage()    {}
 func (*DealerMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b562bf5e5877c9a5, []int{3}
+	return fileDescriptor_b562bf5e5877c9a5, []int{7}
 }
 func (m *DealerMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DealerMeta.Unmarshal(m, b)
@@ -456,17 +872,24 @@ type Hand struct {
 	// Poker action timeout.
 	ActionDeadline int64 `protobuf:"varint,18,opt,name=action_deadline,json=actionDeadline,proto3" json:"action_deadline,omitempty"`
 	// Dealer integration.
-	Dealer               *DealerMeta `protobuf:"bytes,19,opt,name=dealer,proto3" json:"dealer,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Dealer *DealerMeta `protobuf:"bytes,19,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// Chips in the pot not committed by any seat (slashed bonds under
+	// SLASH_DESTINATION_POT). Awarded together with the main pot.
+	DeadMoney uint64 `protobuf:"varint,20,opt,name=dead_money,json=deadMoney,proto3" json:"dead_money,omitempty"`
+	// Per-seat slashed-bond shares owed under SLASH_DESTINATION_PLAYERS, paid
+	// to stacks when the hand ends (table stakes: never usable mid-hand).
+	// Length 9 once normalized.
+	SlashCredit          []uint64 `protobuf:"varint,21,rep,packed,name=slash_credit,json=slashCredit,proto3" json:"slash_credit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Hand) Reset()         { *m = Hand{} }
 func (m *Hand) String() string { return proto.CompactTextString(m) }
 func (*Hand) ProtoMessage()    {}
 func (*Hand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b562bf5e5877c9a5, []int{4}
+	return fileDescriptor_b562bf5e5877c9a5, []int{8}
 }
 func (m *Hand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Hand.Unmarshal(m, b)
@@ -619,16 +1042,36 @@ func (m *Hand) GetDealer() *DealerMeta {
 	return nil
 }
 
+func (m *Hand) GetDeadMoney() uint64 {
+	if m != nil {
+		return m.DeadMoney
+	}
+	return 0
+}
+
+func (m *Hand) GetSlashCredit() []uint64 {
+	if m != nil {
+		return m.SlashCredit
+	}
+	return nil
+}
+
 type Table struct {
 	Id      uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Creator string      `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 	Label   string      `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
 	Params  TableParams `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 	// Fixed-size (9) seats. Empty seats have an empty `player` string.
-	Seats                []*Seat  `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
-	NextHandId           uint64   `protobuf:"varint,6,opt,name=next_hand_id,json=nextHandId,proto3" json:"next_hand_id,omitempty"`
-	ButtonSeat           int32    `protobuf:"varint,7,opt,name=button_seat,json=buttonSeat,proto3" json:"button_seat,omitempty"`
-	Hand                 *Hand    `protobuf:"bytes,8,opt,name=hand,proto3" json:"hand,omitempty"`
+	Seats      []*Seat `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
+	NextHandId uint64  `protobuf:"varint,6,opt,name=next_hand_id,json=nextHandId,proto3" json:"next_hand_id,omitempty"`
+	ButtonSeat int32   `protobuf:"varint,7,opt,name=button_seat,json=buttonSeat,proto3" json:"button_seat,omitempty"`
+	Hand       *Hand   `protobuf:"bytes,8,opt,name=hand,proto3" json:"hand,omitempty"`
+	// Creation deposit held in escrow for the creator; refunded when the table
+	// is swept.
+	CreationDeposit uint64 `protobuf:"varint,9,opt,name=creation_deposit,json=creationDeposit,proto3" json:"creation_deposit,omitempty"`
+	// Block height since which no seat has been occupied; 0 while any seat is.
+	// Maintained by the keeper on every table write.
+	IdleSinceHeight      int64    `protobuf:"varint,10,opt,name=idle_since_height,json=idleSinceHeight,proto3" json:"idle_since_height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -638,7 +1081,7 @@ func (m *Table) Reset()         { *m = Table{} }
 func (m *Table) String() string { return proto.CompactTextString(m) }
 func (*Table) ProtoMessage()    {}
 func (*Table) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b562bf5e5877c9a5, []int{5}
+	return fileDescriptor_b562bf5e5877c9a5, []int{9}
 }
 func (m *Table) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Table.Unmarshal(m, b)
@@ -714,12 +1157,32 @@ func (m *Table) GetHand() *Hand {
 	return nil
 }
 
+func (m *Table) GetCreationDeposit() uint64 {
+	if m != nil {
+		return m.CreationDeposit
+	}
+	return 0
+}
+
+func (m *Table) GetIdleSinceHeight() int64 {
+	if m != nil {
+		return m.IdleSinceHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("onchainpoker.poker.v1.SlashDestination", SlashDestination_name, SlashDestination_value)
+	proto.RegisterEnum("onchainpoker.poker.v1.AbortRefundPolicy", AbortRefundPolicy_name, AbortRefundPolicy_value)
 	proto.RegisterEnum("onchainpoker.poker.v1.HandPhase", HandPhase_name, HandPhase_value)
 	proto.RegisterEnum("onchainpoker.poker.v1.Street", Street_name, Street_value)
 	proto.RegisterType((*GenesisState)(nil), "onchainpoker.poker.v1.GenesisState")
+	proto.RegisterType((*ChipBalance)(nil), "onchainpoker.poker.v1.ChipBalance")
+	proto.RegisterType((*PauseState)(nil), "onchainpoker.poker.v1.PauseState")
+	proto.RegisterType((*Params)(nil), "onchainpoker.poker.v1.Params")
 	proto.RegisterType((*TableParams)(nil), "onchainpoker.poker.v1.TableParams")
 	proto.RegisterType((*Seat)(nil), "onchainpoker.poker.v1.Seat")
+	proto.RegisterType((*AutoTopUp)(nil), "onchainpoker.poker.v1.AutoTopUp")
 	proto.RegisterType((*DealerMeta)(nil), "onchainpoker.poker.v1.DealerMeta")
 	proto.RegisterType((*Hand)(nil), "onchainpoker.poker.v1.Hand")
 	proto.RegisterType((*Table)(nil), "onchainpoker.poker.v1.Table")
@@ -728,81 +1191,125 @@ func init() {
 func init() { proto.RegisterFile("onchainpoker/poker/v1/poker.proto", fileDescriptor_b562bf5e5877c9a5) }
 
 var fileDescriptor_b562bf5e5877c9a5 = []byte{
-	// 1170 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xdd, 0x6e, 0x1b, 0x37,
-	0x13, 0x8d, 0x7e, 0x76, 0x25, 0xcd, 0x4a, 0x8a, 0x42, 0x27, 0xce, 0x26, 0x4e, 0x3e, 0x2b, 0xfa,
-	0x5a, 0x54, 0xc8, 0x85, 0x83, 0xb8, 0x48, 0x0b, 0xf4, 0xa6, 0xb5, 0x62, 0x39, 0x12, 0x90, 0xda,
-	0x02, 0xa5, 0x34, 0x40, 0x6f, 0x16, 0x5c, 0x2d, 0x63, 0x11, 0x5e, 0x91, 0x8b, 0x25, 0xed, 0xda,
-	0x7e, 0x88, 0x3e, 0x47, 0x9f, 0xa1, 0xe8, 0x03, 0xf4, 0x29, 0x0a, 0xb4, 0x17, 0x7d, 0x8e, 0x82,
-	0xc3, 0x95, 0xff, 0x12, 0xe7, 0x46, 0xd8, 0x39, 0xe7, 0x70, 0x39, 0x33, 0x9c, 0xc3, 0x15, 0x3c,
-	0x53, 0x72, 0xbe, 0x60, 0x42, 0x66, 0xea, 0x88, 0xe7, 0x2f, 0xdc, 0xef, 0xc9, 0x4b, 0xf7, 0xb0,
-	0x95, 0xe5, 0xca, 0x28, 0xf2, 0xe0, 0xaa, 0x64, 0xcb, 0xfd, 0x9e, 0xbc, 0x7c, 0x7c, 0xff, 0x50,
-	0x1d, 0x2a, 0x54, 0xbc, 0xb0, 0x4f, 0x4e, 0xdc, 0x93, 0xd0, 0x7c, 0xc3, 0x25, 0xd7, 0x42, 0x4f,
-	0x0d, 0x33, 0x9c, 0xf4, 0xa0, 0x25, 0xf9, 0xa9, 0x89, 0x0c, 0x8b, 0x53, 0x1e, 0x89, 0x24, 0x2c,
-	0x75, 0x4b, 0xfd, 0x2a, 0x0d, 0x2c, 0x38, 0xb3, 0xd8, 0x38, 0x21, 0xdf, 0x81, 0x8f, 0xb4, 0x0e,
-	0xcb, 0xdd, 0x4a, 0x3f, 0xd8, 0x7e, 0xb2, 0xf5, 0xc9, 0x1d, 0xb7, 0x50, 0x3f, 0xa8, 0xfe, 0xf9,
-	0xd7, 0xe6, 0x1d, 0x5a, 0xac, 0xe8, 0xfd, 0x5a, 0x81, 0x00, 0xf1, 0x09, 0xcb, 0xd9, 0x52, 0x93,
-	0x4d, 0x08, 0x96, 0xec, 0x34, 0xca, 0x52, 0x76, 0xc6, 0x73, 0x8d, 0xbb, 0xb5, 0x28, 0x2c, 0xd9,
-	0xe9, 0xc4, 0x21, 0x56, 0xa0, 0x97, 0x2c, 0x4d, 0xa3, 0x38, 0x15, 0x32, 0x09, 0xcb, 0x98, 0x0e,
-	0x20, 0x34, 0xb0, 0x08, 0xd9, 0x80, 0x46, 0x2c, 0x0e, 0x0b, 0xba, 0x82, 0x74, 0x3d, 0x16, 0x87,
-	0x8e, 0x7c, 0x02, 0xb0, 0x14, 0x32, 0x8a, 0x8f, 0xcf, 0x22, 0x21, 0xc3, 0xaa, 0x63, 0x97, 0x42,
-	0x0e, 0x8e, 0xcf, 0xc6, 0x12, 0x59, 0x76, 0xba, 0x62, 0xbd, 0x82, 0x65, 0xa7, 0x8e, 0xdd, 0x82,
-	0x35, 0x36, 0x37, 0x42, 0xc9, 0xc8, 0x88, 0x25, 0x57, 0xc7, 0x26, 0xd2, 0x7c, 0xae, 0x43, 0x1f,
-	0x65, 0xf7, 0x1c, 0x35, 0x73, 0xcc, 0x94, 0xcf, 0xb5, 0xd5, 0x27, 0x9c, 0xa5, 0x3c, 0xbf, 0xae,
-	0xaf, 0x39, 0xbd, 0xa3, 0xae, 0xea, 0x37, 0x21, 0x70, 0x65, 0x47, 0xb1, 0x92, 0x49, 0x58, 0x77,
-	0x95, 0x39, 0x68, 0xa0, 0x64, 0x42, 0x1e, 0x41, 0x3d, 0x67, 0x47, 0x3c, 0x8a, 0x33, 0x1d, 0x36,
-	0xb0, 0x31, 0x35, 0x1b, 0x0f, 0x32, 0x4d, 0xfe, 0x0f, 0xad, 0x8c, 0x69, 0xfd, 0x8b, 0xca, 0x93,
-	0x68, 0xc1, 0xf4, 0x22, 0x84, 0x6e, 0xa9, 0xdf, 0xa4, 0xcd, 0x15, 0x38, 0x62, 0x7a, 0x71, 0x4d,
-	0xa4, 0x59, 0x6a, 0xc2, 0xe0, 0xba, 0x68, 0xca, 0x52, 0xd3, 0x4b, 0xa1, 0x3a, 0xe5, 0xcc, 0x90,
-	0x75, 0xf0, 0xdd, 0xd6, 0x78, 0x06, 0x0d, 0x5a, 0x44, 0xa4, 0x0d, 0xe5, 0xec, 0x08, 0xdb, 0xde,
-	0xa4, 0xe5, 0xec, 0x88, 0xdc, 0x07, 0x4f, 0x1b, 0x36, 0x3f, 0x2a, 0x5a, 0xed, 0x02, 0x42, 0xa0,
-	0x8a, 0x45, 0xb8, 0x0e, 0xe3, 0xb3, 0xc5, 0x16, 0x2a, 0xe5, 0xa1, 0xd7, 0xad, 0xf4, 0x5b, 0x14,
-	0x9f, 0x7b, 0xff, 0x96, 0x00, 0x76, 0xb1, 0x13, 0x3f, 0x72, 0xc3, 0x6c, 0x85, 0x3c, 0x53, 0xf3,
-	0xc5, 0xe5, 0xa0, 0xd5, 0x30, 0x1e, 0xe3, 0xb1, 0x26, 0x7c, 0x7e, 0x14, 0x69, 0x71, 0xce, 0x71,
-	0xfb, 0x16, 0xad, 0x5b, 0x60, 0x2a, 0xce, 0x39, 0xf9, 0x12, 0xda, 0x48, 0x7e, 0x10, 0x92, 0xa5,
-	0xe2, 0x9c, 0xbb, 0x83, 0xaf, 0xd3, 0x96, 0x45, 0xf7, 0x56, 0xa0, 0x7d, 0xbd, 0xdd, 0x35, 0xca,
-	0x94, 0x0e, 0xab, 0x98, 0x45, 0xcd, 0xc6, 0x13, 0xa5, 0x6d, 0xb9, 0xf3, 0xe3, 0x5c, 0xab, 0x1c,
-	0x8f, 0xbd, 0x45, 0x8b, 0x88, 0x3c, 0x05, 0xc8, 0xf9, 0x09, 0x67, 0x29, 0x2e, 0xf2, 0x91, 0x6b,
-	0x38, 0xc4, 0x2e, 0xfb, 0x0a, 0xee, 0x16, 0x74, 0xc2, 0x59, 0x92, 0x0a, 0xc9, 0xf1, 0x7c, 0x2b,
-	0xb4, 0xed, 0xe0, 0xdd, 0x02, 0xed, 0xfd, 0xee, 0x41, 0x75, 0xc4, 0x64, 0x42, 0x1e, 0x42, 0x6d,
-	0xc1, 0x64, 0x72, 0x59, 0xa1, 0x6f, 0xc3, 0x71, 0x42, 0xbe, 0x01, 0x2f, 0x5b, 0x30, 0xed, 0x8a,
-	0x6b, 0x6f, 0x77, 0x6f, 0x31, 0x91, 0x7d, 0xc9, 0xc4, 0xea, 0xa8, 0x93, 0x93, 0x57, 0xe0, 0x6b,
-	0x93, 0x73, 0x6e, 0xb0, 0xe6, 0xf6, 0xf6, 0xd3, 0x5b, 0x16, 0x4e, 0x51, 0x44, 0x0b, 0xb1, 0x9d,
-	0xb6, 0xf8, 0xd8, 0x18, 0x25, 0x23, 0xcd, 0x99, 0xc1, 0x83, 0xf2, 0x28, 0x38, 0x08, 0x07, 0xa0,
-	0x0f, 0x9d, 0x2b, 0x46, 0x73, 0x2a, 0x0f, 0x55, 0xed, 0x4b, 0xb7, 0xa1, 0xf2, 0x0b, 0x68, 0x5f,
-	0x38, 0xce, 0xe9, 0x7c, 0xd4, 0x35, 0x57, 0xb6, 0x43, 0xd5, 0x06, 0x34, 0x0a, 0xfb, 0x28, 0x89,
-	0x4d, 0xf2, 0x68, 0xdd, 0x01, 0x07, 0x92, 0x3c, 0x00, 0x3f, 0xe6, 0x26, 0x32, 0xaa, 0x18, 0x7b,
-	0x2f, 0xe6, 0x66, 0xa6, 0xec, 0x9b, 0xad, 0x5d, 0x73, 0x26, 0x34, 0x77, 0x27, 0xdf, 0x40, 0xba,
-	0xb9, 0x14, 0x92, 0x5a, 0x10, 0x4f, 0x7f, 0x13, 0x02, 0x21, 0x0d, 0xcf, 0x4f, 0x58, 0x6a, 0xdb,
-	0x0a, 0xce, 0x38, 0x2b, 0x68, 0x8c, 0x3d, 0x17, 0x32, 0xb2, 0x7d, 0x0e, 0x83, 0x6e, 0xa5, 0x5f,
-	0xa7, 0xbe, 0x90, 0x78, 0x18, 0xeb, 0xe0, 0x7f, 0x50, 0x69, 0xc2, 0x93, 0xb0, 0xe9, 0x70, 0x17,
-	0xd9, 0x74, 0x6c, 0xe5, 0x42, 0x86, 0x2d, 0xc4, 0x3d, 0x96, 0xa6, 0x63, 0x69, 0x0d, 0xe4, 0xba,
-	0x17, 0xcd, 0xd5, 0x72, 0x29, 0x4c, 0xd8, 0xee, 0x56, 0x6c, 0x36, 0x0e, 0x7c, 0x8d, 0x18, 0x79,
-	0x06, 0x4d, 0xa3, 0x0c, 0x4b, 0x57, 0x9a, 0xbb, 0xa8, 0x09, 0x10, 0x2b, 0x24, 0x5b, 0xb0, 0x96,
-	0x32, 0x6d, 0xa2, 0x8b, 0xac, 0xd9, 0xdc, 0xf0, 0x24, 0xec, 0x74, 0x2b, 0x7d, 0x8f, 0xde, 0xb3,
-	0xd4, 0xb8, 0x60, 0x76, 0x2c, 0x61, 0x3d, 0x16, 0x2b, 0x96, 0x27, 0xe1, 0x3d, 0x1c, 0x5a, 0x17,
-	0xd8, 0xd9, 0x2b, 0x1a, 0x7a, 0x31, 0x7b, 0xc4, 0xcd, 0x9e, 0x83, 0x57, 0xb3, 0x47, 0xbe, 0x07,
-	0xdf, 0xdd, 0x36, 0xe1, 0x5a, 0xb7, 0xd4, 0x0f, 0xb6, 0x9f, 0xdd, 0x32, 0x21, 0x97, 0x46, 0xc4,
-	0x4b, 0xba, 0x44, 0x8b, 0x65, 0xbd, 0x3f, 0xca, 0xe0, 0xe1, 0x25, 0x6d, 0xdd, 0x7f, 0x31, 0xb8,
-	0x65, 0x91, 0x90, 0x10, 0x6a, 0xf3, 0x9c, 0x33, 0xa3, 0x72, 0x1c, 0xdb, 0x06, 0x5d, 0x85, 0x36,
-	0xe7, 0x94, 0xc5, 0x3c, 0xc5, 0xa9, 0x6c, 0x50, 0x17, 0x90, 0x1f, 0xc0, 0xcf, 0xf0, 0xa2, 0xc7,
-	0x81, 0x0b, 0xb6, 0x7b, 0x9f, 0xfb, 0x54, 0xb8, 0x4f, 0xc2, 0xea, 0x83, 0xe1, 0xd6, 0x91, 0x6f,
-	0xc1, 0xb3, 0x23, 0xa6, 0xf1, 0x1a, 0x09, 0xb6, 0x37, 0x6e, 0x9b, 0x76, 0xce, 0x4c, 0x51, 0x85,
-	0xd3, 0x93, 0x2e, 0x34, 0xf1, 0x4b, 0xb6, 0x72, 0x9f, 0xbb, 0xb7, 0xc1, 0x62, 0x23, 0xe7, 0xc0,
-	0x1b, 0x96, 0xa8, 0x7d, 0x64, 0x89, 0x57, 0x50, 0xc5, 0x21, 0xaa, 0x63, 0xee, 0x1b, 0x9f, 0x71,
-	0x68, 0xb1, 0x35, 0xca, 0x9f, 0xff, 0x5d, 0x82, 0xc6, 0x85, 0x6d, 0xc9, 0x63, 0x58, 0x1f, 0xed,
-	0xec, 0xef, 0x46, 0x93, 0xd1, 0xce, 0x74, 0x18, 0xbd, 0xdb, 0x9f, 0x4e, 0x86, 0xaf, 0xc7, 0x7b,
-	0xe3, 0xe1, 0x6e, 0xe7, 0x0e, 0x59, 0x07, 0x72, 0x85, 0x9b, 0x8e, 0xde, 0xed, 0xed, 0xbd, 0x1d,
-	0x76, 0x4a, 0x37, 0xf0, 0xc1, 0x70, 0x36, 0x1b, 0xef, 0xbf, 0xe9, 0x94, 0xc9, 0x23, 0x78, 0x70,
-	0x05, 0xdf, 0x79, 0xbf, 0x33, 0x9e, 0x45, 0x7b, 0x6f, 0x0f, 0x26, 0x9d, 0xca, 0x27, 0xa9, 0xd9,
-	0x3b, 0xba, 0xdf, 0xa9, 0xde, 0xc8, 0xc0, 0x51, 0x74, 0xfc, 0xd3, 0x90, 0x76, 0x3c, 0xf2, 0x14,
-	0x1e, 0x7d, 0xc4, 0x4d, 0x47, 0x07, 0xef, 0x77, 0x0f, 0xde, 0xef, 0x77, 0x7c, 0xf2, 0x10, 0xd6,
-	0xae, 0x25, 0x58, 0x10, 0xb5, 0xe7, 0x0b, 0xf0, 0xdd, 0x05, 0x63, 0x73, 0x9d, 0xce, 0xe8, 0x70,
-	0x38, 0xbb, 0x51, 0x1b, 0x81, 0x76, 0x81, 0x4f, 0xe8, 0x10, 0x93, 0x2c, 0x91, 0xbb, 0x10, 0x14,
-	0x18, 0x02, 0xe5, 0x2b, 0x00, 0xe6, 0x5a, 0x21, 0x1d, 0x68, 0x16, 0x80, 0xcb, 0xb0, 0x3a, 0x58,
-	0xfb, 0xed, 0x9f, 0xff, 0x95, 0x7e, 0x6e, 0x9d, 0x16, 0x7f, 0x76, 0xcc, 0x59, 0xc6, 0x75, 0xec,
-	0xe3, 0xbf, 0x97, 0xaf, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x40, 0x7e, 0xb7, 0x0f, 0x09,
-	0x00, 0x00,
+	// 1885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x57, 0x5b, 0x6f, 0x1b, 0xb9,
+	0x15, 0x5e, 0x59, 0x17, 0x4b, 0x47, 0x17, 0xcb, 0x74, 0xe2, 0x4c, 0x6e, 0x1b, 0x47, 0xdb, 0x62,
+	0x95, 0x00, 0xeb, 0xc5, 0x66, 0x9b, 0x16, 0xe8, 0x3e, 0xec, 0x4a, 0xb6, 0xbc, 0x56, 0xab, 0x58,
+	0x02, 0x35, 0x6e, 0xba, 0x7d, 0x21, 0xa8, 0x19, 0x46, 0x1a, 0x78, 0x34, 0x1c, 0x0c, 0xa9, 0xc4,
+	0xce, 0xdf, 0x68, 0x7f, 0x44, 0x9f, 0xfa, 0x2b, 0xfa, 0xd0, 0xf7, 0x3e, 0x16, 0x28, 0xd0, 0x3e,
+	0xf4, 0x57, 0xb4, 0x40, 0xc1, 0x43, 0x4a, 0xbe, 0x2a, 0x2f, 0xc2, 0xf0, 0x3b, 0xdf, 0x21, 0x0f,
+	0xcf, 0x8d, 0x47, 0xf0, 0x5c, 0x26, 0xc1, 0x8c, 0x47, 0x49, 0x2a, 0xcf, 0x44, 0xf6, 0xb5, 0xfd,
+	0x7d, 0xff, 0x8d, 0xfd, 0xd8, 0x4f, 0x33, 0xa9, 0x25, 0xb9, 0x7f, 0x95, 0xb2, 0x6f, 0x7f, 0xdf,
+	0x7f, 0xf3, 0xe8, 0xde, 0x54, 0x4e, 0x25, 0x32, 0xbe, 0x36, 0x5f, 0x96, 0xdc, 0xfa, 0xeb, 0x06,
+	0xd4, 0x7e, 0x14, 0x89, 0x50, 0x91, 0x1a, 0x6b, 0xae, 0x05, 0x69, 0x41, 0x3d, 0x11, 0xe7, 0x9a,
+	0x69, 0x3e, 0x89, 0x05, 0x8b, 0x42, 0x2f, 0xb7, 0x97, 0x6b, 0x17, 0x68, 0xd5, 0x80, 0xbe, 0xc1,
+	0xfa, 0x21, 0xf9, 0x35, 0x94, 0x50, 0xac, 0xbc, 0x8d, 0xbd, 0x7c, 0xbb, 0xfa, 0xea, 0xc9, 0xfe,
+	0x9d, 0x47, 0xee, 0x23, 0xbf, 0x5b, 0xf8, 0xdb, 0x3f, 0x9f, 0x7d, 0x46, 0x9d, 0x06, 0xf9, 0x0e,
+	0x4a, 0x29, 0xcf, 0xf8, 0x5c, 0x79, 0xf9, 0xbd, 0x5c, 0xbb, 0xfa, 0xea, 0xe9, 0x1a, 0xdd, 0x11,
+	0x92, 0x96, 0xca, 0x56, 0x85, 0x1c, 0x43, 0x35, 0xe5, 0x0b, 0x25, 0x98, 0x32, 0xb6, 0x7a, 0x05,
+	0xdc, 0xe1, 0xf9, 0xda, 0x1d, 0x16, 0x4a, 0xe0, 0xa5, 0xdc, 0x2e, 0x90, 0xae, 0x10, 0xf2, 0x06,
+	0xea, 0xc1, 0x2c, 0x4a, 0xd9, 0x84, 0xc7, 0x3c, 0x09, 0x84, 0xf2, 0x8a, 0x78, 0x93, 0xd6, 0x9a,
+	0xbd, 0x0e, 0x66, 0x51, 0xda, 0xb5, 0x54, 0xb7, 0x59, 0x2d, 0xb8, 0x84, 0x54, 0xeb, 0x7b, 0xa8,
+	0x5e, 0xa1, 0x10, 0x0f, 0x36, 0x79, 0x18, 0x66, 0x42, 0x29, 0x74, 0x5f, 0x85, 0x2e, 0x97, 0x64,
+	0x17, 0x4a, 0x7c, 0x2e, 0x17, 0x89, 0xf6, 0x36, 0xd0, 0xaf, 0x6e, 0xd5, 0x7a, 0x03, 0x70, 0x69,
+	0xaf, 0x09, 0xc2, 0x5c, 0x4d, 0x99, 0xbe, 0x48, 0x05, 0x5b, 0x64, 0xb1, 0xd9, 0x25, 0xdf, 0xae,
+	0xd0, 0xea, 0x5c, 0x4d, 0xfd, 0x8b, 0x54, 0x9c, 0x66, 0xb1, 0x22, 0x8f, 0xa1, 0xb2, 0x8c, 0x91,
+	0x8d, 0x43, 0x81, 0x96, 0xb5, 0x0d, 0x90, 0x6a, 0xfd, 0x23, 0x0f, 0x25, 0xeb, 0x41, 0xf2, 0x15,
+	0xec, 0xcc, 0xf9, 0xb9, 0x8b, 0x67, 0xcc, 0x27, 0x22, 0x66, 0xb1, 0x48, 0xd0, 0xae, 0x3a, 0x6d,
+	0xce, 0xf9, 0x39, 0x46, 0x69, 0x60, 0x04, 0x03, 0x91, 0x90, 0xd7, 0xf0, 0xc0, 0xd0, 0x79, 0xa0,
+	0x23, 0x99, 0x30, 0x1d, 0xcd, 0x85, 0x5c, 0x68, 0xa6, 0x44, 0xa0, 0x9c, 0xc5, 0xf7, 0xe6, 0xfc,
+	0xbc, 0x83, 0x52, 0xdf, 0x0a, 0xc7, 0x22, 0x50, 0x4b, 0xb5, 0x50, 0xf0, 0x58, 0x64, 0xd7, 0xd5,
+	0xf2, 0x2b, 0xb5, 0x43, 0x94, 0x5e, 0x55, 0x7b, 0x01, 0xdb, 0x46, 0x6d, 0xb2, 0xb8, 0x60, 0x51,
+	0xc2, 0x16, 0xc6, 0xa7, 0x0a, 0xc3, 0x5a, 0xa0, 0x8d, 0x39, 0x3f, 0xef, 0x2e, 0x2e, 0xfa, 0xc9,
+	0x29, 0xa2, 0xe4, 0x3b, 0x78, 0x14, 0x25, 0x5a, 0x64, 0x6c, 0xc6, 0x93, 0x90, 0x05, 0x52, 0xc6,
+	0xa1, 0xfc, 0x90, 0xb0, 0x49, 0x2c, 0x83, 0x33, 0x13, 0x3e, 0xa3, 0xf3, 0x00, 0x19, 0xc7, 0x3c,
+	0x09, 0x0f, 0x9c, 0xbc, 0x8b, 0x62, 0xf2, 0x0b, 0xd8, 0xb5, 0x0e, 0x08, 0x32, 0xc1, 0xf1, 0x66,
+	0xa1, 0x48, 0xa5, 0x8a, 0xb4, 0x57, 0xb2, 0xd6, 0xa1, 0xf4, 0xc0, 0x09, 0x0f, 0xad, 0x8c, 0xbc,
+	0x84, 0xed, 0x28, 0x8c, 0x85, 0xf3, 0x9d, 0x3b, 0x69, 0x13, 0x15, 0xb6, 0x8c, 0xc0, 0xe6, 0xb7,
+	0x3d, 0xe1, 0x35, 0x3c, 0x98, 0xf2, 0xb9, 0x48, 0x63, 0x7e, 0xc1, 0xf4, 0xb9, 0x62, 0xa9, 0xc8,
+	0x98, 0x8a, 0xa6, 0x89, 0xc8, 0xbc, 0xb2, 0x3d, 0x62, 0x29, 0xf6, 0xcf, 0xd5, 0x48, 0x64, 0x63,
+	0x94, 0x91, 0x6f, 0x61, 0xf7, 0x96, 0x1a, 0x1e, 0xe7, 0x55, 0x50, 0x6b, 0xe7, 0xba, 0x16, 0x9e,
+	0xd8, 0xfa, 0x7b, 0x01, 0xaa, 0xf8, 0xe5, 0x42, 0xfc, 0x0c, 0xaa, 0xc6, 0x8b, 0x86, 0x26, 0x32,
+	0xe5, 0x42, 0x0b, 0x73, 0x7e, 0x3e, 0xb2, 0x88, 0x21, 0xa8, 0x39, 0x8f, 0x63, 0x36, 0x89, 0xa3,
+	0x24, 0x74, 0x81, 0x04, 0x84, 0xba, 0x06, 0x31, 0xc9, 0x34, 0x89, 0xa6, 0x4e, 0x6c, 0x03, 0x56,
+	0x9e, 0x44, 0x53, 0x2b, 0x7c, 0x02, 0x30, 0x8f, 0x12, 0x17, 0x24, 0x17, 0x9d, 0xf2, 0x3c, 0x4a,
+	0x30, 0x3a, 0x28, 0x5d, 0x85, 0xd0, 0xc5, 0xa1, 0xbc, 0x8c, 0x1d, 0xd9, 0x87, 0x9d, 0xbb, 0x52,
+	0xc9, 0x7a, 0x7d, 0x9b, 0xdf, 0xca, 0xa3, 0x7d, 0xd8, 0xb9, 0x2b, 0x87, 0xac, 0xd3, 0xb7, 0xc3,
+	0x5b, 0x09, 0xf4, 0x0c, 0xaa, 0xf6, 0xda, 0x6c, 0x22, 0x93, 0xd0, 0xb9, 0x1a, 0x2c, 0xd4, 0x95,
+	0x49, 0x48, 0x1e, 0x42, 0x39, 0xe3, 0x67, 0x82, 0x4d, 0x52, 0x85, 0x2e, 0xad, 0xd3, 0x4d, 0xb3,
+	0xee, 0xa6, 0x8a, 0x7c, 0x01, 0xf5, 0x94, 0x2b, 0xf5, 0x41, 0x66, 0x21, 0x9b, 0x71, 0x35, 0xf3,
+	0x60, 0x2f, 0xd7, 0xae, 0xd1, 0xda, 0x12, 0x3c, 0xe6, 0x6a, 0x76, 0x8d, 0xa4, 0x78, 0xac, 0xbd,
+	0xea, 0x75, 0xd2, 0x98, 0xc7, 0x9a, 0xf8, 0xb0, 0xad, 0x62, 0xae, 0x66, 0x2c, 0x14, 0x4a, 0x47,
+	0x09, 0x26, 0x91, 0x57, 0xdb, 0xcb, 0xb5, 0x1b, 0xaf, 0xbe, 0x5c, 0xd3, 0x51, 0xc6, 0x86, 0x7f,
+	0x78, 0x49, 0xa7, 0x4d, 0x75, 0x03, 0x21, 0xbf, 0x87, 0x1d, 0x3e, 0x91, 0x99, 0x66, 0x99, 0x78,
+	0xb7, 0x48, 0x42, 0x96, 0xca, 0x38, 0x0a, 0x2e, 0xbc, 0x3a, 0xee, 0xdb, 0x5e, 0xb3, 0x6f, 0xc7,
+	0x68, 0x50, 0x54, 0x18, 0x21, 0x9f, 0x6e, 0xf3, 0x9b, 0xd0, 0x6f, 0x0a, 0xe5, 0x46, 0x73, 0x8b,
+	0xd6, 0xed, 0xee, 0xa9, 0x48, 0x78, 0xac, 0x2f, 0x5a, 0xff, 0xcb, 0x41, 0x61, 0x2c, 0xb8, 0x36,
+	0x3d, 0xca, 0x3a, 0xd0, 0x35, 0x2f, 0xb7, 0x22, 0x0d, 0xd8, 0x48, 0xcf, 0x30, 0x79, 0x6a, 0x74,
+	0x23, 0x3d, 0x23, 0xf7, 0xa0, 0xa8, 0x34, 0x0f, 0xce, 0x5c, 0xc2, 0xd8, 0x05, 0x21, 0x50, 0xc0,
+	0x50, 0xd8, 0x3c, 0xc1, 0x6f, 0x83, 0xcd, 0x64, 0x2c, 0xb0, 0xc9, 0xd6, 0x29, 0x7e, 0x1b, 0xc7,
+	0xc6, 0x82, 0xbf, 0x17, 0xe6, 0xfc, 0x30, 0x4a, 0xa6, 0x98, 0x13, 0x65, 0x5a, 0x43, 0x70, 0x64,
+	0x31, 0xf2, 0x03, 0x54, 0xf9, 0x42, 0x4b, 0xa6, 0x65, 0xca, 0x16, 0x29, 0xa6, 0x41, 0xf5, 0xd5,
+	0xde, 0xba, 0xab, 0x2f, 0xb4, 0xf4, 0x65, 0x7a, 0x9a, 0xd2, 0x0a, 0x5f, 0x7e, 0x92, 0x36, 0x34,
+	0xed, 0x31, 0x5a, 0x2e, 0x9b, 0x3d, 0x66, 0x49, 0x99, 0x36, 0x10, 0xf7, 0xa5, 0x6b, 0xda, 0xad,
+	0x01, 0x54, 0x56, 0x3b, 0x90, 0xe7, 0x50, 0xd3, 0x3c, 0x9b, 0x0a, 0xcd, 0xec, 0x15, 0xdd, 0x2b,
+	0x68, 0xb1, 0x31, 0x5e, 0xf4, 0x09, 0x54, 0x78, 0x1c, 0xcb, 0x0f, 0xb8, 0xa5, 0x2d, 0xa9, 0x4b,
+	0xa0, 0xf5, 0x9f, 0x1c, 0x80, 0xed, 0x77, 0x6f, 0x84, 0xe6, 0x26, 0x0d, 0x45, 0x2a, 0x83, 0xd9,
+	0xe5, 0x8b, 0xba, 0x89, 0xeb, 0x3e, 0xd6, 0x5e, 0x28, 0x82, 0x33, 0xa6, 0xa2, 0x8f, 0x76, 0x9f,
+	0x3a, 0x2d, 0x1b, 0x60, 0x1c, 0x7d, 0x14, 0xe4, 0xe7, 0xd0, 0x40, 0xe1, 0xbb, 0x28, 0xe1, 0x71,
+	0xf4, 0x51, 0xd8, 0xea, 0x2c, 0xd3, 0xba, 0x41, 0x8f, 0x96, 0xa0, 0xd9, 0xde, 0x38, 0x95, 0xa5,
+	0xd2, 0xb4, 0x4f, 0xe3, 0xe4, 0x4d, 0xb3, 0x1e, 0x49, 0x7c, 0x71, 0x82, 0x45, 0xa6, 0x64, 0x86,
+	0xb5, 0x59, 0xa7, 0x6e, 0x45, 0x9e, 0x02, 0x64, 0xe2, 0xbd, 0xe0, 0x31, 0x2a, 0x95, 0x50, 0x56,
+	0xb1, 0x88, 0x51, 0xfb, 0x12, 0xb6, 0x9c, 0x38, 0x14, 0x3c, 0x8c, 0xa3, 0x44, 0xa0, 0xf7, 0xf3,
+	0xb4, 0x61, 0xe1, 0x43, 0x87, 0xb6, 0xfe, 0x5b, 0x84, 0x82, 0xe9, 0xb8, 0xe4, 0x01, 0x6c, 0x62,
+	0x6b, 0x5e, 0xdd, 0xb0, 0x64, 0x96, 0xfd, 0x90, 0xfc, 0x12, 0x8a, 0xe9, 0x8c, 0x2b, 0x7b, 0xb9,
+	0xc6, 0xda, 0xf0, 0x99, 0x4d, 0x46, 0x86, 0x47, 0x2d, 0x9d, 0xbc, 0x86, 0x92, 0xd2, 0x99, 0x10,
+	0x1a, 0xef, 0xdc, 0x58, 0x3b, 0x2a, 0x8c, 0x91, 0x44, 0x1d, 0xd9, 0xb4, 0x84, 0xc9, 0x42, 0x6b,
+	0x99, 0x30, 0x25, 0xb8, 0xc6, 0x3c, 0x2c, 0x52, 0xb0, 0x10, 0xe6, 0x77, 0x1b, 0x9a, 0x57, 0xba,
+	0xa1, 0x65, 0x15, 0x91, 0xd5, 0xb8, 0x6c, 0x89, 0xc8, 0xfc, 0x19, 0x34, 0x56, 0x6d, 0xd1, 0xf2,
+	0x4a, 0xc8, 0xab, 0x2d, 0x7b, 0x23, 0xb2, 0x1e, 0x43, 0xc5, 0xf5, 0x38, 0x99, 0xa0, 0x93, 0x8a,
+	0xb4, 0x6c, 0x81, 0x61, 0x42, 0xee, 0x43, 0x69, 0x22, 0x34, 0xd3, 0xd2, 0xf5, 0xa6, 0xe2, 0x44,
+	0x68, 0x5f, 0x9a, 0x9d, 0x4d, 0x4f, 0xcd, 0x78, 0xa4, 0x84, 0x8d, 0xbc, 0xed, 0xf7, 0xb5, 0x79,
+	0x94, 0x50, 0x03, 0x62, 0xf4, 0x9f, 0x41, 0x15, 0x5f, 0xb4, 0xf7, 0x3c, 0x36, 0x6e, 0x05, 0xa4,
+	0xc0, 0x12, 0xea, 0xa3, 0xcf, 0xa3, 0x04, 0x5f, 0x44, 0xaf, 0xba, 0x97, 0x6f, 0x97, 0x69, 0x29,
+	0x4a, 0x30, 0x18, 0xbb, 0x50, 0x7a, 0x27, 0xe3, 0x50, 0x84, 0x5e, 0xcd, 0xe2, 0x76, 0x65, 0xcc,
+	0x31, 0x37, 0x8f, 0x12, 0xaf, 0x8e, 0x78, 0x91, 0xc7, 0x71, 0x3f, 0x31, 0xc5, 0x68, 0xbd, 0xc7,
+	0x02, 0x39, 0x9f, 0x47, 0xda, 0x6b, 0xe0, 0x40, 0x51, 0xb3, 0xe0, 0x01, 0x62, 0x58, 0x13, 0x52,
+	0xf3, 0x78, 0xc9, 0xd9, 0x42, 0x4e, 0x15, 0x31, 0x47, 0xd9, 0x87, 0x9d, 0x98, 0x2b, 0xcd, 0x56,
+	0x56, 0xf3, 0x40, 0x8b, 0xd0, 0x6b, 0xee, 0xe5, 0xdb, 0x45, 0xba, 0x6d, 0x44, 0x7d, 0x27, 0xe9,
+	0x18, 0x81, 0x69, 0x21, 0x13, 0xc9, 0xb3, 0xd0, 0xdb, 0xc6, 0xa4, 0xb5, 0x0b, 0x93, 0x7b, 0xce,
+	0xa1, 0xab, 0xdc, 0x23, 0x36, 0xf7, 0x2c, 0xbc, 0xcc, 0x3d, 0xf2, 0x3d, 0x94, 0xec, 0x93, 0xe0,
+	0xed, 0x7c, 0x72, 0x14, 0xbc, 0x2c, 0x44, 0x9c, 0xde, 0x72, 0xd4, 0xa9, 0x99, 0x22, 0x30, 0x47,
+	0xb0, 0xb9, 0x4c, 0xc4, 0x85, 0x77, 0xcf, 0x16, 0xb1, 0x41, 0xde, 0x18, 0xc0, 0xdc, 0xd8, 0xf6,
+	0xf5, 0x20, 0x13, 0x61, 0xa4, 0xbd, 0xfb, 0xf6, 0xc6, 0x88, 0x1d, 0x20, 0xd4, 0xfa, 0x63, 0x1e,
+	0x8a, 0xf8, 0x16, 0x9b, 0xf6, 0xb8, 0x4a, 0xfd, 0x8d, 0x28, 0x34, 0x43, 0x20, 0x4e, 0x1b, 0x32,
+	0xc3, 0xc4, 0xaf, 0xd0, 0xe5, 0xd2, 0xdc, 0x1a, 0x07, 0x31, 0xcc, 0xeb, 0x0a, 0xb5, 0x0b, 0xf2,
+	0xc3, 0x6a, 0x32, 0xb6, 0x73, 0x6d, 0xeb, 0x53, 0x53, 0xf5, 0x9d, 0xe3, 0xf1, 0xaf, 0xa0, 0x68,
+	0x92, 0x74, 0x39, 0xcc, 0x3e, 0x5e, 0x57, 0x2f, 0x82, 0x6b, 0xe7, 0x07, 0xcb, 0x27, 0x7b, 0x50,
+	0xc3, 0xa1, 0x7f, 0x59, 0xbf, 0xf6, 0x79, 0x06, 0x83, 0x1d, 0xdb, 0x1a, 0xbe, 0x51, 0x54, 0x9b,
+	0xb7, 0x8a, 0xea, 0x35, 0x14, 0x30, 0x0d, 0xcb, 0x7b, 0xb9, 0x4f, 0x1c, 0x6d, 0x76, 0x73, 0x47,
+	0x23, 0x9d, 0xbc, 0x80, 0xe6, 0xad, 0x91, 0xcc, 0x56, 0xc2, 0x56, 0xb0, 0x66, 0x1a, 0x53, 0x51,
+	0x12, 0x08, 0x36, 0x13, 0xd1, 0x74, 0xa6, 0xb1, 0x24, 0xf2, 0x76, 0x1a, 0x1b, 0x1b, 0xfc, 0x18,
+	0xe1, 0x97, 0x7f, 0xca, 0x41, 0xf3, 0xe6, 0x0b, 0x4b, 0x9e, 0xc3, 0xd3, 0xf1, 0xa0, 0x33, 0x3e,
+	0x66, 0x87, 0xbd, 0xb1, 0xdf, 0x3f, 0xe9, 0xf8, 0xfd, 0xe1, 0x09, 0x3b, 0x3d, 0x19, 0x8f, 0x7a,
+	0x07, 0xfd, 0xa3, 0x7e, 0xef, 0xb0, 0xf9, 0x19, 0xf9, 0x02, 0x9e, 0xdd, 0xa6, 0x1c, 0xf5, 0x7a,
+	0xec, 0x60, 0x38, 0x18, 0xf4, 0x0e, 0xfc, 0x21, 0x6d, 0xe6, 0xc8, 0x53, 0x78, 0x78, 0x9b, 0x34,
+	0x1a, 0x74, 0x7e, 0xea, 0xd1, 0x71, 0x73, 0x83, 0x3c, 0x84, 0xfb, 0x77, 0x88, 0x87, 0x7e, 0x33,
+	0xff, 0xf2, 0x2f, 0x39, 0xd8, 0xbe, 0xf5, 0x40, 0x9b, 0x43, 0x3b, 0xdd, 0x21, 0xf5, 0x19, 0xed,
+	0x1d, 0x9d, 0x9e, 0x1c, 0xb2, 0xd1, 0x70, 0xd0, 0x3f, 0xf8, 0xe9, 0x86, 0x65, 0x2d, 0xf8, 0xfc,
+	0x2e, 0x92, 0x5b, 0x75, 0x06, 0x83, 0x66, 0x8e, 0x7c, 0x05, 0x2f, 0x3e, 0xcd, 0x61, 0xdd, 0x53,
+	0x9f, 0x75, 0x07, 0xfd, 0x93, 0x43, 0x63, 0xe8, 0x73, 0x78, 0x7a, 0x17, 0x7d, 0x30, 0x3c, 0xf8,
+	0xad, 0xb1, 0x77, 0xdc, 0xcc, 0xbf, 0xfc, 0x57, 0x0e, 0x2a, 0xab, 0xbe, 0x4c, 0x1e, 0xc1, 0xee,
+	0x71, 0xc7, 0x10, 0x8f, 0x3b, 0xe3, 0xde, 0x0d, 0xfb, 0x76, 0x81, 0x5c, 0x91, 0x8d, 0x8f, 0x4f,
+	0x8f, 0x8e, 0x06, 0xbd, 0x66, 0xee, 0x06, 0xde, 0xed, 0xf9, 0x7e, 0xff, 0xe4, 0x47, 0xeb, 0xa5,
+	0x2b, 0x78, 0xe7, 0x6d, 0xa7, 0xef, 0xb3, 0xa3, 0xc1, 0x70, 0xd4, 0xcc, 0xdf, 0x29, 0xf2, 0x4f,
+	0xe9, 0x49, 0xb3, 0x70, 0xc3, 0x02, 0x2b, 0xa2, 0xfd, 0xdf, 0xf5, 0x68, 0xb3, 0x68, 0xc2, 0x72,
+	0x4b, 0x36, 0x3e, 0x1e, 0xbe, 0x3d, 0x1c, 0xbe, 0x3d, 0x69, 0x96, 0xc8, 0x03, 0xd8, 0xb9, 0x66,
+	0xa0, 0x13, 0x6c, 0xbe, 0x9c, 0x41, 0xc9, 0xbe, 0x20, 0xc6, 0xd6, 0xb1, 0x4f, 0x7b, 0x3d, 0xff,
+	0xc6, 0xdd, 0x08, 0x34, 0x1c, 0x3e, 0xa2, 0x3d, 0x34, 0x32, 0x47, 0xb6, 0xa0, 0xea, 0x30, 0x04,
+	0x36, 0xae, 0x00, 0x68, 0x6b, 0x9e, 0x34, 0xa1, 0xe6, 0x00, 0x6b, 0x61, 0xa1, 0xbb, 0xf3, 0xe7,
+	0x7f, 0x7f, 0x9e, 0xfb, 0x43, 0xfd, 0xdc, 0xfd, 0x6f, 0x37, 0x7f, 0xef, 0xd4, 0xa4, 0x84, 0x7f,
+	0xc4, 0xbf, 0xfd, 0xff, 0x00, 0x1c, 0xcf, 0xe4, 0x39, 0xda, 0x0f, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -835,6 +1342,141 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	if !this.PauseState.Equal(&that1.PauseState) {
+		return false
+	}
+	if len(this.ChipBalances) != len(that1.ChipBalances) {
+		return false
+	}
+	for i := range this.ChipBalances {
+		if !this.ChipBalances[i].Equal(&that1.ChipBalances[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ChipBalance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ChipBalance)
+	if !ok {
+		that2, ok := that.(ChipBalance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PauseState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PauseState)
+	if !ok {
+		that2, ok := that.(PauseState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.MsgTypeUrls) != len(that1.MsgTypeUrls) {
+		return false
+	}
+	for i := range this.MsgTypeUrls {
+		if this.MsgTypeUrls[i] != that1.MsgTypeUrls[i] {
+			return false
+		}
+	}
+	if len(this.TableIds) != len(that1.TableIds) {
+		return false
+	}
+	for i := range this.TableIds {
+		if this.TableIds[i] != that1.TableIds[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxTableLabelLen != that1.MaxTableLabelLen {
+		return false
+	}
+	if this.MaxActionTimeoutSecs != that1.MaxActionTimeoutSecs {
+		return false
+	}
+	if this.MaxDealerTimeoutSecs != that1.MaxDealerTimeoutSecs {
+		return false
+	}
+	if this.MaxBuyInUchips != that1.MaxBuyInUchips {
+		return false
+	}
+	if this.InterHandCooldownBlocks != that1.InterHandCooldownBlocks {
+		return false
+	}
+	if this.TableCreationDeposit != that1.TableCreationDeposit {
+		return false
+	}
+	if this.IdleTableBlocks != that1.IdleTableBlocks {
+		return false
+	}
+	if this.GameplayTxsPerSigner != that1.GameplayTxsPerSigner {
+		return false
+	}
+	if this.GameplayTxsPerTable != that1.GameplayTxsPerTable {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -892,6 +1534,12 @@ func (this *TableParams) Equal(that interface{}) bool {
 	if !bytes.Equal(this.PasswordSalt, that1.PasswordSalt) {
 		return false
 	}
+	if this.SlashDestination != that1.SlashDestination {
+		return false
+	}
+	if this.AbortRefundPolicy != that1.AbortRefundPolicy {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -936,6 +1584,45 @@ func (this *Seat) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.LeavePending != that1.LeavePending {
+		return false
+	}
+	if !this.AutoTopUp.Equal(that1.AutoTopUp) {
+		return false
+	}
+	if this.LeaveToBalance != that1.LeaveToBalance {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AutoTopUp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoTopUp)
+	if !ok {
+		that2, ok := that.(AutoTopUp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TargetStack != that1.TargetStack {
+		return false
+	}
+	if this.Allowance != that1.Allowance {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1102,6 +1789,17 @@ func (this *Hand) Equal(that interface{}) bool {
 	if !this.Dealer.Equal(that1.Dealer) {
 		return false
 	}
+	if this.DeadMoney != that1.DeadMoney {
+		return false
+	}
+	if len(this.SlashCredit) != len(that1.SlashCredit) {
+		return false
+	}
+	for i := range this.SlashCredit {
+		if this.SlashCredit[i] != that1.SlashCredit[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1155,6 +1853,12 @@ func (this *Table) Equal(that interface{}) bool {
 	if !this.Hand.Equal(that1.Hand) {
 		return false
 	}
+	if this.CreationDeposit != that1.CreationDeposit {
+		return false
+	}
+	if this.IdleSinceHeight != that1.IdleSinceHeight {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
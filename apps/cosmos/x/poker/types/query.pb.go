@@ -29,6 +29,142 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type QueryParamsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44ea09907992f8ca, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryParamsRequest.Unmarshal(m, b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryParamsRequest.Size(m)
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params               Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44ea09907992f8ca, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryParamsResponse.Unmarshal(m, b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryParamsResponse.Size(m)
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryPauseStateRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryPauseStateRequest) Reset()         { *m = QueryPauseStateRequest{} }
+func (m *QueryPauseStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStateRequest) ProtoMessage()    {}
+func (*QueryPauseStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44ea09907992f8ca, []int{2}
+}
+func (m *QueryPauseStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPauseStateRequest.Unmarshal(m, b)
+}
+func (m *QueryPauseStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPauseStateRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryPauseStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStateRequest.Merge(m, src)
+}
+func (m *QueryPauseStateRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryPauseStateRequest.Size(m)
+}
+func (m *QueryPauseStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStateRequest proto.InternalMessageInfo
+
+type QueryPauseStateResponse struct {
+	PauseState           PauseState `protobuf:"bytes,1,opt,name=pause_state,json=pauseState,proto3" json:"pause_state"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *QueryPauseStateResponse) Reset()         { *m = QueryPauseStateResponse{} }
+func (m *QueryPauseStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStateResponse) ProtoMessage()    {}
+func (*QueryPauseStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44ea09907992f8ca, []int{3}
+}
+func (m *QueryPauseStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPauseStateResponse.Unmarshal(m, b)
+}
+func (m *QueryPauseStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPauseStateResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryPauseStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStateResponse.Merge(m, src)
+}
+func (m *QueryPauseStateResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryPauseStateResponse.Size(m)
+}
+func (m *QueryPauseStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStateResponse proto.InternalMessageInfo
+
+func (m *QueryPauseStateResponse) GetPauseState() PauseState {
+	if m != nil {
+		return m.PauseState
+	}
+	return PauseState{}
+}
+
 type QueryTableRequest struct {
 	TableId              uint64   `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -40,7 +176,7 @@ func (m *QueryTableRequest) Reset()         { *m = QueryTableRequest{} }
 func (m *QueryTableRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTableRequest) ProtoMessage()    {}
 func (*QueryTableRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_44ea09907992f8ca, []int{0}
+	return fileDescriptor_44ea09907992f8ca, []int{4}
 }
 func (m *QueryTableRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryTableRequest.Unmarshal(m, b)
@@ -78,7 +214,7 @@ func (m *QueryTableResponse) Reset()         { *m = QueryTableResponse{} }
 func (m *QueryTableResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTableResponse) ProtoMessage()    {}
 func (*QueryTableResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_44ea09907992f8ca, []int{1}
+	return fileDescriptor_44ea09907992f8ca, []int{5}
 }
 func (m *QueryTableResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryTableResponse.Unmarshal(m, b)
@@ -115,7 +251,7 @@ func (m *QueryTablesRequest) Reset()         { *m = QueryTablesRequest{} }
 func (m *QueryTablesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTablesRequest) ProtoMessage()    {}
 func (*QueryTablesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_44ea09907992f8ca, []int{2}
+	return fileDescriptor_44ea09907992f8ca, []int{6}
 }
 func (m *QueryTablesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryTablesRequest.Unmarshal(m, b)
@@ -146,7 +282,7 @@ func (m *QueryTablesResponse) Reset()         { *m = QueryTablesResponse{} }
 func (m *QueryTablesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTablesResponse) ProtoMessage()    {}
 func (*QueryTablesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_44ea09907992f8ca, []int{3}
+	return fileDescriptor_44ea09907992f8ca, []int{7}
 }
 func (m *QueryTablesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryTablesResponse.Unmarshal(m, b)
@@ -173,48 +309,368 @@ func (m *QueryTablesResponse) GetTableIds() []uint64 {
 	return nil
 }
 
+type QueryChipBalanceRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryChipBalanceRequest) Reset()         { *m = QueryChipBalanceRequest{} }
+func (m *QueryChipBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChipBalanceRequest) ProtoMessage()    {}
+func (*QueryChipBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44ea09907992f8ca, []int{8}
+}
+func (m *QueryChipBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryChipBalanceRequest.Unmarshal(m, b)
+}
+func (m *QueryChipBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryChipBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryChipBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChipBalanceRequest.Merge(m, src)
+}
+func (m *QueryChipBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryChipBalanceRequest.Size(m)
+}
+func (m *QueryChipBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChipBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChipBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryChipBalanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryChipBalanceResponse struct {
+	Amount               uint64   `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryChipBalanceResponse) Reset()         { *m = QueryChipBalanceResponse{} }
+func (m *QueryChipBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChipBalanceResponse) ProtoMessage()    {}
+func (*QueryChipBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44ea09907992f8ca, []int{9}
+}
+func (m *QueryChipBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryChipBalanceResponse.Unmarshal(m, b)
+}
+func (m *QueryChipBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryChipBalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryChipBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChipBalanceResponse.Merge(m, src)
+}
+func (m *QueryChipBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryChipBalanceResponse.Size(m)
+}
+func (m *QueryChipBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChipBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChipBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryChipBalanceResponse) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type QueryTableEscrowRequest struct {
+	TableId              uint64   `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryTableEscrowRequest) Reset()         { *m = QueryTableEscrowRequest{} }
+func (m *QueryTableEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTableEscrowRequest) ProtoMessage()    {}
+func (*QueryTableEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44ea09907992f8ca, []int{10}
+}
+func (m *QueryTableEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryTableEscrowRequest.Unmarshal(m, b)
+}
+func (m *QueryTableEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryTableEscrowRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryTableEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTableEscrowRequest.Merge(m, src)
+}
+func (m *QueryTableEscrowRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryTableEscrowRequest.Size(m)
+}
+func (m *QueryTableEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTableEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTableEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryTableEscrowRequest) GetTableId() uint64 {
+	if m != nil {
+		return m.TableId
+	}
+	return 0
+}
+
+type QueryTableEscrowResponse struct {
+	// Escrow as recorded by every coin movement into or out of the table.
+	Escrow uint64 `protobuf:"varint,1,opt,name=escrow,proto3" json:"escrow,omitempty"`
+	// Breakdown of the table state; stacks + bonds + pot + deposit equals
+	// escrow.
+	Stacks uint64 `protobuf:"varint,2,opt,name=stacks,proto3" json:"stacks,omitempty"`
+	Bonds  uint64 `protobuf:"varint,3,opt,name=bonds,proto3" json:"bonds,omitempty"`
+	// Commits, dead money and pending slash credit of the current hand.
+	Pot uint64 `protobuf:"varint,4,opt,name=pot,proto3" json:"pot,omitempty"`
+	// The creator's table-creation deposit.
+	Deposit              uint64   `protobuf:"varint,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryTableEscrowResponse) Reset()         { *m = QueryTableEscrowResponse{} }
+func (m *QueryTableEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTableEscrowResponse) ProtoMessage()    {}
+func (*QueryTableEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44ea09907992f8ca, []int{11}
+}
+func (m *QueryTableEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryTableEscrowResponse.Unmarshal(m, b)
+}
+func (m *QueryTableEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryTableEscrowResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryTableEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTableEscrowResponse.Merge(m, src)
+}
+func (m *QueryTableEscrowResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryTableEscrowResponse.Size(m)
+}
+func (m *QueryTableEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTableEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTableEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryTableEscrowResponse) GetEscrow() uint64 {
+	if m != nil {
+		return m.Escrow
+	}
+	return 0
+}
+
+func (m *QueryTableEscrowResponse) GetStacks() uint64 {
+	if m != nil {
+		return m.Stacks
+	}
+	return 0
+}
+
+func (m *QueryTableEscrowResponse) GetBonds() uint64 {
+	if m != nil {
+		return m.Bonds
+	}
+	return 0
+}
+
+func (m *QueryTableEscrowResponse) GetPot() uint64 {
+	if m != nil {
+		return m.Pot
+	}
+	return 0
+}
+
+func (m *QueryTableEscrowResponse) GetDeposit() uint64 {
+	if m != nil {
+		return m.Deposit
+	}
+	return 0
+}
+
+type QueryReservesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryReservesRequest) Reset()         { *m = QueryReservesRequest{} }
+func (m *QueryReservesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReservesRequest) ProtoMessage()    {}
+func (*QueryReservesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44ea09907992f8ca, []int{12}
+}
+func (m *QueryReservesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryReservesRequest.Unmarshal(m, b)
+}
+func (m *QueryReservesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryReservesRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryReservesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservesRequest.Merge(m, src)
+}
+func (m *QueryReservesRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryReservesRequest.Size(m)
+}
+func (m *QueryReservesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReservesRequest proto.InternalMessageInfo
+
+type QueryReservesResponse struct {
+	TablesEscrow uint64 `protobuf:"varint,1,opt,name=tables_escrow,json=tablesEscrow,proto3" json:"tables_escrow,omitempty"`
+	ChipBalances uint64 `protobuf:"varint,2,opt,name=chip_balances,json=chipBalances,proto3" json:"chip_balances,omitempty"`
+	// Balance of the poker module account in the bond denom, as a decimal
+	// string.
+	ModuleBalance string `protobuf:"bytes,3,opt,name=module_balance,json=moduleBalance,proto3" json:"module_balance,omitempty"`
+	// True when module_balance equals tables_escrow + chip_balances.
+	Balanced             bool     `protobuf:"varint,4,opt,name=balanced,proto3" json:"balanced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryReservesResponse) Reset()         { *m = QueryReservesResponse{} }
+func (m *QueryReservesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReservesResponse) ProtoMessage()    {}
+func (*QueryReservesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44ea09907992f8ca, []int{13}
+}
+func (m *QueryReservesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryReservesResponse.Unmarshal(m, b)
+}
+func (m *QueryReservesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryReservesResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryReservesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservesResponse.Merge(m, src)
+}
+func (m *QueryReservesResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryReservesResponse.Size(m)
+}
+func (m *QueryReservesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReservesResponse proto.InternalMessageInfo
+
+func (m *QueryReservesResponse) GetTablesEscrow() uint64 {
+	if m != nil {
+		return m.TablesEscrow
+	}
+	return 0
+}
+
+func (m *QueryReservesResponse) GetChipBalances() uint64 {
+	if m != nil {
+		return m.ChipBalances
+	}
+	return 0
+}
+
+func (m *QueryReservesResponse) GetModuleBalance() string {
+	if m != nil {
+		return m.ModuleBalance
+	}
+	return ""
+}
+
+func (m *QueryReservesResponse) GetBalanced() bool {
+	if m != nil {
+		return m.Balanced
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "onchainpoker.poker.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "onchainpoker.poker.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPauseStateRequest)(nil), "onchainpoker.poker.v1.QueryPauseStateRequest")
+	proto.RegisterType((*QueryPauseStateResponse)(nil), "onchainpoker.poker.v1.QueryPauseStateResponse")
 	proto.RegisterType((*QueryTableRequest)(nil), "onchainpoker.poker.v1.QueryTableRequest")
 	proto.RegisterType((*QueryTableResponse)(nil), "onchainpoker.poker.v1.QueryTableResponse")
 	proto.RegisterType((*QueryTablesRequest)(nil), "onchainpoker.poker.v1.QueryTablesRequest")
 	proto.RegisterType((*QueryTablesResponse)(nil), "onchainpoker.poker.v1.QueryTablesResponse")
+	proto.RegisterType((*QueryChipBalanceRequest)(nil), "onchainpoker.poker.v1.QueryChipBalanceRequest")
+	proto.RegisterType((*QueryChipBalanceResponse)(nil), "onchainpoker.poker.v1.QueryChipBalanceResponse")
+	proto.RegisterType((*QueryTableEscrowRequest)(nil), "onchainpoker.poker.v1.QueryTableEscrowRequest")
+	proto.RegisterType((*QueryTableEscrowResponse)(nil), "onchainpoker.poker.v1.QueryTableEscrowResponse")
+	proto.RegisterType((*QueryReservesRequest)(nil), "onchainpoker.poker.v1.QueryReservesRequest")
+	proto.RegisterType((*QueryReservesResponse)(nil), "onchainpoker.poker.v1.QueryReservesResponse")
 }
 
 func init() { proto.RegisterFile("onchainpoker/poker/v1/query.proto", fileDescriptor_44ea09907992f8ca) }
 
 var fileDescriptor_44ea09907992f8ca = []byte{
-	// 334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xcf, 0x4b, 0xce,
-	0x48, 0xcc, 0xcc, 0x2b, 0xc8, 0xcf, 0x4e, 0x2d, 0xd2, 0x87, 0x90, 0x65, 0x86, 0xfa, 0x85, 0xa5,
-	0xa9, 0x45, 0x95, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xa2, 0xc8, 0x4a, 0xf4, 0x20, 0x64,
-	0x99, 0xa1, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x85, 0x3e, 0x88, 0x05, 0x51, 0x2c, 0x25,
-	0x9d, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x0c, 0x31, 0x00, 0xcd, 0x24, 0x29, 0x99, 0xf4, 0xfc, 0xfc,
-	0xf4, 0x9c, 0x54, 0xfd, 0xc4, 0x82, 0x4c, 0xfd, 0xc4, 0xbc, 0xbc, 0xfc, 0x92, 0xc4, 0x92, 0xcc,
-	0xfc, 0xbc, 0x62, 0xa8, 0x2c, 0x0e, 0xa7, 0x40, 0xad, 0x05, 0x29, 0x51, 0xd2, 0xe3, 0x12, 0x0c,
-	0x04, 0x99, 0x17, 0x92, 0x98, 0x94, 0x93, 0x1a, 0x94, 0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0x22, 0x24,
-	0xc9, 0xc5, 0x51, 0x02, 0xe2, 0xc7, 0x67, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xb0, 0x04, 0xb1,
-	0x83, 0xf9, 0x9e, 0x29, 0x4a, 0x7e, 0x5c, 0x42, 0xc8, 0xea, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53,
-	0x85, 0x2c, 0xb8, 0x58, 0xc1, 0x0a, 0xc0, 0xaa, 0xb9, 0x8d, 0x64, 0xf4, 0xb0, 0x7a, 0x50, 0x0f,
-	0xac, 0xc9, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0x88, 0x06, 0x25, 0x11, 0x64, 0xf3, 0x8a,
-	0xa1, 0x0e, 0x50, 0x32, 0xe2, 0x12, 0x46, 0x11, 0x85, 0x5a, 0x23, 0xcd, 0xc5, 0x09, 0x73, 0x57,
-	0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0x4b, 0x10, 0x07, 0xd4, 0x61, 0xc5, 0x46, 0x0b, 0x99, 0xb8,
-	0x58, 0xc1, 0x9a, 0x84, 0xfa, 0x18, 0xb9, 0x58, 0xc1, 0x3a, 0x85, 0x34, 0x70, 0x38, 0x04, 0xc3,
-	0xcb, 0x52, 0x9a, 0x44, 0xa8, 0x84, 0xb8, 0x42, 0xc9, 0xa0, 0xe9, 0xf2, 0x93, 0xc9, 0x4c, 0x5a,
-	0x42, 0x1a, 0xfa, 0xd8, 0x83, 0x17, 0xec, 0xa2, 0x62, 0xfd, 0x6a, 0x98, 0x53, 0x6b, 0x85, 0xda,
-	0x18, 0xb9, 0xd8, 0x20, 0x5e, 0x11, 0x22, 0x6c, 0x0f, 0x2c, 0x10, 0xa4, 0xb4, 0x88, 0x51, 0x0a,
-	0x75, 0x93, 0x2a, 0xd8, 0x4d, 0xf2, 0x42, 0xb2, 0x78, 0xdd, 0xe4, 0x24, 0xbc, 0xe2, 0x91, 0x1c,
-	0x63, 0x14, 0x6f, 0x05, 0x54, 0xa2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0x12, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xee, 0x7a, 0x5c, 0xb9, 0x02, 0x00, 0x00,
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0x7f, 0x6e, 0x93, 0x34, 0x9d, 0xb4, 0x3f, 0xc1, 0x36, 0x6d, 0x8d, 0xdb, 0x52, 0x6a,
+	0xa8, 0x48, 0x0b, 0xcd, 0xd2, 0x14, 0x04, 0x12, 0xb7, 0x22, 0x24, 0xb8, 0x20, 0x30, 0x9c, 0xb8,
+	0x44, 0x1b, 0x7b, 0x95, 0x46, 0x4d, 0xbc, 0xae, 0xd7, 0x29, 0x54, 0x55, 0x2f, 0x1c, 0x38, 0xa1,
+	0x5e, 0x38, 0xf0, 0x02, 0xa0, 0xf2, 0x28, 0xdc, 0xb9, 0x73, 0x40, 0x3c, 0x08, 0xf2, 0xee, 0x38,
+	0x7f, 0x71, 0xea, 0x4b, 0x95, 0x99, 0x9d, 0xd9, 0xef, 0x67, 0x66, 0x3d, 0x53, 0xd8, 0x10, 0xbe,
+	0x7b, 0xc0, 0x5a, 0x7e, 0x20, 0x0e, 0x79, 0x48, 0xf5, 0xdf, 0xe3, 0x5d, 0x7a, 0xd4, 0xe5, 0xe1,
+	0x49, 0x35, 0x08, 0x45, 0x24, 0xc8, 0xe2, 0x60, 0x48, 0x55, 0xff, 0x3d, 0xde, 0xb5, 0xca, 0x4d,
+	0xd1, 0x14, 0x2a, 0x82, 0xc6, 0xbf, 0x74, 0xb0, 0xb5, 0xe2, 0x0a, 0xd9, 0x11, 0x52, 0x5f, 0x30,
+	0x72, 0x93, 0xb5, 0xda, 0x14, 0xa2, 0xd9, 0xe6, 0x94, 0x05, 0x2d, 0xca, 0x7c, 0x5f, 0x44, 0x2c,
+	0x6a, 0x09, 0x5f, 0xe2, 0x69, 0x0a, 0x0a, 0xca, 0xc6, 0x21, 0x76, 0x19, 0xc8, 0xab, 0xf8, 0xbe,
+	0x97, 0x2c, 0x64, 0x1d, 0xe9, 0xf0, 0xa3, 0x2e, 0x97, 0x91, 0xed, 0xc0, 0xc2, 0x90, 0x57, 0x06,
+	0xc2, 0x97, 0x9c, 0x3c, 0x86, 0x42, 0xa0, 0x3c, 0xa6, 0x71, 0xc3, 0xa8, 0x94, 0x6a, 0x6b, 0xd5,
+	0x7f, 0x16, 0x52, 0xd5, 0x69, 0xfb, 0xb9, 0x1f, 0xbf, 0xd6, 0xff, 0x73, 0x30, 0xc5, 0x36, 0x61,
+	0x09, 0xef, 0xec, 0x4a, 0xfe, 0x3a, 0x62, 0x11, 0x4f, 0xd4, 0x5c, 0x58, 0x1e, 0x3b, 0x41, 0xc5,
+	0x67, 0x50, 0x0a, 0x62, 0x6f, 0x5d, 0xc6, 0x6e, 0x94, 0xdd, 0x48, 0x95, 0x4d, 0xf2, 0x51, 0x1a,
+	0x82, 0x9e, 0xc7, 0xae, 0xc2, 0x55, 0x25, 0xf2, 0x86, 0x35, 0xda, 0x89, 0x32, 0xb9, 0x06, 0xc5,
+	0x28, 0xb6, 0xeb, 0x2d, 0x4f, 0xdd, 0x9d, 0x73, 0x66, 0x94, 0xfd, 0xdc, 0xb3, 0x5f, 0x00, 0x19,
+	0x8c, 0x47, 0x9e, 0x47, 0x90, 0x57, 0x01, 0x48, 0xb2, 0x9a, 0x42, 0xa2, 0x92, 0x10, 0x42, 0x27,
+	0xf4, 0x1a, 0xad, 0x8e, 0x7a, 0x8d, 0xae, 0xc1, 0xc2, 0x90, 0x17, 0x65, 0x56, 0x60, 0x36, 0xe1,
+	0x8a, 0x7b, 0x3d, 0x5d, 0xc9, 0x39, 0x45, 0x04, 0x93, 0xf6, 0x1e, 0xb6, 0xeb, 0xc9, 0x41, 0x2b,
+	0xd8, 0x67, 0x6d, 0xe6, 0xbb, 0xbd, 0x7a, 0x4c, 0x98, 0x61, 0x9e, 0x17, 0x72, 0xa9, 0x5f, 0x68,
+	0xd6, 0x49, 0x4c, 0xbb, 0x06, 0xe6, 0x78, 0x12, 0xaa, 0x2d, 0x41, 0x81, 0x75, 0x44, 0xd7, 0x8f,
+	0xb0, 0x07, 0x68, 0xd9, 0xf7, 0x61, 0xb9, 0x0f, 0xf7, 0x54, 0xba, 0xa1, 0x78, 0x97, 0xa1, 0x71,
+	0x9f, 0x0c, 0x30, 0xc7, 0xd3, 0xfa, 0x52, 0x5c, 0x79, 0x12, 0x29, 0x6d, 0xc5, 0x7e, 0x19, 0x31,
+	0xf7, 0x50, 0x9a, 0x53, 0xda, 0xaf, 0x2d, 0x52, 0x86, 0x7c, 0x43, 0xf8, 0x9e, 0x34, 0xa7, 0x95,
+	0x5b, 0x1b, 0xe4, 0x0a, 0x4c, 0x07, 0x22, 0x32, 0x73, 0xca, 0x17, 0xff, 0x8c, 0x0b, 0xf7, 0x78,
+	0x20, 0x64, 0x2b, 0x32, 0xf3, 0x1a, 0x07, 0x4d, 0x7b, 0x09, 0xca, 0x8a, 0xc6, 0xe1, 0x92, 0x87,
+	0xc7, 0xfd, 0xce, 0x7f, 0x35, 0x60, 0x71, 0xe4, 0x00, 0x19, 0x6f, 0xc2, 0xbc, 0xaa, 0x45, 0xd6,
+	0x87, 0x50, 0xe7, 0xb4, 0x53, 0x17, 0x14, 0x07, 0xb9, 0x07, 0xad, 0xa0, 0xde, 0xd0, 0xbd, 0x4c,
+	0xb8, 0xe7, 0xdc, 0x7e, 0x7f, 0x25, 0xd9, 0x84, 0xff, 0x3b, 0xc2, 0xeb, 0xb6, 0x79, 0x12, 0xa6,
+	0xca, 0x98, 0x75, 0xe6, 0xb5, 0x17, 0xe3, 0x88, 0x05, 0x45, 0x3c, 0xf7, 0x54, 0x4d, 0x45, 0xa7,
+	0x67, 0xd7, 0x2e, 0x8a, 0x90, 0x57, 0x98, 0xe4, 0xa3, 0x01, 0x05, 0x3d, 0x58, 0x64, 0x2b, 0xe5,
+	0xb3, 0x1b, 0x9f, 0x64, 0x6b, 0x3b, 0x4b, 0xa8, 0x2e, 0xdc, 0xde, 0xfc, 0xf0, 0xf3, 0xcf, 0xe7,
+	0xa9, 0x75, 0xb2, 0x46, 0x53, 0xf6, 0x86, 0x56, 0xff, 0x62, 0x00, 0xf4, 0x47, 0x8d, 0xec, 0x4c,
+	0x56, 0x18, 0x19, 0x76, 0xab, 0x9a, 0x35, 0x1c, 0xa1, 0xb6, 0x15, 0xd4, 0x2d, 0x62, 0xa7, 0x42,
+	0xf5, 0xd6, 0x03, 0x39, 0x37, 0x20, 0xaf, 0xbe, 0x3a, 0x52, 0x99, 0xa4, 0x32, 0xb8, 0x02, 0xac,
+	0xad, 0x0c, 0x91, 0x88, 0x72, 0x4f, 0xa1, 0x6c, 0x93, 0x4a, 0x0a, 0x8a, 0xfe, 0x40, 0xe8, 0x69,
+	0x32, 0x19, 0x67, 0xea, 0xcd, 0xf4, 0x68, 0x93, 0xcb, 0x75, 0xb2, 0xbd, 0xd9, 0xf0, 0xa6, 0xb8,
+	0xf4, 0xcd, 0x34, 0x13, 0xf9, 0x66, 0x40, 0x69, 0x60, 0xf4, 0xc9, 0xc4, 0x57, 0x18, 0x5f, 0x2c,
+	0x16, 0xcd, 0x1c, 0x8f, 0x5c, 0x0f, 0x14, 0x17, 0x25, 0x3b, 0x29, 0x5c, 0x83, 0xc3, 0x43, 0x4f,
+	0x71, 0x4b, 0x9d, 0x91, 0x0b, 0x03, 0x4a, 0x03, 0x7b, 0x63, 0x32, 0xe7, 0xf8, 0x5e, 0xb2, 0x68,
+	0xe6, 0x78, 0xe4, 0x7c, 0xa8, 0x38, 0x77, 0x09, 0xcd, 0xfa, 0xa6, 0x14, 0x37, 0xd6, 0xb9, 0x01,
+	0xc5, 0x64, 0x75, 0x90, 0x3b, 0x93, 0x64, 0x47, 0x36, 0x8f, 0x75, 0x37, 0x5b, 0x30, 0x02, 0xde,
+	0x56, 0x80, 0x1b, 0x64, 0x3d, 0x05, 0x30, 0xc4, 0x84, 0xfd, 0x85, 0xef, 0xbf, 0xaf, 0x1b, 0x6f,
+	0xe7, 0xdf, 0xe3, 0x51, 0x74, 0x12, 0x70, 0xd9, 0x28, 0xa8, 0xff, 0xf2, 0x7b, 0x7f, 0x07, 0x00,
+	0x7f, 0xc9, 0x15, 0x13, 0x95, 0x08, 0x00, 0x00,
 }
 
-func (this *QueryTableRequest) Equal(that interface{}) bool {
+func (this *QueryParamsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*QueryTableRequest)
+	that1, ok := that.(*QueryParamsRequest)
 	if !ok {
-		that2, ok := that.(QueryTableRequest)
+		that2, ok := that.(QueryParamsRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -226,22 +682,19 @@ func (this *QueryTableRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.TableId != that1.TableId {
-		return false
-	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *QueryTableResponse) Equal(that interface{}) bool {
+func (this *QueryParamsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*QueryTableResponse)
+	that1, ok := that.(*QueryParamsResponse)
 	if !ok {
-		that2, ok := that.(QueryTableResponse)
+		that2, ok := that.(QueryParamsResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -253,7 +706,7 @@ func (this *QueryTableResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Table.Equal(&that1.Table) {
+	if !this.Params.Equal(&that1.Params) {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -261,14 +714,14 @@ func (this *QueryTableResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *QueryTablesRequest) Equal(that interface{}) bool {
+func (this *QueryPauseStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*QueryTablesRequest)
+	that1, ok := that.(*QueryPauseStateRequest)
 	if !ok {
-		that2, ok := that.(QueryTablesRequest)
+		that2, ok := that.(QueryPauseStateRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -285,14 +738,14 @@ func (this *QueryTablesRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *QueryTablesResponse) Equal(that interface{}) bool {
+func (this *QueryPauseStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*QueryTablesResponse)
+	that1, ok := that.(*QueryPauseStateResponse)
 	if !ok {
-		that2, ok := that.(QueryTablesResponse)
+		that2, ok := that.(QueryPauseStateResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -304,34 +757,328 @@ func (this *QueryTablesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.TableIds) != len(that1.TableIds) {
+	if !this.PauseState.Equal(&that1.PauseState) {
 		return false
 	}
-	for i := range this.TableIds {
-		if this.TableIds[i] != that1.TableIds[i] {
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *QueryTableRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryTableRequest)
+	if !ok {
+		that2, ok := that.(QueryTableRequest)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TableId != that1.TableId {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
+func (this *QueryTableResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+	that1, ok := that.(*QueryTableResponse)
+	if !ok {
+		that2, ok := that.(QueryTableResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Table.Equal(&that1.Table) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *QueryTablesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryTablesRequest)
+	if !ok {
+		that2, ok := that.(QueryTablesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *QueryTablesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryTablesResponse)
+	if !ok {
+		that2, ok := that.(QueryTablesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.TableIds) != len(that1.TableIds) {
+		return false
+	}
+	for i := range this.TableIds {
+		if this.TableIds[i] != that1.TableIds[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *QueryChipBalanceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryChipBalanceRequest)
+	if !ok {
+		that2, ok := that.(QueryChipBalanceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *QueryChipBalanceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryChipBalanceResponse)
+	if !ok {
+		that2, ok := that.(QueryChipBalanceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *QueryTableEscrowRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryTableEscrowRequest)
+	if !ok {
+		that2, ok := that.(QueryTableEscrowRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TableId != that1.TableId {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *QueryTableEscrowResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryTableEscrowResponse)
+	if !ok {
+		that2, ok := that.(QueryTableEscrowResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Escrow != that1.Escrow {
+		return false
+	}
+	if this.Stacks != that1.Stacks {
+		return false
+	}
+	if this.Bonds != that1.Bonds {
+		return false
+	}
+	if this.Pot != that1.Pot {
+		return false
+	}
+	if this.Deposit != that1.Deposit {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *QueryReservesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryReservesRequest)
+	if !ok {
+		that2, ok := that.(QueryReservesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *QueryReservesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryReservesResponse)
+	if !ok {
+		that2, ok := that.(QueryReservesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TablesEscrow != that1.TablesEscrow {
+		return false
+	}
+	if this.ChipBalances != that1.ChipBalances {
+		return false
+	}
+	if this.ModuleBalance != that1.ModuleBalance {
+		return false
+	}
+	if this.Balanced != that1.Balanced {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
 // QueryClient is the client API for Query service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	PauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error)
 	Table(ctx context.Context, in *QueryTableRequest, opts ...grpc.CallOption) (*QueryTableResponse, error)
 	Tables(ctx context.Context, in *QueryTablesRequest, opts ...grpc.CallOption) (*QueryTablesResponse, error)
+	ChipBalance(ctx context.Context, in *QueryChipBalanceRequest, opts ...grpc.CallOption) (*QueryChipBalanceResponse, error)
+	// TableEscrow returns the chips recorded as escrowed for one table,
+	// alongside the stack/bond/pot breakdown of its current state.
+	TableEscrow(ctx context.Context, in *QueryTableEscrowRequest, opts ...grpc.CallOption) (*QueryTableEscrowResponse, error)
+	// Reserves compares the module's liabilities (all table escrows plus
+	// cashier balances) with the poker module account balance.
+	Reserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error)
 }
 
 type queryClient struct {
@@ -342,6 +1089,24 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error) {
+	out := new(QueryPauseStateResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Query/PauseState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Table(ctx context.Context, in *QueryTableRequest, opts ...grpc.CallOption) (*QueryTableResponse, error) {
 	out := new(QueryTableResponse)
 	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Query/Table", in, out, opts...)
@@ -360,27 +1125,114 @@ func (c *queryClient) Tables(ctx context.Context, in *QueryTablesRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ChipBalance(ctx context.Context, in *QueryChipBalanceRequest, opts ...grpc.CallOption) (*QueryChipBalanceResponse, error) {
+	out := new(QueryChipBalanceResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Query/ChipBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TableEscrow(ctx context.Context, in *QueryTableEscrowRequest, opts ...grpc.CallOption) (*QueryTableEscrowResponse, error) {
+	out := new(QueryTableEscrowResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Query/TableEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Reserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error) {
+	out := new(QueryReservesResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Query/Reserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	PauseState(context.Context, *QueryPauseStateRequest) (*QueryPauseStateResponse, error)
 	Table(context.Context, *QueryTableRequest) (*QueryTableResponse, error)
 	Tables(context.Context, *QueryTablesRequest) (*QueryTablesResponse, error)
+	ChipBalance(context.Context, *QueryChipBalanceRequest) (*QueryChipBalanceResponse, error)
+	// TableEscrow returns the chips recorded as escrowed for one table,
+	// alongside the stack/bond/pot breakdown of its current state.
+	TableEscrow(context.Context, *QueryTableEscrowRequest) (*QueryTableEscrowResponse, error)
+	// Reserves compares the module's liabilities (all table escrows plus
+	// cashier balances) with the poker module account balance.
+	Reserves(context.Context, *QueryReservesRequest) (*QueryReservesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) PauseState(ctx context.Context, req *QueryPauseStateRequest) (*QueryPauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseState not implemented")
+}
 func (*UnimplementedQueryServer) Table(ctx context.Context, req *QueryTableRequest) (*QueryTableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Table not implemented")
 }
 func (*UnimplementedQueryServer) Tables(ctx context.Context, req *QueryTablesRequest) (*QueryTablesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Tables not implemented")
 }
+func (*UnimplementedQueryServer) ChipBalance(ctx context.Context, req *QueryChipBalanceRequest) (*QueryChipBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChipBalance not implemented")
+}
+func (*UnimplementedQueryServer) TableEscrow(ctx context.Context, req *QueryTableEscrowRequest) (*QueryTableEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TableEscrow not implemented")
+}
+func (*UnimplementedQueryServer) Reserves(ctx context.Context, req *QueryReservesRequest) (*QueryReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reserves not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.poker.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.poker.v1.Query/PauseState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseState(ctx, req.(*QueryPauseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Table_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTableRequest)
 	if err := dec(in); err != nil {
@@ -417,11 +1269,73 @@ func _Query_Tables_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ChipBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChipBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChipBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.poker.v1.Query/ChipBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChipBalance(ctx, req.(*QueryChipBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TableEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTableEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TableEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.poker.v1.Query/TableEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TableEscrow(ctx, req.(*QueryTableEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Reserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReservesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.poker.v1.Query/Reserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reserves(ctx, req.(*QueryReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "onchainpoker.poker.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PauseState",
+			Handler:    _Query_PauseState_Handler,
+		},
 		{
 			MethodName: "Table",
 			Handler:    _Query_Table_Handler,
@@ -430,6 +1344,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Tables",
 			Handler:    _Query_Tables_Handler,
 		},
+		{
+			MethodName: "ChipBalance",
+			Handler:    _Query_ChipBalance_Handler,
+		},
+		{
+			MethodName: "TableEscrow",
+			Handler:    _Query_TableEscrow_Handler,
+		},
+		{
+			MethodName: "Reserves",
+			Handler:    _Query_Reserves_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "onchainpoker/poker/v1/query.proto",
@@ -0,0 +1,420 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+)
+
+const (
+	// NumSeats is the fixed table size; per-seat arrays always have this length.
+	NumSeats = 9
+
+	// NumHolePos is the length of DealerMeta.hole_pos (two cards per seat).
+	NumHolePos = 2 * NumSeats
+
+	// UnsetPos marks an unassigned deck position / unknown card.
+	UnsetPos = 255
+
+	// NumCards is the deck size; card ids are 0..NumCards-1.
+	NumCards = 52
+)
+
+// ValidateTableParams checks the structural invariants the state machine
+// relies on. Policy caps enforced by MsgCreateTable (label length, timeout
+// and amount ceilings) are deliberately not re-checked here so tables created
+// under older limits still import.
+func ValidateTableParams(p TableParams) error {
+	if p.MaxPlayers != NumSeats {
+		return fmt.Errorf("max_players must be %d", NumSeats)
+	}
+	if p.SmallBlind == 0 || p.BigBlind == 0 || p.BigBlind < p.SmallBlind {
+		return fmt.Errorf("invalid blinds %d/%d", p.SmallBlind, p.BigBlind)
+	}
+	if p.MinBuyIn == 0 || p.MaxBuyIn == 0 || p.MaxBuyIn < p.MinBuyIn {
+		return fmt.Errorf("invalid buy-in range %d..%d", p.MinBuyIn, p.MaxBuyIn)
+	}
+	if p.ActionTimeoutSecs > uint64(math.MaxInt64) {
+		return fmt.Errorf("action_timeout_secs exceeds int64 max")
+	}
+	if p.DealerTimeoutSecs > uint64(math.MaxInt64) {
+		return fmt.Errorf("dealer_timeout_secs exceeds int64 max")
+	}
+	if p.RakeBps != 0 {
+		return fmt.Errorf("rake_bps must be 0")
+	}
+	if _, ok := SlashDestination_name[int32(p.SlashDestination)]; !ok {
+		return fmt.Errorf("unknown slash_destination %d", p.SlashDestination)
+	}
+	if _, ok := AbortRefundPolicy_name[int32(p.AbortRefundPolicy)]; !ok {
+		return fmt.Errorf("unknown abort_refund_policy %d", p.AbortRefundPolicy)
+	}
+	// Legacy (pre-v2) tables carry a password hash without a salt.
+	if len(p.PasswordHash) != 0 && len(p.PasswordHash) != sha256.Size {
+		return fmt.Errorf("password_hash must be empty or %d bytes", sha256.Size)
+	}
+	if len(p.PasswordSalt) != 0 {
+		if len(p.PasswordHash) == 0 {
+			return fmt.Errorf("password_salt set without password_hash")
+		}
+		if len(p.PasswordSalt) < 16 || len(p.PasswordSalt) > 32 {
+			return fmt.Errorf("password_salt must be 16-32 bytes")
+		}
+	}
+	return nil
+}
+
+// Validate checks that a table is internally consistent: seat layout, hand
+// arrays and indices, phase/street/board consistency and dealer positions.
+func (t Table) Validate() error {
+	if t.Id == 0 {
+		return fmt.Errorf("table id must be > 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(t.Creator); err != nil {
+		return fmt.Errorf("invalid creator: %w", err)
+	}
+	if err := ValidateTableParams(t.Params); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	if t.NextHandId == 0 {
+		return fmt.Errorf("next_hand_id must be > 0")
+	}
+	if t.ButtonSeat < -1 || t.ButtonSeat >= NumSeats {
+		return fmt.Errorf("button_seat %d out of range", t.ButtonSeat)
+	}
+	if t.IdleSinceHeight < 0 {
+		return fmt.Errorf("idle_since_height must be >= 0")
+	}
+
+	if len(t.Seats) != NumSeats {
+		return fmt.Errorf("seats must have length %d, got %d", NumSeats, len(t.Seats))
+	}
+	players := make(map[string]int, NumSeats)
+	for i, s := range t.Seats {
+		if err := validateSeat(s); err != nil {
+			return fmt.Errorf("seat %d: %w", i, err)
+		}
+		if s == nil || s.Player == "" {
+			continue
+		}
+		if a := s.AutoTopUp; a != nil && (a.TargetStack == 0 || a.TargetStack > t.Params.MaxBuyIn || a.Allowance == 0) {
+			return fmt.Errorf("seat %d: invalid auto_top_up", i)
+		}
+		if prev, ok := players[s.Player]; ok {
+			return fmt.Errorf("player %s seated twice (seats %d and %d)", s.Player, prev, i)
+		}
+		players[s.Player] = i
+	}
+	if len(players) != 0 && t.IdleSinceHeight != 0 {
+		return fmt.Errorf("idle_since_height set on a table with seated players")
+	}
+
+	if t.Hand != nil {
+		if t.Hand.HandId == 0 || t.Hand.HandId >= t.NextHandId {
+			return fmt.Errorf("hand_id %d must be in [1, next_hand_id %d)", t.Hand.HandId, t.NextHandId)
+		}
+		if err := t.validateHand(); err != nil {
+			return fmt.Errorf("hand %d: %w", t.Hand.HandId, err)
+		}
+	}
+	if _, err := t.EscrowTotal(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateSeat(s *Seat) error {
+	if s == nil {
+		return nil
+	}
+	if len(s.Hole) != 0 && len(s.Hole) != 2 {
+		return fmt.Errorf("hole must have length 0 or 2, got %d", len(s.Hole))
+	}
+	for _, c := range s.Hole {
+		if c >= NumCards && c != UnsetPos {
+			return fmt.Errorf("invalid hole card %d", c)
+		}
+	}
+	if s.Player == "" {
+		if len(s.Pk) != 0 || s.Stack != 0 || s.Bond != 0 || s.LeavePending || s.AutoTopUp != nil {
+			return fmt.Errorf("empty seat carries pk, stack, bond, leave_pending or auto_top_up")
+		}
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Player); err != nil {
+		return fmt.Errorf("invalid player: %w", err)
+	}
+	if _, err := ocpcrypto.PointFromBytesCanonical(s.Pk); err != nil {
+		return fmt.Errorf("pk is not a canonical ristretto point")
+	}
+	return nil
+}
+
+// boardLenForStreet is the number of public board cards once street is dealt.
+func boardLenForStreet(s Street) int {
+	switch s {
+	case Street_STREET_FLOP:
+		return 3
+	case Street_STREET_TURN:
+		return 4
+	case Street_STREET_RIVER:
+		return 5
+	default:
+		return 0
+	}
+}
+
+func (t Table) validateHand() error {
+	h := t.Hand
+
+	if len(h.InHand) != NumSeats || len(h.Folded) != NumSeats || len(h.AllIn) != NumSeats ||
+		len(h.StreetCommit) != NumSeats || len(h.TotalCommit) != NumSeats || len(h.LastIntervalActed) != NumSeats {
+		return fmt.Errorf("per-seat arrays must have length %d", NumSeats)
+	}
+	for _, seat := range []int32{h.ButtonSeat, h.SmallBlindSeat, h.BigBlindSeat} {
+		if seat < 0 || seat >= NumSeats {
+			return fmt.Errorf("button/blind seat %d out of range", seat)
+		}
+	}
+	if h.ActionOn < -1 || h.ActionOn >= NumSeats {
+		return fmt.Errorf("action_on %d out of range", h.ActionOn)
+	}
+	if h.ActionDeadline < 0 {
+		return fmt.Errorf("action_deadline must be >= 0")
+	}
+	if len(h.SlashCredit) != 0 && len(h.SlashCredit) != NumSeats {
+		return fmt.Errorf("slash_credit must have length 0 or %d", NumSeats)
+	}
+	for i, c := range h.SlashCredit {
+		if c != 0 && (!h.InHand[i] || t.Seats[i] == nil || t.Seats[i].Player == "") {
+			return fmt.Errorf("seat %d holds slash credit but is not seated in hand", i)
+		}
+	}
+
+	inHand := 0
+	for i := 0; i < NumSeats; i++ {
+		if !h.InHand[i] {
+			if h.Folded[i] || h.AllIn[i] || h.StreetCommit[i] != 0 || h.TotalCommit[i] != 0 {
+				return fmt.Errorf("seat %d not in hand but carries hand state", i)
+			}
+			continue
+		}
+		inHand++
+		// A folded player may leave mid-hand; their commit stays in the pot.
+		if !h.Folded[i] && (t.Seats[i] == nil || t.Seats[i].Player == "") {
+			return fmt.Errorf("seat %d is live in hand but empty", i)
+		}
+		if h.StreetCommit[i] > h.TotalCommit[i] {
+			return fmt.Errorf("seat %d street_commit exceeds total_commit", i)
+		}
+		if h.LastIntervalActed[i] < -1 || (h.LastIntervalActed[i] >= 0 && uint64(h.LastIntervalActed[i]) > h.IntervalId) {
+			return fmt.Errorf("seat %d last_interval_acted %d out of range", i, h.LastIntervalActed[i])
+		}
+	}
+	if inHand < 2 {
+		return fmt.Errorf("hand needs at least 2 seats in hand, got %d", inHand)
+	}
+	if h.ActionOn >= 0 {
+		if !h.InHand[h.ActionOn] || h.Folded[h.ActionOn] {
+			return fmt.Errorf("action_on seat %d is not live in hand", h.ActionOn)
+		}
+	}
+
+	// Board: unique card ids, length consistent with phase/street.
+	if len(h.Board) > 5 {
+		return fmt.Errorf("board has %d cards", len(h.Board))
+	}
+	seen := make(map[uint32]bool, len(h.Board))
+	for _, c := range h.Board {
+		if c >= NumCards {
+			return fmt.Errorf("invalid board card %d", c)
+		}
+		if seen[c] {
+			return fmt.Errorf("duplicate board card %d", c)
+		}
+		seen[c] = true
+	}
+
+	wantBoard := boardLenForStreet(h.Street)
+	switch h.Phase {
+	case HandPhase_HAND_PHASE_SHUFFLE:
+		if h.Street != Street_STREET_PREFLOP || len(h.Board) != 0 {
+			return fmt.Errorf("shuffle phase must be preflop with an empty board")
+		}
+	case HandPhase_HAND_PHASE_BETTING:
+		if h.Street == Street_STREET_UNSPECIFIED || len(h.Board) != wantBoard {
+			return fmt.Errorf("betting on %s with %d board cards", h.Street, len(h.Board))
+		}
+		if h.ActionOn < 0 {
+			return fmt.Errorf("betting phase without action_on")
+		}
+	case HandPhase_HAND_PHASE_AWAIT_FLOP:
+		if h.Street != Street_STREET_PREFLOP || len(h.Board) >= 3 {
+			return fmt.Errorf("awaiting flop on %s with %d board cards", h.Street, len(h.Board))
+		}
+	case HandPhase_HAND_PHASE_AWAIT_TURN:
+		if h.Street != Street_STREET_FLOP || len(h.Board) != 3 {
+			return fmt.Errorf("awaiting turn on %s with %d board cards", h.Street, len(h.Board))
+		}
+	case HandPhase_HAND_PHASE_AWAIT_RIVER:
+		if h.Street != Street_STREET_TURN || len(h.Board) != 4 {
+			return fmt.Errorf("awaiting river on %s with %d board cards", h.Street, len(h.Board))
+		}
+	case HandPhase_HAND_PHASE_AWAIT_SHOWDOWN, HandPhase_HAND_PHASE_SHOWDOWN:
+		if h.Street != Street_STREET_RIVER || len(h.Board) != 5 {
+			return fmt.Errorf("showdown on %s with %d board cards", h.Street, len(h.Board))
+		}
+	default:
+		return fmt.Errorf("invalid phase %s", h.Phase)
+	}
+	switch h.Phase {
+	case HandPhase_HAND_PHASE_AWAIT_FLOP, HandPhase_HAND_PHASE_AWAIT_TURN,
+		HandPhase_HAND_PHASE_AWAIT_RIVER, HandPhase_HAND_PHASE_AWAIT_SHOWDOWN:
+		if h.ActionOn != -1 {
+			return fmt.Errorf("action_on must be -1 while awaiting the dealer")
+		}
+	}
+
+	// Revealed hole cards of this hand must not collide with each other or
+	// the board.
+	for i, s := range t.Seats {
+		if s == nil || !h.InHand[i] {
+			continue
+		}
+		for _, c := range s.Hole {
+			if c == UnsetPos {
+				continue
+			}
+			if seen[c] {
+				return fmt.Errorf("seat %d hole card %d already dealt", i, c)
+			}
+			seen[c] = true
+		}
+	}
+
+	if h.Dealer != nil {
+		if err := validateDealerMeta(h); err != nil {
+			return fmt.Errorf("dealer: %w", err)
+		}
+	}
+	return nil
+}
+
+func validateDealerMeta(h *Hand) error {
+	d := h.Dealer
+	if len(d.HolePos) != NumHolePos {
+		return fmt.Errorf("hole_pos must have length %d", NumHolePos)
+	}
+	if d.DeckSize > NumCards {
+		return fmt.Errorf("deck_size %d exceeds %d", d.DeckSize, NumCards)
+	}
+	if d.RevealDeadline < 0 {
+		return fmt.Errorf("reveal_deadline must be >= 0")
+	}
+	if h.Phase != HandPhase_HAND_PHASE_SHUFFLE && !d.DeckFinalized {
+		return fmt.Errorf("phase %s requires a finalized deck", h.Phase)
+	}
+
+	if !d.DeckFinalized {
+		for _, p := range d.HolePos {
+			if p != UnsetPos {
+				return fmt.Errorf("hole_pos assigned before deck finalization")
+			}
+		}
+		if d.RevealPos != UnsetPos {
+			return fmt.Errorf("reveal_pos set before deck finalization")
+		}
+		return nil
+	}
+
+	used := make(map[uint32]bool, NumHolePos)
+	for seat := 0; seat < NumSeats; seat++ {
+		for c := 0; c < 2; c++ {
+			p := d.HolePos[seat*2+c]
+			if p == UnsetPos {
+				continue
+			}
+			if !h.InHand[seat] {
+				return fmt.Errorf("hole_pos assigned to seat %d which is not in hand", seat)
+			}
+			if p >= d.DeckSize {
+				return fmt.Errorf("hole_pos %d out of deck bounds %d", p, d.DeckSize)
+			}
+			if used[p] {
+				return fmt.Errorf("duplicate hole_pos %d", p)
+			}
+			used[p] = true
+		}
+	}
+	if d.Cursor > d.DeckSize || int(d.Cursor)+len(h.Board) > int(d.DeckSize) {
+		return fmt.Errorf("cursor %d with %d board cards exceeds deck_size %d", d.Cursor, len(h.Board), d.DeckSize)
+	}
+	if used[d.Cursor] {
+		return fmt.Errorf("cursor %d overlaps hole positions", d.Cursor)
+	}
+	if d.RevealPos != UnsetPos && d.RevealPos >= d.DeckSize {
+		return fmt.Errorf("reveal_pos %d out of deck bounds %d", d.RevealPos, d.DeckSize)
+	}
+	return nil
+}
+
+// EscrowTotal returns the chips the poker module account holds for this
+// table: seat stacks and bonds, the pot (commits, dead money and pending
+// slash credit) of the current hand and the creator's creation deposit.
+func (t Table) EscrowTotal() (uint64, error) {
+	stacks, bonds, pot, err := t.EscrowBreakdown()
+	if err != nil {
+		return 0, err
+	}
+	total := stacks
+	for _, v := range []uint64{bonds, pot, t.CreationDeposit} {
+		if total > math.MaxUint64-v {
+			return 0, fmt.Errorf("table %d escrow overflows uint64", t.Id)
+		}
+		total += v
+	}
+	return total, nil
+}
+
+// EscrowBreakdown splits the seat and hand part of EscrowTotal into seat
+// stacks, seat bonds and the current hand's pot.
+func (t Table) EscrowBreakdown() (stacks, bonds, pot uint64, err error) {
+	add := func(total *uint64, v uint64) error {
+		if *total > math.MaxUint64-v {
+			return fmt.Errorf("table %d escrow overflows uint64", t.Id)
+		}
+		*total += v
+		return nil
+	}
+	for _, s := range t.Seats {
+		if s == nil {
+			continue
+		}
+		if err := add(&stacks, s.Stack); err != nil {
+			return 0, 0, 0, err
+		}
+		if err := add(&bonds, s.Bond); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+	if t.Hand != nil {
+		for _, c := range t.Hand.TotalCommit {
+			if err := add(&pot, c); err != nil {
+				return 0, 0, 0, err
+			}
+		}
+		if err := add(&pot, t.Hand.DeadMoney); err != nil {
+			return 0, 0, 0, err
+		}
+		for _, c := range t.Hand.SlashCredit {
+			if err := add(&pot, c); err != nil {
+				return 0, 0, 0, err
+			}
+		}
+	}
+	return stacks, bonds, pot, nil
+}
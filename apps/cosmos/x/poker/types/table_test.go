@@ -0,0 +1,210 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onchainpoker/apps/cosmos/internal/ocpcrypto"
+)
+
+func testAddr(b byte) string {
+	return sdk.AccAddress(bytes.Repeat([]byte{b}, 20)).String()
+}
+
+func testPk(n uint64) []byte {
+	return ocpcrypto.MulBase(ocpcrypto.ScalarFromUint64(n)).Bytes()
+}
+
+func testParams() TableParams {
+	return TableParams{
+		MaxPlayers:        NumSeats,
+		SmallBlind:        1,
+		BigBlind:          2,
+		MinBuyIn:          100,
+		MaxBuyIn:          1000,
+		ActionTimeoutSecs: 30,
+		DealerTimeoutSecs: 120,
+		PlayerBond:        10,
+	}
+}
+
+func unsetHolePos() []uint32 {
+	out := make([]uint32, NumHolePos)
+	for i := range out {
+		out[i] = UnsetPos
+	}
+	return out
+}
+
+// testTable returns a heads-up table whose flop is awaiting the dealer:
+// hole positions 0..3 are assigned to seats 0 and 1, and the cursor is at 4.
+func testTable() Table {
+	seats := make([]*Seat, NumSeats)
+	for i := range seats {
+		seats[i] = &Seat{Hole: []uint32{UnsetPos, UnsetPos}}
+	}
+	seats[0] = &Seat{Player: testAddr(1), Pk: testPk(1), Stack: 98, Bond: 10, Hole: []uint32{UnsetPos, UnsetPos}}
+	seats[1] = &Seat{Player: testAddr(2), Pk: testPk(2), Stack: 98, Bond: 10, Hole: []uint32{UnsetPos, UnsetPos}}
+
+	holePos := unsetHolePos()
+	holePos[0], holePos[2], holePos[1], holePos[3] = 0, 1, 2, 3
+
+	return Table{
+		Id:         1,
+		Creator:    testAddr(1),
+		Params:     testParams(),
+		Seats:      seats,
+		NextHandId: 2,
+		ButtonSeat: 0,
+		Hand: &Hand{
+			HandId:            1,
+			Phase:             HandPhase_HAND_PHASE_AWAIT_FLOP,
+			Street:            Street_STREET_PREFLOP,
+			ButtonSeat:        0,
+			SmallBlindSeat:    0,
+			BigBlindSeat:      1,
+			ActionOn:          -1,
+			InHand:            []bool{true, true, false, false, false, false, false, false, false},
+			Folded:            make([]bool, NumSeats),
+			AllIn:             make([]bool, NumSeats),
+			StreetCommit:      make([]uint64, NumSeats),
+			TotalCommit:       []uint64{2, 2, 0, 0, 0, 0, 0, 0, 0},
+			LastIntervalActed: []int32{0, 0, -1, -1, -1, -1, -1, -1, -1},
+			Dealer: &DealerMeta{
+				EpochId:       1,
+				DeckSize:      NumCards,
+				DeckFinalized: true,
+				HolePos:       holePos,
+				Cursor:        4,
+				RevealPos:     4,
+			},
+		},
+	}
+}
+
+func TestTableValidate_Valid(t *testing.T) {
+	tbl := testTable()
+	require.NoError(t, tbl.Validate())
+
+	escrow, err := tbl.EscrowTotal()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2*(98+10)+4), escrow)
+}
+
+func TestTableValidate_Rejects(t *testing.T) {
+	cases := map[string]func(tbl *Table){
+		"short seats":           func(tbl *Table) { tbl.Seats = tbl.Seats[:8] },
+		"bad pk":                func(tbl *Table) { tbl.Seats[0].Pk = bytes.Repeat([]byte{0xff}, 32) },
+		"player seated twice":   func(tbl *Table) { tbl.Seats[1].Player = tbl.Seats[0].Player },
+		"empty seat with stack": func(tbl *Table) { tbl.Seats[5].Stack = 1 },
+		"empty seat leaving":    func(tbl *Table) { tbl.Seats[5].LeavePending = true },
+		"idle with players":     func(tbl *Table) { tbl.IdleSinceHeight = 7 },
+		"auto top-up over max": func(tbl *Table) {
+			tbl.Seats[0].AutoTopUp = &AutoTopUp{TargetStack: tbl.Params.MaxBuyIn + 1, Allowance: 1}
+		},
+		"rake":                  func(tbl *Table) { tbl.Params.RakeBps = 10 },
+		"hand id not allocated": func(tbl *Table) { tbl.Hand.HandId = 2 },
+		"short hand array":      func(tbl *Table) { tbl.Hand.Folded = tbl.Hand.Folded[:3] },
+		"action_on range":       func(tbl *Table) { tbl.Hand.ActionOn = 9 },
+		"action_on awaiting":    func(tbl *Table) { tbl.Hand.ActionOn = 0 },
+		"street mismatch":       func(tbl *Table) { tbl.Hand.Street = Street_STREET_TURN },
+		"commit outside hand":   func(tbl *Table) { tbl.Hand.TotalCommit[4] = 1 },
+		"live seat empty": func(tbl *Table) {
+			tbl.Seats[1] = &Seat{Hole: []uint32{UnsetPos, UnsetPos}}
+		},
+		"duplicate board card": func(tbl *Table) { tbl.Hand.Board = []uint32{7, 7} },
+		"hole_pos length":      func(tbl *Table) { tbl.Hand.Dealer.HolePos = tbl.Hand.Dealer.HolePos[:17] },
+		"duplicate hole_pos":   func(tbl *Table) { tbl.Hand.Dealer.HolePos[3] = 0 },
+		"hole_pos out of hand": func(tbl *Table) { tbl.Hand.Dealer.HolePos[8] = 10 },
+		"cursor past deck":     func(tbl *Table) { tbl.Hand.Dealer.Cursor = 51; tbl.Hand.Board = []uint32{1, 2} },
+		"unfinalized deck":     func(tbl *Table) { tbl.Hand.Dealer.DeckFinalized = false },
+	}
+	for name, mutate := range cases {
+		t.Run(name, func(t *testing.T) {
+			tbl := testTable()
+			mutate(&tbl)
+			require.Error(t, tbl.Validate())
+		})
+	}
+}
+
+func TestTableValidate_FoldedPlayerMayLeave(t *testing.T) {
+	tbl := testTable()
+	tbl.Hand.InHand[2] = true
+	tbl.Hand.Folded[2] = true
+	tbl.Hand.TotalCommit[2] = 1
+	require.NoError(t, tbl.Validate())
+}
+
+func TestValidateGenesis_Escrow(t *testing.T) {
+	gs := GenesisState{NextTableId: 2, Tables: []Table{testTable()}, Params: DefaultParams()}
+	require.NoError(t, ValidateGenesis(&gs))
+
+	total, err := gs.EscrowTotal()
+	require.NoError(t, err)
+	require.Equal(t, uint64(220), total)
+
+	gs.Tables[0].Seats[0].Stack = ^uint64(0)
+	require.Error(t, ValidateGenesis(&gs))
+}
+
+func TestValidateGenesis_Params(t *testing.T) {
+	gs := DefaultGenesisState()
+	require.NoError(t, ValidateGenesis(gs))
+
+	gs.Params.MaxBuyInUchips = 0
+	require.ErrorContains(t, ValidateGenesis(gs), "max_buy_in_uchips")
+
+	gs.Params = DefaultParams()
+	gs.Params.MaxActionTimeoutSecs = 24*60*60 + 1
+	require.ErrorContains(t, ValidateGenesis(gs), "max_action_timeout_secs")
+
+	gs.Params = DefaultParams()
+	gs.Params.IdleTableBlocks = 1
+	require.ErrorContains(t, ValidateGenesis(gs), "idle_table_blocks")
+
+	gs.Params = DefaultParams()
+	gs.Params.TableCreationDeposit = gs.Params.MaxBuyInUchips + 1
+	require.ErrorContains(t, ValidateGenesis(gs), "table_creation_deposit")
+}
+
+func TestPauseStateValidate(t *testing.T) {
+	require.NoError(t, PauseState{
+		MsgTypeUrls: []string{"/onchainpoker.poker.v1.MsgSit", DealerMsgInitHandTypeURL},
+		TableIds:    []uint64{1, 2},
+	}.Validate())
+
+	for name, p := range map[string]PauseState{
+		"foreign msg":   {MsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		"unpausable":    {MsgTypeUrls: []string{"/onchainpoker.dealer.v1.MsgTimeout"}},
+		"duplicate msg": {MsgTypeUrls: []string{"/onchainpoker.poker.v1.MsgSit", "/onchainpoker.poker.v1.MsgSit"}},
+		"zero table":    {TableIds: []uint64{0}},
+		"dup table":     {TableIds: []uint64{4, 4}},
+	} {
+		require.Error(t, p.Validate(), name)
+	}
+}
+
+func TestValidateGenesis_ChipBalances(t *testing.T) {
+	player := testAddr(0x51)
+	gs := GenesisState{
+		NextTableId:  2,
+		Tables:       []Table{testTable()},
+		Params:       DefaultParams(),
+		ChipBalances: []ChipBalance{{Address: player, Amount: 30}},
+	}
+	require.NoError(t, ValidateGenesis(&gs))
+	total, err := gs.EscrowTotal()
+	require.NoError(t, err)
+	require.Equal(t, uint64(250), total, "table escrow 220 + cashier 30")
+
+	gs.ChipBalances = append(gs.ChipBalances, ChipBalance{Address: player, Amount: 1})
+	require.ErrorContains(t, ValidateGenesis(&gs), "duplicate chip balance")
+
+	gs.ChipBalances = []ChipBalance{{Address: player}}
+	require.ErrorContains(t, ValidateGenesis(&gs), "must be > 0")
+}
@@ -45,10 +45,14 @@ type MsgCreateTable struct {
 	// Empty = table has no password. Plaintext password never crosses the wire.
 	PasswordCommitment []byte `protobuf:"bytes,13,opt,name=password_commitment,json=passwordCommitment,proto3" json:"password_commitment,omitempty"`
 	// 32 random bytes for new tables; empty if password_commitment is empty.
-	PasswordSalt         []byte   `protobuf:"bytes,14,opt,name=password_salt,json=passwordSalt,proto3" json:"password_salt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PasswordSalt []byte `protobuf:"bytes,14,opt,name=password_salt,json=passwordSalt,proto3" json:"password_salt,omitempty"`
+	// Where slashed player bonds go. Unspecified = fee collector.
+	SlashDestination SlashDestination `protobuf:"varint,15,opt,name=slash_destination,json=slashDestination,proto3,enum=onchainpoker.poker.v1.SlashDestination" json:"slash_destination,omitempty"`
+	// Abort refund policy. Unspecified = refund all commits.
+	AbortRefundPolicy    AbortRefundPolicy `protobuf:"varint,16,opt,name=abort_refund_policy,json=abortRefundPolicy,proto3,enum=onchainpoker.poker.v1.AbortRefundPolicy" json:"abort_refund_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *MsgCreateTable) Reset()         { *m = MsgCreateTable{} }
@@ -256,6 +260,7 @@ type MsgAct struct {
 	// fold|check|call|bet|raise
 	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
 	// For bet/raise: desired total street commitment ("BetTo").
+	// For call: optional; if non-zero, the call fails when the bet to match exceeds it.
 	Amount               uint64   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -379,8 +384,10 @@ func (m *MsgTickResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgTickResponse proto.InternalMessageInfo
 
 type MsgLeave struct {
-	Player               string   `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	TableId              uint64   `protobuf:"varint,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Player  string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	TableId uint64 `protobuf:"varint,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// Credit the cashier balance instead of paying out to the bank account.
+	ToBalance            bool     `protobuf:"varint,3,opt,name=to_balance,json=toBalance,proto3" json:"to_balance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -411,6 +418,9 @@ func (m *MsgLeave) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgLeave proto.InternalMessageInfo
 
 type MsgLeaveResponse struct {
+	// True when the player is still live in a hand: the seat is cashed out once
+	// the hand ends instead of immediately.
+	LeavePending         bool     `protobuf:"varint,1,opt,name=leave_pending,json=leavePending,proto3" json:"leave_pending,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -440,6 +450,13 @@ func (m *MsgLeaveResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveResponse proto.InternalMessageInfo
 
+func (m *MsgLeaveResponse) GetLeavePending() bool {
+	if m != nil {
+		return m.LeavePending
+	}
+	return false
+}
+
 type MsgRebuy struct {
 	Player               string   `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
 	TableId              uint64   `protobuf:"varint,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
@@ -511,6 +528,412 @@ func (m *MsgRebuyResponse) GetNewStack() uint64 {
 	return 0
 }
 
+type MsgSetAutoTopUp struct {
+	Player  string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	TableId uint64 `protobuf:"varint,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// target_stack 0 turns auto top-up off.
+	TargetStack          uint64   `protobuf:"varint,3,opt,name=target_stack,json=targetStack,proto3" json:"target_stack,omitempty"`
+	Allowance            uint64   `protobuf:"varint,4,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgSetAutoTopUp) Reset()         { *m = MsgSetAutoTopUp{} }
+func (m *MsgSetAutoTopUp) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoTopUp) ProtoMessage()    {}
+func (*MsgSetAutoTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f98834a333fcdff, []int{14}
+}
+func (m *MsgSetAutoTopUp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSetAutoTopUp.Unmarshal(m, b)
+}
+func (m *MsgSetAutoTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSetAutoTopUp.Marshal(b, m, deterministic)
+}
+func (m *MsgSetAutoTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoTopUp.Merge(m, src)
+}
+func (m *MsgSetAutoTopUp) XXX_Size() int {
+	return xxx_messageInfo_MsgSetAutoTopUp.Size(m)
+}
+func (m *MsgSetAutoTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoTopUp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoTopUp proto.InternalMessageInfo
+
+type MsgSetAutoTopUpResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgSetAutoTopUpResponse) Reset()         { *m = MsgSetAutoTopUpResponse{} }
+func (m *MsgSetAutoTopUpResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoTopUpResponse) ProtoMessage()    {}
+func (*MsgSetAutoTopUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f98834a333fcdff, []int{15}
+}
+func (m *MsgSetAutoTopUpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSetAutoTopUpResponse.Unmarshal(m, b)
+}
+func (m *MsgSetAutoTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSetAutoTopUpResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgSetAutoTopUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoTopUpResponse.Merge(m, src)
+}
+func (m *MsgSetAutoTopUpResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgSetAutoTopUpResponse.Size(m)
+}
+func (m *MsgSetAutoTopUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoTopUpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoTopUpResponse proto.InternalMessageInfo
+
+type MsgDeposit struct {
+	Player               string   `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Amount               uint64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
+func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgDeposit) ProtoMessage()    {}
+func (*MsgDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f98834a333fcdff, []int{16}
+}
+func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgDeposit.Unmarshal(m, b)
+}
+func (m *MsgDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgDeposit.Marshal(b, m, deterministic)
+}
+func (m *MsgDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeposit.Merge(m, src)
+}
+func (m *MsgDeposit) XXX_Size() int {
+	return xxx_messageInfo_MsgDeposit.Size(m)
+}
+func (m *MsgDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeposit proto.InternalMessageInfo
+
+type MsgDepositResponse struct {
+	Balance              uint64   `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
+func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositResponse) ProtoMessage()    {}
+func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f98834a333fcdff, []int{17}
+}
+func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgDepositResponse.Unmarshal(m, b)
+}
+func (m *MsgDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgDepositResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositResponse.Merge(m, src)
+}
+func (m *MsgDepositResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgDepositResponse.Size(m)
+}
+func (m *MsgDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
+
+func (m *MsgDepositResponse) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type MsgWithdraw struct {
+	Player               string   `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Amount               uint64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
+func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdraw) ProtoMessage()    {}
+func (*MsgWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f98834a333fcdff, []int{18}
+}
+func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgWithdraw.Unmarshal(m, b)
+}
+func (m *MsgWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgWithdraw.Marshal(b, m, deterministic)
+}
+func (m *MsgWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdraw.Merge(m, src)
+}
+func (m *MsgWithdraw) XXX_Size() int {
+	return xxx_messageInfo_MsgWithdraw.Size(m)
+}
+func (m *MsgWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdraw proto.InternalMessageInfo
+
+type MsgWithdrawResponse struct {
+	Balance              uint64   `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
+func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawResponse) ProtoMessage()    {}
+func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f98834a333fcdff, []int{19}
+}
+func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgWithdrawResponse.Unmarshal(m, b)
+}
+func (m *MsgWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgWithdrawResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawResponse.Merge(m, src)
+}
+func (m *MsgWithdrawResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgWithdrawResponse.Size(m)
+}
+func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawResponse) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type MsgChangeTable struct {
+	Player      string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	FromTableId uint64 `protobuf:"varint,2,opt,name=from_table_id,json=fromTableId,proto3" json:"from_table_id,omitempty"`
+	ToTableId   uint64 `protobuf:"varint,3,opt,name=to_table_id,json=toTableId,proto3" json:"to_table_id,omitempty"`
+	// Sit parameters for to_table_id, as in MsgSit.
+	BuyIn                uint64   `protobuf:"varint,4,opt,name=buy_in,json=buyIn,proto3" json:"buy_in,omitempty"`
+	PkPlayer             []byte   `protobuf:"bytes,5,opt,name=pk_player,json=pkPlayer,proto3" json:"pk_player,omitempty"`
+	PasswordProof        []byte   `protobuf:"bytes,6,opt,name=password_proof,json=passwordProof,proto3" json:"password_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgChangeTable) Reset()         { *m = MsgChangeTable{} }
+func (m *MsgChangeTable) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeTable) ProtoMessage()    {}
+func (*MsgChangeTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f98834a333fcdff, []int{20}
+}
+func (m *MsgChangeTable) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgChangeTable.Unmarshal(m, b)
+}
+func (m *MsgChangeTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgChangeTable.Marshal(b, m, deterministic)
+}
+func (m *MsgChangeTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeTable.Merge(m, src)
+}
+func (m *MsgChangeTable) XXX_Size() int {
+	return xxx_messageInfo_MsgChangeTable.Size(m)
+}
+func (m *MsgChangeTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeTable proto.InternalMessageInfo
+
+type MsgChangeTableResponse struct {
+	Seat                 uint32   `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgChangeTableResponse) Reset()         { *m = MsgChangeTableResponse{} }
+func (m *MsgChangeTableResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeTableResponse) ProtoMessage()    {}
+func (*MsgChangeTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f98834a333fcdff, []int{21}
+}
+func (m *MsgChangeTableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgChangeTableResponse.Unmarshal(m, b)
+}
+func (m *MsgChangeTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgChangeTableResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgChangeTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeTableResponse.Merge(m, src)
+}
+func (m *MsgChangeTableResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgChangeTableResponse.Size(m)
+}
+func (m *MsgChangeTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeTableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeTableResponse proto.InternalMessageInfo
+
+func (m *MsgChangeTableResponse) GetSeat() uint32 {
+	if m != nil {
+		return m.Seat
+	}
+	return 0
+}
+
+type MsgUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params replaces all module params; every field must be set.
+	Params               Params   `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f98834a333fcdff, []int{22}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgUpdateParams.Unmarshal(m, b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return xxx_messageInfo_MsgUpdateParams.Size(m)
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+type MsgUpdateParamsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f98834a333fcdff, []int{23}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgUpdateParamsResponse.Unmarshal(m, b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgUpdateParamsResponse.Size(m)
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+type MsgSetPauseState struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// pause_state replaces the current state; send an empty one to unpause all.
+	PauseState           PauseState `protobuf:"bytes,2,opt,name=pause_state,json=pauseState,proto3" json:"pause_state"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *MsgSetPauseState) Reset()         { *m = MsgSetPauseState{} }
+func (m *MsgSetPauseState) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPauseState) ProtoMessage()    {}
+func (*MsgSetPauseState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f98834a333fcdff, []int{24}
+}
+func (m *MsgSetPauseState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSetPauseState.Unmarshal(m, b)
+}
+func (m *MsgSetPauseState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSetPauseState.Marshal(b, m, deterministic)
+}
+func (m *MsgSetPauseState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPauseState.Merge(m, src)
+}
+func (m *MsgSetPauseState) XXX_Size() int {
+	return xxx_messageInfo_MsgSetPauseState.Size(m)
+}
+func (m *MsgSetPauseState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPauseState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPauseState proto.InternalMessageInfo
+
+type MsgSetPauseStateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgSetPauseStateResponse) Reset()         { *m = MsgSetPauseStateResponse{} }
+func (m *MsgSetPauseStateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPauseStateResponse) ProtoMessage()    {}
+func (*MsgSetPauseStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f98834a333fcdff, []int{25}
+}
+func (m *MsgSetPauseStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSetPauseStateResponse.Unmarshal(m, b)
+}
+func (m *MsgSetPauseStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSetPauseStateResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgSetPauseStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPauseStateResponse.Merge(m, src)
+}
+func (m *MsgSetPauseStateResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgSetPauseStateResponse.Size(m)
+}
+func (m *MsgSetPauseStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPauseStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPauseStateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateTable)(nil), "onchainpoker.poker.v1.MsgCreateTable")
 	proto.RegisterType((*MsgCreateTableResponse)(nil), "onchainpoker.poker.v1.MsgCreateTableResponse")
@@ -526,66 +949,110 @@ func init() {
 	proto.RegisterType((*MsgLeaveResponse)(nil), "onchainpoker.poker.v1.MsgLeaveResponse")
 	proto.RegisterType((*MsgRebuy)(nil), "onchainpoker.poker.v1.MsgRebuy")
 	proto.RegisterType((*MsgRebuyResponse)(nil), "onchainpoker.poker.v1.MsgRebuyResponse")
+	proto.RegisterType((*MsgSetAutoTopUp)(nil), "onchainpoker.poker.v1.MsgSetAutoTopUp")
+	proto.RegisterType((*MsgSetAutoTopUpResponse)(nil), "onchainpoker.poker.v1.MsgSetAutoTopUpResponse")
+	proto.RegisterType((*MsgDeposit)(nil), "onchainpoker.poker.v1.MsgDeposit")
+	proto.RegisterType((*MsgDepositResponse)(nil), "onchainpoker.poker.v1.MsgDepositResponse")
+	proto.RegisterType((*MsgWithdraw)(nil), "onchainpoker.poker.v1.MsgWithdraw")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "onchainpoker.poker.v1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgChangeTable)(nil), "onchainpoker.poker.v1.MsgChangeTable")
+	proto.RegisterType((*MsgChangeTableResponse)(nil), "onchainpoker.poker.v1.MsgChangeTableResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "onchainpoker.poker.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "onchainpoker.poker.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetPauseState)(nil), "onchainpoker.poker.v1.MsgSetPauseState")
+	proto.RegisterType((*MsgSetPauseStateResponse)(nil), "onchainpoker.poker.v1.MsgSetPauseStateResponse")
 }
 
 func init() { proto.RegisterFile("onchainpoker/poker/v1/tx.proto", fileDescriptor_6f98834a333fcdff) }
 
 var fileDescriptor_6f98834a333fcdff = []byte{
-	// 861 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x73, 0xdb, 0x44,
-	0x14, 0x46, 0xb5, 0x6c, 0xcb, 0x2f, 0x76, 0xea, 0x2a, 0x69, 0x50, 0x1d, 0x48, 0x32, 0x2a, 0x81,
-	0x4c, 0x99, 0xda, 0xb4, 0xbd, 0xf5, 0x16, 0xf7, 0x42, 0x03, 0x66, 0x8a, 0x9c, 0x13, 0x33, 0x8c,
-	0x66, 0x25, 0x2d, 0xea, 0x8e, 0xa5, 0x5d, 0x8d, 0x76, 0x9d, 0xd8, 0xb7, 0x0e, 0x27, 0xf8, 0x03,
-	0x9c, 0x39, 0x72, 0xec, 0x81, 0x5f, 0xc2, 0x85, 0x2b, 0xc3, 0xa5, 0x7f, 0x83, 0xd9, 0x5d, 0x49,
-	0xc8, 0x53, 0xa2, 0xe4, 0x10, 0x2e, 0x1e, 0xbf, 0xf7, 0x7d, 0xef, 0xed, 0xdb, 0xef, 0xbd, 0x27,
-	0x09, 0x0e, 0x18, 0x0d, 0x5f, 0x23, 0x42, 0x33, 0xb6, 0xc0, 0xf9, 0x44, 0xff, 0x5e, 0x3c, 0x99,
-	0x88, 0xd5, 0x38, 0xcb, 0x99, 0x60, 0xf6, 0xfd, 0x3a, 0x3e, 0xd6, 0xbf, 0x17, 0x4f, 0x46, 0xbb,
-	0x31, 0x8b, 0x99, 0x62, 0x4c, 0xe4, 0x3f, 0x4d, 0x1e, 0x7d, 0x18, 0x32, 0x9e, 0x32, 0x3e, 0x49,
-	0x79, 0x2c, 0x93, 0xa4, 0x3c, 0x2e, 0x80, 0x07, 0x1a, 0xf0, 0x75, 0x84, 0x36, 0x34, 0xe4, 0xfe,
-	0x6c, 0xc2, 0xf6, 0x8c, 0xc7, 0x2f, 0x72, 0x8c, 0x04, 0x3e, 0x47, 0x41, 0x82, 0xed, 0xa7, 0xd0,
-	0x0d, 0xa5, 0xc9, 0x72, 0xc7, 0x38, 0x32, 0x4e, 0x7a, 0x53, 0xe7, 0x8f, 0xdf, 0x1f, 0xef, 0x16,
-	0x51, 0xa7, 0x51, 0x94, 0x63, 0xce, 0xe7, 0x22, 0x27, 0x34, 0xf6, 0x4a, 0xa2, 0x7d, 0x08, 0x5b,
-	0x3c, 0x45, 0x49, 0xe2, 0x07, 0x09, 0xa1, 0x91, 0x73, 0xe7, 0xc8, 0x38, 0x31, 0x3d, 0x50, 0xae,
-	0xa9, 0xf4, 0xd8, 0xfb, 0xd0, 0x0b, 0x48, 0x5c, 0xc0, 0x2d, 0x05, 0x5b, 0x01, 0x89, 0x35, 0xf8,
-	0x11, 0x40, 0x4a, 0xa8, 0x1f, 0x2c, 0xd7, 0x3e, 0xa1, 0x8e, 0xa9, 0xd1, 0x94, 0xd0, 0xe9, 0x72,
-	0xfd, 0x92, 0x2a, 0x14, 0xad, 0x4a, 0xb4, 0x5d, 0xa0, 0x68, 0xa5, 0xd1, 0x31, 0xec, 0xa0, 0x50,
-	0x10, 0x46, 0x7d, 0x41, 0x52, 0xcc, 0x96, 0xc2, 0xe7, 0x38, 0xe4, 0x4e, 0x47, 0xd1, 0xee, 0x69,
-	0xe8, 0x5c, 0x23, 0x73, 0x1c, 0x72, 0xc9, 0x8f, 0x30, 0x4a, 0x70, 0xbe, 0xc9, 0xef, 0x6a, 0xbe,
-	0x86, 0xea, 0xfc, 0x43, 0xd8, 0xca, 0x12, 0xb4, 0xc6, 0xb9, 0x1f, 0x30, 0x1a, 0x39, 0x96, 0xbe,
-	0x99, 0x76, 0x4d, 0x19, 0x8d, 0xec, 0x07, 0x60, 0xe5, 0x68, 0x81, 0xfd, 0x20, 0xe3, 0x4e, 0xef,
-	0xc8, 0x38, 0x19, 0x78, 0x5d, 0x69, 0x4f, 0x33, 0x15, 0x2b, 0x2b, 0xd7, 0x64, 0xee, 0x80, 0x42,
-	0xe5, 0x65, 0x5e, 0x69, 0x8f, 0xbd, 0x0b, 0xed, 0x04, 0x05, 0x38, 0x71, 0xb6, 0xa4, 0xd0, 0x9e,
-	0x36, 0xec, 0x09, 0xec, 0x64, 0x88, 0xf3, 0x4b, 0x96, 0x47, 0x7e, 0xc8, 0xd2, 0x94, 0x88, 0x14,
-	0x53, 0xe1, 0x0c, 0x8e, 0x8c, 0x93, 0xbe, 0x67, 0x97, 0xd0, 0x8b, 0x0a, 0xb1, 0x1f, 0xc2, 0xa0,
-	0x0a, 0xe0, 0x28, 0x11, 0xce, 0xb6, 0xa2, 0xf6, 0x4b, 0xe7, 0x1c, 0x25, 0xe2, 0xf9, 0xf0, 0xa7,
-	0x5f, 0x0f, 0x3f, 0xf8, 0xf1, 0xdd, 0xdb, 0x47, 0x65, 0xd3, 0xce, 0x4c, 0xab, 0x3f, 0x1c, 0x78,
-	0x56, 0xc9, 0x72, 0x9f, 0xc1, 0xde, 0xe6, 0x28, 0x78, 0x98, 0x67, 0x8c, 0x72, 0x2c, 0xef, 0x28,
-	0xa4, 0xc3, 0x27, 0x91, 0x9a, 0x09, 0xd3, 0xeb, 0x2a, 0xfb, 0x65, 0xe4, 0xfe, 0x69, 0x40, 0x67,
-	0xc6, 0xe3, 0x39, 0x11, 0xf6, 0x17, 0xd0, 0xd1, 0x57, 0xbd, 0x76, 0x6e, 0x0a, 0xde, 0x46, 0xde,
-	0x3b, 0x1b, 0x79, 0xed, 0xfb, 0xd0, 0xd9, 0x98, 0x87, 0x76, 0xa0, 0xda, 0xbd, 0x0f, 0xbd, 0x6c,
-	0x51, 0x28, 0xaa, 0x66, 0xa1, 0xef, 0x59, 0xd9, 0x42, 0xeb, 0x69, 0x1f, 0xc3, 0x76, 0xa5, 0x43,
-	0x96, 0x33, 0xf6, 0x83, 0x6a, 0x6b, 0xdf, 0xab, 0xd4, 0x79, 0x25, 0x9d, 0xcf, 0xef, 0x96, 0x4a,
-	0x14, 0x65, 0x9c, 0x99, 0x56, 0x6b, 0x68, 0x9e, 0x99, 0x56, 0x67, 0xd8, 0xad, 0xc9, 0xf1, 0x89,
-	0xda, 0x8c, 0x39, 0x11, 0x95, 0x0c, 0x36, 0x98, 0x1c, 0x23, 0xa1, 0xae, 0x37, 0xf0, 0xd4, 0x7f,
-	0x37, 0x81, 0xbe, 0x64, 0x09, 0x94, 0x8b, 0x2f, 0x11, 0x8d, 0xa4, 0x08, 0x21, 0x4a, 0x92, 0x9b,
-	0x88, 0xa0, 0x79, 0x0d, 0x22, 0xd4, 0x2a, 0xd5, 0x5c, 0x77, 0x0f, 0x76, 0xeb, 0xa7, 0x95, 0x95,
-	0xb9, 0xbf, 0xe8, 0x2e, 0x9c, 0x86, 0xb7, 0xdc, 0x85, 0x3d, 0xe8, 0xe8, 0x15, 0x52, 0x3b, 0xdb,
-	0xf3, 0x0a, 0x4b, 0xf9, 0x53, 0xb6, 0xa4, 0xa2, 0xe8, 0x4e, 0x61, 0xbd, 0x27, 0xad, 0x3b, 0x54,
-	0x22, 0x9e, 0x86, 0x95, 0x88, 0x6e, 0x0c, 0xdd, 0x19, 0x8f, 0xcf, 0x49, 0xb8, 0xf8, 0x9f, 0xb5,
-	0xba, 0x07, 0x77, 0x8b, 0x83, 0xaa, 0xb3, 0x5f, 0x83, 0x35, 0xe3, 0xf1, 0xd7, 0x18, 0x5d, 0xe0,
-	0x5b, 0xd5, 0xe9, 0xfd, 0x7b, 0xdb, 0x30, 0x2c, 0x4f, 0xaa, 0x4e, 0x7f, 0x63, 0xa8, 0xe3, 0x3d,
-	0x1c, 0x2c, 0xd7, 0xb7, 0xdf, 0x26, 0xdd, 0x8e, 0x56, 0x73, 0x3b, 0x26, 0xaa, 0x2c, 0x55, 0x41,
-	0x35, 0xd5, 0xfb, 0xd0, 0xa3, 0xf8, 0xd2, 0xe7, 0x02, 0x85, 0x8b, 0x62, 0xbb, 0x2d, 0x8a, 0x2f,
-	0xe7, 0xd2, 0x7e, 0xfa, 0x97, 0x09, 0xad, 0x19, 0x8f, 0xed, 0x10, 0xb6, 0xea, 0xef, 0x88, 0xe3,
-	0xf1, 0x7f, 0xbe, 0x98, 0xc6, 0x9b, 0xcf, 0x8f, 0xd1, 0xe3, 0x1b, 0xd1, 0xaa, 0x4a, 0xbe, 0x82,
-	0x96, 0x7c, 0x8e, 0x7c, 0x7c, 0x75, 0xd4, 0x9c, 0x88, 0xd1, 0x71, 0x23, 0x5c, 0x25, 0xfb, 0x1e,
-	0x7a, 0xff, 0x6e, 0xe5, 0xc3, 0x86, 0x98, 0x92, 0x34, 0xfa, 0xfc, 0x06, 0xa4, 0x7a, 0xad, 0x72,
-	0xdb, 0x1a, 0x6a, 0x3d, 0x0d, 0x1b, 0x6b, 0xad, 0xed, 0x84, 0xfd, 0x0d, 0x98, 0x6a, 0x21, 0x0e,
-	0xae, 0xa6, 0x4b, 0x7c, 0xf4, 0x69, 0x33, 0x5e, 0xe5, 0xfb, 0x16, 0xda, 0x7a, 0xc8, 0x0f, 0xaf,
-	0x0e, 0x50, 0x84, 0xd1, 0x67, 0xd7, 0x10, 0xea, 0x29, 0xf5, 0xe0, 0x36, 0xa4, 0x54, 0x84, 0xa6,
-	0x94, 0x1b, 0x83, 0x37, 0x6a, 0xbf, 0x79, 0xf7, 0xf6, 0x91, 0x31, 0xdd, 0xf9, 0xed, 0xef, 0x03,
-	0xe3, 0xbb, 0xc1, 0xaa, 0xf8, 0xfc, 0x11, 0xeb, 0x0c, 0xf3, 0xa0, 0xa3, 0x3e, 0x4f, 0x9e, 0xfd,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x2e, 0x57, 0x01, 0x21, 0x09, 0x00, 0x00,
+	// 1368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x3f, 0x6f, 0xdb, 0x46,
+	0x14, 0x8f, 0x62, 0x59, 0xa2, 0x9e, 0x24, 0x47, 0xa6, 0x1d, 0x87, 0x61, 0x12, 0xff, 0x61, 0x9a,
+	0xda, 0x4d, 0x1b, 0xa9, 0x71, 0x80, 0x16, 0x48, 0x27, 0x29, 0x19, 0x12, 0xb7, 0x2a, 0x5c, 0x4a,
+	0x41, 0x8a, 0x00, 0x05, 0x71, 0x22, 0xcf, 0x34, 0x61, 0x8a, 0x47, 0xf0, 0x4e, 0xb1, 0xb5, 0x05,
+	0x5d, 0xda, 0x4f, 0xd0, 0xa1, 0x53, 0xc7, 0x8c, 0x19, 0xda, 0xef, 0xd0, 0xa9, 0x43, 0x97, 0x6e,
+	0x1d, 0xba, 0x64, 0xef, 0x27, 0x28, 0xee, 0x8e, 0xa4, 0x28, 0x27, 0xa2, 0xd5, 0xc0, 0x59, 0x04,
+	0xdd, 0x7b, 0xbf, 0x7b, 0xef, 0xf7, 0xfe, 0xdc, 0xbd, 0x03, 0x61, 0x9d, 0x04, 0xf6, 0x21, 0xf2,
+	0x82, 0x90, 0x1c, 0xe1, 0xa8, 0x25, 0x7f, 0x9f, 0xdf, 0x6d, 0xb1, 0x93, 0x66, 0x18, 0x11, 0x46,
+	0xd4, 0xcb, 0x59, 0x7d, 0x53, 0xfe, 0x3e, 0xbf, 0xab, 0xaf, 0xba, 0xc4, 0x25, 0x02, 0xd1, 0xe2,
+	0xff, 0x24, 0x58, 0xbf, 0x62, 0x13, 0x3a, 0x24, 0xb4, 0x35, 0xa4, 0x2e, 0x37, 0x32, 0xa4, 0x6e,
+	0xac, 0xb8, 0x2a, 0x15, 0x96, 0xdc, 0x21, 0x17, 0xb1, 0x6a, 0xeb, 0xed, 0x04, 0x62, 0x7f, 0x1c,
+	0x62, 0xfc, 0xb1, 0x08, 0x4b, 0x5d, 0xea, 0x3e, 0x88, 0x30, 0x62, 0xb8, 0x8f, 0x06, 0x3e, 0x56,
+	0x77, 0xa1, 0x6c, 0xf3, 0x25, 0x89, 0xb4, 0xc2, 0x66, 0x61, 0xa7, 0xd2, 0xd1, 0xfe, 0xfc, 0xf5,
+	0xce, 0x6a, 0x6c, 0xb8, 0xed, 0x38, 0x11, 0xa6, 0xb4, 0xc7, 0x22, 0x2f, 0x70, 0xcd, 0x04, 0xa8,
+	0x6e, 0x40, 0x95, 0x0e, 0x91, 0xef, 0x5b, 0x03, 0xdf, 0x0b, 0x1c, 0xed, 0xe2, 0x66, 0x61, 0xa7,
+	0x68, 0x82, 0x10, 0x75, 0xb8, 0x44, 0xbd, 0x06, 0x95, 0x81, 0xe7, 0xc6, 0xea, 0x05, 0xa1, 0x56,
+	0x06, 0x9e, 0x2b, 0x95, 0xd7, 0x01, 0x86, 0x5e, 0x60, 0x0d, 0x46, 0x63, 0xcb, 0x0b, 0xb4, 0xa2,
+	0xd4, 0x0e, 0xbd, 0xa0, 0x33, 0x1a, 0x3f, 0x0e, 0x84, 0x16, 0x9d, 0x24, 0xda, 0xc5, 0x58, 0x8b,
+	0x4e, 0xa4, 0xb6, 0x09, 0x2b, 0xc8, 0x66, 0x1e, 0x09, 0x2c, 0xe6, 0x0d, 0x31, 0x19, 0x31, 0x8b,
+	0x62, 0x9b, 0x6a, 0x25, 0x01, 0x5b, 0x96, 0xaa, 0xbe, 0xd4, 0xf4, 0xb0, 0x4d, 0x39, 0xde, 0xc1,
+	0xc8, 0xc7, 0xd1, 0x34, 0xbe, 0x2c, 0xf1, 0x52, 0x95, 0xc5, 0x6f, 0x40, 0x35, 0xf4, 0xd1, 0x18,
+	0x47, 0xd6, 0x80, 0x04, 0x8e, 0xa6, 0xc8, 0xc8, 0xa4, 0xa8, 0x43, 0x02, 0x47, 0xbd, 0x0a, 0x4a,
+	0x84, 0x8e, 0xb0, 0x35, 0x08, 0xa9, 0x56, 0xd9, 0x2c, 0xec, 0xd4, 0xcd, 0x32, 0x5f, 0x77, 0x42,
+	0xb1, 0x97, 0x33, 0x97, 0x60, 0xaa, 0x81, 0xd0, 0xf2, 0x60, 0xf6, 0xa5, 0x44, 0x5d, 0x85, 0x45,
+	0x1f, 0x0d, 0xb0, 0xaf, 0x55, 0x79, 0xa2, 0x4d, 0xb9, 0x50, 0x5b, 0xb0, 0x12, 0x22, 0x4a, 0x8f,
+	0x49, 0xe4, 0x58, 0x36, 0x19, 0x0e, 0x3d, 0x36, 0xc4, 0x01, 0xd3, 0xea, 0x9b, 0x85, 0x9d, 0x9a,
+	0xa9, 0x26, 0xaa, 0x07, 0xa9, 0x46, 0xbd, 0x09, 0xf5, 0x74, 0x03, 0x45, 0x3e, 0xd3, 0x96, 0x04,
+	0xb4, 0x96, 0x08, 0x7b, 0xc8, 0x67, 0x6a, 0x1f, 0x96, 0xa9, 0x8f, 0xe8, 0xa1, 0xe5, 0x60, 0xca,
+	0xbc, 0x00, 0xf1, 0xc4, 0x68, 0x97, 0x36, 0x0b, 0x3b, 0x4b, 0xbb, 0xdb, 0xcd, 0xb7, 0x76, 0x62,
+	0xb3, 0xc7, 0xf1, 0x0f, 0x27, 0x70, 0xb3, 0x41, 0x4f, 0x49, 0xd4, 0x6f, 0x61, 0x05, 0x0d, 0x48,
+	0xc4, 0xac, 0x08, 0x1f, 0x8c, 0x02, 0xc7, 0x0a, 0x89, 0xef, 0xd9, 0x63, 0xad, 0x21, 0xec, 0xee,
+	0xcc, 0xb0, 0xdb, 0xe6, 0x3b, 0x4c, 0xb1, 0x61, 0x5f, 0xe0, 0xcd, 0x65, 0x74, 0x5a, 0x74, 0xbf,
+	0xf1, 0xe3, 0x2f, 0x1b, 0x17, 0xbe, 0x7f, 0xfd, 0xea, 0x76, 0xd2, 0x64, 0x7b, 0x45, 0xa5, 0xd6,
+	0xa8, 0xef, 0x15, 0x95, 0xe5, 0x86, 0x6a, 0x2a, 0x49, 0x6c, 0x66, 0x5d, 0xfa, 0x0f, 0x71, 0x80,
+	0x7c, 0x36, 0x36, 0xee, 0xc1, 0xda, 0x74, 0x3f, 0x9b, 0x98, 0x86, 0x24, 0xa0, 0x98, 0x17, 0x8a,
+	0x71, 0x81, 0xe5, 0x39, 0xa2, 0xb1, 0x8b, 0x66, 0x59, 0xac, 0x1f, 0x3b, 0xc6, 0x5f, 0x05, 0x28,
+	0x75, 0xa9, 0xdb, 0xf3, 0x98, 0xfa, 0x29, 0x94, 0x64, 0xbd, 0xce, 0x6c, 0xfe, 0x18, 0x37, 0x65,
+	0xf7, 0xe2, 0x94, 0x5d, 0xf5, 0x32, 0x94, 0xa6, 0x9a, 0x7a, 0x71, 0x20, 0x7a, 0xf6, 0x1a, 0x54,
+	0xc2, 0xa3, 0xb8, 0x2d, 0x44, 0x43, 0xd7, 0x4c, 0x25, 0x3c, 0x92, 0x4d, 0xa1, 0xde, 0x82, 0xa5,
+	0xb4, 0x98, 0x61, 0x44, 0xc8, 0x81, 0xe8, 0xcd, 0x9a, 0x99, 0x96, 0x78, 0x9f, 0x0b, 0xef, 0x5f,
+	0x4a, 0xd2, 0x13, 0xd3, 0xd8, 0x2b, 0x2a, 0x0b, 0x8d, 0xe2, 0x5e, 0x51, 0x29, 0x35, 0xca, 0x93,
+	0xec, 0x18, 0x1f, 0x88, 0xe3, 0xdd, 0xf3, 0x58, 0x9a, 0x06, 0x15, 0x8a, 0x14, 0x23, 0x26, 0xc2,
+	0xab, 0x9b, 0xe2, 0xbf, 0xe1, 0x43, 0x8d, 0xa3, 0x18, 0x8a, 0xd8, 0x23, 0x14, 0x38, 0x3c, 0x09,
+	0x36, 0xf2, 0xfd, 0x79, 0x92, 0x20, 0x71, 0x39, 0x49, 0xc8, 0x30, 0x95, 0x58, 0x63, 0x0d, 0x56,
+	0xb3, 0xde, 0x12, 0x66, 0xc6, 0x4f, 0xb2, 0x0a, 0x6d, 0xfb, 0x9c, 0xab, 0xb0, 0x06, 0x25, 0x79,
+	0x0f, 0x88, 0x8b, 0xa7, 0x62, 0xc6, 0x2b, 0x21, 0x1f, 0x92, 0x51, 0xc0, 0xe2, 0xea, 0xc4, 0xab,
+	0x37, 0x52, 0x6b, 0x34, 0x44, 0x12, 0xdb, 0x76, 0x9a, 0x44, 0xc3, 0x85, 0x72, 0x97, 0xba, 0x7d,
+	0xcf, 0x3e, 0x7a, 0xcf, 0xb9, 0x5a, 0x86, 0x4b, 0xb1, 0xa3, 0xd4, 0xf7, 0x0f, 0x05, 0x50, 0xba,
+	0xd4, 0xfd, 0x0a, 0xa3, 0xe7, 0xf8, 0x7c, 0x13, 0x75, 0x03, 0x80, 0x11, 0x6b, 0x80, 0x7c, 0x14,
+	0xd8, 0x58, 0x24, 0x4b, 0x31, 0x2b, 0x8c, 0x74, 0xa4, 0xe0, 0xcd, 0xbc, 0x7c, 0x0e, 0x8d, 0x84,
+	0x48, 0xda, 0x5e, 0x37, 0xa1, 0xee, 0x73, 0x01, 0x3f, 0x90, 0x8e, 0x17, 0xb8, 0x82, 0x97, 0x62,
+	0xd6, 0x84, 0x70, 0x5f, 0xca, 0x8c, 0x17, 0x32, 0x04, 0x13, 0x0f, 0x46, 0xe3, 0xf3, 0xaf, 0xb5,
+	0xac, 0xe9, 0x42, 0x7e, 0x4d, 0x5b, 0xd0, 0x48, 0x18, 0xa4, 0xdc, 0xaf, 0x41, 0x25, 0xc0, 0xc7,
+	0x16, 0x65, 0xc8, 0x3e, 0x8a, 0xaf, 0x08, 0x25, 0xc0, 0xc7, 0x3d, 0xbe, 0x36, 0x5e, 0x15, 0x44,
+	0x29, 0x7a, 0x98, 0xb5, 0x47, 0x8c, 0xf4, 0x49, 0xf8, 0x24, 0x3c, 0x5f, 0xea, 0x5b, 0x50, 0x63,
+	0x28, 0x72, 0x31, 0x8b, 0x09, 0xc8, 0x00, 0xaa, 0x52, 0x26, 0x38, 0xa8, 0xd7, 0xa1, 0x82, 0x7c,
+	0x9f, 0x1c, 0x8b, 0xfa, 0xc8, 0xa6, 0x9d, 0x08, 0xde, 0x8c, 0xf1, 0x2a, 0x5c, 0x39, 0xc5, 0x38,
+	0xd3, 0xc0, 0xd0, 0xa5, 0xee, 0x43, 0x1c, 0x12, 0xfa, 0x4e, 0x97, 0xde, 0x24, 0xcf, 0x17, 0xf3,
+	0xf3, 0xdc, 0x04, 0x75, 0xe2, 0x28, 0xcd, 0xb4, 0x06, 0xe5, 0xa4, 0xcd, 0xe2, 0xab, 0x38, 0x5e,
+	0x1a, 0x87, 0x50, 0xed, 0x52, 0xf7, 0xa9, 0xc7, 0x0e, 0x9d, 0x08, 0x1d, 0xbf, 0x4f, 0x66, 0x2d,
+	0x58, 0xc9, 0x78, 0x9a, 0x83, 0xda, 0xbf, 0x05, 0xf9, 0x56, 0x3a, 0x44, 0x81, 0x1b, 0xbf, 0x95,
+	0xfe, 0x3f, 0x3d, 0x03, 0xea, 0x07, 0x11, 0x19, 0x5a, 0xa7, 0xba, 0xa0, 0xca, 0x85, 0xfd, 0xb8,
+	0x13, 0xd6, 0xa1, 0xca, 0xc8, 0x04, 0x21, 0x1b, 0xa1, 0xc2, 0x48, 0xff, 0x5c, 0xc7, 0x4a, 0x69,
+	0x9e, 0xb1, 0x62, 0x7c, 0x02, 0x6b, 0xd3, 0x31, 0xe7, 0x0e, 0x92, 0x9f, 0xe5, 0x21, 0x79, 0x12,
+	0x3a, 0x88, 0xe1, 0x7d, 0x14, 0xa1, 0x21, 0x55, 0x3f, 0x83, 0x0a, 0x1a, 0xb1, 0x43, 0x12, 0x79,
+	0x6c, 0x7c, 0x66, 0x9a, 0x26, 0x50, 0xf5, 0x0b, 0x28, 0x85, 0xc2, 0x82, 0x48, 0x51, 0x75, 0xf7,
+	0xc6, 0x8c, 0xd7, 0x84, 0x74, 0xd3, 0x29, 0xfe, 0xfe, 0xf7, 0xc6, 0x05, 0x33, 0xde, 0x72, 0x5f,
+	0x4d, 0xe2, 0x98, 0x18, 0x8c, 0x8f, 0x43, 0x96, 0x5b, 0x7a, 0x1c, 0x5e, 0x16, 0xc4, 0x75, 0xd0,
+	0xc3, 0x6c, 0x1f, 0x8d, 0x28, 0xee, 0x31, 0xc4, 0xf0, 0x3b, 0x13, 0x7f, 0x04, 0xd5, 0x90, 0x5b,
+	0xe1, 0xe7, 0x98, 0xe1, 0x98, 0xfd, 0xd6, 0x4c, 0xf6, 0x89, 0xbf, 0x38, 0x02, 0x08, 0x53, 0xc9,
+	0x5b, 0xa3, 0xd0, 0x41, 0x3b, 0xcd, 0x34, 0x09, 0x63, 0xf7, 0xb7, 0x0a, 0x2c, 0x74, 0xa9, 0xab,
+	0xda, 0x50, 0xcd, 0xbe, 0xe8, 0x6f, 0xcd, 0xf0, 0x3d, 0xfd, 0x50, 0xd2, 0xef, 0xcc, 0x05, 0x4b,
+	0xeb, 0xff, 0x25, 0x2c, 0xf0, 0x07, 0xd3, 0x8d, 0xd9, 0xbb, 0x7a, 0x1e, 0xd3, 0x6f, 0xe5, 0xaa,
+	0x53, 0x63, 0xdf, 0x41, 0x65, 0xf2, 0xfc, 0xb8, 0x99, 0xb3, 0x27, 0x01, 0xe9, 0x1f, 0xcf, 0x01,
+	0xca, 0x72, 0x6d, 0xdb, 0xb9, 0x5c, 0xdb, 0x76, 0x2e, 0xd7, 0xcc, 0xf0, 0x57, 0xbf, 0x86, 0xa2,
+	0x98, 0xfc, 0xeb, 0xb3, 0xe1, 0x5c, 0xaf, 0x7f, 0x98, 0xaf, 0x4f, 0xed, 0x7d, 0x03, 0x8b, 0x72,
+	0x98, 0x6f, 0xcc, 0xde, 0x20, 0x00, 0xfa, 0xf6, 0x19, 0x80, 0xac, 0x49, 0x39, 0x5c, 0x73, 0x4c,
+	0x0a, 0x80, 0xbe, 0x7d, 0x06, 0x20, 0x35, 0x79, 0x00, 0xb5, 0xa9, 0xd9, 0x97, 0x13, 0x5d, 0x16,
+	0xa7, 0x37, 0xe7, 0xc3, 0xa5, 0x7e, 0x9e, 0x42, 0x39, 0x19, 0x4b, 0x5b, 0xb3, 0xb7, 0xc6, 0x10,
+	0xfd, 0xa3, 0x33, 0x21, 0xa9, 0xe1, 0x67, 0xa0, 0xa4, 0x63, 0xc5, 0x98, 0xbd, 0x2d, 0xc1, 0xe8,
+	0xb7, 0xcf, 0xc6, 0xa4, 0xb6, 0xf9, 0x81, 0xcb, 0x8c, 0x85, 0xbc, 0x03, 0x37, 0x81, 0xe9, 0x77,
+	0xe6, 0x82, 0x65, 0x2b, 0x30, 0x75, 0xb1, 0xe6, 0x54, 0x20, 0x8b, 0xd3, 0x9b, 0xf3, 0xe1, 0x52,
+	0x3f, 0x1e, 0xd4, 0xa7, 0x2f, 0xc2, 0xed, 0xdc, 0x12, 0x4e, 0x80, 0x7a, 0x6b, 0x4e, 0x60, 0xe2,
+	0x4a, 0x5f, 0x7c, 0xf1, 0xfa, 0xd5, 0xed, 0x42, 0x67, 0xe5, 0xe5, 0x3f, 0xeb, 0x85, 0x67, 0xf5,
+	0x93, 0xf8, 0x1b, 0x05, 0x1b, 0x87, 0x98, 0x0e, 0x4a, 0xe2, 0x0b, 0xc5, 0xbd, 0xff, 0x06, 0x00,
+	0x36, 0x41, 0xa4, 0x22, 0x47, 0x11, 0x00, 0x00,
 }
 
 func (this *MsgCreateTable) Equal(that interface{}) bool {
@@ -646,6 +1113,12 @@ func (this *MsgCreateTable) Equal(that interface{}) bool {
 	if !bytes.Equal(this.PasswordSalt, that1.PasswordSalt) {
 		return false
 	}
+	if this.SlashDestination != that1.SlashDestination {
+		return false
+	}
+	if this.AbortRefundPolicy != that1.AbortRefundPolicy {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -937,6 +1410,9 @@ func (this *MsgLeave) Equal(that interface{}) bool {
 	if this.TableId != that1.TableId {
 		return false
 	}
+	if this.ToBalance != that1.ToBalance {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -961,6 +1437,9 @@ func (this *MsgLeaveResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.LeavePending != that1.LeavePending {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1026,61 +1505,428 @@ func (this *MsgRebuyResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreateTable(ctx context.Context, in *MsgCreateTable, opts ...grpc.CallOption) (*MsgCreateTableResponse, error)
-	Sit(ctx context.Context, in *MsgSit, opts ...grpc.CallOption) (*MsgSitResponse, error)
-	StartHand(ctx context.Context, in *MsgStartHand, opts ...grpc.CallOption) (*MsgStartHandResponse, error)
-	Act(ctx context.Context, in *MsgAct, opts ...grpc.CallOption) (*MsgActResponse, error)
-	Tick(ctx context.Context, in *MsgTick, opts ...grpc.CallOption) (*MsgTickResponse, error)
-	Leave(ctx context.Context, in *MsgLeave, opts ...grpc.CallOption) (*MsgLeaveResponse, error)
-	Rebuy(ctx context.Context, in *MsgRebuy, opts ...grpc.CallOption) (*MsgRebuyResponse, error)
-}
-
-type msgClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
-}
-
-func (c *msgClient) CreateTable(ctx context.Context, in *MsgCreateTable, opts ...grpc.CallOption) (*MsgCreateTableResponse, error) {
-	out := new(MsgCreateTableResponse)
-	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Msg/CreateTable", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *MsgSetAutoTopUp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *msgClient) Sit(ctx context.Context, in *MsgSit, opts ...grpc.CallOption) (*MsgSitResponse, error) {
-	out := new(MsgSitResponse)
-	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Msg/Sit", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgSetAutoTopUp)
+	if !ok {
+		that2, ok := that.(MsgSetAutoTopUp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *msgClient) StartHand(ctx context.Context, in *MsgStartHand, opts ...grpc.CallOption) (*MsgStartHandResponse, error) {
-	out := new(MsgStartHandResponse)
-	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Msg/StartHand", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
+	if this.Player != that1.Player {
+		return false
+	}
+	if this.TableId != that1.TableId {
+		return false
+	}
+	if this.TargetStack != that1.TargetStack {
+		return false
+	}
+	if this.Allowance != that1.Allowance {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgSetAutoTopUpResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoTopUpResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoTopUpResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDeposit)
+	if !ok {
+		that2, ok := that.(MsgDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Player != that1.Player {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgDepositResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDepositResponse)
+	if !ok {
+		that2, ok := that.(MsgDepositResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgWithdraw) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdraw)
+	if !ok {
+		that2, ok := that.(MsgWithdraw)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Player != that1.Player {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgWithdrawResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgChangeTable) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgChangeTable)
+	if !ok {
+		that2, ok := that.(MsgChangeTable)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Player != that1.Player {
+		return false
+	}
+	if this.FromTableId != that1.FromTableId {
+		return false
+	}
+	if this.ToTableId != that1.ToTableId {
+		return false
+	}
+	if this.BuyIn != that1.BuyIn {
+		return false
+	}
+	if !bytes.Equal(this.PkPlayer, that1.PkPlayer) {
+		return false
+	}
+	if !bytes.Equal(this.PasswordProof, that1.PasswordProof) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgChangeTableResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgChangeTableResponse)
+	if !ok {
+		that2, ok := that.(MsgChangeTableResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Seat != that1.Seat {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgSetPauseState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetPauseState)
+	if !ok {
+		that2, ok := that.(MsgSetPauseState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.PauseState.Equal(&that1.PauseState) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MsgSetPauseStateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetPauseStateResponse)
+	if !ok {
+		that2, ok := that.(MsgSetPauseStateResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateTable(ctx context.Context, in *MsgCreateTable, opts ...grpc.CallOption) (*MsgCreateTableResponse, error)
+	Sit(ctx context.Context, in *MsgSit, opts ...grpc.CallOption) (*MsgSitResponse, error)
+	StartHand(ctx context.Context, in *MsgStartHand, opts ...grpc.CallOption) (*MsgStartHandResponse, error)
+	Act(ctx context.Context, in *MsgAct, opts ...grpc.CallOption) (*MsgActResponse, error)
+	Tick(ctx context.Context, in *MsgTick, opts ...grpc.CallOption) (*MsgTickResponse, error)
+	Leave(ctx context.Context, in *MsgLeave, opts ...grpc.CallOption) (*MsgLeaveResponse, error)
+	Rebuy(ctx context.Context, in *MsgRebuy, opts ...grpc.CallOption) (*MsgRebuyResponse, error)
+	// SetAutoTopUp configures (or, with target_stack 0, clears) the caller's
+	// automatic between-hand top-up at a table.
+	SetAutoTopUp(ctx context.Context, in *MsgSetAutoTopUp, opts ...grpc.CallOption) (*MsgSetAutoTopUpResponse, error)
+	// Deposit moves chips from the player's bank account to their cashier
+	// balance; Withdraw moves them back.
+	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// ChangeTable leaves one table for the cashier balance and sits at another
+	// funded from it, in one message.
+	ChangeTable(ctx context.Context, in *MsgChangeTable, opts ...grpc.CallOption) (*MsgChangeTableResponse, error)
+	// UpdateParams replaces the module params. Only the module authority
+	// (x/gov by default) may call it.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetPauseState replaces the circuit breaker state. Only the module
+	// authority may call it.
+	SetPauseState(ctx context.Context, in *MsgSetPauseState, opts ...grpc.CallOption) (*MsgSetPauseStateResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateTable(ctx context.Context, in *MsgCreateTable, opts ...grpc.CallOption) (*MsgCreateTableResponse, error) {
+	out := new(MsgCreateTableResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Msg/CreateTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Sit(ctx context.Context, in *MsgSit, opts ...grpc.CallOption) (*MsgSitResponse, error) {
+	out := new(MsgSitResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Msg/Sit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StartHand(ctx context.Context, in *MsgStartHand, opts ...grpc.CallOption) (*MsgStartHandResponse, error) {
+	out := new(MsgStartHandResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Msg/StartHand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (c *msgClient) Act(ctx context.Context, in *MsgAct, opts ...grpc.CallOption) (*MsgActResponse, error) {
@@ -1119,6 +1965,60 @@ func (c *msgClient) Rebuy(ctx context.Context, in *MsgRebuy, opts ...grpc.CallOp
 	return out, nil
 }
 
+func (c *msgClient) SetAutoTopUp(ctx context.Context, in *MsgSetAutoTopUp, opts ...grpc.CallOption) (*MsgSetAutoTopUpResponse, error) {
+	out := new(MsgSetAutoTopUpResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Msg/SetAutoTopUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Msg/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Msg/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeTable(ctx context.Context, in *MsgChangeTable, opts ...grpc.CallOption) (*MsgChangeTableResponse, error) {
+	out := new(MsgChangeTableResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Msg/ChangeTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPauseState(ctx context.Context, in *MsgSetPauseState, opts ...grpc.CallOption) (*MsgSetPauseStateResponse, error) {
+	out := new(MsgSetPauseStateResponse)
+	err := c.cc.Invoke(ctx, "/onchainpoker.poker.v1.Msg/SetPauseState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateTable(context.Context, *MsgCreateTable) (*MsgCreateTableResponse, error)
@@ -1128,6 +2028,22 @@ type MsgServer interface {
 	Tick(context.Context, *MsgTick) (*MsgTickResponse, error)
 	Leave(context.Context, *MsgLeave) (*MsgLeaveResponse, error)
 	Rebuy(context.Context, *MsgRebuy) (*MsgRebuyResponse, error)
+	// SetAutoTopUp configures (or, with target_stack 0, clears) the caller's
+	// automatic between-hand top-up at a table.
+	SetAutoTopUp(context.Context, *MsgSetAutoTopUp) (*MsgSetAutoTopUpResponse, error)
+	// Deposit moves chips from the player's bank account to their cashier
+	// balance; Withdraw moves them back.
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	// ChangeTable leaves one table for the cashier balance and sits at another
+	// funded from it, in one message.
+	ChangeTable(context.Context, *MsgChangeTable) (*MsgChangeTableResponse, error)
+	// UpdateParams replaces the module params. Only the module authority
+	// (x/gov by default) may call it.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetPauseState replaces the circuit breaker state. Only the module
+	// authority may call it.
+	SetPauseState(context.Context, *MsgSetPauseState) (*MsgSetPauseStateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1155,6 +2071,24 @@ func (*UnimplementedMsgServer) Leave(ctx context.Context, req *MsgLeave) (*MsgLe
 func (*UnimplementedMsgServer) Rebuy(ctx context.Context, req *MsgRebuy) (*MsgRebuyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rebuy not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoTopUp(ctx context.Context, req *MsgSetAutoTopUp) (*MsgSetAutoTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoTopUp not implemented")
+}
+func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (*UnimplementedMsgServer) ChangeTable(ctx context.Context, req *MsgChangeTable) (*MsgChangeTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeTable not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetPauseState(ctx context.Context, req *MsgSetPauseState) (*MsgSetPauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPauseState not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1286,6 +2220,114 @@ func _Msg_Rebuy_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoTopUp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.poker.v1.Msg/SetAutoTopUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoTopUp(ctx, req.(*MsgSetAutoTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.poker.v1.Msg/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.poker.v1.Msg/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeTable)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.poker.v1.Msg/ChangeTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeTable(ctx, req.(*MsgChangeTable))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.poker.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPauseState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onchainpoker.poker.v1.Msg/SetPauseState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPauseState(ctx, req.(*MsgSetPauseState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "onchainpoker.poker.v1.Msg",
@@ -1319,6 +2361,30 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Rebuy",
 			Handler:    _Msg_Rebuy_Handler,
 		},
+		{
+			MethodName: "SetAutoTopUp",
+			Handler:    _Msg_SetAutoTopUp_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "ChangeTable",
+			Handler:    _Msg_ChangeTable_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetPauseState",
+			Handler:    _Msg_SetPauseState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "onchainpoker/poker/v1/tx.proto",